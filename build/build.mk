@@ -122,6 +122,7 @@ BINARIES := docgen:./cmd/docgen \
 	dynamic-rp:./cmd/dynamic-rp \
 	ucpd:./cmd/ucpd \
 	controller:./cmd/controller \
+	kubernetes-recipe-driver:./cmd/kubernetes-recipe-driver \
 	testrp:./test/testrp \
 	magpiego:./test/magpiego \
 	pre-upgrade:./cmd/pre-upgrade
@@ -244,7 +244,23 @@ CREATE TABLE IF NOT EXISTS resources (
   resource_data jsonb NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_resource_query ON resources (resource_type, root_scope);
+CREATE TABLE IF NOT EXISTS queue_messages (
+  id TEXT PRIMARY KEY NOT NULL,
+  queue_name TEXT NOT NULL,
+  dequeue_count INTEGER NOT NULL DEFAULT 0,
+  enqueue_at timestamp(6) with time zone NOT NULL,
+  expire_at timestamp(6) with time zone NOT NULL,
+  next_visible_at timestamp(6) with time zone NOT NULL,
+  content_type TEXT NOT NULL,
+  data bytea NOT NULL,
+  dead_letter_reason TEXT,
+  dead_letter_error TEXT,
+  dead_letter_stack TEXT,
+  dead_lettered_at timestamp(6) with time zone
+);
+CREATE INDEX IF NOT EXISTS idx_queue_messages_dequeue ON queue_messages (queue_name, next_visible_at) WHERE dead_lettered_at IS NULL;
 GRANT ALL PRIVILEGES ON TABLE resources TO ${db_user};
+GRANT ALL PRIVILEGES ON TABLE queue_messages TO ${db_user};
 GRANT ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public TO ${db_user};
 "
 
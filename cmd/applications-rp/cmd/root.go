@@ -22,14 +22,17 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
 	runtimelog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/recipes/drift"
 	"github.com/radius-project/radius/pkg/server"
 
 	"github.com/radius-project/radius/pkg/components/hosting"
@@ -41,7 +44,25 @@ import (
 	msgrp_setup "github.com/radius-project/radius/pkg/messagingrp/setup"
 )
 
-const serviceName = "radius"
+const (
+	serviceName = "radius"
+
+	// driftLeaseName is the name of the lease held by the applications-rp replica that checks for drift.
+	driftLeaseName = "applications-rp-drift-detector"
+)
+
+// driftResourceTypes are the portable resource types served by applications-rp that can be deployed by recipes.
+var driftResourceTypes = []string{
+	"Applications.Core/extenders",
+	"Applications.Dapr/configurationStores",
+	"Applications.Dapr/pubSubBrokers",
+	"Applications.Dapr/secretStores",
+	"Applications.Dapr/stateStores",
+	"Applications.Datastores/mongoDatabases",
+	"Applications.Datastores/redisCaches",
+	"Applications.Datastores/sqlDatabases",
+	"Applications.Messaging/rabbitMQQueues",
+}
 
 var rootCmd = &cobra.Command{
 	Use:   "applications-rp",
@@ -76,17 +97,24 @@ var rootCmd = &cobra.Command{
 			services = append(services, &traceservice.Service{Options: &options.Config.TracerProvider})
 		}
 
-		builders, err := builders(options)
+		config, err := controllerconfig.New(options)
 		if err != nil {
 			return err
 		}
 
+		builders := builders(config)
+
 		services = append(
 			services,
 			server.NewAPIService(options, builders),
 			server.NewAsyncWorker(options, builders),
 		)
 
+		// Drift detection is opt-in because planning recipes calls the cloud providers.
+		if options.Config.DriftDetection.Enabled {
+			services = append(services, newDriftService(options, config))
+		}
+
 		host := &hosting.Host{
 			Services: services,
 		}
@@ -107,12 +135,7 @@ func Execute() {
 	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Eventually there will be only a single namespace Radius.Core for core resources.
@@ -124,5 +147,37 @@ func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
+}
+
+// newDriftService creates the service that periodically checks the portable resources deployed by recipes for drift.
+func newDriftService(options hostoptions.HostOptions, config *controllerconfig.RecipeControllerConfig) *drift.Service {
+	return &drift.Service{
+		ServiceName: "applications-rp drift detector",
+		Interval:    drift.IntervalFromSeconds(options.Config.DriftDetection.IntervalSeconds),
+		LeaseName:   driftLeaseName,
+		NewLeaseClient: func() (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(options.K8sConfig)
+		},
+		NewDetector: func(ctx context.Context) (*drift.Detector, error) {
+			databaseClient, err := databaseprovider.FromOptions(options.Config.DatabaseProvider).GetClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			rootScopes, err := drift.RadiusPlanes(options.UCPConnection)
+			if err != nil {
+				return nil, err
+			}
+
+			return &drift.Detector{
+				DatabaseClient: databaseClient,
+				Engine:         config.Engine,
+				RootScopes:     rootScopes,
+				ResourceTypes: func(ctx context.Context, rootScope string) ([]string, error) {
+					return driftResourceTypes, nil
+				},
+			}, nil
+		},
+	}
 }
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kubernetes-recipe-driver is a recipe driver plugin that deploys raw Kubernetes manifests. Register it with the
+// control plane using the "recipeDrivers" configuration:
+//
+//	recipeDrivers:
+//	  - kind: kubernetes
+//	    command: /usr/local/bin/kubernetes-recipe-driver
+package main
+
+import (
+	"fmt"
+	"os"
+
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	"github.com/radius-project/radius/pkg/recipes/driver/kubernetesmanifest"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin"
+)
+
+func main() {
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load the Kubernetes configuration: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	kubeClient, err := client.New(restConfig, client.Options{Scheme: clientgoscheme.Scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create the Kubernetes client: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	plugin.Main(&kubernetesmanifest.Handler{Client: kubeClient})
+}
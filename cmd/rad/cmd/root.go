@@ -34,6 +34,8 @@ import (
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
 	app_delete_preview "github.com/radius-project/radius/pkg/cli/cmd/app/delete/preview"
 	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
+	app_graph_diff "github.com/radius-project/radius/pkg/cli/cmd/app/graph/diff"
+	app_graph_history "github.com/radius-project/radius/pkg/cli/cmd/app/graph/history"
 	app_graph_preview "github.com/radius-project/radius/pkg/cli/cmd/app/graph/preview"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
 	app_list_preview "github.com/radius-project/radius/pkg/cli/cmd/app/list/preview"
@@ -41,10 +43,12 @@ import (
 	app_show_preview "github.com/radius-project/radius/pkg/cli/cmd/app/show/preview"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	app_status_preview "github.com/radius-project/radius/pkg/cli/cmd/app/status/preview"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	bicep_publishextension "github.com/radius-project/radius/pkg/cli/cmd/bicep/publishextension"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_create_preview "github.com/radius-project/radius/pkg/cli/cmd/env/create/preview"
@@ -62,6 +66,7 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	radinit_preview "github.com/radius-project/radius/pkg/cli/cmd/radinit/preview"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
@@ -71,10 +76,16 @@ import (
 	recipe_pack_delete "github.com/radius-project/radius/pkg/cli/cmd/recipepack/delete"
 	recipe_pack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
+	recipe_pack_update "github.com/radius-project/radius/pkg/cli/cmd/recipepack/update"
+	resource_canceloperation "github.com/radius-project/radius/pkg/cli/cmd/resource/canceloperation"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_drift "github.com/radius-project/radius/pkg/cli/cmd/resource/drift"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_logs "github.com/radius-project/radius/pkg/cli/cmd/resource/logs"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_update "github.com/radius-project/radius/pkg/cli/cmd/resource/update"
+	resource_watch "github.com/radius-project/radius/pkg/cli/cmd/resource/watch"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
 	resourceprovider_list "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/list"
@@ -83,6 +94,7 @@ import (
 	resourcetype_delete "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/delete"
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
+	"github.com/radius-project/radius/pkg/cli/cmd/role"
 	"github.com/radius-project/radius/pkg/cli/cmd/rollback"
 	rollback_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/rollback/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -302,9 +314,24 @@ func initSubCommands() {
 	resourceCreateCmd, _ := resource_create.NewCommand(framework)
 	resourceCmd.AddCommand(resourceCreateCmd)
 
+	resourceUpdateCmd, _ := resource_update.NewCommand(framework)
+	resourceCmd.AddCommand(resourceUpdateCmd)
+
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceCancelOperationCmd, _ := resource_canceloperation.NewCommand(framework)
+	resourceCmd.AddCommand(resourceCancelOperationCmd)
+
+	resourceLogsCmd, _ := resource_logs.NewCommand(framework)
+	resourceCmd.AddCommand(resourceLogsCmd)
+
+	resourceDriftCmd, _ := resource_drift.NewCommand(framework)
+	resourceCmd.AddCommand(resourceDriftCmd)
+
+	resourceWatchCmd, _ := resource_watch.NewCommand(framework)
+	resourceCmd.AddCommand(resourceWatchCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -350,12 +377,27 @@ func initSubCommands() {
 	showRecipePackCmd, _ := recipe_pack_show.NewCommand(framework)
 	recipePackCmd.AddCommand(showRecipePackCmd)
 
+	updateRecipePackCmd, _ := recipe_pack_update.NewCommand(framework)
+	recipePackCmd.AddCommand(updateRecipePackCmd)
+
 	providerCmd := credential.NewCommand(framework)
 	RootCmd.AddCommand(providerCmd)
 
+	deadLetterCmd := deadletter.NewCommand(framework)
+	RootCmd.AddCommand(deadLetterCmd)
+
+	auditCmd := audit.NewCommand(framework)
+	RootCmd.AddCommand(auditCmd)
+
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	roleCmd := role.NewCommand(framework)
+	RootCmd.AddCommand(roleCmd)
+
+	lockCmd := lock.NewCommand(framework)
+	RootCmd.AddCommand(lockCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	previewInitCmd, _ := radinit_preview.NewCommand(framework)
 	wirePreviewSubcommand(initCmd, previewInitCmd)
@@ -438,6 +480,10 @@ func initSubCommands() {
 	appGraphCmd, _ := app_graph.NewCommand(framework)
 	previewAppGraphCmd, _ := app_graph_preview.NewCommand(framework)
 	wirePreviewSubcommand(appGraphCmd, previewAppGraphCmd)
+	appGraphDiffCmd, _ := app_graph_diff.NewCommand(framework)
+	appGraphCmd.AddCommand(appGraphDiffCmd)
+	appGraphHistoryCmd, _ := app_graph_history.NewCommand(framework)
+	appGraphCmd.AddCommand(appGraphHistoryCmd)
 	applicationCmd.AddCommand(appGraphCmd)
 
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
@@ -42,6 +42,12 @@ const (
 
 	// TopParameterName is an optional query parameter that defines the number of records requested by the client.
 	TopParameterName = "top"
+
+	// WatchParameterName is an optional query parameter that turns a list request into a watch of the listed resources.
+	WatchParameterName = "watch"
+
+	// ResumeTokenParameterName is an optional query parameter that resumes a watch after the event the token was returned with.
+	ResumeTokenParameterName = "resumeToken"
 )
 
 // The constants below define the default, max, and min values for the number of records to be returned by the server.
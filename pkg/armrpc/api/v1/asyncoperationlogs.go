@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+)
+
+const (
+	// LogLevelInfo is the level of informational log entries, such as the standard output of a tool.
+	LogLevelInfo = "Info"
+
+	// LogLevelError is the level of error log entries, such as the standard error of a tool.
+	LogLevelError = "Error"
+)
+
+// AsyncOperationLogs represents the logs captured during the execution of an async operation, such as the output of
+// the recipes executed by the operation.
+type AsyncOperationLogs struct {
+	// Value is the list of log entries in the order they were recorded.
+	Value []AsyncOperationLogEntry `json:"value"`
+
+	// DroppedEntries is the number of the oldest log entries that were dropped to keep the logs within their size limit.
+	DroppedEntries int `json:"droppedEntries,omitempty"`
+}
+
+// AsyncOperationLogEntry represents a single log entry of an async operation.
+type AsyncOperationLogEntry struct {
+	// Time is the time the entry was recorded.
+	Time time.Time `json:"time"`
+
+	// Source is the component which produced the entry, such as "terraform" or "bicep".
+	Source string `json:"source"`
+
+	// Level is the level of the entry, either LogLevelInfo or LogLevelError.
+	Level string `json:"level"`
+
+	// Message is the content of the entry.
+	Message string `json:"message"`
+}
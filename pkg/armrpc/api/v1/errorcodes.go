@@ -41,6 +41,12 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used when the caller is not allowed to perform the requested operation.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
+	// Used when the resume token of a watch is no longer valid.
+	CodeResumeTokenExpired = "ResumeTokenExpired"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -61,4 +67,10 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when a management lock prevents a resource from being modified or deleted.
+	CodeScopeLocked = "ScopeLocked"
+
+	// Used when the message of an async operation has been moved to the dead-letter queue.
+	CodeDeadLettered = "DeadLettered"
 )
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+const (
+	// WatchEventTypeSaved is the type of the watch event sent when a resource is created or updated.
+	WatchEventTypeSaved = "Saved"
+
+	// WatchEventTypeDeleted is the type of the watch event sent when a resource is deleted.
+	WatchEventTypeDeleted = "Deleted"
+)
+
+// WatchEvent represents the data of a server-sent event returned by a watch of a resource list.
+type WatchEvent struct {
+	// Type is the type of the change, either WatchEventTypeSaved or WatchEventTypeDeleted.
+	Type string `json:"type"`
+
+	// ID is the resource id of the changed resource.
+	ID string `json:"id"`
+
+	// Resource is the resource after the change. Resource is omitted for deleted resources.
+	Resource any `json:"resource,omitempty"`
+
+	// ResumeToken resumes the watch after this event. It is also sent as the id of the server-sent event.
+	ResumeToken string `json:"resumeToken"`
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationlog
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// DefaultMaxSize is the default maximum size in bytes of the logs recorded for an operation. The logs are stored
+	// along with the operation status, so the limit has to fit the object size limit of the databases.
+	DefaultMaxSize = 256 * 1024
+
+	// maxMessageSize is the maximum size in bytes of the message of an entry. Longer messages are truncated.
+	maxMessageSize = 16 * 1024
+
+	// entryOverhead is the approximate size in bytes of the fields of an entry other than its message.
+	entryOverhead = 64
+)
+
+type contextKey struct{}
+
+// Recorder records the log entries of an async operation. The oldest entries are dropped once the size of the logs
+// exceeds the maximum size. A Recorder is safe for concurrent use, and the methods of a nil Recorder are no-ops so
+// that components can record logs without checking whether the operation captures them.
+type Recorder struct {
+	mu      sync.Mutex
+	maxSize int
+	size    int
+	entries []v1.AsyncOperationLogEntry
+	dropped int
+}
+
+// NewRecorder creates a Recorder keeping at most maxSize bytes of logs.
+func NewRecorder(maxSize int) *Recorder {
+	return &Recorder{maxSize: maxSize}
+}
+
+// WithRecorder returns a copy of ctx carrying the given recorder.
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, contextKey{}, recorder)
+}
+
+// FromContext returns the recorder carried by ctx, or nil if ctx does not carry a recorder.
+func FromContext(ctx context.Context) *Recorder {
+	recorder, _ := ctx.Value(contextKey{}).(*Recorder)
+	return recorder
+}
+
+// Record records a log entry with the given source, level and message.
+func (r *Recorder) Record(source string, level string, message string) {
+	if r == nil {
+		return
+	}
+
+	if len(message) > maxMessageSize {
+		message = message[:maxMessageSize] + "... (truncated)"
+	}
+
+	entry := v1.AsyncOperationLogEntry{
+		Time:    time.Now().UTC(),
+		Source:  source,
+		Level:   level,
+		Message: message,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+	r.size += entrySize(entry)
+
+	drop := 0
+	for r.size > r.maxSize && drop < len(r.entries) {
+		r.size -= entrySize(r.entries[drop])
+		drop++
+	}
+
+	if drop > 0 {
+		r.entries = slices.Delete(r.entries, 0, drop)
+		r.dropped += drop
+	}
+}
+
+// Logs returns a copy of the recorded logs, or nil if no entry was recorded.
+func (r *Recorder) Logs() *v1.AsyncOperationLogs {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) == 0 && r.dropped == 0 {
+		return nil
+	}
+
+	return &v1.AsyncOperationLogs{
+		Value:          slices.Clone(r.entries),
+		DroppedEntries: r.dropped,
+	}
+}
+
+func entrySize(entry v1.AsyncOperationLogEntry) int {
+	return len(entry.Source) + len(entry.Level) + len(entry.Message) + entryOverhead
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationlog
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Recorder_Record(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+	require.Nil(t, recorder.Logs())
+
+	recorder.Record("terraform", v1.LogLevelInfo, "Initializing the backend...")
+	recorder.Record("terraform", v1.LogLevelError, "Error: Invalid provider configuration")
+
+	logs := recorder.Logs()
+	require.Len(t, logs.Value, 2)
+	require.Equal(t, 0, logs.DroppedEntries)
+	require.Equal(t, "terraform", logs.Value[0].Source)
+	require.Equal(t, v1.LogLevelInfo, logs.Value[0].Level)
+	require.Equal(t, "Initializing the backend...", logs.Value[0].Message)
+	require.False(t, logs.Value[0].Time.IsZero())
+	require.Equal(t, v1.LogLevelError, logs.Value[1].Level)
+	require.Equal(t, "Error: Invalid provider configuration", logs.Value[1].Message)
+}
+
+func Test_Recorder_DropsOldestEntries(t *testing.T) {
+	message := strings.Repeat("a", 100)
+	recorder := NewRecorder(3 * (len("bicep") + len(v1.LogLevelInfo) + len(message) + entryOverhead))
+
+	for range 5 {
+		recorder.Record("bicep", v1.LogLevelInfo, message)
+	}
+	recorder.Record("bicep", v1.LogLevelInfo, "last")
+
+	logs := recorder.Logs()
+	require.Len(t, logs.Value, 3)
+	require.Equal(t, 3, logs.DroppedEntries)
+	require.Equal(t, "last", logs.Value[2].Message)
+}
+
+func Test_Recorder_TruncatesLongMessages(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+	recorder.Record("terraform", v1.LogLevelInfo, strings.Repeat("a", maxMessageSize+1))
+
+	logs := recorder.Logs()
+	require.Len(t, logs.Value, 1)
+	require.Equal(t, strings.Repeat("a", maxMessageSize)+"... (truncated)", logs.Value[0].Message)
+}
+
+func Test_Recorder_Concurrent(t *testing.T) {
+	recorder := NewRecorder(DefaultMaxSize)
+
+	wg := sync.WaitGroup{}
+	for range 10 {
+		wg.Go(func() {
+			for range 10 {
+				recorder.Record("terraform", v1.LogLevelInfo, "message")
+			}
+		})
+	}
+	wg.Wait()
+
+	require.Len(t, recorder.Logs().Value, 100)
+}
+
+func Test_Recorder_Nil(t *testing.T) {
+	var recorder *Recorder
+	recorder.Record("terraform", v1.LogLevelInfo, "message")
+	require.Nil(t, recorder.Logs())
+}
+
+func Test_FromContext(t *testing.T) {
+	require.Nil(t, FromContext(context.Background()))
+
+	recorder := NewRecorder(DefaultMaxSize)
+	ctx := WithRecorder(context.Background(), recorder)
+	require.Same(t, recorder, FromContext(ctx))
+}
@@ -16,6 +16,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	database "github.com/radius-project/radius/pkg/components/database"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -159,6 +160,44 @@ func (c *MockStatusManagerQueueAsyncOperationCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// SaveLogs mocks base method.
+func (m *MockStatusManager) SaveLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, logs *v1.AsyncOperationLogs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLogs", ctx, id, operationID, logs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLogs indicates an expected call of SaveLogs.
+func (mr *MockStatusManagerMockRecorder) SaveLogs(ctx, id, operationID, logs any) *MockStatusManagerSaveLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLogs", reflect.TypeOf((*MockStatusManager)(nil).SaveLogs), ctx, id, operationID, logs)
+	return &MockStatusManagerSaveLogsCall{Call: call}
+}
+
+// MockStatusManagerSaveLogsCall wrap *gomock.Call
+type MockStatusManagerSaveLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerSaveLogsCall) Return(arg0 error) *MockStatusManagerSaveLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerSaveLogsCall) Do(f func(context.Context, resources.ID, uuid.UUID, *v1.AsyncOperationLogs) error) *MockStatusManagerSaveLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerSaveLogsCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, *v1.AsyncOperationLogs) error) *MockStatusManagerSaveLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
@@ -196,3 +235,41 @@ func (c *MockStatusManagerUpdateCall) DoAndReturn(f func(context.Context, resour
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateInBatch mocks base method.
+func (m *MockStatusManager) UpdateInBatch(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, operations []database.BatchOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInBatch", ctx, id, operationID, state, endTime, opError, operations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInBatch indicates an expected call of UpdateInBatch.
+func (mr *MockStatusManagerMockRecorder) UpdateInBatch(ctx, id, operationID, state, endTime, opError, operations any) *MockStatusManagerUpdateInBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInBatch", reflect.TypeOf((*MockStatusManager)(nil).UpdateInBatch), ctx, id, operationID, state, endTime, opError, operations)
+	return &MockStatusManagerUpdateInBatchCall{Call: call}
+}
+
+// MockStatusManagerUpdateInBatchCall wrap *gomock.Call
+type MockStatusManagerUpdateInBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateInBatchCall) Return(arg0 error) *MockStatusManagerUpdateInBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateInBatchCall) Do(f func(context.Context, resources.ID, uuid.UUID, v1.ProvisioningState, *time.Time, *v1.ErrorDetails, []database.BatchOperation) error) *MockStatusManagerUpdateInBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateInBatchCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, v1.ProvisioningState, *time.Time, *v1.ErrorDetails, []database.BatchOperation) error) *MockStatusManagerUpdateInBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
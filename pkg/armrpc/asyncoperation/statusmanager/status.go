@@ -43,4 +43,8 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+
+	// CancelRequestedTime represents the time when the cancellation of the async operation was requested. The worker
+	// processing the operation cancels it once it observes this value.
+	CancelRequestedTime *time.Time `json:"cancelRequestedTime,omitempty"`
 }
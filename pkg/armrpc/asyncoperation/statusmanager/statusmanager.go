@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -59,8 +60,13 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
-	// Delete deletes an async operation status.
+	// UpdateInBatch updates an async operation status and applies the given database operations in a single batch,
+	// so that either all or none of the writes are applied.
+	UpdateInBatch(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, operations []database.BatchOperation) error
+	// Delete deletes an async operation status and the logs of the operation.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// SaveLogs saves the logs captured during the execution of an async operation.
+	SaveLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, logs *v1.AsyncOperationLogs) error
 }
 
 // New creates statusManager instance.
@@ -77,6 +83,12 @@ func (aom *statusManager) operationStatusResourceID(id resources.ID, operationID
 	return fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), aom.location, operationID)
 }
 
+// LogsResourceID returns the ID of the logs of the operation whose operation status has the given ID. The logs are
+// stored separately from the operation status so that polling the status does not read them.
+func LogsResourceID(operationStatusID resources.ID) string {
+	return operationStatusID.Truncate().String() + "/operationlogs/" + operationStatusID.Name()
+}
+
 // QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
 // a request message. If an error occurs, the status is deleted using the databaseClient.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
@@ -146,6 +158,13 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 // Update retrieves an existing operation status resource from the store, updates its fields with the
 // given parameters, and saves it back to the store.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+	return aom.UpdateInBatch(ctx, id, operationID, state, endTime, opError, nil)
+}
+
+// UpdateInBatch retrieves an existing operation status resource from the store, updates its fields with the
+// given parameters, and saves it back to the store together with the given operations. The operations must
+// target the same database as the status manager.
+func (aom *statusManager) UpdateInBatch(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails, operations []database.BatchOperation) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	obj, err := aom.databaseClient.Get(ctx, opID)
 	if err != nil {
@@ -170,13 +189,42 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
-	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if len(operations) == 0 {
+		return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	}
+
+	batch := append(slices.Clone(operations), database.NewSaveOperation(obj, database.WithETag(obj.ETag)))
+	return aom.databaseClient.ExecuteBatch(ctx, batch)
 }
 
 // Delete deletes the operation status resource associated with the given ID and
-// operationID, and returns an error if unsuccessful.
+// operationID along with the logs of the operation, and returns an error if unsuccessful.
 func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
-	return aom.databaseClient.Delete(ctx, aom.operationStatusResourceID(id, operationID))
+	statusID, err := resources.ParseResource(aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	// Delete the logs first so that they are not left behind if deleting the operation status fails.
+	err = aom.databaseClient.Delete(ctx, LogsResourceID(statusID))
+	if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+		return err
+	}
+
+	return aom.databaseClient.Delete(ctx, statusID.String())
+}
+
+// SaveLogs saves the logs of the operation, replacing the logs saved previously if any.
+func (aom *statusManager) SaveLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, logs *v1.AsyncOperationLogs) error {
+	statusID, err := resources.ParseResource(aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	return aom.databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: LogsResourceID(statusID)},
+		Data:     logs,
+	})
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
@@ -190,16 +190,28 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
-		Desc      string
-		DeleteErr error
+		Desc          string
+		DeleteLogsErr error
+		DeleteErr     error
 	}{
 		{
-			Desc:      "delete_success",
-			DeleteErr: nil,
+			Desc:          "delete_success",
+			DeleteLogsErr: nil,
+			DeleteErr:     nil,
 		},
 		{
-			Desc:      "delete_error",
-			DeleteErr: errors.New(deleteErr),
+			Desc:          "delete_without_logs",
+			DeleteLogsErr: &database.ErrNotFound{},
+			DeleteErr:     nil,
+		},
+		{
+			Desc:          "delete_logs_error",
+			DeleteLogsErr: errors.New(deleteErr),
+		},
+		{
+			Desc:          "delete_error",
+			DeleteLogsErr: nil,
+			DeleteErr:     errors.New(deleteErr),
 		},
 	}
 
@@ -208,12 +220,20 @@ func TestDeleteAsyncOperationStatus(t *testing.T) {
 			aomTest, mctrl := setup(t)
 			defer mctrl.Finish()
 
-			aomTest.databaseClient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.DeleteErr)
-			rid, err := resources.ParseResource(azureEnvResourceID)
+			operationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+			statusID := "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/00000000-0000-0000-0000-000000000001"
+			logsID := "/planes/radius/local/providers/applications.core/locations/test-location/operationlogs/00000000-0000-0000-0000-000000000001"
+
+			aomTest.databaseClient.EXPECT().Delete(gomock.Any(), logsID, gomock.Any()).Return(tt.DeleteLogsErr)
+			if tt.DeleteLogsErr == nil || errors.Is(tt.DeleteLogsErr, &database.ErrNotFound{}) {
+				aomTest.databaseClient.EXPECT().Delete(gomock.Any(), statusID, gomock.Any()).Return(tt.DeleteErr)
+			}
+
+			rid, err := resources.ParseResource(ucpEnvResourceID)
 			require.NoError(t, err)
-			err = aomTest.manager.Delete(context.TODO(), rid, uuid.New())
+			err = aomTest.manager.Delete(context.TODO(), rid, operationID)
 
-			if tt.DeleteErr != nil {
+			if tt.DeleteLogsErr != nil && !errors.Is(tt.DeleteLogsErr, &database.ErrNotFound{}) || tt.DeleteErr != nil {
 				require.Error(t, err, deleteErr)
 			} else {
 				require.NoError(t, err)
@@ -325,3 +345,68 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAsyncOperationStatusInBatch(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	aomTest.databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.Object{
+			Metadata: database.Metadata{ID: opID.String(), ETag: "etag"},
+			Data:     testAos,
+		}, nil)
+
+	resource := &database.Object{Metadata: database.Metadata{ID: azureEnvResourceID, ETag: "resource-etag"}}
+	aomTest.databaseClient.
+		EXPECT().
+		ExecuteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, operations []database.BatchOperation) error {
+			require.Len(t, operations, 2)
+			require.Equal(t, resource, operations[0].Object)
+
+			require.Equal(t, database.BatchOperationSave, operations[1].Kind)
+			require.Equal(t, database.ETag("etag"), operations[1].Options.ETag)
+			status, ok := operations[1].Object.Data.(*Status)
+			require.True(t, ok)
+			require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	operations := []database.BatchOperation{database.NewSaveOperation(resource, database.WithETag(resource.ETag))}
+	err = aomTest.manager.UpdateInBatch(context.TODO(), rid, opID, v1.ProvisioningStateSucceeded, nil, nil, operations)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+}
+
+func TestLogsResourceID(t *testing.T) {
+	statusID := resources.MustParse("/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/00000000-0000-0000-0000-000000000001")
+	require.Equal(t, "/planes/radius/local/providers/Applications.Core/locations/global/operationlogs/00000000-0000-0000-0000-000000000001", LogsResourceID(statusID))
+}
+
+func TestSaveAsyncOperationLogs(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	logs := &v1.AsyncOperationLogs{
+		Value: []v1.AsyncOperationLogEntry{{Source: "terraform", Level: v1.LogLevelInfo, Message: "Apply complete!"}},
+	}
+
+	operationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	aomTest.databaseClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			require.Equal(t, "/planes/radius/local/providers/applications.core/locations/test-location/operationlogs/00000000-0000-0000-0000-000000000001", obj.ID)
+			require.Equal(t, logs, obj.Data)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(ucpEnvResourceID)
+	require.NoError(t, err)
+	err = aomTest.manager.SaveLogs(context.TODO(), rid, operationID, logs)
+	require.NoError(t, err)
+}
@@ -27,6 +27,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/metrics"
@@ -36,7 +37,6 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
-	"github.com/google/uuid"
 	"golang.org/x/sync/semaphore"
 )
 
@@ -58,6 +58,13 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultNotifyFallbackInterval is the default dequeue interval when the queue notifies about new messages.
+	defaultNotifyFallbackInterval = time.Duration(5) * time.Second
+
+	// defaultCancellationCheckInterval is the default interval to check whether the cancellation of a running operation
+	// has been requested.
+	defaultCancellationCheckInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +86,14 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// NotifyFallbackIntervalDuration is the duration for the dequeue interval when the queue notifies about new
+	// messages. The worker still polls to pick up messages whose lease expired.
+	NotifyFallbackIntervalDuration time.Duration
+
+	// CancellationCheckInterval is the interval to check whether the cancellation of a running operation has been
+	// requested.
+	CancellationCheckInterval time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -115,6 +130,12 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.NotifyFallbackIntervalDuration == time.Duration(0) {
+		options.NotifyFallbackIntervalDuration = defaultNotifyFallbackInterval
+	}
+	if options.CancellationCheckInterval == time.Duration(0) {
+		options.CancellationCheckInterval = defaultCancellationCheckInterval
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -129,7 +150,10 @@ func New(
 // resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
+	msgCh, err := queue.StartDequeuer(
+		ctx, w.requestQueue,
+		queue.WithDequeueInterval(w.options.DequeueIntervalDuration),
+		queue.WithNotifyFallbackInterval(w.options.NotifyFallbackIntervalDuration))
 	if err != nil {
 		return err
 	}
@@ -192,24 +216,32 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.DatabaseClient())
+				info := queue.DeadLetterInfo{
+					Reason: queue.DeadLetterReasonMaxDequeueCountExceeded,
+					Error:  w.lastOperationError(reqCtx, op, errMsg),
+				}
+				w.deadLetterOperation(reqCtx, msgreq, failed, asyncCtrl.DatabaseClient(), info)
 				return
 			}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+			// TODO: Handle the edge case where the same message is delivered twice in multiple instances.
 
-			dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID)
+			status, err := w.getOperationStatus(reqCtx, op)
 			if err != nil {
 				opLogger.Error(err, "failed to check potential deduplication.")
 				return
 			}
-			if dup {
+			if w.isDuplicated(status) {
 				opLogger.Info("duplicated message detected")
 				return
 			}
 
+			if status.CancelRequestedTime != nil {
+				opLogger.Info("Operation was canceled before it started.")
+				w.completeOperation(reqCtx, msgreq, newCancelRequestedResult(op), asyncCtrl.DatabaseClient())
+				return
+			}
+
 			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.DatabaseClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
 				return
 			}
@@ -238,22 +270,43 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
 	defer opCancel()
 
+	// The logs recorded by the controller, such as the output of recipes, are saved when the operation completes.
+	recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+	asyncReqCtx = operationlog.WithRecorder(asyncReqCtx, recorder)
+
 	opDone := make(chan struct{}, 1)
 	opStartAt := time.Now()
 
 	// Start new go routine to cancel and timeout async operation.
 	go func() {
 		defer func(done chan struct{}) {
-			close(done)
+			defer close(done)
 			if err := recover(); err != nil {
-				msg := fmt.Errorf("recovering from panic %v: %s", err, debug.Stack())
+				stack := debug.Stack()
+				msg := fmt.Errorf("recovering from panic %v: %s", err, stack)
 				logger.Error(msg, "recovering from panic")
 
 				// When backend controller has a critical bug such as nil reference, asyncCtrl.Run() is panicking.
-				// If this happens, the message is requeued after message lock time (5 mins).
-				// After message lock is expired, message will be reprocessed 'w.options.MaxOperationRetryCount' times and
-				// then complete the message and change provisioningState to 'Failed'. Meanwhile, PUT request will
-				// be blocked.
+				// Retrying the operation would most likely panic again and block the PUT request until
+				// 'w.options.MaxOperationRetryCount' is exceeded, so fail the operation now and move the message to the
+				// dead-letter queue along with the stack. The message can be replayed once the bug is fixed.
+				failed := ctrl.NewFailedResult(v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: fmt.Sprintf("async operation panicked: %v", err),
+				})
+				info := queue.DeadLetterInfo{
+					Reason: queue.DeadLetterReasonPanic,
+					Error:  fmt.Sprint(err),
+					Stack:  string(stack),
+				}
+
+				// Updating the status may hit the same bug. If so, the message is requeued after the message lock expires.
+				defer func() {
+					if err := recover(); err != nil {
+						logger.Error(fmt.Errorf("recovering from panic %v: %s", err, debug.Stack()), "failed to move the message to the dead-letter queue")
+					}
+				}()
+				w.deadLetterOperation(ctx, message, failed, asyncCtrl.DatabaseClient(), info)
 			}
 		}(opDone)
 
@@ -279,24 +332,46 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
+			w.saveLogs(ctx, asyncReq, recorder)
 			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
 		}
 		trace.SetAsyncResultStatus(result, span)
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+	cancellationCheck := time.NewTicker(w.options.CancellationCheckInterval)
+	defer cancellationCheck.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
+
+		case <-cancellationCheck.C:
+			// The cancellation is requested through the operation status because the operation may run in another replica.
+			status, err := w.getOperationStatus(ctx, asyncReq)
+			if err != nil {
+				logger.Error(err, "failed to check the cancellation of async operation")
+				continue
+			}
+			if status.CancelRequestedTime == nil {
+				continue
+			}
+
+			logger.Info("Cancelling async operation on request.")
+
+			opCancel()
+			w.saveLogs(ctx, asyncReq, recorder)
+			w.completeOperation(ctx, message, newCancelRequestedResult(asyncReq), asyncCtrl.DatabaseClient())
+			return
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
@@ -305,6 +380,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
+			w.saveLogs(ctx, asyncReq, recorder)
 			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
 			return
 
@@ -355,6 +431,77 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// saveLogs saves the logs recorded during the execution of the operation, if any. Failing to save the logs does not
+// fail the operation.
+func (w *AsyncRequestProcessWorker) saveLogs(ctx context.Context, req *ctrl.Request, recorder *operationlog.Recorder) {
+	logs := recorder.Logs()
+	if logs == nil {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	if err := w.sm.SaveLogs(ctx, rID, req.OperationID, logs); err != nil {
+		logger.Error(err, "failed to save the logs of the operation", "operationID", req.OperationID.String())
+	}
+}
+
+// deadLetterOperation completes the operation with the failed result and moves the message to the dead-letter queue
+// so that it is not processed again. The message is finished instead if the queue does not support dead-lettering.
+func (w *AsyncRequestProcessWorker) deadLetterOperation(ctx context.Context, message *queue.Message, result ctrl.Result, sc database.Client, info queue.DeadLetterInfo) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	req := &ctrl.Request{}
+	if err := json.Unmarshal(message.Data, req); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		return
+	}
+
+	dlc, canDeadLetter := w.requestQueue.(queue.DeadLetterClient)
+	if canDeadLetter && result.Error != nil {
+		// The code lets isDuplicated tell a replayed message apart from a redelivered message of a completed operation.
+		result.Error.Code = v1.CodeDeadLettered
+	}
+
+	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	if err != nil {
+		logger.Error(err, "failed to update resource and/or operation status")
+		return
+	}
+
+	if canDeadLetter {
+		if err := dlc.DeadLetterMessage(ctx, message, info); err != nil {
+			logger.Error(err, "failed to move the message to the dead-letter queue")
+		} else {
+			logger.Info("Moved message to the dead-letter queue.", "reason", info.Reason)
+		}
+	} else if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+}
+
+// lastOperationError returns the error recorded in the operation status by the last attempt to process the operation,
+// followed by errMsg. It returns errMsg if no error was recorded.
+func (w *AsyncRequestProcessWorker) lastOperationError(ctx context.Context, req *ctrl.Request, errMsg string) string {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return errMsg
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil || status.Error == nil {
+		return errMsg
+	}
+
+	return fmt.Sprintf("%s: last error: %s: %s", errMsg, status.Error.Code, status.Error.Message)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -364,7 +511,7 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
+	operations, err := updateResourceState(ctx, sc, rID.String(), state)
 	if errors.Is(err, &database.ErrNotFound{}) {
 		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
 	} else if err != nil {
@@ -372,37 +519,49 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	// Otherwise we update the operationStatus to the result.
+	// The resource and the operationStatus are written in a single batch so that they cannot diverge.
 	now := time.Now().UTC()
-	err = w.sm.Update(ctx, rID, req.OperationID, state, &now, opErr)
+	err = w.sm.UpdateInBatch(ctx, rID, req.OperationID, state, &now, opErr, operations)
 	if err != nil {
-		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+		logger.Error(err, "failed to update the provisioningState in resource and operationstatus", "operationID", req.OperationID.String())
 		return err
 	}
 
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID string, operationID uuid.UUID) (bool, error) {
-	rID, err := resources.ParseResource(resourceID)
+func (w *AsyncRequestProcessWorker) getOperationStatus(ctx context.Context, req *ctrl.Request) (*manager.Status, error) {
+	rID, err := resources.ParseResource(req.ResourceID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	status, err := w.sm.Get(ctx, rID, operationID)
-	if err != nil {
-		return false, err
-	}
+	return w.sm.Get(ctx, rID, req.OperationID)
+}
 
+func (w *AsyncRequestProcessWorker) isDuplicated(status *manager.Status) bool {
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
-	// 2. If the operation is in terminal state, we consider it as a duplicated operation.
+	// 2. If the operation is in terminal state, we consider it as a duplicated operation, unless its message was
+	//    dead-lettered and has been replayed.
 	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
-		status.Status.IsTerminal() {
-		return true, nil
+		(status.Status.IsTerminal() && !isDeadLettered(status)) {
+		return true
 	}
 
-	return false, nil
+	return false
+}
+
+// isDeadLettered returns true if the operation failed because its message was moved to the dead-letter queue.
+func isDeadLettered(status *manager.Status) bool {
+	return status.Status == v1.ProvisioningStateFailed && status.Error != nil && status.Error.Code == v1.CodeDeadLettered
+}
+
+// newCancelRequestedResult returns the result of the operation whose cancellation has been requested.
+func newCancelRequestedResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) was canceled on request.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
 }
 
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
@@ -413,10 +572,12 @@ func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time
 	return d
 }
 
-func updateResourceState(ctx context.Context, sc database.Client, id string, state v1.ProvisioningState) error {
+// updateResourceState returns the batch operations which update the provisioningState of the resource to state.
+// It returns no operations if the resource is already in the target state.
+func updateResourceState(ctx context.Context, sc database.Client, id string, state v1.ProvisioningState) ([]database.BatchOperation, error) {
 	obj, err := sc.Get(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	objmap := obj.Data.(map[string]any)
@@ -425,15 +586,10 @@ func updateResourceState(ctx context.Context, sc database.Client, id string, sta
 		// Do not update it if provisioning state is already the target state.
 		// This happens when redeploying worker can stop completing message.
 		// So, provisioningState in Resource is updated but not in operationStatus record.
-		return nil
+		return nil, nil
 	}
 
 	objmap["provisioningState"] = string(state)
 
-	err = sc.Save(ctx, obj, database.WithETag(obj.ETag))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return []database.BatchOperation{database.NewSaveOperation(obj, database.WithETag(obj.ETag))}, nil
 }
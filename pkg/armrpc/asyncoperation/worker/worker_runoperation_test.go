@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database"
 	inmemorystore "github.com/radius-project/radius/pkg/components/database/inmemory"
@@ -205,8 +207,19 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, _ v1.ProvisioningState, _ *time.Time, _ *v1.ErrorDetails, operations []database.BatchOperation) error {
+			// The resource provisioningState must be written in the same batch as the operation status.
+			require.Len(t, operations, 1)
+			require.Equal(t, database.BatchOperationSave, operations[0].Kind)
+			return nil
+		}).Times(1)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateUpdating,
+			Error:  &v1.ErrorDetails{Code: v1.CodeInternal, Message: "deployment failed"},
+		},
+	}, nil).Times(1)
 
 	expectedDequeueCount := 2
 
@@ -254,6 +267,107 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	letters, err := tCtx.testQueue.ListDeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, testMessage.ID, letters[0].ID)
+	require.Equal(t, queue.DeadLetterReasonMaxDequeueCountExceeded, letters[0].Reason)
+	require.Equal(t, "exceeded max retry count to process async operation message: 4: last error: Internal: deployment failed", letters[0].Error)
+}
+
+func TestStart_ReplayDeadLetter(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// The status manager keeps the last status written by the worker.
+	var mu sync.Mutex
+	status := &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateAccepted}}
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID) (*manager.Status, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			copied := *status
+			return &copied, nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opErr *v1.ErrorDetails, _ []database.BatchOperation) error {
+			mu.Lock()
+			defer mu.Unlock()
+			status = &manager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{Status: state, Error: opErr}}
+			return nil
+		}).AnyTimes()
+
+	registry := NewControllerRegistry()
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+	}
+
+	// The controller panics the first time, which dead-letters the message, and succeeds once the message is replayed.
+	var calls atomic.Int32
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			if calls.Add(1) == 1 {
+				panic("!!! don't panic !!!")
+			}
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(0)
+	err := registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		letters, err := tCtx.testQueue.ListDeadLetters(ctx)
+		return err == nil && len(letters) == 1
+	}, 5*time.Second, defaultTestDequeueInterval)
+
+	current, err := tCtx.mockSM.Get(ctx, resources.ID{}, uuid.Nil)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, current.Status)
+	require.Equal(t, v1.CodeDeadLettered, current.Error.Code)
+
+	err = tCtx.testQueue.ReplayDeadLetter(ctx, testMessage.ID)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		current, err := tCtx.mockSM.Get(ctx, resources.ID{}, uuid.Nil)
+		return err == nil && current.Status == v1.ProvisioningStateSucceeded
+	}, 5*time.Second, defaultTestDequeueInterval)
+	require.Equal(t, int32(2), calls.Load())
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	letters, err := tCtx.testQueue.ListDeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, letters)
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -265,9 +379,8 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -341,9 +454,8 @@ func TestStart_RunOperation(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
 	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
@@ -410,8 +522,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -439,6 +550,55 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_SavesLogs(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+
+	// The logs are saved before the operation status is completed.
+	saveLogs := tCtx.mockSM.EXPECT().SaveLogs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID, logs *v1.AsyncOperationLogs) error {
+			require.Len(t, logs.Value, 1)
+			require.Equal(t, "terraform", logs.Value[0].Source)
+			require.Equal(t, "Apply complete!", logs.Value[0].Message)
+			return nil
+		}).Times(1)
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateSucceeded), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).After(saveLogs).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			operationlog.FromContext(ctx).Record("terraform", v1.LogLevelInfo, "Apply complete!")
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	// Ensure that message is finished.
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -448,8 +608,8 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -539,9 +699,8 @@ func TestRunOperation_Timeout(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ []database.BatchOperation) error {
 			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out because it was processing longer than") &&
 				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
 				return nil
@@ -579,14 +738,133 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestStart_CancelRequestedBeforeStart(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := *testOperationStatus
+	requestedAt := time.Now().UTC()
+	canceledStatus.CancelRequestedTime = &requestedAt
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&canceledStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	registry := NewControllerRegistry()
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			require.Fail(t, "controller must not run for the canceled operation")
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := *testOperationStatus
+	requestedAt := time.Now().UTC()
+	canceledStatus.CancelRequestedTime = &requestedAt
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&canceledStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails, _ []database.BatchOperation) error {
+			if state == v1.ProvisioningStateCanceled && opError.Message == "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) was canceled on request." &&
+				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
+				return nil
+			}
+			return errors.New("!!! failed to update status !!!")
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationCheckInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			close(done)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
-	tCtx, _ := newTestContext(t, defaultTestLockTime)
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestResourceObject(), nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
 	require.NoError(t, err)
 
-	worker := New(Options{}, nil, tCtx.testQueue, nil)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
 
 	opts := ctrl.Options{
 		DatabaseClient: tCtx.mockSC,
@@ -609,5 +887,47 @@ func TestRunOperation_PanicController(t *testing.T) {
 		worker.runOperation(tCtx.ctx, msg, testCtrl)
 	})
 
-	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
+	require.Equal(t, 0, tCtx.internalQ.Len(), "ensure that message is removed from the queue")
+
+	letters, err := tCtx.testQueue.ListDeadLetters(tCtx.ctx)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, msg.ID, letters[0].ID)
+	require.Equal(t, queue.DeadLetterReasonPanic, letters[0].Reason)
+	require.Equal(t, "!!! don't panic !!!", letters[0].Error)
+	require.Contains(t, letters[0].Stack, "runOperation")
+}
+
+func TestRunOperation_PanicControllerWithoutDeadLetterQueue(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestResourceObject(), nil).Times(1)
+	tCtx.mockSM.EXPECT().UpdateInBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+
+	// Hide the queue.DeadLetterClient implementation of the in-memory queue.
+	worker := New(Options{}, tCtx.mockSM, struct{ queue.Client }{tCtx.testQueue}, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			panic("!!! don't panic !!!")
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		worker.runOperation(tCtx.ctx, msg, testCtrl)
+	})
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+	letters, err := tCtx.testQueue.ListDeadLetters(tCtx.ctx)
+	require.NoError(t, err)
+	require.Empty(t, letters)
 }
@@ -35,6 +35,7 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMessageExtendMargin, worker.options.MessageExtendMargin)
 	require.Equal(t, defaultMinMessageLockDuration, worker.options.MinMessageLockDuration)
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
+	require.Equal(t, defaultCancellationCheckInterval, worker.options.CancellationCheckInterval)
 }
 
 func TestUpdateResourceState(t *testing.T) {
@@ -96,18 +97,15 @@ func TestUpdateResourceState(t *testing.T) {
 					}, nil
 				})
 
+			operations, err := updateResourceState(ctx, databaseClient, "fakeid", tt.updateState)
 			if tt.callSave {
-				databaseClient.
-					EXPECT().
-					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
-						k := obj.Data.(map[string]any)
-						require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
-						return nil
-					})
+				require.Len(t, operations, 1)
+				require.Equal(t, database.BatchOperationSave, operations[0].Kind)
+				k := operations[0].Object.Data.(map[string]any)
+				require.Equal(t, k["provisioningState"].(string), string(tt.updateState))
+			} else {
+				require.Empty(t, operations)
 			}
-
-			err := updateResourceState(ctx, databaseClient, "fakeid", tt.updateState)
 			require.ErrorIs(t, err, tt.outErr)
 		})
 	}
@@ -73,6 +73,22 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationPost,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/logs", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationGet,
+		ControllerFactory: defaultoperation.NewGetOperationLogs,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
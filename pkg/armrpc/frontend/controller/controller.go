@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -28,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -62,6 +64,10 @@ type Options struct {
 	// KubeClient is the Kubernetes controller runtime client.
 	KubeClient runtimeclient.Client
 
+	// LockChecker checks the management locks that apply to a resource before it is created, updated or deleted.
+	// Locks are not checked when LockChecker is nil.
+	LockChecker LockChecker
+
 	// ResourceType is the string that represents the resource type. May be empty if the controller
 	// does not represent a single type of resource.
 	ResourceType string
@@ -88,6 +94,8 @@ func (o Options) Validate() error {
 	// PathBase is usually empty, so it is not validated here.
 	//
 	// KubeClient is not used by the majority of the code, so it is not validated here.
+	//
+	// LockChecker is only used by UCP, so it is not validated here.
 
 	return err
 }
@@ -249,3 +257,22 @@ type DeleteFilter[T any] func(ctx context.Context, oldResource *T, options *Opti
 // UpdateFilters should return a rest.Response to handle the request without allowing updates to occur. Any
 // errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
 type UpdateFilter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) (rest.Response, error)
+
+// LockChecker checks the management locks that apply to a resource before a request changes it.
+type LockChecker interface {
+	// CheckLocks returns a response rejecting the request if a lock prevents the HTTP method from being performed
+	// on the resource with the given ID, or nil if the request is allowed.
+	CheckLocks(ctx context.Context, id resources.ID, method string) (rest.Response, error)
+}
+
+// LockTargetID returns the ID of the resource that the request changes, parsed from the original URL of the request.
+// The ResourceID of the ARM request context must not be used to check locks because it is taken from the Referer
+// header, which is set by the client.
+func LockTargetID(ctx context.Context, pathBase string, method string) (resources.ID, error) {
+	rpcCtx := v1.ARMRequestContextFromContext(ctx)
+	if pathBase == "" {
+		pathBase = v1.ParsePathBase(rpcCtx.OriginalURL.Path)
+	}
+
+	return resources.ParseByMethod(strings.TrimPrefix(rpcCtx.OriginalURL.Path, pathBase), method)
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,14 @@ var (
 	DefaultSheme = "http"
 )
 
+const (
+	// JSONContentType is the content type of a JSON request body.
+	JSONContentType = "application/json"
+
+	// MergePatchContentType is the content type of a JSON merge patch (RFC 7396) request body.
+	MergePatchContentType = "application/merge-patch+json"
+)
+
 var (
 	// ErrUnsupportedContentType represents the error of unsupported content-type.
 	ErrUnsupportedContentType = errors.New("unsupported Content-Type")
@@ -52,14 +61,25 @@ var (
 // is "application/json". It returns the body as a byte array or an error if the content type is not supported
 // or an error occurs while reading the body.
 func ReadJSONBody(r *http.Request) ([]byte, error) {
+	return readBody(r, JSONContentType)
+}
+
+// ReadMergePatchBody extracts the content of a PATCH request - it reads the body of the request if the content
+// type is "application/merge-patch+json" or "application/json". It returns the body as a byte array or an error
+// if the content type is not supported or an error occurs while reading the body.
+func ReadMergePatchBody(r *http.Request) ([]byte, error) {
+	return readBody(r, MergePatchContentType, JSONContentType)
+}
+
+func readBody(r *http.Request, allowedContentTypes ...string) ([]byte, error) {
 	defer r.Body.Close()
 
 	contentType := strings.ToLower(strings.TrimSpace(r.Header.Get(ContentTypeHeaderKey)))
 	if i := strings.Index(contentType, ";"); i > -1 {
-		contentType = contentType[0:i]
+		contentType = strings.TrimSpace(contentType[0:i])
 	}
 
-	if contentType != "application/json" {
+	if !slices.Contains(allowedContentTypes, contentType) {
 		return nil, ErrUnsupportedContentType
 	}
 	data, err := io.ReadAll(r.Body)
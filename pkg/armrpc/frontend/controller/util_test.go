@@ -65,6 +65,43 @@ func TestReadJSONBody(t *testing.T) {
 	}
 }
 
+func TestReadMergePatchBody(t *testing.T) {
+	content, err := json.Marshal(map[string]any{
+		"properties": map[string]any{
+			"size": "L",
+		},
+	})
+	require.NoError(t, err)
+
+	contentTypeTests := []struct {
+		contentType string
+		err         error
+	}{
+		{"application/merge-patch+json", nil},
+		{"application/merge-patch+json; charset=utf8", nil},
+		{"application/json", nil},
+		{"application/json-patch+json", ErrUnsupportedContentType},
+		{"plain/text", ErrUnsupportedContentType},
+	}
+
+	for _, tc := range contentTypeTests {
+		t.Run(tc.contentType, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, "http://github.com", bytes.NewBuffer(content))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+			// act
+			parsed, err := ReadMergePatchBody(req)
+			// assert
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(content), string(parsed))
+			}
+		})
+	}
+}
+
 var tag string = uuid.New().String()
 
 func TestValidateEtag_IfMatch(t *testing.T) {
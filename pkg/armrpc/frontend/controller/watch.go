@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// LastEventIDHeader is the header sent by server-sent event clients to resume after the last event they received.
+	LastEventIDHeader = "Last-Event-ID"
+
+	// DefaultWatchHeartbeatInterval is the interval at which heartbeat comments are sent to keep idle watches open.
+	DefaultWatchHeartbeatInterval = 30 * time.Second
+)
+
+// WatchConverter converts a stored object into the versioned resource sent in a watch event.
+type WatchConverter func(obj *database.Object) (any, error)
+
+// IsWatchRequest returns true if the request asks to watch the listed resources instead of listing them.
+func IsWatchRequest(req *http.Request) bool {
+	watch, err := strconv.ParseBool(req.URL.Query().Get(v1.WatchParameterName))
+	return err == nil && watch
+}
+
+// GetResumeToken returns the resume token of a watch request, from either the resumeToken query parameter
+// or the Last-Event-ID header.
+func GetResumeToken(req *http.Request) string {
+	if token := req.URL.Query().Get(v1.ResumeTokenParameterName); token != "" {
+		return token
+	}
+
+	return req.Header.Get(LastEventIDHeader)
+}
+
+// WatchResources watches the resources matching the query and returns a response that streams the changes
+// as server-sent events. It responds with 400 Bad Request if the database client does not support watching
+// and 410 Gone if the resume token has expired.
+func WatchResources(ctx context.Context, databaseClient database.Client, query database.Query, resumeToken string, convert WatchConverter) (rest.Response, error) {
+	watcher, ok := databaseClient.(database.Watcher)
+	if !ok {
+		return rest.NewBadRequestResponse("Watch is not supported by the configured database provider."), nil
+	}
+
+	events, err := watcher.Watch(ctx, query, resumeToken)
+	if errors.Is(err, &database.ErrResumeTokenExpired{}) {
+		return rest.NewGoneResponse(v1.CodeResumeTokenExpired, err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &WatchResponse{Events: events, Convert: convert, HeartbeatInterval: DefaultWatchHeartbeatInterval}, nil
+}
+
+// WatchResponse streams database change events to the client as server-sent events.
+type WatchResponse struct {
+	// Events is the channel of changes to stream. The response ends when the channel is closed.
+	Events <-chan database.Event
+
+	// Convert converts the stored objects of saved events into versioned resources.
+	Convert WatchConverter
+
+	// HeartbeatInterval is the interval at which heartbeat comments are sent.
+	HeartbeatInterval time.Duration
+}
+
+// Apply writes the events to the http.ResponseWriter as they arrive until the channel is closed or the request
+// is cancelled.
+func (r *WatchResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return fmt.Errorf("error flushing watch response: %w", err)
+	}
+
+	heartbeat := time.NewTicker(r.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+
+		case event, ok := <-r.Events:
+			if !ok {
+				return nil
+			}
+
+			data := v1.WatchEvent{
+				Type:        string(event.Type),
+				ID:          event.ID,
+				ResumeToken: event.ResumeToken,
+			}
+			if event.Object != nil {
+				resource, err := r.Convert(event.Object)
+				if err != nil {
+					logger.Error(err, "failed to convert watched resource", "resourceID", event.ID)
+					continue
+				}
+				data.Resource = resource
+			}
+
+			b, err := json.Marshal(data)
+			if err != nil {
+				return fmt.Errorf("error marshaling watch event: %w", err)
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResumeToken, event.Type, b); err != nil {
+				// The client has gone away.
+				return nil
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/changefeed"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIsWatchRequest(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected bool
+	}{
+		{"/resources", false},
+		{"/resources?watch=true", true},
+		{"/resources?watch=1", true},
+		{"/resources?watch=false", false},
+		{"/resources?watch=yes", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			require.Equal(t, tt.expected, IsWatchRequest(req))
+		})
+	}
+}
+
+func TestGetResumeToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/resources?watch=true", nil)
+	require.Empty(t, GetResumeToken(req))
+
+	req.Header.Set(LastEventIDHeader, "header-token")
+	require.Equal(t, "header-token", GetResumeToken(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/resources?watch=true&resumeToken=query-token", nil)
+	req.Header.Set(LastEventIDHeader, "header-token")
+	require.Equal(t, "query-token", GetResumeToken(req))
+}
+
+func TestWatchResources(t *testing.T) {
+	query := database.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg", ResourceType: "Applications.Core/environments"}
+	convert := func(obj *database.Object) (any, error) { return obj.Data, nil }
+
+	t.Run("not supported", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+
+		resp, err := WatchResources(context.Background(), databaseClient, query, "", convert)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, resp)
+	})
+
+	t.Run("resume token expired", func(t *testing.T) {
+		databaseClient := changefeed.New(inmemory.NewClient(), 0)
+
+		resp, err := WatchResources(context.Background(), databaseClient, query, "expired", convert)
+		require.NoError(t, err)
+		require.IsType(t, &rest.GoneResponse{}, resp)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		databaseClient := changefeed.New(inmemory.NewClient(), 0)
+		err := databaseClient.Save(ctx, &database.Object{
+			Metadata: database.Metadata{ID: query.RootScope + "/providers/Applications.Core/environments/env0"},
+			Data:     map[string]any{"name": "env0"},
+		})
+		require.NoError(t, err)
+
+		resp, err := WatchResources(ctx, databaseClient, query, "", convert)
+		require.NoError(t, err)
+		require.IsType(t, &WatchResponse{}, resp)
+
+		event := <-resp.(*WatchResponse).Events
+		require.Equal(t, database.EventTypeSaved, event.Type)
+	})
+}
+
+func TestWatchResponse_Apply(t *testing.T) {
+	events := make(chan database.Event, 2)
+	events <- database.Event{
+		Type:        database.EventTypeSaved,
+		ID:          "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0",
+		Object:      &database.Object{Data: map[string]any{"name": "env0"}},
+		ResumeToken: "token-1",
+	}
+	events <- database.Event{
+		Type:        database.EventTypeDeleted,
+		ID:          "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0",
+		ResumeToken: "token-2",
+	}
+	close(events)
+
+	resp := &WatchResponse{
+		Events:            events,
+		Convert:           func(obj *database.Object) (any, error) { return obj.Data, nil },
+		HeartbeatInterval: DefaultWatchHeartbeatInterval,
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/resources?watch=true", nil)
+	err := resp.Apply(context.Background(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	expected := "id: token-1\nevent: Saved\ndata: {\"type\":\"Saved\",\"id\":\"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0\",\"resource\":{\"name\":\"env0\"},\"resumeToken\":\"token-1\"}\n\n" +
+		"id: token-2\nevent: Deleted\ndata: {\"type\":\"Deleted\",\"id\":\"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0\",\"resumeToken\":\"token-2\"}\n\n"
+	require.Equal(t, expected, w.Body.String())
+}
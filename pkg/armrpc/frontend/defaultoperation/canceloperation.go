@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to request the cancellation of an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run records the cancellation request in the operation status and returns the status. The worker processing the
+// operation, which may run in another replica, cancels it and marks it as Canceled. It returns a NotFound response if
+// the operation does not exist and a Conflict response if it has already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	obj, err := e.DatabaseClient().Get(ctx, serviceCtx.ResourceID.String())
+	if errors.Is(&database.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	os := &manager.Status{}
+	if err := obj.As(os); err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("The operation %q has already completed with status %q.", serviceCtx.ResourceID.Name(), os.Status)), nil
+	}
+
+	if os.CancelRequestedTime == nil {
+		now := time.Now().UTC()
+		os.CancelRequestedTime = &now
+		obj.Data = os
+
+		err = e.DatabaseClient().Save(ctx, obj, database.WithETag(obj.ETag))
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			return rest.NewConflictResponse(fmt.Sprintf("The operation %q was updated while requesting the cancellation. Please try again.", serviceCtx.ResourceID.Name())), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return rest.NewOKResponse(os.AsyncOperationStatus), nil
+}
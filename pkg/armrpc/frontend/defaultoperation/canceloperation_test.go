@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	ctx := context.Background()
+
+	rawDataModel := testutil.ReadFixture("operationstatus_datamodel.json")
+
+	newStatus := func(state v1.ProvisioningState) *manager.Status {
+		os := &manager.Status{}
+		_ = json.Unmarshal(rawDataModel, os)
+		os.Status = state
+		return os
+	}
+
+	newRequest := func(t *testing.T) (context.Context, *httptest.ResponseRecorder, *http.Request) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPost, operationStatusTestHeaderFile, nil)
+		require.NoError(t, err)
+		return rpctest.NewARMRequestContext(req), w, req
+	}
+
+	t.Run("cancel non-existing operation", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return nil, &database.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("cancel completed operation", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id},
+					Data:     newStatus(v1.ProvisioningStateSucceeded),
+				}, nil
+			})
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+
+	t.Run("cancel running operation", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     newStatus(v1.ProvisioningStateUpdating),
+				}, nil
+			})
+
+		databaseClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+				require.Len(t, opts, 1)
+				os, ok := obj.Data.(*manager.Status)
+				require.True(t, ok)
+				require.NotNil(t, os.CancelRequestedTime)
+				return nil
+			})
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &v1.AsyncOperationStatus{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, v1.ProvisioningStateUpdating, actualOutput.Status)
+	})
+
+	t.Run("cancel operation updated concurrently", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     newStatus(v1.ProvisioningStateUpdating),
+				}, nil
+			})
+
+		databaseClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.ErrConcurrency{})
+
+		ctl, err := NewCancelOperation(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+}
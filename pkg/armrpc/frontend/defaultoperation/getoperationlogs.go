@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+var _ ctrl.Controller = (*GetOperationLogs)(nil)
+
+// GetOperationLogs is the controller implementation to get the logs of an async operation.
+type GetOperationLogs struct {
+	ctrl.BaseController
+}
+
+// NewGetOperationLogs creates a new GetOperationLogs.
+func NewGetOperationLogs(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetOperationLogs{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the logs captured during the execution of an asynchronous operation, such as the output of the recipes
+// it executed. The logs are saved when the operation completes, so an empty list is returned for operations that are
+// still running or that did not capture logs. It returns a NotFound response if the operation does not exist.
+func (e *GetOperationLogs) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	logs := &v1.AsyncOperationLogs{}
+	_, err := e.GetResource(ctx, manager.LogsResourceID(serviceCtx.ResourceID), logs)
+	if err == nil {
+		return rest.NewOKResponse(logs), nil
+	} else if !errors.Is(err, &database.ErrNotFound{}) {
+		return nil, err
+	}
+
+	os := &manager.Status{}
+	_, err = e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(&v1.AsyncOperationLogs{Value: []v1.AsyncOperationLogEntry{}}), nil
+}
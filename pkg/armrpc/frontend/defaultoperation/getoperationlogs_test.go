@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetOperationLogsRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	ctx := context.Background()
+
+	newRequest := func(t *testing.T) (context.Context, *httptest.ResponseRecorder, *http.Request) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, operationStatusTestHeaderFile, nil)
+		require.NoError(t, err)
+		return rpctest.NewARMRequestContext(req), w, req
+	}
+
+	notFound := func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+		return nil, &database.ErrNotFound{ID: id}
+	}
+
+	t.Run("get logs of non-existing operation", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		databaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(notFound).Times(2)
+
+		ctl, err := NewGetOperationLogs(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("get logs of operation without logs", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		os := &manager.Status{}
+		_ = json.Unmarshal(testutil.ReadFixture("operationstatus_datamodel.json"), os)
+
+		databaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(notFound)
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{Metadata: database.Metadata{ID: id}, Data: os}, nil
+			})
+
+		ctl, err := NewGetOperationLogs(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &v1.AsyncOperationLogs{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Empty(t, actualOutput.Value)
+	})
+
+	t.Run("get logs", func(t *testing.T) {
+		ctx, w, req := newRequest(t)
+
+		logs := &v1.AsyncOperationLogs{
+			Value: []v1.AsyncOperationLogEntry{
+				{Source: "terraform", Level: v1.LogLevelError, Message: "Error: Invalid provider configuration"},
+			},
+			DroppedEntries: 2,
+		}
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				require.True(t, strings.HasSuffix(id, "/locations/westus/operationlogs/00000000-0000-0000-0000-000000000000"))
+				return &database.Object{Metadata: database.Metadata{ID: id}, Data: logs}, nil
+			})
+
+		ctl, err := NewGetOperationLogs(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &v1.AsyncOperationLogs{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, logs, actualOutput)
+	})
+}
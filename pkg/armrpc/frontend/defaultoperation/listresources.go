@@ -44,7 +44,8 @@ func NewListResources[P interface {
 }
 
 // Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// is returned if the query fails. When the watch query parameter is set, Run instead streams the changes to the resources as
+// server-sent events.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -54,6 +55,12 @@ func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, re
 		ScopeRecursive: e.listRecursiveQuery,
 	}
 
+	if ctrl.IsWatchRequest(req) {
+		return ctrl.WatchResources(ctx, e.DatabaseClient(), query, ctrl.GetResumeToken(req), func(obj *database.Object) (any, error) {
+			return e.convert(obj, serviceCtx.APIVersion)
+		})
+	}
+
 	result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
@@ -69,12 +76,7 @@ func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req
 
 	items := []any{}
 	for _, item := range result.Items {
-		resource := new(T)
-		if err := item.As(resource); err != nil {
-			return nil, err
-		}
-
-		versioned, err := e.ResponseConverter()(resource, serviceCtx.APIVersion)
+		versioned, err := e.convert(&item, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -87,3 +89,12 @@ func (e *ListResources[P, T]) createPaginationResponse(ctx context.Context, req
 		NextLink: ctrl.GetNextLinkURL(ctx, req, result.PaginationToken),
 	}, nil
 }
+
+func (e *ListResources[P, T]) convert(obj *database.Object, apiVersion string) (any, error) {
+	resource := new(T)
+	if err := obj.As(resource); err != nil {
+		return nil, err
+	}
+
+	return e.ResponseConverter()(resource, apiVersion)
+}
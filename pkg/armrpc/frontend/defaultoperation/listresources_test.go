@@ -17,17 +17,21 @@ limitations under the License.
 package defaultoperation
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/changefeed"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -184,3 +188,100 @@ func TestListResourcesRun(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesRun_Watch(t *testing.T) {
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, resourceTestHeaderFile, nil)
+	require.NoError(t, err)
+	serviceCtx := v1.ARMRequestContextFromContext(rpctest.NewARMRequestContext(req))
+
+	databaseClient := changefeed.New(inmemory.NewClient(), 0)
+	err = databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: serviceCtx.ResourceID.String()},
+		Data:     &testDataModel{Name: "env0"},
+	})
+	require.NoError(t, err)
+
+	ctl, err := NewListResources(ctrl.Options{DatabaseClient: databaseClient}, ctrl.ResourceOptions[testDataModel]{
+		ResponseConverter: resourceToVersioned,
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := v1.WithARMRequestContext(r.Context(), serviceCtx)
+		resp, err := ctl.Run(ctx, w, r)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, r)
+	}))
+	defer server.Close()
+
+	watch := func(t *testing.T, resumeToken string) *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"?watch=true", nil)
+		require.NoError(t, err)
+		if resumeToken != "" {
+			req.Header.Set(ctrl.LastEventIDHeader, resumeToken)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	readEvent := func(t *testing.T, scanner *bufio.Scanner) (string, v1.WatchEvent) {
+		id := ""
+		event := v1.WatchEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+			case line == "" && id != "":
+				return id, event
+			}
+		}
+		require.FailNow(t, "stream ended before an event was received")
+		return "", event
+	}
+
+	resp := watch(t, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	scanner := bufio.NewScanner(resp.Body)
+
+	id, event := readEvent(t, scanner)
+	require.Equal(t, v1.WatchEventTypeSaved, event.Type)
+	require.Equal(t, serviceCtx.ResourceID.String(), event.ID)
+	require.Equal(t, id, event.ResumeToken)
+	require.Equal(t, map[string]any{"name": "env0"}, event.Resource)
+
+	err = databaseClient.Delete(context.Background(), serviceCtx.ResourceID.String())
+	require.NoError(t, err)
+
+	id, event = readEvent(t, scanner)
+	require.Equal(t, v1.WatchEventTypeDeleted, event.Type)
+	require.Nil(t, event.Resource)
+	resp.Body.Close()
+
+	t.Run("resume", func(t *testing.T) {
+		err := databaseClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: serviceCtx.ResourceID.String()},
+			Data:     &testDataModel{Name: "env1"},
+		})
+		require.NoError(t, err)
+
+		resp := watch(t, id)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_, event := readEvent(t, bufio.NewScanner(resp.Body))
+		require.Equal(t, v1.WatchEventTypeSaved, event.Type)
+		require.Equal(t, map[string]any{"name": "env1"}, event.Resource)
+	})
+
+	t.Run("resume token expired", func(t *testing.T) {
+		resp := watch(t, "invalid")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusGone, resp.StatusCode)
+	})
+}
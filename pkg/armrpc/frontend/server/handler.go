@@ -135,7 +135,8 @@ func CreateHandler(ctx context.Context, resourceType string, operationMethod v1.
 		return nil, err
 	}
 
-	handler := HandlerForController(ctrl, v1.OperationType{Type: resourceType, Method: operationMethod})
+	operationType := v1.OperationType{Type: resourceType, Method: operationMethod}
+	handler := withLockChecks(HandlerForController(ctrl, operationType), operationType, opts.LockChecker, opts.PathBase)
 	return handler, nil
 }
 
@@ -174,7 +175,7 @@ func RegisterHandler(ctx context.Context, opts HandlerOptions, ctrlOpts ctrl.Opt
 		return nil
 	}
 
-	handler := HandlerForController(ctrl, *opts.OperationType)
+	handler := withLockChecks(HandlerForController(ctrl, *opts.OperationType), *opts.OperationType, ctrlOpts.LockChecker, ctrlOpts.PathBase)
 	namedRouter := opts.ParentRouter.With(opts.Middlewares...)
 	if opts.Path == CatchAllPath {
 		namedRouter.HandleFunc(opts.Path, handler)
@@ -185,6 +186,46 @@ func RegisterHandler(ctx context.Context, opts HandlerOptions, ctrlOpts ctrl.Opt
 	return nil
 }
 
+// withLockChecks wraps the handler of a PUT, PATCH or DELETE operation so that the request is rejected when a management
+// lock prevents it. The handler is returned unchanged for other operations, or when locks are not checked.
+func withLockChecks(handler http.HandlerFunc, operationType v1.OperationType, lockChecker ctrl.LockChecker, pathBase string) http.HandlerFunc {
+	if lockChecker == nil {
+		return handler
+	}
+
+	switch operationType.Method {
+	case v1.OperationPut, v1.OperationPatch, v1.OperationDelete:
+	default:
+		return handler
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		id, err := ctrl.LockTargetID(ctx, pathBase, req.Method)
+		if err != nil {
+			_ = rest.NewBadRequestResponse(err.Error()).Apply(ctx, w, req)
+			return
+		}
+
+		response, err := lockChecker.CheckLocks(ctx, id, req.Method)
+		if err != nil {
+			HandleError(ctx, w, req, err)
+			return
+		}
+
+		if response != nil {
+			err = response.Apply(ctx, w, req)
+			if err != nil {
+				HandleError(ctx, w, req, err)
+			}
+			return
+		}
+
+		handler(w, req)
+	}
+}
+
 func withOtelLabelsForRequest(req *http.Request) {
 	labeler, ok := otelhttp.LabelerFromContext(req.Context())
 	if !ok {
@@ -251,6 +292,28 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            v1.OperationPost,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/logs",
+		ResourceType:      statusRT,
+		Method:            v1.OperationGet,
+		ControllerFactory: defaultoperation.NewGetOperationLogs,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -34,6 +34,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -289,3 +290,81 @@ func Test_HandlerForController_OperationType(t *testing.T) {
 
 	require.Equal(t, expectedType.String(), rCtx.OperationType.String())
 }
+
+type testLockChecker struct {
+	response rest.Response
+	err      error
+	called   bool
+	id       resources.ID
+}
+
+func (c *testLockChecker) CheckLocks(ctx context.Context, id resources.ID, method string) (rest.Response, error) {
+	c.called = true
+	c.id = id
+	return c.response, c.err
+}
+
+func Test_WithLockChecks(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/test")
+	referer := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/other")
+
+	tests := []struct {
+		name           string
+		method         v1.OperationMethod
+		checker        *testLockChecker
+		expectedCalled bool
+		expectedStatus int
+	}{
+		{
+			name:           "allowed",
+			method:         v1.OperationDelete,
+			checker:        &testLockChecker{},
+			expectedCalled: true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "locked",
+			method:         v1.OperationPut,
+			checker:        &testLockChecker{response: rest.NewConflictResponse("locked")},
+			expectedCalled: true,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "error",
+			method:         v1.OperationPatch,
+			checker:        &testLockChecker{err: errors.New("failed")},
+			expectedCalled: true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "read is not checked",
+			method:         v1.OperationGet,
+			checker:        &testLockChecker{response: rest.NewConflictResponse("locked")},
+			expectedCalled: false,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}
+			handler := withLockChecks(inner, v1.OperationType{Type: "Applications.Test/testResources", Method: tt.method}, tt.checker, "/path/base")
+
+			req := httptest.NewRequest(tt.method.HTTPMethod(), "/path/base"+id.String(), nil)
+			// The resource ID of the context comes from the Referer header, so it must not be used to check the locks.
+			rpcCtx := &v1.ARMRequestContext{ResourceID: referer, OriginalURL: *req.URL}
+			req = req.WithContext(v1.WithARMRequestContext(testcontext.New(t), rpcCtx))
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedCalled, tt.checker.called)
+			require.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedCalled {
+				require.Equal(t, id, tt.checker.id)
+			}
+		})
+	}
+}
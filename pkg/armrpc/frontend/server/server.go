@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/pkg/version"
@@ -45,6 +46,9 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// Audit configures the audit log of mutating requests. Auditing is disabled when nil.
+	Audit *audit.MiddlewareOptions
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -64,6 +68,9 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
+	if options.Audit != nil {
+		r.Use(audit.Middleware(*options.Audit))
+	}
 
 	r.Get(versionEndpoint, version.ReportVersionHandler)
 	r.Get(healthzEndpoint, version.ReportVersionHandler)
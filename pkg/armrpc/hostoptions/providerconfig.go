@@ -19,6 +19,7 @@ package hostoptions
 import (
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/components/audit/auditprovider"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
@@ -46,6 +47,10 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	DriftDetection   DriftDetectionOptions                `yaml:"driftDetection,omitempty"`
+	RecipeDrivers    []RecipeDriverPluginOptions          `yaml:"recipeDrivers,omitempty"`
+	RecipeCache      RecipeCacheOptions                   `yaml:"recipeCache,omitempty"`
+	AuditProvider    auditprovider.Options                `yaml:"auditProvider,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -94,6 +99,35 @@ type BicepOptions struct {
 	DeleteRetryDelaySeconds string `yaml:"deleteRetryDelaySeconds,omitempty"`
 }
 
+// DriftDetectionOptions includes options for detecting drift of the output resources deployed by recipes.
+type DriftDetectionOptions struct {
+	// Enabled enables the periodic drift detection.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// IntervalSeconds is the time between two drift checks of the same resource in seconds. Defaults to one hour.
+	IntervalSeconds int `yaml:"intervalSeconds,omitempty"`
+}
+
+// RecipeDriverPluginOptions includes options for an out-of-process recipe driver plugin.
+type RecipeDriverPluginOptions struct {
+	// Kind is the recipe kind handled by the plugin, e.g. "pulumi". Recipe definitions select the plugin by their kind.
+	Kind string `yaml:"kind"`
+	// Command is the path to the plugin executable.
+	Command string `yaml:"command"`
+	// Args are the arguments passed to the plugin executable.
+	Args []string `yaml:"args,omitempty"`
+	// TimeoutSeconds is the time the plugin is given to complete an operation in seconds. Defaults to 30 minutes.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+// RecipeCacheOptions includes options for the cache of downloaded recipe artifacts, such as recipe layers, Terraform
+// modules and provider plugins.
+type RecipeCacheOptions struct {
+	// Path is the path to the directory of the cache. The cache is disabled if it is empty.
+	Path string `yaml:"path,omitempty"`
+	// MaxSizeMB is the maximum size of the cache in megabytes. Defaults to 2048.
+	MaxSizeMB int `yaml:"maxSizeMB,omitempty"`
+}
+
 // TerraformOptions includes options required for terraform execution.
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
@@ -727,6 +727,80 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given message.
+func NewForbiddenResponse(message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
+// GoneResponse represents an HTTP 410 with an ARM error payload.
+type GoneResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewGoneResponse creates a GoneResponse with the given error code and message.
+func NewGoneResponse(code string, message string) Response {
+	return &GoneResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    code,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 410 Gone HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *GoneResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusGone), logging.LogHTTPStatusCode, http.StatusGone)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusGone)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
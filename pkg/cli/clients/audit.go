@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_auditclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients AuditClient
+
+// AuditClient is used to query the audit log of mutating operations.
+type AuditClient interface {
+	// ListAuditRecords lists the audit records selected by the query, newest first.
+	ListAuditRecords(ctx context.Context, query audit.Query) ([]*audit.Record, error)
+}
+
+var _ AuditClient = (*UCPAuditClient)(nil)
+
+// UCPAuditClient implements AuditClient using the audit admin API of UCP.
+type UCPAuditClient struct {
+	Connection sdk.Connection
+}
+
+// ListAuditRecords lists the audit records selected by the query, newest first. Error responses are returned as
+// *azcore.ResponseError.
+func (c *UCPAuditClient) ListAuditRecords(ctx context.Context, query audit.Query) ([]*audit.Record, error) {
+	values := url.Values{}
+	if query.ResourceID != "" {
+		values.Set(admin.ResourceIDParameter, query.ResourceID)
+	}
+	if !query.Since.IsZero() {
+		values.Set(admin.SinceParameter, query.Since.UTC().Format(time.RFC3339))
+	}
+	if !query.Until.IsZero() {
+		values.Set(admin.UntilParameter, query.Until.UTC().Format(time.RFC3339))
+	}
+	if query.Top > 0 {
+		values.Set(admin.TopParameter, strconv.Itoa(query.Top))
+	}
+
+	u := c.Connection.Endpoint() + admin.AuditRecordsPath
+	if len(values) > 0 {
+		u += "?" + values.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &admin.AuditRecordList{}
+	if err := json.Unmarshal(body, list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit response: %w", err)
+	}
+	return list.Value, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
+)
+
+func Test_UCPAuditClient(t *testing.T) {
+	ctx := context.Background()
+
+	// The database sink only reads recent days unless the query selects a start time.
+	now := time.Now().UTC().Truncate(time.Second)
+	sink := audit.NewDatabaseSink(inmemory.NewClient(), 0)
+	require.NoError(t, sink.Write(ctx, &audit.Record{ID: "1", Timestamp: now.Add(-time.Hour), ResourceID: "/planes/radius/local/resourceGroups/a/providers/Applications.Core/containers/c"}))
+	require.NoError(t, sink.Write(ctx, &audit.Record{ID: "2", Timestamp: now, ResourceID: "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/c"}))
+
+	router := chi.NewRouter()
+	admin.RegisterAuditRoutes(router, "/apis/api.ucp.dev/v1alpha3"+admin.AuditRecordsPath, sink)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPAuditClient{Connection: connection}
+
+	records, err := client.ListAuditRecords(ctx, audit.Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "2", records[0].ID)
+
+	records, err = client.ListAuditRecords(ctx, audit.Query{ResourceID: "/planes/radius/local/resourceGroups/a"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "1", records[0].ID)
+
+	records, err = client.ListAuditRecords(ctx, audit.Query{Since: now.Add(-30 * time.Minute), Until: now.Add(time.Minute), Top: 5})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "2", records[0].ID)
+}
+
+func Test_UCPAuditClient_Disabled(t *testing.T) {
+	router := chi.NewRouter()
+	admin.RegisterAuditRoutes(router, "/apis/api.ucp.dev/v1alpha3"+admin.AuditRecordsPath, nil)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPAuditClient{Connection: connection}
+
+	_, err = client.ListAuditRecords(context.Background(), audit.Query{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "auditing is not enabled")
+}
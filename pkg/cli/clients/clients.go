@@ -178,6 +178,9 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResource creates or updates a resource using its type name (or id).
 	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error)
 
+	// UpdateResource applies a JSON merge patch (RFC 7396) to a resource using its type name (or id).
+	UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error)
+
 	// DeleteResource deletes a resource by its type and name (or id).
 	// When force is true, the delete will proceed even if the resource is in a non-terminal provisioning state.
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string, force bool) (bool, error)
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_deadletterclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DeadLetterClient
+
+// DeadLetterClient is used to inspect, replay or purge async operations in the dead-letter queue. An empty queue name
+// selects the queue of UCP.
+type DeadLetterClient interface {
+	// ListDeadLetters lists the dead-lettered messages of a queue, oldest first.
+	ListDeadLetters(ctx context.Context, queueName string) ([]*queue.DeadLetter, error)
+
+	// GetDeadLetter gets a dead-lettered message.
+	GetDeadLetter(ctx context.Context, queueName string, id string) (*queue.DeadLetter, error)
+
+	// ReplayDeadLetter moves a dead-lettered message back to its queue.
+	ReplayDeadLetter(ctx context.Context, queueName string, id string) error
+
+	// PurgeDeadLetter deletes a dead-lettered message.
+	PurgeDeadLetter(ctx context.Context, queueName string, id string) error
+}
+
+var _ DeadLetterClient = (*UCPDeadLetterClient)(nil)
+
+// UCPDeadLetterClient implements DeadLetterClient using the dead-letter admin API of UCP.
+type UCPDeadLetterClient struct {
+	Connection sdk.Connection
+}
+
+// ListDeadLetters lists the dead-lettered messages of a queue, oldest first.
+func (c *UCPDeadLetterClient) ListDeadLetters(ctx context.Context, queueName string) ([]*queue.DeadLetter, error) {
+	list := &admin.DeadLetterList{}
+	if err := c.do(ctx, http.MethodGet, queueName, "", list); err != nil {
+		return nil, err
+	}
+	return list.Value, nil
+}
+
+// GetDeadLetter gets a dead-lettered message.
+func (c *UCPDeadLetterClient) GetDeadLetter(ctx context.Context, queueName string, id string) (*queue.DeadLetter, error) {
+	letter := &queue.DeadLetter{}
+	if err := c.do(ctx, http.MethodGet, queueName, "/"+url.PathEscape(id), letter); err != nil {
+		return nil, err
+	}
+	return letter, nil
+}
+
+// ReplayDeadLetter moves a dead-lettered message back to its queue.
+func (c *UCPDeadLetterClient) ReplayDeadLetter(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodPost, queueName, "/"+url.PathEscape(id)+"/replay", nil)
+}
+
+// PurgeDeadLetter deletes a dead-lettered message.
+func (c *UCPDeadLetterClient) PurgeDeadLetter(ctx context.Context, queueName string, id string) error {
+	return c.do(ctx, http.MethodDelete, queueName, "/"+url.PathEscape(id), nil)
+}
+
+// do sends a request to the dead-letter admin API and decodes the response into result, if it is not nil. Error
+// responses are returned as *azcore.ResponseError so that Is404Error works.
+func (c *UCPDeadLetterClient) do(ctx context.Context, method string, queueName string, path string, result any) error {
+	u := c.Connection.Endpoint() + admin.DeadLettersPath + path
+	if queueName != "" {
+		u += "?" + url.Values{admin.QueueNameParameter: []string{queueName}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to unmarshal dead-letter response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
+)
+
+func Test_UCPDeadLetterClient(t *testing.T) {
+	ctx := context.Background()
+
+	letter := &queue.DeadLetter{
+		ID:             "message-1",
+		DeadLetterInfo: queue.DeadLetterInfo{Reason: queue.DeadLetterReasonPanic, Error: "boom"},
+		DequeueCount:   1,
+		Data:           []byte(`{"operationId":"op"}`),
+	}
+
+	var queueNames []string
+	getClient := func(ctx context.Context, queueName string) (queue.DeadLetterClient, error) {
+		queueNames = append(queueNames, queueName)
+		return &fakeDeadLetterQueue{letters: map[string]*queue.DeadLetter{letter.ID: letter}}, nil
+	}
+
+	router := chi.NewRouter()
+	admin.RegisterDeadLetterRoutes(router, "/apis/api.ucp.dev/v1alpha3"+admin.DeadLettersPath, getClient)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPDeadLetterClient{Connection: connection}
+
+	letters, err := client.ListDeadLetters(ctx, "radius")
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	require.Equal(t, letter.ID, letters[0].ID)
+	require.JSONEq(t, string(letter.Data), string(letters[0].Data))
+
+	got, err := client.GetDeadLetter(ctx, "", letter.ID)
+	require.NoError(t, err)
+	require.Equal(t, letter.DeadLetterInfo, got.DeadLetterInfo)
+
+	_, err = client.GetDeadLetter(ctx, "", "missing")
+	require.True(t, Is404Error(err))
+
+	require.NoError(t, client.ReplayDeadLetter(ctx, "", letter.ID))
+	require.NoError(t, client.PurgeDeadLetter(ctx, "", letter.ID))
+	require.True(t, Is404Error(client.PurgeDeadLetter(ctx, "", "missing")))
+
+	require.Equal(t, "radius", queueNames[0])
+	require.Equal(t, "", queueNames[1])
+}
+
+// fakeDeadLetterQueue is a queue.DeadLetterClient serving a fixed set of dead letters.
+type fakeDeadLetterQueue struct {
+	queue.DeadLetterClient
+	letters map[string]*queue.DeadLetter
+}
+
+func (f *fakeDeadLetterQueue) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	result := []*queue.DeadLetter{}
+	for _, l := range f.letters {
+		result = append(result, l)
+	}
+	return result, nil
+}
+
+func (f *fakeDeadLetterQueue) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	if l, ok := f.letters[id]; ok {
+		return l, nil
+	}
+	return nil, queue.ErrDeadLetterNotFound
+}
+
+func (f *fakeDeadLetterQueue) ReplayDeadLetter(ctx context.Context, id string) error {
+	_, err := f.GetDeadLetter(ctx, id)
+	return err
+}
+
+func (f *fakeDeadLetterQueue) PurgeDeadLetter(ctx context.Context, id string) error {
+	_, err := f.GetDeadLetter(ctx, id)
+	return err
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -78,3 +79,25 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// IsResumeTokenExpiredError returns true if the error is the 410 Gone response returned by a watch whose resume
+// token has expired.
+func IsResumeTokenExpiredError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	if !errors.As(err, &responseError) {
+		return false
+	}
+
+	return responseError.ErrorCode == v1.CodeResumeTokenExpired || responseError.StatusCode == http.StatusGone
+}
+
+// IsRecipePlanNotSupportedError returns true if the error is returned by the planRecipe action of an environment whose
+// recipe driver cannot plan the recipe.
+func IsRecipePlanNotSupportedError(err error) bool {
+	responseError := &azcore.ResponseError{}
+	if !errors.As(err, &responseError) {
+		return false
+	}
+
+	return responseError.ErrorCode == recipes.RecipePlanNotSupported
+}
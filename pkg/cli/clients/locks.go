@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_lockclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients LockClient
+
+// LockClient is used to manage the locks that protect UCP scopes and resources from being modified or deleted.
+type LockClient interface {
+	// ListLocks lists the locks of a plane.
+	ListLocks(ctx context.Context, planeName string) ([]*ucpv20231001.LockResource, error)
+
+	// CreateOrUpdateLock creates or updates a lock.
+	CreateOrUpdateLock(ctx context.Context, planeName string, name string, resource *ucpv20231001.LockResource) error
+
+	// DeleteLock deletes a lock. It returns false if the lock did not exist.
+	DeleteLock(ctx context.Context, planeName string, name string) (bool, error)
+}
+
+var _ LockClient = (*UCPLockClient)(nil)
+
+// UCPLockClient implements LockClient using the System.Authorization APIs of UCP.
+type UCPLockClient struct {
+	Connection sdk.Connection
+}
+
+// ListLocks lists the locks of a plane.
+func (c *UCPLockClient) ListLocks(ctx context.Context, planeName string) ([]*ucpv20231001.LockResource, error) {
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*ucpv20231001.LockResource{}
+	pager := client.NewListPager(planeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page.Value...)
+	}
+
+	return results, nil
+}
+
+// CreateOrUpdateLock creates or updates a lock.
+func (c *UCPLockClient) CreateOrUpdateLock(ctx context.Context, planeName string, name string, resource *ucpv20231001.LockResource) error {
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, name, *resource, nil)
+	return err
+}
+
+// DeleteLock deletes a lock. It returns false if the lock did not exist.
+func (c *UCPLockClient) DeleteLock(ctx context.Context, planeName string, name string) (bool, error) {
+	client, err := ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = policy.WithCaptureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, name, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != http.StatusNoContent, nil
+}
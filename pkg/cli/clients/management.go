@@ -178,6 +178,31 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.C
 	return response.GenericResource, nil
 }
 
+// UpdateResource applies a JSON merge patch (RFC 7396) to a resource using its type name (or id).
+func (amc *UCPApplicationsManagementClient) UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error) {
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	client, err := amc.createGenericClient(scope, resourceType)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	poller, err := client.BeginUpdate(ctx, name, patch, &generated.GenericResourcesClientBeginUpdateOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	response, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return response.GenericResource, nil
+}
+
 // DeleteResource deletes a resource by its type and name (or id).
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string, force bool) (bool, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
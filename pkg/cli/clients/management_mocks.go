@@ -41,6 +41,7 @@ import (
 type genericResourceClient interface {
 	BeginCreateOrUpdate(ctx context.Context, resourceName string, genericResourceParameters generated.GenericResource, options *generated.GenericResourcesClientBeginCreateOrUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error)
 	BeginDelete(ctx context.Context, resourceName string, options *generated.GenericResourcesClientBeginDeleteOptions) (*runtime.Poller[generated.GenericResourcesClientDeleteResponse], error)
+	BeginUpdate(ctx context.Context, resourceName string, genericResourceParameters map[string]any, options *generated.GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientUpdateResponse], error)
 	Get(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (generated.GenericResourcesClientGetResponse, error)
 	NewListByRootScopePager(options *generated.GenericResourcesClientListByRootScopeOptions) *runtime.Pager[generated.GenericResourcesClientListByRootScopeResponse]
 }
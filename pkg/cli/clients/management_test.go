@@ -638,6 +638,25 @@ func Test_Resource(t *testing.T) {
 		require.Equal(t, expectedResource, response)
 	})
 
+	t.Run("UpdateResource", func(t *testing.T) {
+		mock := NewMockgenericResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		patch := map[string]any{
+			"properties": map[string]any{
+				"size": "L",
+			},
+		}
+
+		mock.EXPECT().
+			BeginUpdate(gomock.Any(), testResourceName, patch, gomock.Any()).
+			Return(poller(&generated.GenericResourcesClientUpdateResponse{GenericResource: expectedResource}), nil)
+
+		response, err := client.UpdateResource(context.Background(), testResourceType, testResourceID, patch)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, response)
+	})
+
 	t.Run("DeleteResource", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := NewMockgenericResourceClient(ctrl)
@@ -1755,3 +1755,42 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateResource mocks base method.
+func (m *MockApplicationsManagementClient) UpdateResource(ctx context.Context, resourceType, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", ctx, resourceType, resourceNameOrID, patch)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockApplicationsManagementClientMockRecorder) UpdateResource(ctx, resourceType, resourceNameOrID, patch any) *MockApplicationsManagementClientUpdateResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).UpdateResource), ctx, resourceType, resourceNameOrID, patch)
+	return &MockApplicationsManagementClientUpdateResourceCall{Call: call}
+}
+
+// MockApplicationsManagementClientUpdateResourceCall wrap *gomock.Call
+type MockApplicationsManagementClientUpdateResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientUpdateResourceCall) Return(arg0 generated.GenericResource, arg1 error) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientUpdateResourceCall) Do(f func(context.Context, string, string, map[string]any) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientUpdateResourceCall) DoAndReturn(f func(context.Context, string, string, map[string]any) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
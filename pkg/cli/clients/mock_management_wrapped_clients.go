@@ -123,6 +123,45 @@ func (c *MockgenericResourceClientBeginDeleteCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// BeginUpdate mocks base method.
+func (m *MockgenericResourceClient) BeginUpdate(ctx context.Context, resourceName string, genericResourceParameters map[string]any, options *generated.GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientUpdateResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginUpdate", ctx, resourceName, genericResourceParameters, options)
+	ret0, _ := ret[0].(*runtime.Poller[generated.GenericResourcesClientUpdateResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginUpdate indicates an expected call of BeginUpdate.
+func (mr *MockgenericResourceClientMockRecorder) BeginUpdate(ctx, resourceName, genericResourceParameters, options any) *MockgenericResourceClientBeginUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginUpdate", reflect.TypeOf((*MockgenericResourceClient)(nil).BeginUpdate), ctx, resourceName, genericResourceParameters, options)
+	return &MockgenericResourceClientBeginUpdateCall{Call: call}
+}
+
+// MockgenericResourceClientBeginUpdateCall wrap *gomock.Call
+type MockgenericResourceClientBeginUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgenericResourceClientBeginUpdateCall) Return(arg0 *runtime.Poller[generated.GenericResourcesClientUpdateResponse], arg1 error) *MockgenericResourceClientBeginUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgenericResourceClientBeginUpdateCall) Do(f func(context.Context, string, map[string]any, *generated.GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientUpdateResponse], error)) *MockgenericResourceClientBeginUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgenericResourceClientBeginUpdateCall) DoAndReturn(f func(context.Context, string, map[string]any, *generated.GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientUpdateResponse], error)) *MockgenericResourceClientBeginUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockgenericResourceClient) Get(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (generated.GenericResourcesClientGetResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_operationclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients OperationClient
+
+// operationAPIVersion is the api-version sent with operation requests. Operation statuses are not versioned, so any
+// api-version accepted by the resource provider works.
+const operationAPIVersion = "2023-10-01-preview"
+
+// OperationClient is used to manage async operations.
+type OperationClient interface {
+	// CancelOperation requests the cancellation of an async operation given the ID of its operation status. The
+	// operation is canceled asynchronously and the returned status is the status at the time of the request.
+	CancelOperation(ctx context.Context, operationStatusID string) (*v1.AsyncOperationStatus, error)
+
+	// GetOperationLogs gets the logs captured during the execution of an async operation, such as the output of the
+	// recipes it executed, given the ID of its operation status.
+	GetOperationLogs(ctx context.Context, operationStatusID string) (*v1.AsyncOperationLogs, error)
+}
+
+var _ OperationClient = (*UCPOperationClient)(nil)
+
+// UCPOperationClient implements OperationClient using the operationStatuses API of the resource providers.
+type UCPOperationClient struct {
+	Connection sdk.Connection
+}
+
+// CancelOperation requests the cancellation of an async operation given the ID of its operation status. Error
+// responses are returned as *azcore.ResponseError so that Is404Error works.
+func (c *UCPOperationClient) CancelOperation(ctx context.Context, operationStatusID string) (*v1.AsyncOperationStatus, error) {
+	status := &v1.AsyncOperationStatus{}
+	if err := c.send(ctx, http.MethodPost, operationStatusID+"/cancel", status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// GetOperationLogs gets the logs of an async operation given the ID of its operation status. Error responses are
+// returned as *azcore.ResponseError so that Is404Error works.
+func (c *UCPOperationClient) GetOperationLogs(ctx context.Context, operationStatusID string) (*v1.AsyncOperationLogs, error) {
+	logs := &v1.AsyncOperationLogs{}
+	if err := c.send(ctx, http.MethodGet, operationStatusID+"/logs", logs); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// send sends a request to the given path of the operationStatuses API and unmarshals the response into out.
+func (c *UCPOperationClient) send(ctx context.Context, method string, path string, out any) error {
+	u := c.Connection.Endpoint() + path + "?" + url.Values{"api-version": []string{operationAPIVersion}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
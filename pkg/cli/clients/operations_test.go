@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_UCPOperationClient(t *testing.T) {
+	ctx := context.Background()
+
+	const operationStatusID = "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/op-1"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, operationAPIVersion, r.URL.Query().Get("api-version"))
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /apis/api.ucp.dev/v1alpha3" + operationStatusID + "/cancel":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&v1.AsyncOperationStatus{Name: "op-1", Status: v1.ProvisioningStateUpdating})
+		case "GET /apis/api.ucp.dev/v1alpha3" + operationStatusID + "/logs":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&v1.AsyncOperationLogs{
+				Value: []v1.AsyncOperationLogEntry{{Source: "terraform", Level: v1.LogLevelInfo, Message: "Apply complete!"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPOperationClient{Connection: connection}
+
+	status, err := client.CancelOperation(ctx, operationStatusID)
+	require.NoError(t, err)
+	require.Equal(t, "op-1", status.Name)
+	require.Equal(t, v1.ProvisioningStateUpdating, status.Status)
+
+	_, err = client.CancelOperation(ctx, "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/missing")
+	require.True(t, Is404Error(err))
+
+	logs, err := client.GetOperationLogs(ctx, operationStatusID)
+	require.NoError(t, err)
+	require.Len(t, logs.Value, 1)
+	require.Equal(t, "Apply complete!", logs.Value[0].Message)
+
+	_, err = client.GetOperationLogs(ctx, "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/missing")
+	require.True(t, Is404Error(err))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_recipepacklockclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients RecipePackLockClient
+
+const (
+	// radiusCoreAPIVersion is the api-version used for the updatelocks action of recipe packs.
+	radiusCoreAPIVersion = "2025-08-01-preview"
+)
+
+// RecipePackLockClient is used to manage the locks of the recipes in recipe packs.
+type RecipePackLockClient interface {
+	// UpdateLocks updates the locks of the recipes in the recipe pack with the given ID to the immutable references
+	// their sources currently resolve to. A dry run only reports the available updates.
+	UpdateLocks(ctx context.Context, recipePackID string, request recipes.RecipeLockUpdateRequest) (*recipes.RecipeLockUpdates, error)
+}
+
+var _ RecipePackLockClient = (*UCPRecipePackLockClient)(nil)
+
+// UCPRecipePackLockClient implements RecipePackLockClient using the updatelocks action of recipe packs.
+type UCPRecipePackLockClient struct {
+	Connection sdk.Connection
+}
+
+// UpdateLocks updates the locks of the recipes in the recipe pack with the given ID. Error responses are returned as
+// *azcore.ResponseError so that Is404Error works.
+func (c *UCPRecipePackLockClient) UpdateLocks(ctx context.Context, recipePackID string, request recipes.RecipeLockUpdateRequest) (*recipes.RecipeLockUpdates, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.Connection.Endpoint() + recipePackID + "/updatelocks?" + url.Values{"api-version": []string{radiusCoreAPIVersion}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := &recipes.RecipeLockUpdates{}
+	if err := json.Unmarshal(b, updates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recipe lock updates: %w", err)
+	}
+	return updates, nil
+}
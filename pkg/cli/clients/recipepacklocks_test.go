@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_UCPRecipePackLockClient(t *testing.T) {
+	ctx := context.Background()
+
+	const recipePackID = "/planes/radius/local/resourceGroups/test-rg/providers/Radius.Core/recipePacks/pack0"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		if r.URL.Path != "/apis/api.ucp.dev/v1alpha3"+recipePackID+"/updatelocks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, radiusCoreAPIVersion, r.URL.Query().Get("api-version"))
+
+		request := recipes.RecipeLockUpdateRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, recipes.RecipeLockUpdateRequest{ResourceTypes: []string{"Radius.Resources/redis"}, DryRun: true}, request)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&recipes.RecipeLockUpdates{
+			Recipes: []recipes.RecipeLockUpdate{{ResourceType: "Radius.Resources/redis", Locked: "sha256:abc", Latest: "sha256:def", UpdateAvailable: true}},
+		})
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPRecipePackLockClient{Connection: connection}
+
+	request := recipes.RecipeLockUpdateRequest{ResourceTypes: []string{"Radius.Resources/redis"}, DryRun: true}
+	updates, err := client.UpdateLocks(ctx, recipePackID, request)
+	require.NoError(t, err)
+	require.Equal(t, []recipes.RecipeLockUpdate{{ResourceType: "Radius.Resources/redis", Locked: "sha256:abc", Latest: "sha256:def", UpdateAvailable: true}}, updates.Recipes)
+
+	_, err = client.UpdateLocks(ctx, "/planes/radius/local/resourceGroups/test-rg/providers/Radius.Core/recipePacks/missing", request)
+	require.True(t, Is404Error(err))
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_recipeplanclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients RecipePlanClient
+
+// RecipePlanClient is used to plan recipe deployments without executing them.
+type RecipePlanClient interface {
+	// PlanRecipe plans the recipe for the resource in the request using the environment with the given ID, and returns
+	// the changes the recipe would make to its output resources.
+	PlanRecipe(ctx context.Context, environmentID string, request recipes.RecipePlanRequest) (*recipes.RecipePlan, error)
+}
+
+var _ RecipePlanClient = (*UCPRecipePlanClient)(nil)
+
+// UCPRecipePlanClient implements RecipePlanClient using the planRecipe action of Applications.Core and Radius.Core
+// environments.
+type UCPRecipePlanClient struct {
+	ClientOptions *arm.ClientOptions
+}
+
+// PlanRecipe plans the recipe for the resource in the request using the environment with the given ID. The API version
+// of the request is chosen by the generated client of the environment's resource provider.
+func (c *UCPRecipePlanClient) PlanRecipe(ctx context.Context, environmentID string, request recipes.RecipePlanRequest) (*recipes.RecipePlan, error) {
+	id, err := resources.ParseResource(environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generated client doesn't like the leading '/' in the scope.
+	scope := strings.TrimPrefix(id.RootScope(), resources.SegmentSeparator)
+
+	if strings.EqualFold(id.ProviderNamespace(), "Radius.Core") {
+		client, err := corerpv20250801.NewEnvironmentsClient(&aztoken.AnonymousCredential{}, c.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		body := corerpv20250801.RecipePlanRequest{
+			ResourceID:    to.Ptr(request.ResourceID),
+			RecipeName:    optionalString(request.RecipeName),
+			ApplicationID: optionalString(request.ApplicationID),
+			Parameters:    request.Parameters,
+			Properties:    request.Properties,
+		}
+		response, err := client.PlanRecipe(ctx, scope, id.Name(), body, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+		for _, change := range response.Changes {
+			plan.Changes = append(plan.Changes, plannedResourceChange((*string)(change.Action), change.ResourceType, change.Name, change.ID))
+		}
+		return plan, nil
+	}
+
+	client, err := corerpv20231001.NewEnvironmentsClient(&aztoken.AnonymousCredential{}, c.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	body := corerpv20231001.RecipePlanRequest{
+		ResourceID:    to.Ptr(request.ResourceID),
+		RecipeName:    optionalString(request.RecipeName),
+		ApplicationID: optionalString(request.ApplicationID),
+		Parameters:    request.Parameters,
+		Properties:    request.Properties,
+	}
+	response, err := client.PlanRecipe(ctx, scope, id.Name(), body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+	for _, change := range response.Changes {
+		plan.Changes = append(plan.Changes, plannedResourceChange((*string)(change.Action), change.ResourceType, change.Name, change.ID))
+	}
+	return plan, nil
+}
+
+// plannedResourceChange converts the fields of a planned change returned by a generated client.
+func plannedResourceChange(action *string, resourceType *string, name *string, id *string) recipes.PlannedResourceChange {
+	return recipes.PlannedResourceChange{
+		Action:       recipes.PlanAction(to.String(action)),
+		ResourceType: to.String(resourceType),
+		Name:         to.String(name),
+		ID:           to.String(id),
+	}
+}
+
+// optionalString returns nil for an empty string so that it is omitted from the request.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_UCPRecipePlanClient(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		appCoreEnvironmentID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+		radiusCoreEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Radius.Core/environments/env1"
+		resourceID              = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis0"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		request := recipes.RecipePlanRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, resourceID, request.ResourceID)
+		require.Equal(t, "redis", request.RecipeName)
+		require.Equal(t, map[string]any{"size": "S"}, request.Parameters)
+
+		switch r.URL.Path {
+		case "/apis/api.ucp.dev/v1alpha3" + appCoreEnvironmentID + "/planRecipe":
+			require.Equal(t, "2023-10-01-preview", r.URL.Query().Get("api-version"))
+		case "/apis/api.ucp.dev/v1alpha3" + radiusCoreEnvironmentID + "/planRecipe":
+			require.Equal(t, "2025-08-01-preview", r.URL.Query().Get("api-version"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&recipes.RecipePlan{
+			Changes: []recipes.PlannedResourceChange{{Action: recipes.PlanActionCreate, ResourceType: "kubernetes_deployment", Name: "redis"}},
+		})
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + "/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+	client := &UCPRecipePlanClient{ClientOptions: sdk.NewClientOptions(connection)}
+
+	for _, environmentID := range []string{appCoreEnvironmentID, radiusCoreEnvironmentID} {
+		plan, err := client.PlanRecipe(ctx, environmentID, recipes.RecipePlanRequest{ResourceID: resourceID, RecipeName: "redis", Parameters: map[string]any{"size": "S"}})
+		require.NoError(t, err)
+		require.Equal(t, []recipes.PlannedResourceChange{{Action: recipes.PlanActionCreate, ResourceType: "kubernetes_deployment", Name: "redis"}}, plan.Changes)
+	}
+
+	_, err = client.PlanRecipe(ctx, "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/missing", recipes.RecipePlanRequest{ResourceID: resourceID, RecipeName: "redis", Parameters: map[string]any{"size": "S"}})
+	require.True(t, Is404Error(err))
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_roleclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients RoleClient
+
+// RoleClient is used to manage the role definitions and role assignments that control access to UCP.
+type RoleClient interface {
+	// ListRoleDefinitions lists the role definitions of a plane.
+	ListRoleDefinitions(ctx context.Context, planeName string) ([]*ucpv20231001.RoleDefinitionResource, error)
+
+	// GetRoleDefinition gets a role definition.
+	GetRoleDefinition(ctx context.Context, planeName string, name string) (ucpv20231001.RoleDefinitionResource, error)
+
+	// CreateOrUpdateRoleDefinition creates or updates a role definition.
+	CreateOrUpdateRoleDefinition(ctx context.Context, planeName string, name string, resource *ucpv20231001.RoleDefinitionResource) error
+
+	// DeleteRoleDefinition deletes a role definition. It returns false if the role definition did not exist.
+	DeleteRoleDefinition(ctx context.Context, planeName string, name string) (bool, error)
+
+	// ListRoleAssignments lists the role assignments of a plane.
+	ListRoleAssignments(ctx context.Context, planeName string) ([]*ucpv20231001.RoleAssignmentResource, error)
+
+	// GetRoleAssignment gets a role assignment.
+	GetRoleAssignment(ctx context.Context, planeName string, name string) (ucpv20231001.RoleAssignmentResource, error)
+
+	// CreateOrUpdateRoleAssignment creates or updates a role assignment.
+	CreateOrUpdateRoleAssignment(ctx context.Context, planeName string, name string, resource *ucpv20231001.RoleAssignmentResource) error
+
+	// DeleteRoleAssignment deletes a role assignment. It returns false if the role assignment did not exist.
+	DeleteRoleAssignment(ctx context.Context, planeName string, name string) (bool, error)
+}
+
+var _ RoleClient = (*UCPRoleClient)(nil)
+
+// UCPRoleClient implements RoleClient using the System.Authorization APIs of UCP.
+type UCPRoleClient struct {
+	Connection sdk.Connection
+}
+
+// ListRoleDefinitions lists the role definitions of a plane.
+func (c *UCPRoleClient) ListRoleDefinitions(ctx context.Context, planeName string) ([]*ucpv20231001.RoleDefinitionResource, error) {
+	client, err := ucpv20231001.NewRoleDefinitionsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*ucpv20231001.RoleDefinitionResource{}
+	pager := client.NewListPager(planeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page.Value...)
+	}
+
+	return results, nil
+}
+
+// GetRoleDefinition gets a role definition.
+func (c *UCPRoleClient) GetRoleDefinition(ctx context.Context, planeName string, name string) (ucpv20231001.RoleDefinitionResource, error) {
+	client, err := ucpv20231001.NewRoleDefinitionsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return ucpv20231001.RoleDefinitionResource{}, err
+	}
+
+	response, err := client.Get(ctx, planeName, name, nil)
+	if err != nil {
+		return ucpv20231001.RoleDefinitionResource{}, err
+	}
+
+	return response.RoleDefinitionResource, nil
+}
+
+// CreateOrUpdateRoleDefinition creates or updates a role definition.
+func (c *UCPRoleClient) CreateOrUpdateRoleDefinition(ctx context.Context, planeName string, name string, resource *ucpv20231001.RoleDefinitionResource) error {
+	client, err := ucpv20231001.NewRoleDefinitionsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, name, *resource, nil)
+	return err
+}
+
+// DeleteRoleDefinition deletes a role definition. It returns false if the role definition did not exist.
+func (c *UCPRoleClient) DeleteRoleDefinition(ctx context.Context, planeName string, name string) (bool, error) {
+	client, err := ucpv20231001.NewRoleDefinitionsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = policy.WithCaptureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, name, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != http.StatusNoContent, nil
+}
+
+// ListRoleAssignments lists the role assignments of a plane.
+func (c *UCPRoleClient) ListRoleAssignments(ctx context.Context, planeName string) ([]*ucpv20231001.RoleAssignmentResource, error) {
+	client, err := ucpv20231001.NewRoleAssignmentsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*ucpv20231001.RoleAssignmentResource{}
+	pager := client.NewListPager(planeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page.Value...)
+	}
+
+	return results, nil
+}
+
+// GetRoleAssignment gets a role assignment.
+func (c *UCPRoleClient) GetRoleAssignment(ctx context.Context, planeName string, name string) (ucpv20231001.RoleAssignmentResource, error) {
+	client, err := ucpv20231001.NewRoleAssignmentsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return ucpv20231001.RoleAssignmentResource{}, err
+	}
+
+	response, err := client.Get(ctx, planeName, name, nil)
+	if err != nil {
+		return ucpv20231001.RoleAssignmentResource{}, err
+	}
+
+	return response.RoleAssignmentResource, nil
+}
+
+// CreateOrUpdateRoleAssignment creates or updates a role assignment.
+func (c *UCPRoleClient) CreateOrUpdateRoleAssignment(ctx context.Context, planeName string, name string, resource *ucpv20231001.RoleAssignmentResource) error {
+	client, err := ucpv20231001.NewRoleAssignmentsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, name, *resource, nil)
+	return err
+}
+
+// DeleteRoleAssignment deletes a role assignment. It returns false if the role assignment did not exist.
+func (c *UCPRoleClient) DeleteRoleAssignment(ctx context.Context, planeName string, name string) (bool, error) {
+	client, err := ucpv20231001.NewRoleAssignmentsClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = policy.WithCaptureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, name, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != http.StatusNoContent, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+func Test_UCPRoleClient(t *testing.T) {
+	ctx := context.Background()
+
+	const pathBase = "/apis/api.ucp.dev/v1alpha3"
+	const prefix = pathBase + "/planes/radius/local/providers/System.Authorization/"
+
+	// The fake server stores the request bodies by path and lists them by collection.
+	var lock sync.Mutex
+	stored := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		path := strings.TrimSuffix(r.URL.Path, "/")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			stored[path] = body
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			if _, ok := stored[path]; !ok {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			delete(stored, path)
+		case http.MethodGet:
+			if body, ok := stored[path]; ok {
+				_, _ = w.Write(body)
+				return
+			}
+
+			if !strings.HasSuffix(path, "/roleDefinitions") && !strings.HasSuffix(path, "/roleAssignments") {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+				return
+			}
+
+			values := []json.RawMessage{}
+			for key, body := range stored {
+				if strings.HasPrefix(key, path+"/") {
+					values = append(values, body)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"value": values})
+		}
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL + pathBase)
+	require.NoError(t, err)
+	client := &UCPRoleClient{Connection: connection}
+
+	definition := &ucpv20231001.RoleDefinitionResource{
+		Location:   to.Ptr("global"),
+		Properties: &ucpv20231001.RoleDefinitionProperties{Actions: to.SliceOfPtrs("*/read")},
+	}
+	require.NoError(t, client.CreateOrUpdateRoleDefinition(ctx, "local", "reader", definition))
+	require.Contains(t, stored, prefix+"roleDefinitions/reader")
+
+	definitions, err := client.ListRoleDefinitions(ctx, "local")
+	require.NoError(t, err)
+	require.Len(t, definitions, 1)
+
+	got, err := client.GetRoleDefinition(ctx, "local", "reader")
+	require.NoError(t, err)
+	require.Equal(t, "*/read", *got.Properties.Actions[0])
+
+	assignment := &ucpv20231001.RoleAssignmentResource{
+		Location: to.Ptr("global"),
+		Properties: &ucpv20231001.RoleAssignmentProperties{
+			PrincipalName:    to.Ptr("alice"),
+			PrincipalType:    to.Ptr(ucpv20231001.PrincipalTypeUser),
+			RoleDefinitionID: to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/reader"),
+			Scope:            to.Ptr("/planes/radius/local"),
+		},
+	}
+	require.NoError(t, client.CreateOrUpdateRoleAssignment(ctx, "local", "alice-reader", assignment))
+
+	assignments, err := client.ListRoleAssignments(ctx, "local")
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	require.Equal(t, "alice", *assignments[0].Properties.PrincipalName)
+
+	deleted, err := client.DeleteRoleAssignment(ctx, "local", "alice-reader")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	deleted, err = client.DeleteRoleAssignment(ctx, "local", "alice-reader")
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	deleted, err = client.DeleteRoleDefinition(ctx, "local", "reader")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	_, err = client.GetRoleDefinition(ctx, "local", "reader")
+	require.True(t, Is404Error(err))
+}
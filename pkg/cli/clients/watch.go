@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_watchclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients WatchClient
+
+// watchRetryDelay is the delay before a watch is resumed after its stream was interrupted.
+var watchRetryDelay = time.Second
+
+// WatchHandler is called with each event received by a watch. Returning an error stops the watch.
+type WatchHandler func(event *v1.WatchEvent) error
+
+// WatchClient is used to watch changes to resources.
+type WatchClient interface {
+	// WatchResources watches the resource list at the given path, such as the resources of a resource type in a
+	// scope or the resources of a resource group, and calls handler with each event. When resumeToken is empty the
+	// current resources are sent first as Saved events. The watch is resumed after the last event received if the
+	// stream is interrupted, or starts over from the current resources if that is no longer possible. It returns when
+	// the context is cancelled or handler returns an error.
+	WatchResources(ctx context.Context, path string, apiVersion string, resumeToken string, handler WatchHandler) error
+}
+
+var _ WatchClient = (*UCPWatchClient)(nil)
+
+// UCPWatchClient implements WatchClient using the server-sent events returned by list requests with watch=true.
+type UCPWatchClient struct {
+	Connection sdk.Connection
+}
+
+// WatchResources watches the resource list at the given path. Error responses are returned as *azcore.ResponseError,
+// including the 410 Gone response returned when the resume token has expired.
+func (c *UCPWatchClient) WatchResources(ctx context.Context, path string, apiVersion string, resumeToken string, handler WatchHandler) error {
+	initialResumeToken := resumeToken
+	for {
+		err := c.watch(ctx, path, apiVersion, &resumeToken, handler)
+		if ctx.Err() != nil {
+			return nil
+		} else if IsResumeTokenExpiredError(err) && resumeToken != initialResumeToken {
+			// Resume tokens are only valid on the server that returned them, and the watch may have been resumed on
+			// another one. Start over from the current resources.
+			resumeToken = ""
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// The stream ended without an error, for example because a proxy closed an idle connection or the
+		// server restarted. Resume after the last event received.
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// watch reads the events of a single watch request until the stream ends, updating resumeToken as events are received.
+// It returns nil when the stream ends or is interrupted after it was established.
+func (c *UCPWatchClient) watch(ctx context.Context, path string, apiVersion string, resumeToken *string, handler WatchHandler) error {
+	values := url.Values{
+		"api-version":               []string{apiVersion},
+		v1.WatchParameterName:       []string{"true"},
+		v1.ResumeTokenParameterName: []string{*resumeToken},
+	}
+	if *resumeToken == "" {
+		values.Del(v1.ResumeTokenParameterName)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Connection.Endpoint()+path+"?"+values.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.Connection.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return runtime.NewResponseError(resp)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	data := strings.Builder{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// The stream ended or the connection was interrupted. The caller resumes the watch.
+			return nil
+		}
+
+		// Comments such as heartbeats, and the id and event fields are ignored. The id and type of the event are
+		// also part of its data.
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			// A blank line dispatches the event.
+			if data.Len() == 0 {
+				continue
+			}
+
+			event := &v1.WatchEvent{}
+			if err := json.Unmarshal([]byte(data.String()), event); err != nil {
+				return fmt.Errorf("failed to unmarshal watch event: %w", err)
+			}
+			data.Reset()
+
+			if err := handler(event); err != nil {
+				return err
+			}
+			*resumeToken = event.ResumeToken
+
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteString("\n")
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_UCPWatchClient(t *testing.T) {
+	watchRetryDelay = time.Millisecond
+	t.Cleanup(func() { watchRetryDelay = time.Second })
+
+	const path = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers"
+
+	resumeTokens := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, path, r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("watch"))
+		require.Equal(t, "2023-10-01-preview", r.URL.Query().Get("api-version"))
+
+		resumeToken := r.URL.Query().Get("resumeToken")
+		resumeTokens = append(resumeTokens, resumeToken)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch resumeToken {
+		case "":
+			// The first stream ends after a single event, and must be resumed by the client.
+			fmt.Fprint(w, ": heartbeat\n\n")
+			fmt.Fprint(w, "id: 1\nevent: Saved\ndata: {\"type\":\"Saved\",\"id\":\"c1\",\"resource\":{\"name\":\"c1\"},\"resumeToken\":\"1\"}\n\n")
+		case "1":
+			fmt.Fprint(w, "id: 2\nevent: Deleted\ndata: {\"type\":\"Deleted\",\"id\":\"c1\",\"resumeToken\":\"2\"}\n\n")
+		default:
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPWatchClient{Connection: connection}
+
+	errStop := errors.New("stop")
+	events := []*v1.WatchEvent{}
+	err = client.WatchResources(context.Background(), path, "2023-10-01-preview", "", func(event *v1.WatchEvent) error {
+		events = append(events, event)
+		if len(events) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+
+	require.Equal(t, []string{"", "1"}, resumeTokens)
+	require.Equal(t, []*v1.WatchEvent{
+		{Type: v1.WatchEventTypeSaved, ID: "c1", Resource: map[string]any{"name": "c1"}, ResumeToken: "1"},
+		{Type: v1.WatchEventTypeDeleted, ID: "c1", ResumeToken: "2"},
+	}, events)
+
+	t.Run("resume token expired", func(t *testing.T) {
+		err := client.WatchResources(context.Background(), path, "2023-10-01-preview", "expired", func(event *v1.WatchEvent) error {
+			return nil
+		})
+		require.Error(t, err)
+		require.True(t, IsResumeTokenExpiredError(err))
+	})
+}
+
+func Test_UCPWatchClient_StartsOverWhenResumeTokenExpired(t *testing.T) {
+	watchRetryDelay = time.Millisecond
+	t.Cleanup(func() { watchRetryDelay = time.Second })
+
+	const path = "/planes/radius/local/resourceGroups/test-rg/resources"
+
+	resumeTokens := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resumeToken := r.URL.Query().Get("resumeToken")
+		resumeTokens = append(resumeTokens, resumeToken)
+
+		// The token of the first stream is not valid on the server the watch is resumed on.
+		if resumeToken != "" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusGone)
+			fmt.Fprint(w, `{"error":{"code":"ResumeTokenExpired","message":"the resume token has expired"}}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "data: {\"type\":\"Saved\",\"id\":\"c1\",\"resumeToken\":\"server%d.1\"}\n\n", len(resumeTokens))
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPWatchClient{Connection: connection}
+
+	errStop := errors.New("stop")
+	events := []*v1.WatchEvent{}
+	err = client.WatchResources(context.Background(), path, "2023-10-01-preview", "", func(event *v1.WatchEvent) error {
+		events = append(events, event)
+		if len(events) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+
+	require.Equal(t, []string{"", "server1.1", ""}, resumeTokens)
+	require.Equal(t, "server3.1", events[1].ResumeToken)
+}
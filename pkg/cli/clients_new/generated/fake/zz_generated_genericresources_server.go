@@ -39,6 +39,10 @@ type GenericResourcesServer struct {
 	// ListSecrets is the fake for method GenericResourcesClient.ListSecrets
 	// HTTP status codes to indicate success: http.StatusOK
 	ListSecrets func(ctx context.Context, resourceName string, options *generated.GenericResourcesClientListSecretsOptions) (resp azfake.Responder[generated.GenericResourcesClientListSecretsResponse], errResp azfake.ErrorResponder)
+
+	// BeginUpdate is the fake for method GenericResourcesClient.BeginUpdate
+	// HTTP status codes to indicate success: http.StatusOK, http.StatusAccepted
+	BeginUpdate func(ctx context.Context, resourceName string, genericResourceParameters map[string]any, options *generated.GenericResourcesClientBeginUpdateOptions) (resp azfake.PollerResponder[generated.GenericResourcesClientUpdateResponse], errResp azfake.ErrorResponder)
 }
 
 // NewGenericResourcesServerTransport creates a new instance of GenericResourcesServerTransport with the provided implementation.
@@ -50,6 +54,7 @@ func NewGenericResourcesServerTransport(srv *GenericResourcesServer) *GenericRes
 		beginCreateOrUpdate:     newTracker[azfake.PollerResponder[generated.GenericResourcesClientCreateOrUpdateResponse]](),
 		beginDelete:             newTracker[azfake.PollerResponder[generated.GenericResourcesClientDeleteResponse]](),
 		newListByRootScopePager: newTracker[azfake.PagerResponder[generated.GenericResourcesClientListByRootScopeResponse]](),
+		beginUpdate:             newTracker[azfake.PollerResponder[generated.GenericResourcesClientUpdateResponse]](),
 	}
 }
 
@@ -60,6 +65,7 @@ type GenericResourcesServerTransport struct {
 	beginCreateOrUpdate     *tracker[azfake.PollerResponder[generated.GenericResourcesClientCreateOrUpdateResponse]]
 	beginDelete             *tracker[azfake.PollerResponder[generated.GenericResourcesClientDeleteResponse]]
 	newListByRootScopePager *tracker[azfake.PagerResponder[generated.GenericResourcesClientListByRootScopeResponse]]
+	beginUpdate             *tracker[azfake.PollerResponder[generated.GenericResourcesClientUpdateResponse]]
 }
 
 // Do implements the policy.Transporter interface for GenericResourcesServerTransport.
@@ -93,6 +99,8 @@ func (g *GenericResourcesServerTransport) dispatchToMethodFake(req *http.Request
 				res.resp, res.err = g.dispatchNewListByRootScopePager(req)
 			case "GenericResourcesClient.ListSecrets":
 				res.resp, res.err = g.dispatchListSecrets(req)
+			case "GenericResourcesClient.BeginUpdate":
+				res.resp, res.err = g.dispatchBeginUpdate(req)
 			default:
 				res.err = fmt.Errorf("unhandled API %s", method)
 			}
@@ -284,6 +292,50 @@ func (g *GenericResourcesServerTransport) dispatchListSecrets(req *http.Request)
 	return resp, nil
 }
 
+func (g *GenericResourcesServerTransport) dispatchBeginUpdate(req *http.Request) (*http.Response, error) {
+	if g.srv.BeginUpdate == nil {
+		return nil, &nonRetriableError{errors.New("fake for method BeginUpdate not implemented")}
+	}
+	beginUpdate := g.beginUpdate.get(req)
+	if beginUpdate == nil {
+		const regexStr = `/(?P<rootScope>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/(?P<resourceType>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/(?P<resourceName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)`
+		regex := regexp.MustCompile(regexStr)
+		matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+		if len(matches) < 4 {
+			return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+		}
+		body, err := server.UnmarshalRequestAsJSON[map[string]any](req)
+		if err != nil {
+			return nil, err
+		}
+		resourceNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceName")])
+		if err != nil {
+			return nil, err
+		}
+		respr, errRespr := g.srv.BeginUpdate(req.Context(), resourceNameParam, body, nil)
+		if respErr := server.GetError(errRespr, req); respErr != nil {
+			return nil, respErr
+		}
+		beginUpdate = &respr
+		g.beginUpdate.add(req, beginUpdate)
+	}
+
+	resp, err := server.PollerResponderNext(beginUpdate, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains([]int{http.StatusOK, http.StatusAccepted}, resp.StatusCode) {
+		g.beginUpdate.remove(req)
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK, http.StatusAccepted", resp.StatusCode)}
+	}
+	if !server.PollerResponderMore(beginUpdate) {
+		g.beginUpdate.remove(req)
+	}
+
+	return resp, nil
+}
+
 // set this to conditionally intercept incoming requests to GenericResourcesServerTransport
 var genericResourcesServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
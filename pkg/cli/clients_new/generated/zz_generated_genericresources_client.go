@@ -346,3 +346,71 @@ func (client *GenericResourcesClient) listSecretsHandleResponse(resp *http.Respo
 	}
 	return result, nil
 }
+
+// BeginUpdate - Updates a Generic resource using JSON merge patch (RFC 7396) semantics
+// If the operation fails it returns an *azcore.ResponseError type.
+//   - resourceName - The name of the generic resource
+//   - options - GenericResourcesClientBeginUpdateOptions contains the optional parameters for the GenericResourcesClient.BeginUpdate
+//     method.
+func (client *GenericResourcesClient) BeginUpdate(ctx context.Context, resourceName string, genericResourceParameters map[string]any, options *GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[GenericResourcesClientUpdateResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.update(ctx, resourceName, genericResourceParameters, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller[GenericResourcesClientUpdateResponse](resp, client.internal.Pipeline(), nil)
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[GenericResourcesClientUpdateResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// Update - Updates a Generic resource using JSON merge patch (RFC 7396) semantics
+// If the operation fails it returns an *azcore.ResponseError type.
+func (client *GenericResourcesClient) update(ctx context.Context, resourceName string, genericResourceParameters map[string]any, options *GenericResourcesClientBeginUpdateOptions) (*http.Response, error) {
+	var err error
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "GenericResourcesClient.BeginUpdate")
+	req, err := client.updateCreateRequest(ctx, resourceName, genericResourceParameters, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// updateCreateRequest creates the Update request.
+func (client *GenericResourcesClient) updateCreateRequest(ctx context.Context, resourceName string, genericResourceParameters map[string]any, _ *GenericResourcesClientBeginUpdateOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}"
+	if client.resourceType == "" {
+		return nil, errors.New("parameter client.resourceType cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if client.rootScope == "" {
+		return nil, errors.New("parameter client.rootScope cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", version20231001Preview)
+	req.Raw().URL.RawQuery = strings.ReplaceAll(reqQP.Encode(), "+", "%20")
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	req.Raw().Header["Content-Type"] = []string{"application/merge-patch+json"}
+	if err := runtime.MarshalAsJSON(req, genericResourceParameters); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
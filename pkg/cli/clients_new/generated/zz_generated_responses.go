@@ -30,3 +30,9 @@ type GenericResourcesClientListByRootScopeResponse struct {
 type GenericResourcesClientListSecretsResponse struct {
 	Value map[string]*string
 }
+
+// GenericResourcesClientUpdateResponse contains the response from method GenericResourcesClient.BeginUpdate.
+type GenericResourcesClientUpdateResponse struct {
+	// Generic resource
+	GenericResource
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/app/graph"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	cligraph "github.com/radius-project/radius/pkg/cli/graph"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+)
+
+const (
+	// formatMarkdown renders the diff as Markdown. It is specific to this
+	// command and therefore not part of output.SupportedFormats.
+	formatMarkdown = "markdown"
+
+	// refPrefixBranch selects the modeled graph committed for a source
+	// branch on the radius-graph orphan branch.
+	refPrefixBranch = "branch:"
+
+	// refPrefixApplication selects the deployed graph of an application.
+	refPrefixApplication = "app:"
+
+	// refRevisionSeparator separates the revision from the branch name of a
+	// 'branch:' reference. Git does not allow ':' in branch names.
+	refRevisionSeparator = ":"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad app graph diff` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+	cmd := &cobra.Command{
+		Use:   "diff <base> <target>",
+		Short: "Compares two application graphs.",
+		Long: `Compares two application graphs.
+
+Each graph is one of:
+
+  - a path to a graph JSON file, such as the app-graph.json written by 'rad app graph ./app.bicep',
+  - 'branch:<source-branch>', the modeled graph committed for <source-branch> on the radius-graph orphan branch,
+  - 'branch:<source-branch>:<revision>', the same graph as of a revision listed by 'rad app graph history',
+  - 'app:<application>', the graph of an application deployed in the current workspace.
+
+Resources are matched by type and name and reported as added, removed, modified
+or unchanged, followed by the connections that were added or removed. Use
+'--output markdown' to produce a summary suitable for a pull request comment.`,
+		Args: cobra.ExactArgs(2),
+		Example: `
+# Compare the modeled graph of a pull request branch with the one on main.
+rad app graph diff branch:main branch:feature/foo
+
+# Compare the modeled graph for main with an earlier revision of itself.
+rad app graph diff branch:main:3f9c2e1 branch:main
+
+# Compare the modeled graph in ./app-graph.json with the deployed application.
+rad app graph diff ./app-graph.json app:my-application
+
+# Produce a Markdown summary for a pull request comment.
+rad app graph diff branch:main branch:feature/foo --output markdown`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringP("output", "o", output.DefaultFormat, fmt.Sprintf("output format (supported formats are %s)", strings.Join(append(output.SupportedFormats(), formatMarkdown), ", ")))
+
+	return cmd, runner
+}
+
+// graphRef identifies one of the two graphs being compared.
+type graphRef struct {
+	// raw is the argument as supplied by the user.
+	raw string
+
+	// path is set for graph files.
+	path string
+
+	// branch is set for modeled graphs stored on the orphan branch.
+	branch string
+
+	// revision optionally selects an earlier version of a modeled graph.
+	revision string
+
+	// application is set for deployed graphs.
+	application string
+}
+
+// parseGraphRef parses a positional argument of the diff command.
+func parseGraphRef(arg string) (graphRef, error) {
+	ref := graphRef{raw: arg}
+	switch {
+	case strings.HasPrefix(arg, refPrefixBranch):
+		ref.branch = strings.TrimPrefix(arg, refPrefixBranch)
+		if branch, revision, ok := strings.Cut(ref.branch, refRevisionSeparator); ok {
+			ref.branch, ref.revision = branch, revision
+			if ref.revision == "" {
+				return graphRef{}, clierrors.Message("Graph reference %q is missing a revision.", arg)
+			}
+		}
+		if ref.branch == "" {
+			return graphRef{}, clierrors.Message("Graph reference %q is missing a branch name.", arg)
+		}
+	case strings.HasPrefix(arg, refPrefixApplication):
+		ref.application = strings.TrimPrefix(arg, refPrefixApplication)
+		if ref.application == "" {
+			return graphRef{}, clierrors.Message("Graph reference %q is missing an application name.", arg)
+		}
+	case arg == "":
+		return graphRef{}, clierrors.Message("Graph reference must not be empty.")
+	default:
+		ref.path = arg
+	}
+	return ref, nil
+}
+
+// Runner is the runner implementation for the `rad app graph diff` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+
+	// GraphStore loads modeled graphs referenced with 'branch:'. It must
+	// implement persistence.Historian to load a revision.
+	GraphStore persistence.Store
+
+	// Workspace is only set when one of the graphs is a deployed application.
+	Workspace *workspaces.Workspace
+
+	Base   graphRef
+	Target graphRef
+	Format string
+}
+
+// NewRunner creates a new instance of the `rad app graph diff` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		GraphStore:        factory.GetGraphStore(),
+	}
+}
+
+// Validate runs validation for the `rad app graph diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(strings.TrimSpace(format), formatMarkdown) {
+		r.Format = formatMarkdown
+	} else {
+		r.Format, err = cli.RequireOutput(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.Base, err = parseGraphRef(args[0])
+	if err != nil {
+		return err
+	}
+	r.Target, err = parseGraphRef(args[1])
+	if err != nil {
+		return err
+	}
+
+	if r.Base.application != "" || r.Target.application != "" {
+		r.Workspace, err = cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+		if err != nil {
+			return err
+		}
+		r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad app graph diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	base, err := r.load(ctx, r.Base)
+	if err != nil {
+		return err
+	}
+	target, err := r.load(ctx, r.Target)
+	if err != nil {
+		return err
+	}
+
+	diff := cligraph.DiffGraphs(base, target)
+
+	switch r.Format {
+	case output.FormatJson:
+		return r.Output.WriteFormatted(r.Format, diff, output.FormatterOptions{})
+	case formatMarkdown:
+		r.Output.LogInfo("%s", cligraph.RenderDiffMarkdown(diff))
+		return nil
+	default:
+		r.Output.LogInfo("Comparing %s with %s: %s", r.Base.raw, r.Target.raw, diff.Summary())
+		if len(diff.Resources) > 0 {
+			r.Output.LogInfo("")
+			if err := r.Output.WriteFormatted(r.Format, diff.Resources, resourceDiffTableFormat()); err != nil {
+				return err
+			}
+		}
+		if len(diff.Connections) > 0 {
+			r.Output.LogInfo("")
+			if err := r.Output.WriteFormatted(r.Format, diff.Connections, connectionDiffTableFormat()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// load returns the graph identified by ref.
+func (r *Runner) load(ctx context.Context, ref graphRef) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	switch {
+	case ref.branch != "":
+		if r.GraphStore == nil {
+			return nil, clierrors.Message("Modeled graph store is not configured.")
+		}
+		if ref.revision != "" {
+			return r.loadRevision(ctx, ref)
+		}
+		result, err := r.GraphStore.Load(ctx, graph.ModeledGraphKey(ref.branch))
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, clierrors.Message("No modeled graph has been committed for branch %q.", ref.branch)
+		} else if err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case ref.application != "":
+		client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+		if err != nil {
+			return nil, err
+		}
+		deployed, err := client.GetApplicationGraph(ctx, ref.application)
+		if clients.Is404Error(err) {
+			return nil, clierrors.Message("Application %q does not exist or has been deleted.", ref.application)
+		} else if err != nil {
+			return nil, err
+		}
+		// The deployed graph uses an older API version with the same shape.
+		return cligraph.ConvertGraph(deployed)
+
+	default:
+		data, err := os.ReadFile(ref.path)
+		if err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to read graph file %q.", ref.path)
+		}
+		result := &corerpv20250801preview.ApplicationGraphResponse{}
+		if err := json.Unmarshal(data, result); err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to parse graph file %q.", ref.path)
+		}
+		return result, nil
+	}
+}
+
+// loadRevision returns the modeled graph identified by a 'branch:' ref with a
+// revision.
+func (r *Runner) loadRevision(ctx context.Context, ref graphRef) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	historian, ok := r.GraphStore.(persistence.Historian)
+	if !ok {
+		return nil, clierrors.Message("The modeled graph store does not keep history; cannot load %q.", ref.raw)
+	}
+	result, err := historian.LoadRevision(ctx, graph.ModeledGraphKey(ref.branch), ref.revision)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil, clierrors.Message("The modeled graph for branch %q did not exist at revision %s.", ref.branch, ref.revision)
+	} else if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to load revision %s of the modeled graph for branch %q.", ref.revision, ref.branch)
+	}
+	return result, nil
+}
+
+func resourceDiffTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+		},
+	}
+}
+
+func connectionDiffTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CONNECTION",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "SOURCE",
+				JSONPath: "{ .Source }",
+			},
+			{
+				Heading:  "TARGET",
+				JSONPath: "{ .Target }",
+			},
+		},
+	}
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	cligraph "github.com/radius-project/radius/pkg/cli/graph"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	frontendID = "/planes/radius/local/resourcegroups/default/providers/Applications.Core/containers/frontend"
+	cacheID    = "/planes/radius/local/resourcegroups/default/providers/Applications.Datastores/redisCaches/cache"
+)
+
+// historyStore is a persistence.Store that also implements
+// persistence.Historian.
+type historyStore struct {
+	*persistence.MockStore
+	*persistence.MockHistorian
+}
+
+func testGraph(frontendHash string) *corerpv20250801preview.ApplicationGraphResponse {
+	return &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{
+				ID:          new(frontendID),
+				Name:        new("frontend"),
+				Type:        new("Applications.Core/containers"),
+				DiffHash:    new(frontendHash),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{},
+			},
+			{
+				ID:          new(cacheID),
+				Name:        new("cache"),
+				Type:        new("Applications.Datastores/redisCaches"),
+				DiffHash:    new("sha256:cache"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{},
+			},
+		},
+	}
+}
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Diff two branches",
+			Input:         []string{"branch:main", "branch:feature/foo"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "main", runner.Base.branch)
+				require.Equal(t, "feature/foo", runner.Target.branch)
+				require.Equal(t, output.FormatTable, runner.Format)
+				require.Nil(t, runner.Workspace)
+			},
+		},
+		{
+			Name:          "Diff file with application",
+			Input:         []string{"./app-graph.json", "app:my-app", "--output", "markdown"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "./app-graph.json", runner.Base.path)
+				require.Equal(t, "my-app", runner.Target.application)
+				require.Equal(t, formatMarkdown, runner.Format)
+				require.NotNil(t, runner.Workspace)
+			},
+		},
+		{
+			Name:          "Diff with json output",
+			Input:         []string{"a.json", "b.json", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Diff branch revision",
+			Input:         []string{"branch:user@example/foo:3f9c2e1", "branch:feature@v2"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "user@example/foo", runner.Base.branch)
+				require.Equal(t, "3f9c2e1", runner.Base.revision)
+				require.Equal(t, "feature@v2", runner.Target.branch)
+				require.Empty(t, runner.Target.revision)
+			},
+		},
+		{
+			Name:          "Diff with empty revision",
+			Input:         []string{"branch:main:", "branch:main"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Diff with one argument",
+			Input:         []string{"branch:main"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Diff with empty branch",
+			Input:         []string{"branch:", "branch:main"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Diff with empty application",
+			Input:         []string{"branch:main", "app:"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Diff with unsupported output",
+			Input:         []string{"a.json", "b.json", "-o", "yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run_Branches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := persistence.NewMockStore(ctrl)
+	store.EXPECT().
+		Load(gomock.Any(), persistence.Key{Namespace: "main", Name: "app-graph"}).
+		Return(testGraph("sha256:v1"), nil)
+	store.EXPECT().
+		Load(gomock.Any(), persistence.Key{Namespace: "feature%2Ffoo", Name: "app-graph"}).
+		Return(testGraph("sha256:v2"), nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Output:     outputSink,
+		GraphStore: store,
+		Base:       graphRef{raw: "branch:main", branch: "main"},
+		Target:     graphRef{raw: "branch:feature/foo", branch: "feature/foo"},
+		Format:     output.FormatJson,
+	}
+
+	require.NoError(t, runner.Run(context.Background()))
+
+	expected := cligraph.DiffGraphs(testGraph("sha256:v1"), testGraph("sha256:v2"))
+	require.Equal(t, []any{
+		output.FormattedOutput{Format: output.FormatJson, Obj: expected},
+	}, outputSink.Writes)
+	require.Equal(t, 1, expected.Count(cligraph.ChangeModified))
+}
+
+func Test_Run_BranchRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := &historyStore{
+		MockStore:     persistence.NewMockStore(ctrl),
+		MockHistorian: persistence.NewMockHistorian(ctrl),
+	}
+	store.MockHistorian.EXPECT().
+		LoadRevision(gomock.Any(), persistence.Key{Namespace: "main", Name: "app-graph"}, "3f9c2e1").
+		Return(testGraph("sha256:v1"), nil)
+	store.MockStore.EXPECT().
+		Load(gomock.Any(), persistence.Key{Namespace: "main", Name: "app-graph"}).
+		Return(testGraph("sha256:v2"), nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Output:     outputSink,
+		GraphStore: store,
+		Base:       graphRef{raw: "branch:main:3f9c2e1", branch: "main", revision: "3f9c2e1"},
+		Target:     graphRef{raw: "branch:main", branch: "main"},
+		Format:     output.FormatJson,
+	}
+
+	require.NoError(t, runner.Run(context.Background()))
+
+	expected := cligraph.DiffGraphs(testGraph("sha256:v1"), testGraph("sha256:v2"))
+	require.Equal(t, []any{
+		output.FormattedOutput{Format: output.FormatJson, Obj: expected},
+	}, outputSink.Writes)
+}
+
+func Test_Run_BranchRevisionWithoutHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	runner := &Runner{
+		Output:     &output.MockOutput{},
+		GraphStore: persistence.NewMockStore(ctrl),
+		Base:       graphRef{raw: "branch:main:3f9c2e1", branch: "main", revision: "3f9c2e1"},
+		Target:     graphRef{raw: "branch:main", branch: "main"},
+		Format:     output.FormatTable,
+	}
+
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, "does not keep history")
+}
+
+func Test_Run_BranchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := persistence.NewMockStore(ctrl)
+	store.EXPECT().
+		Load(gomock.Any(), gomock.Any()).
+		Return(nil, persistence.ErrNotFound)
+
+	runner := &Runner{
+		Output:     &output.MockOutput{},
+		GraphStore: store,
+		Base:       graphRef{raw: "branch:main", branch: "main"},
+		Target:     graphRef{raw: "branch:other", branch: "other"},
+		Format:     output.FormatTable,
+	}
+
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, `No modeled graph has been committed for branch "main".`)
+}
+
+func Test_Run_FileAndApplication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	path := filepath.Join(t.TempDir(), "app-graph.json")
+	data, err := json.Marshal(testGraph("sha256:v1"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	deployed := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:          new("/planes/radius/local/resourcegroups/prod/providers/Applications.Core/containers/frontend"),
+				Name:        new("frontend"),
+				Type:        new("Applications.Core/containers"),
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{},
+			},
+		},
+	}
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetApplicationGraph(gomock.Any(), "my-app").
+		Return(deployed, nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/prod"},
+		Base:              graphRef{raw: path, path: path},
+		Target:            graphRef{raw: "app:my-app", application: "my-app"},
+		Format:            output.FormatTable,
+	}
+
+	require.NoError(t, runner.Run(context.Background()))
+
+	require.Len(t, outputSink.Writes, 3)
+	require.Equal(t, output.LogOutput{
+		Format: "Comparing %s with %s: %s",
+		Params: []any{path, "app:my-app", "0 added, 1 removed, 0 modified, 1 unchanged"},
+	}, outputSink.Writes[0])
+	formatted := outputSink.Writes[2].(output.FormattedOutput)
+	require.Equal(t, resourceDiffTableFormat(), formatted.Options)
+	require.Len(t, formatted.Obj, 2)
+}
+
+func Test_Run_Markdown(t *testing.T) {
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "base.json")
+	targetPath := filepath.Join(dir, "target.json")
+	for path, hash := range map[string]string{basePath: "sha256:v1", targetPath: "sha256:v2"} {
+		data, err := json.Marshal(testGraph(hash))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+	}
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Output: outputSink,
+		Base:   graphRef{raw: basePath, path: basePath},
+		Target: graphRef{raw: targetPath, path: targetPath},
+		Format: formatMarkdown,
+	}
+
+	require.NoError(t, runner.Run(context.Background()))
+
+	expected := cligraph.RenderDiffMarkdown(cligraph.DiffGraphs(testGraph("sha256:v1"), testGraph("sha256:v2")))
+	require.Equal(t, []any{output.LogOutput{Format: "%s", Params: []any{expected}}}, outputSink.Writes)
+}
+
+func Test_Run_MissingFile(t *testing.T) {
+	runner := &Runner{
+		Output: &output.MockOutput{},
+		Base:   graphRef{raw: "missing.json", path: filepath.Join(t.TempDir(), "missing.json")},
+		Target: graphRef{raw: "missing.json", path: filepath.Join(t.TempDir(), "missing.json")},
+		Format: output.FormatTable,
+	}
+
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, "Failed to read graph file")
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
@@ -35,6 +36,10 @@ const (
 	defaultModeledGraphFile = "app-graph.json"
 	modeledGraphKeyName     = "app-graph"
 
+	// collapseOutputResourcesFlag hides output resources in exported
+	// diagrams.
+	collapseOutputResourcesFlag = "collapse-output-resources"
+
 	// envGitHubActions is set to "true" by GitHub Actions for every step
 	// running inside a runner. When present, rad operates in repo-radius
 	// mode and persists graph artifacts to the radius-graph orphan branch.
@@ -66,21 +71,35 @@ the command compiles the template and writes the resulting modeled graph to
 If the command runs inside a GitHub Actions runner (GITHUB_ACTIONS=true), the
 modeled graph is committed to <source-branch>/app-graph.json on the radius-graph
 orphan branch instead of the local filesystem. This is auto-detected; no flag
-is required.`,
+is required.
+
+Use '--output dot', '--output mermaid' or '--output svg' to render the graph as
+a Graphviz, Mermaid or SVG diagram. For a deployed application the diagram is
+printed; for an app.bicep it is written next to app-graph.json as
+app-graph.dot, app-graph.mmd or app-graph.svg. Connections point in the
+direction data flows. Use --collapse-output-resources to leave output resources
+out of the diagram.`,
 		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Show graph for the deployed application named my-application.
 rad app graph -a my-application
 
 # Build the modeled graph for an app.bicep and write it to ./app-graph.json.
-rad app graph ./app.bicep`,
+rad app graph ./app.bicep
+
+# Render the deployed application as an SVG diagram.
+rad app graph -a my-application --output svg > my-application.svg
+
+# Render the deployed application as a Mermaid flowchart without output resources.
+rad app graph -a my-application --output mermaid --collapse-output-resources`,
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
-	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringP("output", "o", output.DefaultFormat, fmt.Sprintf("output format (supported formats are %s)", strings.Join(append(output.SupportedFormats(), cligraph.ExportFormats()...), ", ")))
+	cmd.Flags().Bool(collapseOutputResourcesFlag, false, "leave output resources out of dot, mermaid and svg diagrams")
 
 	return cmd, runner
 }
@@ -99,8 +118,14 @@ type Runner struct {
 	// Modeled-graph mode field.
 	BicepFilePath string
 
+	// Format is an output.SupportedFormats value or one of
+	// cligraph.ExportFormats.
 	Format string
 
+	// CollapseOutputResources leaves output resources out of exported
+	// diagrams.
+	CollapseOutputResources bool
+
 	// GraphStore persists modeled graphs to the radius-graph orphan branch
 	// when running in repo-radius mode. Defaulted in NewRunner; tests may
 	// substitute a mock implementation.
@@ -146,13 +171,43 @@ func sourceBranch() string {
 	return os.Getenv(envGitHubRefName)
 }
 
+// ModeledGraphKey returns the persistence.Key under which the modeled graph
+// for the given source branch is stored.
+//
+// The raw branch name is encoded with url.QueryEscape before being used as
+// the key namespace. Real PR branches routinely contain path separators
+// ("feature/foo", "dependabot/..."), which the git store rejects in a
+// single namespace segment. Percent-encoding collapses each branch to a
+// single safe segment while keeping distinct branches distinct (so
+// "feature/foo" and "feature-foo" do not collide).
+func ModeledGraphKey(branch string) persistence.Key {
+	return persistence.Key{Namespace: url.QueryEscape(branch), Name: modeledGraphKeyName}
+}
+
+// isExportFormat returns true when format is rendered by cligraph.Export.
+func isExportFormat(format string) bool {
+	return slices.Contains(cligraph.ExportFormats(), format)
+}
+
 // Validate runs validation for the `rad app graph` command.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
-	format, err := cli.RequireOutput(cmd)
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+	if format = strings.ToLower(strings.TrimSpace(format)); isExportFormat(format) {
+		r.Format = format
+	} else {
+		r.Format, err = cli.RequireOutput(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.CollapseOutputResources, err = cmd.Flags().GetBool(collapseOutputResourcesFlag)
 	if err != nil {
 		return err
 	}
-	r.Format = format
 
 	if len(args) == 1 && isModeledGraphArg(args[0]) {
 		r.BicepFilePath = args[0]
@@ -217,8 +272,20 @@ func (r *Runner) runDeployed(ctx context.Context) error {
 		return err
 	}
 
-	switch r.Format {
-	case output.FormatJson:
+	switch {
+	case isExportFormat(r.Format):
+		// The deployed graph uses an older API version with the same shape.
+		converted, err := cligraph.ConvertGraph(applicationGraphResponse)
+		if err != nil {
+			return err
+		}
+		rendered, err := cligraph.Export(converted, r.Format, r.exportOptions(r.ApplicationName))
+		if err != nil {
+			return err
+		}
+		r.Output.LogInfo("%s", rendered)
+		return nil
+	case r.Format == output.FormatJson:
 		return r.Output.WriteFormatted(r.Format, applicationGraphResponse, output.FormatterOptions{})
 	default:
 		graph := applicationGraphResponse.Resources
@@ -247,9 +314,51 @@ func (r *Runner) runModeled(ctx context.Context) error {
 	}
 
 	if inRepoRadiusMode() {
-		return r.persistToOrphanBranch(ctx, graph)
+		err = r.persistToOrphanBranch(ctx, graph, cligraph.ModeledEnvironmentID(template))
+	} else {
+		err = r.writeToLocalFile(graph)
+	}
+	if err != nil {
+		return err
 	}
-	return r.writeToLocalFile(graph)
+
+	if isExportFormat(r.Format) {
+		return r.writeExport(graph)
+	}
+	return nil
+}
+
+// exportOptions returns the options for rendering a diagram titled title.
+func (r *Runner) exportOptions(title string) cligraph.ExportOptions {
+	return cligraph.ExportOptions{
+		Title:                   title,
+		CollapseOutputResources: r.CollapseOutputResources,
+	}
+}
+
+// writeExport renders the modeled graph in r.Format and writes it next to
+// ./app-graph.json, for example to ./app-graph.svg.
+func (r *Runner) writeExport(graph *corerpv20250801preview.ApplicationGraphResponse) error {
+	title := strings.TrimSuffix(filepath.Base(r.BicepFilePath), filepath.Ext(r.BicepFilePath))
+	rendered, err := cligraph.Export(graph, r.Format, r.exportOptions(title))
+	if err != nil {
+		return err
+	}
+
+	ext := r.Format
+	if ext == cligraph.ExportFormatMermaid {
+		ext = "mmd"
+	}
+	path := strings.TrimSuffix(defaultModeledGraphFile, filepath.Ext(defaultModeledGraphFile)) + "." + ext
+	if err := os.WriteFile(path, []byte(rendered), 0o644); err != nil {
+		return fmt.Errorf("write %s diagram to %q: %w", r.Format, path, err)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+	r.Output.LogInfo("Wrote %s diagram to %s", r.Format, absPath)
+	return nil
 }
 
 // writeToLocalFile serializes graph to ./app-graph.json in the current
@@ -271,15 +380,11 @@ func (r *Runner) writeToLocalFile(graph *corerpv20250801preview.ApplicationGraph
 }
 
 // persistToOrphanBranch commits graph to <encoded-source-branch>/app-graph.json
-// on the radius-graph orphan branch via the git-backed persistence Store.
-//
-// The raw branch name is encoded with url.QueryEscape before being used as
-// the key namespace. Real PR branches routinely contain path separators
-// ("feature/foo", "dependabot/..."), which the git store rejects in a
-// single namespace segment. Percent-encoding collapses each branch to a
-// single safe segment while keeping distinct branches distinct (so
-// "feature/foo" and "feature-foo" do not collide).
-func (r *Runner) persistToOrphanBranch(ctx context.Context, graph *corerpv20250801preview.ApplicationGraphResponse) error {
+// on the radius-graph orphan branch via the git-backed persistence Store. See
+// ModeledGraphKey for how the branch name is encoded. When environmentID is
+// not empty it is saved as the persistence.EnvironmentLabel of the graph so
+// that graph queries can scope results to the environment.
+func (r *Runner) persistToOrphanBranch(ctx context.Context, graph *corerpv20250801preview.ApplicationGraphResponse, environmentID string) error {
 	branch := sourceBranch()
 	if branch == "" {
 		return clierrors.Message("Cannot determine source branch from GITHUB_HEAD_REF or GITHUB_REF_NAME; cannot persist modeled graph.")
@@ -289,15 +394,17 @@ func (r *Runner) persistToOrphanBranch(ctx context.Context, graph *corerpv202508
 		return clierrors.Message("Modeled graph store is not configured.")
 	}
 
-	namespace := url.QueryEscape(branch)
-	key := persistence.Key{Namespace: namespace, Name: modeledGraphKeyName}
+	key := ModeledGraphKey(branch)
 	opts := persistence.SaveOptions{
 		Message: fmt.Sprintf("radius: update modeled graph for %s", branch),
 	}
+	if environmentID != "" {
+		opts.Labels = map[string]string{persistence.EnvironmentLabel: environmentID}
+	}
 	if err := r.GraphStore.Save(ctx, key, graph, opts); err != nil {
 		return fmt.Errorf("commit modeled graph to %s branch: %w", gitstore.DefaultGraphBranch, err)
 	}
 
-	r.Output.LogInfo("Parsed %d resources. Committed %s/%s.json to branch %s", len(graph.Resources), namespace, modeledGraphKeyName, gitstore.DefaultGraphBranch)
+	r.Output.LogInfo("Parsed %d resources. Committed %s/%s.json to branch %s", len(graph.Resources), key.Namespace, key.Name, gitstore.DefaultGraphBranch)
 	return nil
 }
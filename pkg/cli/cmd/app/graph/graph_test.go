@@ -18,6 +18,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	cligraph "github.com/radius-project/radius/pkg/cli/graph"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -87,6 +88,35 @@ func Test_Validate(t *testing.T) {
 				require.Equal(t, "test-app", runner.ApplicationName)
 			},
 		},
+		{
+			Name:          "Graph command with export format",
+			Input:         []string{"test-app", "--output", "SVG", "--collapse-output-resources"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "svg", runner.Format)
+				require.True(t, runner.CollapseOutputResources)
+			},
+		},
+		{
+			Name:          "Graph command with unsupported output",
+			Input:         []string{"test-app", "--output", "png"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Graph command missing application",
 			Input:         []string{"-a", "test-app"},
@@ -279,6 +309,60 @@ func Test_Run_JSON(t *testing.T) {
 	require.Equal(t, graph, formatted.Obj)
 }
 
+func Test_Run_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graph := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:   new(containerResourceID),
+				Name: new(containerResourceName),
+				Type: new(containerResourceType),
+				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+					{
+						ID:   new("/planes/radius/local/resourcegroups/test-group/providers/kubernetes/Deployments/demo"),
+						Type: new("kubernetes: apps/Deployment"),
+						Name: new("demo"),
+					},
+				},
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        new(redisResourceID),
+						Direction: &directionOutbound,
+					},
+				},
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetApplicationGraph(gomock.Any(), "test-app").
+		Return(graph, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory:       &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:               &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+		Output:                  outputSink,
+		Format:                  cligraph.ExportFormatMermaid,
+		CollapseOutputResources: true,
+		ApplicationName:         "test-app",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	converted, err := cligraph.ConvertGraph(graph)
+	require.NoError(t, err)
+	expected := cligraph.RenderMermaid(converted, cligraph.ExportOptions{Title: "test-app", CollapseOutputResources: true})
+	require.Equal(t, []any{output.LogOutput{Format: "%s", Params: []any{expected}}}, outputSink.Writes)
+	require.Contains(t, expected, "n0 --> n1")
+	require.NotContains(t, expected, "demo")
+}
+
 const sampleBicepPath = "/tmp/app.bicep"
 
 // sampleTemplate returns a minimal ARM template containing a single
@@ -340,6 +424,37 @@ func TestRunner_RunModeled_LocalFilesystem(t *testing.T) {
 	require.Contains(t, string(contents), "Applications.Core/containers")
 }
 
+func TestRunner_RunModeled_LocalFilesystemWithExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	withTempCwd(t)
+	t.Setenv("GITHUB_ACTIONS", "")
+
+	bicepMock := bicep.NewMockInterface(ctrl)
+	bicepMock.EXPECT().
+		PrepareTemplate(sampleBicepPath).
+		Return(sampleTemplate(), nil).
+		Times(1)
+
+	runner := &Runner{
+		Bicep:         bicepMock,
+		Output:        &output.MockOutput{},
+		BicepFilePath: sampleBicepPath,
+		Format:        cligraph.ExportFormatSVG,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	_, err = os.Stat(defaultModeledGraphFile)
+	require.NoError(t, err)
+	contents, err := os.ReadFile("app-graph.svg")
+	require.NoError(t, err)
+	require.Contains(t, string(contents), "<title>app</title>")
+	require.Contains(t, string(contents), "frontend")
+}
+
 func TestRunner_RunModeled_OrphanBranchPersistence(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -413,6 +528,55 @@ func TestRunner_RunModeled_RealGitStore_SlashBranch(t *testing.T) {
 	require.NoError(t, err, "runModeled must accept slash-containing GITHUB_HEAD_REF values")
 }
 
+// TestRunner_RunModeled_RealGitStore_EnvironmentLabel saves a modeled graph
+// through the real git-backed Store and checks that the application's
+// environment is recorded so that environment-scoped graph queries see it.
+func TestRunner_RunModeled_RealGitStore_EnvironmentLabel(t *testing.T) {
+	repoDir := initGitRepo(t)
+	chdirT(t, repoDir)
+
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_HEAD_REF", "main")
+	t.Setenv("GITHUB_REF_NAME", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	template := sampleTemplate()
+	template["resources"] = append(template["resources"].([]any),
+		map[string]any{"type": "Applications.Core/environments", "name": "myenv"},
+		map[string]any{
+			"type":       "Applications.Core/applications",
+			"name":       "app",
+			"properties": map[string]any{"environment": "[resourceId('Applications.Core/environments', 'myenv')]"},
+		},
+	)
+
+	bicepMock := bicep.NewMockInterface(ctrl)
+	bicepMock.EXPECT().
+		PrepareTemplate(sampleBicepPath).
+		Return(template, nil).
+		Times(1)
+
+	store, err := gitstore.NewStore(gitstore.Options{Branch: "test-graph-" + t.Name()})
+	require.NoError(t, err)
+
+	runner := &Runner{
+		Bicep:         bicepMock,
+		Output:        &output.MockOutput{},
+		BicepFilePath: sampleBicepPath,
+		GraphStore:    store,
+	}
+	require.NoError(t, runner.Run(context.Background()))
+
+	envID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/environments/myenv"
+	blast, err := store.BlastRadius(context.Background(), "main", envID)
+	require.NoError(t, err)
+	require.Len(t, blast.Affected, 1)
+	require.Equal(t, "frontend", blast.Affected[0].Name)
+	require.Equal(t, []persistence.Key{ModeledGraphKey("main")}, blast.Graphs)
+}
+
 func TestRunner_RunModeled_FallsBackToRefName(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,338 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/app/graph"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// dateLayout is the layout accepted by --at for a calendar day.
+const dateLayout = "2006-01-02"
+
+// NewCommand creates an instance of the command and runner for the `rad app graph history` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+	cmd := &cobra.Command{
+		Use:   "history <source-branch>",
+		Short: "Lists the revisions of the modeled graph committed for a branch.",
+		Long: `Lists the revisions of the modeled graph committed for a branch.
+
+Every time 'rad app graph ./app.bicep' runs in GitHub Actions the modeled graph
+for the source branch is committed to the radius-graph orphan branch. This
+command lists those commits, newest first.
+
+Use --at to print the graph as of a revision instead. The value is either a
+revision from the list or a point in time, given as an RFC 3339 timestamp or a
+date (YYYY-MM-DD, meaning the end of that day in local time). A point in time
+selects the newest revision saved at or before it.
+
+Use --restore to commit a previous revision as the latest modeled graph for the
+branch.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# List the revisions of the modeled graph for main.
+rad app graph history main
+
+# Show the modeled graph for main as it was at the end of 14 January 2025.
+rad app graph history main --at 2025-01-14
+
+# Show the modeled graph for main at a specific revision as JSON.
+rad app graph history main --at 3f9c2e1 --output json
+
+# Make a previous revision the latest modeled graph for main.
+rad app graph history main --restore 3f9c2e1`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().String("at", "", "show the graph as of a revision, RFC 3339 timestamp or date (YYYY-MM-DD)")
+	cmd.Flags().String("restore", "", "commit the graph at the given revision as the latest version")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app graph history` command.
+type Runner struct {
+	Output output.Interface
+
+	// GraphStore holds the modeled graphs. It must implement
+	// persistence.Historian.
+	GraphStore persistence.Store
+
+	// Branch is the source branch whose modeled graph is inspected.
+	Branch string
+
+	// At is the revision to show. Exactly one of At and AtTime is set when
+	// --at is supplied.
+	At string
+
+	// AtTime is the point in time to show.
+	AtTime time.Time
+
+	// Restore is the revision to restore.
+	Restore string
+
+	Format string
+}
+
+// NewRunner creates a new instance of the `rad app graph history` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output:     factory.GetOutput(),
+		GraphStore: factory.GetGraphStore(),
+	}
+}
+
+// Validate runs validation for the `rad app graph history` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.Branch = strings.TrimSpace(args[0])
+	if r.Branch == "" {
+		return clierrors.Message("Branch name must not be empty.")
+	}
+
+	at, err := cmd.Flags().GetString("at")
+	if err != nil {
+		return err
+	}
+	r.At, r.AtTime = parseAt(strings.TrimSpace(at))
+
+	r.Restore, err = cmd.Flags().GetString("restore")
+	if err != nil {
+		return err
+	}
+	r.Restore = strings.TrimSpace(r.Restore)
+	if cmd.Flags().Changed("restore") && r.Restore == "" {
+		return clierrors.Message("The --restore flag requires a revision.")
+	}
+	if r.Restore != "" && at != "" {
+		return clierrors.Message("The --at and --restore flags cannot be used together.")
+	}
+
+	return nil
+}
+
+// parseAt splits the value of --at into a revision or a point in time.
+func parseAt(value string) (string, time.Time) {
+	if value == "" {
+		return "", time.Time{}
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return "", t
+	}
+	if t, err := time.ParseInLocation(dateLayout, value, time.Local); err == nil {
+		// A date includes the revisions saved during that day.
+		return "", t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return value, time.Time{}
+}
+
+// Run runs the `rad app graph history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	historian, ok := r.GraphStore.(persistence.Historian)
+	if r.GraphStore == nil || !ok {
+		return clierrors.Message("The modeled graph store does not keep history.")
+	}
+
+	key := graph.ModeledGraphKey(r.Branch)
+	revisions, err := historian.History(ctx, key)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return clierrors.Message("No modeled graph has been committed for branch %q.", r.Branch)
+	} else if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Restore != "":
+		opts := persistence.SaveOptions{
+			Message: fmt.Sprintf("radius: restore modeled graph for %s to %s", r.Branch, r.Restore),
+		}
+		// Keep the environment of the restored revision so that graph
+		// queries scoped to the environment still include the graph.
+		for _, revision := range revisions {
+			if strings.HasPrefix(revision.ID, r.Restore) && revision.Labels[persistence.EnvironmentLabel] != "" {
+				opts.Labels = map[string]string{persistence.EnvironmentLabel: revision.Labels[persistence.EnvironmentLabel]}
+				break
+			}
+		}
+		if err := historian.Restore(ctx, key, r.Restore, opts); err != nil {
+			return r.revisionError(err, r.Restore)
+		}
+		r.Output.LogInfo("Restored the modeled graph for branch %q to revision %s.", r.Branch, r.Restore)
+		return nil
+
+	case r.At != "" || !r.AtTime.IsZero():
+		revision := r.At
+		if revision == "" {
+			selected, ok := persistence.RevisionAt(revisions, r.AtTime)
+			if !ok {
+				return clierrors.Message("No modeled graph had been committed for branch %q at %s.", r.Branch, r.AtTime.Format(time.RFC3339))
+			}
+			revision = selected.ID
+		}
+		result, err := historian.LoadRevision(ctx, key, revision)
+		if err != nil {
+			return r.revisionError(err, revision)
+		}
+		return r.writeGraph(revision, result)
+
+	default:
+		if r.Format == output.FormatJson {
+			return r.Output.WriteFormatted(r.Format, revisions, output.FormatterOptions{})
+		}
+		return r.Output.WriteFormatted(r.Format, toRevisionRows(revisions), revisionTableFormat())
+	}
+}
+
+// revisionError translates an error loading revision into a user-facing
+// message.
+func (r *Runner) revisionError(err error, revision string) error {
+	if errors.Is(err, persistence.ErrNotFound) {
+		return clierrors.Message("The modeled graph for branch %q did not exist at revision %s.", r.Branch, revision)
+	}
+	return clierrors.MessageWithCause(err, "Failed to load revision %s of the modeled graph for branch %q.", revision, r.Branch)
+}
+
+// writeGraph prints the graph loaded at revision.
+func (r *Runner) writeGraph(revision string, result *corerpv20250801preview.ApplicationGraphResponse) error {
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, result, output.FormatterOptions{})
+	}
+
+	resources := make([]*corerpv20250801preview.ApplicationGraphResource, 0, len(result.Resources))
+	for _, res := range result.Resources {
+		if res != nil {
+			resources = append(resources, res)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if a, b := strings.ToLower(to.String(resources[i].Type)), strings.ToLower(to.String(resources[j].Type)); a != b {
+			return a < b
+		}
+		return strings.ToLower(to.String(resources[i].Name)) < strings.ToLower(to.String(resources[j].Name))
+	})
+
+	r.Output.LogInfo("Modeled graph for branch %q at revision %s: %d resources", r.Branch, revision, len(resources))
+	if len(resources) == 0 {
+		return nil
+	}
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(r.Format, resources, resourceTableFormat())
+}
+
+// revisionRow is the table representation of a persistence.Revision.
+type revisionRow struct {
+	Revision  string
+	Timestamp string
+	Message   string
+	Labels    string
+}
+
+// toRevisionRows converts revisions for display in a table. Revisions are
+// abbreviated in the same way as git, only the first line of each message
+// is kept, and deleted revisions are marked in the message column.
+func toRevisionRows(revisions []persistence.Revision) []revisionRow {
+	rows := make([]revisionRow, 0, len(revisions))
+	for _, rev := range revisions {
+		id := rev.ID
+		if len(id) > 12 {
+			id = id[:12]
+		}
+		message, _, _ := strings.Cut(rev.Message, "\n")
+		if rev.Deleted {
+			message = "(deleted) " + message
+		}
+
+		labels := make([]string, 0, len(rev.Labels))
+		for k, v := range rev.Labels {
+			labels = append(labels, k+"="+v)
+		}
+		sort.Strings(labels)
+
+		rows = append(rows, revisionRow{
+			Revision:  id,
+			Timestamp: rev.Timestamp.Local().Format(time.RFC3339),
+			Message:   message,
+			Labels:    strings.Join(labels, ","),
+		})
+	}
+	return rows
+}
+
+func revisionTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "REVISION",
+				JSONPath: "{ .Revision }",
+			},
+			{
+				Heading:  "TIMESTAMP",
+				JSONPath: "{ .Timestamp }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+			{
+				Heading:  "LABELS",
+				JSONPath: "{ .Labels }",
+			},
+		},
+	}
+}
+
+func resourceTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
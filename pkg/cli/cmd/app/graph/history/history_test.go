@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+// historyStore is a persistence.Store that also implements
+// persistence.Historian.
+type historyStore struct {
+	*persistence.MockStore
+	*persistence.MockHistorian
+}
+
+func newHistoryStore(ctrl *gomock.Controller) *historyStore {
+	return &historyStore{
+		MockStore:     persistence.NewMockStore(ctrl),
+		MockHistorian: persistence.NewMockHistorian(ctrl),
+	}
+}
+
+var (
+	mainKey = persistence.Key{Namespace: "main", Name: "app-graph"}
+
+	testRevisions = []persistence.Revision{
+		{ID: "2222222222222222222222222222222222222222", Message: "radius: update modeled graph for main", Timestamp: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{ID: "1111111111111111111111111111111111111111", Message: "first\n\nbody", Labels: map[string]string{"sha": "abc", "env": "dev"}, Timestamp: time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)},
+	}
+)
+
+func testGraph() *corerpv20250801preview.ApplicationGraphResponse {
+	return &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{ID: new("/frontend"), Name: new("frontend"), Type: new("Applications.Core/containers")},
+		},
+	}
+}
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List history",
+			Input:         []string{"main"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "main", runner.Branch)
+				require.Empty(t, runner.At)
+				require.True(t, runner.AtTime.IsZero())
+			},
+		},
+		{
+			Name:          "At revision",
+			Input:         []string{"feature/foo", "--at", "3f9c2e1"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "3f9c2e1", runner.At)
+				require.True(t, runner.AtTime.IsZero())
+			},
+		},
+		{
+			Name:          "At timestamp",
+			Input:         []string{"main", "--at", "2025-01-14T00:00:00Z"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Empty(t, runner.At)
+				require.Equal(t, time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC), runner.AtTime.UTC())
+			},
+		},
+		{
+			Name:          "At date",
+			Input:         []string{"main", "--at", "2025-01-14"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, time.Date(2025, 1, 14, 23, 59, 59, int(time.Second-time.Nanosecond), time.Local), runner.AtTime)
+			},
+		},
+		{
+			Name:          "Restore",
+			Input:         []string{"main", "--restore", "3f9c2e1"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				require.Equal(t, "3f9c2e1", r.(*Runner).Restore)
+			},
+		},
+		{
+			Name:          "At and restore",
+			Input:         []string{"main", "--at", "a", "--restore", "b"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Empty restore",
+			Input:         []string{"main", "--restore", ""},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Missing branch",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(testRevisions, nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{Output: outputSink, GraphStore: store, Branch: "main", Format: output.FormatTable}
+	require.NoError(t, runner.Run(context.Background()))
+
+	require.Equal(t, []any{
+		output.FormattedOutput{
+			Format: output.FormatTable,
+			Obj: []revisionRow{
+				{Revision: "222222222222", Timestamp: testRevisions[0].Timestamp.Local().Format(time.RFC3339), Message: "radius: update modeled graph for main"},
+				{Revision: "111111111111", Timestamp: testRevisions[1].Timestamp.Local().Format(time.RFC3339), Message: "first", Labels: "env=dev,sha=abc"},
+			},
+			Options: revisionTableFormat(),
+		},
+	}, outputSink.Writes)
+}
+
+func Test_Run_AtTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(testRevisions, nil)
+	store.MockHistorian.EXPECT().LoadRevision(gomock.Any(), mainKey, testRevisions[1].ID).Return(testGraph(), nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Output:     outputSink,
+		GraphStore: store,
+		Branch:     "main",
+		AtTime:     time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC),
+		Format:     output.FormatJson,
+	}
+	require.NoError(t, runner.Run(context.Background()))
+	require.Equal(t, []any{output.FormattedOutput{Format: output.FormatJson, Obj: testGraph()}}, outputSink.Writes)
+}
+
+func Test_Run_AtTimeBeforeFirstRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(testRevisions, nil)
+
+	runner := &Runner{
+		Output:     &output.MockOutput{},
+		GraphStore: store,
+		Branch:     "main",
+		AtTime:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Format:     output.FormatTable,
+	}
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, `No modeled graph had been committed for branch "main" at`)
+}
+
+func Test_Run_AtRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(testRevisions, nil)
+	store.MockHistorian.EXPECT().LoadRevision(gomock.Any(), mainKey, "1111111").Return(testGraph(), nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{Output: outputSink, GraphStore: store, Branch: "main", At: "1111111", Format: output.FormatTable}
+	require.NoError(t, runner.Run(context.Background()))
+
+	require.Equal(t, []any{
+		output.LogOutput{Format: "Modeled graph for branch %q at revision %s: %d resources", Params: []any{"main", "1111111", 1}},
+		output.LogOutput{Format: ""},
+		output.FormattedOutput{Format: output.FormatTable, Obj: testGraph().Resources, Options: resourceTableFormat()},
+	}, outputSink.Writes)
+}
+
+func Test_Run_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(testRevisions, nil)
+	store.MockHistorian.EXPECT().
+		Restore(gomock.Any(), mainKey, "1111111", persistence.SaveOptions{Message: "radius: restore modeled graph for main to 1111111"}).
+		Return(nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{Output: outputSink, GraphStore: store, Branch: "main", Restore: "1111111", Format: output.FormatTable}
+	require.NoError(t, runner.Run(context.Background()))
+	require.Equal(t, []any{
+		output.LogOutput{Format: "Restored the modeled graph for branch %q to revision %s.", Params: []any{"main", "1111111"}},
+	}, outputSink.Writes)
+}
+
+func Test_Run_Restore_KeepsEnvironment(t *testing.T) {
+	envID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/environments/dev"
+	revisions := []persistence.Revision{
+		{ID: "2222222222222222222222222222222222222222", Timestamp: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{ID: "1111111111111111111111111111111111111111", Labels: map[string]string{persistence.EnvironmentLabel: envID}, Timestamp: time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)},
+	}
+
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), mainKey).Return(revisions, nil)
+	store.MockHistorian.EXPECT().
+		Restore(gomock.Any(), mainKey, "1111111", persistence.SaveOptions{
+			Message: "radius: restore modeled graph for main to 1111111",
+			Labels:  map[string]string{persistence.EnvironmentLabel: envID},
+		}).
+		Return(nil)
+
+	runner := &Runner{Output: &output.MockOutput{}, GraphStore: store, Branch: "main", Restore: "1111111", Format: output.FormatTable}
+	require.NoError(t, runner.Run(context.Background()))
+}
+
+func Test_Run_BranchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := newHistoryStore(ctrl)
+	store.MockHistorian.EXPECT().History(gomock.Any(), gomock.Any()).Return(nil, persistence.ErrNotFound)
+
+	runner := &Runner{Output: &output.MockOutput{}, GraphStore: store, Branch: "main", Format: output.FormatTable}
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, `No modeled graph has been committed for branch "main".`)
+}
+
+func Test_Run_StoreWithoutHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	runner := &Runner{Output: &output.MockOutput{}, GraphStore: persistence.NewMockStore(ctrl), Branch: "main", Format: output.FormatTable}
+	err := runner.Run(context.Background())
+	require.ErrorContains(t, err, "does not keep history")
+}
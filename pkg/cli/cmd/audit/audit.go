@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	audit_list "github.com/radius-project/radius/pkg/cli/cmd/audit/list"
+	"github.com/radius-project/radius/pkg/cli/framework"
+)
+
+// NewCommand creates an instance of the command for the `rad audit` command.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Query the audit log.",
+		Long:  "Query the audit log." + common.LongDescriptionBlurb,
+		Example: `
+# List the most recent audit records
+rad audit list
+
+# List the changes made to a resource in the last day
+rad audit list --resource /planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend --since 24h
+`,
+	}
+
+	list, _ := audit_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/components/audit"
+)
+
+// LongDescriptionBlurb is a blurb that's included in all of the command descriptions for 'audit'.
+// The newlines are intentional, don't make changes without looking at the formatting.
+const LongDescriptionBlurb = `
+
+When auditing is enabled, the Radius control-plane records every create, update, delete and action request with the
+caller, the operation, the resource, the response status and the changes made to the resource. Values of sensitive
+fields are redacted.
+
+Auditing is configured with the 'ucp.audit' values of the Helm chart. Records can only be queried when they are
+stored in the database or in a file.`
+
+// Record is the table view of an audit.Record.
+type Record struct {
+	Timestamp     time.Time
+	User          string
+	OperationType string
+	ResourceID    string
+	StatusCode    int
+	Changes       int
+}
+
+// NewRecord creates the table view of record.
+func NewRecord(record *audit.Record) Record {
+	return Record{
+		Timestamp:     record.Timestamp,
+		User:          record.User,
+		OperationType: record.OperationType,
+		ResourceID:    record.ResourceID,
+		StatusCode:    record.StatusCode,
+		Changes:       len(record.Changes),
+	}
+}
+
+// RecordFormat returns the table format of Record.
+func RecordFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIMESTAMP",
+				JSONPath: "{ .Timestamp }",
+			},
+			{
+				Heading:  "USER",
+				JSONPath: "{ .User }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .StatusCode }",
+			},
+			{
+				Heading:  "CHANGES",
+				JSONPath: "{ .Changes }",
+			},
+		},
+	}
+}
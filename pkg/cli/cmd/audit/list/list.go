@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// defaultTop is the default number of records listed.
+	defaultTop = 100
+)
+
+// NewCommand creates an instance of the command and runner for the `rad audit list` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit records",
+		Long:  "List audit records, newest first." + common.LongDescriptionBlurb,
+		Example: `
+# List the most recent audit records
+rad audit list
+
+# List the audit records of a resource
+rad audit list --resource /planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend
+
+# List the audit records of all resources in a resource group in the last 2 hours
+rad audit list --resource /planes/radius/local/resourceGroups/prod --since 2h
+
+# List the audit records of a time range, including the changes made
+rad audit list --since 2024-01-01T00:00:00Z --until 2024-01-02T00:00:00Z --output json
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("resource", "", "The ID of a resource or scope. Lists the records of the resource and of the resources it contains")
+	cmd.Flags().String("since", "", "Lists the records written at or after this time, as an RFC3339 timestamp or a duration before now such as 24h. Records stored in the database are listed for the last 90 days when omitted")
+	cmd.Flags().String("until", "", "Lists the records written before this time, as an RFC3339 timestamp or a duration before now such as 1h")
+	cmd.Flags().Int("top", defaultTop, "The maximum number of records to list. Use 0 to list all records")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad audit list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+	Query             audit.Query
+
+	// now returns the current time. It is overridden in tests.
+	now func() time.Time
+}
+
+// NewRunner creates a new instance of the `rad audit list` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		now:               time.Now,
+	}
+}
+
+// Validate runs validation for the `rad audit list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	resourceID, err := cmd.Flags().GetString("resource")
+	if err != nil {
+		return err
+	}
+	if resourceID != "" {
+		if _, err := resources.Parse(resourceID); err != nil {
+			return clierrors.Message("'%s' is not a valid resource ID.", resourceID)
+		}
+	}
+	r.Query.ResourceID = resourceID
+
+	r.Query.Since, err = r.requireTime(cmd, "since")
+	if err != nil {
+		return err
+	}
+
+	r.Query.Until, err = r.requireTime(cmd, "until")
+	if err != nil {
+		return err
+	}
+
+	if !r.Query.Since.IsZero() && !r.Query.Until.IsZero() && !r.Query.Since.Before(r.Query.Until) {
+		return clierrors.Message("The value of '--since' must be before the value of '--until'.")
+	}
+
+	r.Query.Top, err = cmd.Flags().GetInt("top")
+	if err != nil {
+		return err
+	}
+	if r.Query.Top < 0 {
+		return clierrors.Message("The value of '--top' must not be negative.")
+	}
+
+	return nil
+}
+
+// requireTime parses the value of a time flag. The value is either an RFC3339 timestamp or a duration before now.
+func (r *Runner) requireTime(cmd *cobra.Command, name string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return r.now().Add(-d), nil
+	}
+
+	return time.Time{}, clierrors.Message("The value of '--%s' must be an RFC3339 timestamp such as 2024-01-02T15:04:05Z or a duration such as 24h, got %q.", name, value)
+}
+
+// Run runs the `rad audit list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateAuditClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	records, err := client.ListAuditRecords(ctx, r.Query)
+	if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, records, output.FormatterOptions{})
+	}
+
+	views := make([]common.Record, 0, len(records))
+	for _, record := range records {
+		views = append(views, common.NewRecord(record))
+	}
+	return r.Output.WriteFormatted(r.Format, views, common.RecordFormat())
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/frontend"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, audit.Query{Top: defaultTop}, runner.(*Runner).Query)
+			},
+		},
+		{
+			Name:          "List Command with resource and time range",
+			Input:         []string{"--resource", testResourceID, "--since", "2024-01-01T00:00:00Z", "--until", "2024-01-02T00:00:00Z", "--top", "0"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, audit.Query{
+					ResourceID: testResourceID,
+					Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					Until:      time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				}, runner.(*Runner).Query)
+			},
+		},
+		{
+			Name:          "List Command with duration",
+			Input:         []string{"--since", "24h"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				since := runner.(*Runner).Query.Since
+				require.WithinDuration(t, time.Now().Add(-24*time.Hour), since, time.Minute)
+			},
+		},
+		{
+			Name:          "List Command with invalid resource",
+			Input:         []string{"--resource", "not-an-id"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with invalid time",
+			Input:         []string{"--since", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with since after until",
+			Input:         []string{"--since", "2024-01-02T00:00:00Z", "--until", "2024-01-01T00:00:00Z"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with negative top",
+			Input:         []string{"--top", "-1"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"a"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := audit.Query{ResourceID: testResourceID, Top: 10}
+	records := []*audit.Record{
+		{
+			ID:            "record-1",
+			Timestamp:     timestamp,
+			Source:        "ucp",
+			User:          "alice",
+			OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+			Method:        "PUT",
+			ResourceID:    testResourceID,
+			StatusCode:    200,
+			Changes: []audit.Change{
+				{Path: "properties.container.image", Old: "nginx:1", New: "nginx:2"},
+			},
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockAuditClient(ctrl)
+		client.EXPECT().ListAuditRecords(gomock.Any(), query).Return(records, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{AuditClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Query:             query,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []common.Record{
+					{
+						Timestamp:     timestamp,
+						User:          "alice",
+						OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+						ResourceID:    testResourceID,
+						StatusCode:    200,
+						Changes:       1,
+					},
+				},
+				Options: common.RecordFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockAuditClient(ctrl)
+		client.EXPECT().ListAuditRecords(gomock.Any(), query).Return(records, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{AuditClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "json",
+			Query:             query,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{output.FormattedOutput{Format: "json", Obj: records}}, outputSink.Writes)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+// LongDescriptionBlurb is a blurb that's included in all of the command descriptions for 'deadletter'.
+// The newlines are intentional, don't make changes without looking at the formatting.
+const LongDescriptionBlurb = `
+
+Async operations that keep failing, or whose controller panics, are moved to a dead-letter queue instead of being
+retried forever. The operation is marked as failed and the message is kept along with the last error and the panic
+stack so that it can be inspected, replayed once the problem is fixed, or purged.
+
+Each resource provider has its own queue. Use '--queue' to select it: '--queue radius' for the Applications resource provider, or '--queue dynamic-rp' for the dynamic resource provider.
+The queue of the Radius control-plane is used when '--queue' is omitted.`
+
+// AddQueueFlag adds the '--queue' flag to cmd.
+func AddQueueFlag(cmd *cobra.Command) {
+	cmd.Flags().String("queue", "", "The name of the queue, for example radius. Defaults to the queue of the Radius control-plane")
+}
+
+// RequireQueue returns the value of the '--queue' flag.
+func RequireQueue(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("queue")
+}
+
+// DeadLetter is the table view of a queue.DeadLetter. The operation fields are decoded from the message when it is an
+// async operation request.
+type DeadLetter struct {
+	ID             string
+	OperationType  string
+	ResourceID     string
+	Reason         string
+	Error          string
+	DequeueCount   int
+	DeadLetteredAt time.Time
+}
+
+// asyncOperationRequest holds the fields of an async operation request message shown by the commands.
+type asyncOperationRequest struct {
+	OperationType string `json:"operationType"`
+	ResourceID    string `json:"resourceID"`
+}
+
+// NewDeadLetter creates the table view of letter.
+func NewDeadLetter(letter *queue.DeadLetter) DeadLetter {
+	req := asyncOperationRequest{}
+	// Messages that are not async operation requests are shown without operation fields.
+	_ = json.Unmarshal(letter.Data, &req)
+
+	return DeadLetter{
+		ID:             letter.ID,
+		OperationType:  req.OperationType,
+		ResourceID:     req.ResourceID,
+		Reason:         letter.Reason,
+		Error:          letter.Error,
+		DequeueCount:   letter.DequeueCount,
+		DeadLetteredAt: letter.DeadLetteredAt,
+	}
+}
+
+// DeadLetterFormat returns the table format of DeadLetter.
+func DeadLetterFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .DequeueCount }",
+			},
+			{
+				Heading:  "DEAD-LETTERED AT",
+				JSONPath: "{ .DeadLetteredAt }",
+			},
+		},
+	}
+}
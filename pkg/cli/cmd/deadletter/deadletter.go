@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/deadletter/purge"
+	deadletter_replay "github.com/radius-project/radius/pkg/cli/cmd/deadletter/replay"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/deadletter/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+)
+
+// NewCommand creates an instance of the command for the `rad deadletter` command.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "Manage dead-lettered async operations.",
+		Long:  "Manage dead-lettered async operations." + common.LongDescriptionBlurb,
+		Example: `
+# List dead-lettered operations of the Applications resource provider
+rad deadletter list --queue radius
+
+# Show a dead-lettered operation, including the panic stack
+rad deadletter show <id> --queue radius
+
+# Retry a dead-lettered operation
+rad deadletter replay <id> --queue radius
+
+# Delete a dead-lettered operation
+rad deadletter purge <id> --queue radius
+`,
+	}
+
+	list, _ := deadletter_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := deadletter_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	replay, _ := deadletter_replay.NewCommand(factory)
+	cmd.AddCommand(replay)
+
+	purge, _ := deadletter_purge.NewCommand(factory)
+	cmd.AddCommand(purge)
+
+	return cmd
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with queue",
+			Input:         []string{"--queue", "radius"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "radius", runner.(*Runner).Queue)
+			},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"a"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	deadLetteredAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	letters := []*queue.DeadLetter{
+		{
+			ID:             "message-1",
+			DeadLetterInfo: queue.DeadLetterInfo{Reason: queue.DeadLetterReasonPanic, Error: "boom"},
+			DequeueCount:   1,
+			DeadLetteredAt: deadLetteredAt,
+			Data:           []byte(`{"operationType":"APPLICATIONS.CORE/CONTAINERS|PUT","resourceID":"/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/frontend"}`),
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().ListDeadLetters(gomock.Any(), "radius").Return(letters, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			Queue:             "radius",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []common.DeadLetter{
+					{
+						ID:             "message-1",
+						OperationType:  "APPLICATIONS.CORE/CONTAINERS|PUT",
+						ResourceID:     "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/frontend",
+						Reason:         queue.DeadLetterReasonPanic,
+						Error:          "boom",
+						DequeueCount:   1,
+						DeadLetteredAt: deadLetteredAt,
+					},
+				},
+				Options: common.DeadLetterFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().ListDeadLetters(gomock.Any(), "").Return(letters, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{output.FormattedOutput{Format: "json", Obj: letters}}, outputSink.Writes)
+	})
+}
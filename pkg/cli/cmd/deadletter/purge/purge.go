@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+const (
+	purgeConfirmationMsg = "Are you sure you want to purge dead-lettered operation '%s'?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter purge` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge <id>",
+		Short: "Delete a dead-lettered async operation",
+		Long:  "Delete a dead-lettered async operation. The operation stays failed." + common.LongDescriptionBlurb,
+		Example: `
+# Delete a dead-lettered operation of the Applications resource provider
+rad deadletter purge <id> --queue radius
+
+# Delete a dead-lettered operation and bypass confirmation prompt
+rad deadletter purge <id> --queue radius --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	common.AddQueueFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Queue             string
+	ID                string
+	Confirm           bool
+}
+
+// NewRunner creates a new instance of the `rad deadletter purge` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Queue, err = common.RequireQueue(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.ID = args[0]
+	return nil
+}
+
+// Run runs the `rad deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(purgeConfirmationMsg, r.ID), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.PurgeDeadLetter(ctx, r.Queue, r.ID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The dead-lettered operation %q was not found or has been purged.", r.ID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Dead-lettered operation %q purged.", r.ID)
+	return nil
+}
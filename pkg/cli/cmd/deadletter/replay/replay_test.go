@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Replay Command",
+			Input:         []string{"message-1"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Replay Command with too many args",
+			Input:         []string{"message-1", "message-2"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().ReplayDeadLetter(gomock.Any(), "radius", "message-1").Return(nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Queue:             "radius",
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{Format: "Dead-lettered operation %q was moved back to the queue.", Params: []any{"message-1"}},
+		}, outputSink.Writes)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().ReplayDeadLetter(gomock.Any(), "", "message-1").Return(&azcore.ResponseError{StatusCode: http.StatusNotFound}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The dead-lettered operation %q was not found or has been purged.", "message-1"), err)
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter show` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show a dead-lettered async operation",
+		Long:  "Show a dead-lettered async operation along with the last error and the panic stack." + common.LongDescriptionBlurb,
+		Example: `
+# Show a dead-lettered operation of the Applications resource provider
+rad deadletter show <id> --queue radius
+
+# Show the full message, including the async operation request, as JSON
+rad deadletter show <id> --queue radius --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	common.AddQueueFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter show` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+	Queue             string
+	ID                string
+}
+
+// NewRunner creates a new instance of the `rad deadletter show` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter show` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Queue, err = common.RequireQueue(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.ID = args[0]
+	return nil
+}
+
+// Run runs the `rad deadletter show` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	letter, err := client.GetDeadLetter(ctx, r.Queue, r.ID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The dead-lettered operation %q was not found or has been purged.", r.ID)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, letter, output.FormatterOptions{})
+	}
+
+	err = r.Output.WriteFormatted(r.Format, common.NewDeadLetter(letter), common.DeadLetterFormat())
+	if err != nil {
+		return err
+	}
+
+	if letter.Error != "" {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Error: %s", letter.Error)
+	}
+	if letter.Stack != "" {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Stack:\n%s", letter.Stack)
+	}
+	return nil
+}
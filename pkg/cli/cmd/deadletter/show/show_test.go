@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Show Command",
+			Input:         []string{"message-1", "--queue", "radius"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "message-1", runner.(*Runner).ID)
+				require.Equal(t, "radius", runner.(*Runner).Queue)
+			},
+		},
+		{
+			Name:          "Show Command without id",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	letter := &queue.DeadLetter{
+		ID:             "message-1",
+		DeadLetterInfo: queue.DeadLetterInfo{Reason: queue.DeadLetterReasonPanic, Error: "boom", Stack: "goroutine 1 [running]:"},
+		DequeueCount:   1,
+		Data:           []byte(`{}`),
+	}
+
+	t.Run("Exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().GetDeadLetter(gomock.Any(), "", "message-1").Return(letter, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     common.NewDeadLetter(letter),
+				Options: common.DeadLetterFormat(),
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Error: %s", Params: []any{"boom"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Stack:\n%s", Params: []any{"goroutine 1 [running]:"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockDeadLetterClient(ctrl)
+		client.EXPECT().GetDeadLetter(gomock.Any(), "", "message-1").Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			ID:                "message-1",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The dead-lettered operation %q was not found or has been purged.", "message-1"), err)
+	})
+}
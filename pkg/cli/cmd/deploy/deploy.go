@@ -121,6 +121,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the resources that recipes would create, without deploying
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -131,6 +134,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Preview the changes that recipes would make to their output resources without deploying the template")
 
 	return cmd, runner
 }
@@ -150,6 +154,7 @@ type Runner struct {
 	ApplicationName          string
 	EnvironmentNameOrID      string
 	FilePath                 string
+	WhatIf                   bool
 	Parameters               map[string]map[string]any
 	Template                 map[string]any
 	TemplateInspectionResult bicep.TemplateInspectionResult
@@ -197,6 +202,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	// Get the file path early so we can prepare the template
 	r.FilePath = args[0]
 
+	// The flag is not defined by `rad run`, which shares this validation.
+	r.WhatIf, _ = cmd.Flags().GetBool("what-if")
+
 	// Prepare the template early to check if it contains an environment resource.
 	// This allows us to skip environment validation if the template will create one.
 	r.Template, err = r.Bicep.PrepareTemplate(r.FilePath)
@@ -228,6 +236,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.EnvironmentNameOrID = ""
 	}
 
+	// Recipes are planned using the environment they are registered in, so there is nothing to preview
+	// when the template creates the environment.
+	if r.WhatIf && r.EnvironmentNameOrID == "" {
+		return clierrors.Message("The --what-if flag requires an existing environment. Use --environment to specify the environment name.")
+	}
+
 	// This might be empty, and that's fine!
 	r.ApplicationName, err = cli.ReadApplicationName(cmd, *workspace)
 	if err != nil {
@@ -287,6 +301,11 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Nothing below this point may be run in what-if mode because it creates resources.
+	if r.WhatIf {
+		return r.runWhatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	// Note: This only applies when the environment already exists. If the template is creating the environment,
@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/recipepack"
 	"github.com/radius-project/radius/pkg/cli/test_client_factory"
@@ -40,6 +41,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	corerpfake "github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/spf13/cobra"
@@ -935,6 +937,142 @@ func getRecipePacks(t *testing.T, template map[string]any, resourceKey string) (
 	return packs, ok
 }
 
+func Test_Run_WhatIf(t *testing.T) {
+	environmentID := fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Applications.Core/environments/%s", radcli.TestEnvironmentName, radcli.TestEnvironmentName)
+	template := map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"cacheName":   map[string]any{"type": "string", "defaultValue": "redis0"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"type": "Applications.Core/applications@2023-10-01-preview",
+				"name": "app",
+			},
+			"cache": map[string]any{
+				"type": "Applications.Datastores/redisCaches@2023-10-01-preview",
+				"name": "[parameters('cacheName')]",
+				"properties": map[string]any{
+					"environment": "[parameters('environment')]",
+					"application": "[resourceId('Applications.Core/applications', 'app')]",
+					"recipe": map[string]any{
+						"name":       "premium",
+						"parameters": map[string]any{"sku": "Premium"},
+					},
+				},
+			},
+			"computed": map[string]any{
+				"type": "Applications.Messaging/rabbitMQQueues@2023-10-01-preview",
+				"name": "[format('{0}-queue', parameters('cacheName'))]",
+			},
+			"manual": map[string]any{
+				"type": "Applications.Datastores/sqlDatabases@2023-10-01-preview",
+				"name": "db",
+				"properties": map[string]any{
+					"resourceProvisioning": "manual",
+				},
+			},
+			"mongo": map[string]any{
+				"type": "Applications.Datastores/mongoDatabases@2023-10-01-preview",
+				"name": "mongo",
+			},
+			"sql": map[string]any{
+				"type": "Applications.Datastores/sqlDatabases@2023-10-01-preview",
+				"name": "sql",
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	planClient := clients.NewMockRecipePlanClient(ctrl)
+	planClient.EXPECT().
+		PlanRecipe(gomock.Any(), environmentID, recipes.RecipePlanRequest{
+			ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis0",
+			RecipeName: "premium",
+			Parameters: map[string]any{"sku": "Premium"},
+			Properties: map[string]any{
+				"environment": environmentID,
+				"recipe": map[string]any{
+					"name":       "premium",
+					"parameters": map[string]any{"sku": "Premium"},
+				},
+			},
+		}).
+		Return(&recipes.RecipePlan{
+			Changes: []recipes.PlannedResourceChange{
+				{Action: recipes.PlanActionCreate, ResourceType: "Microsoft.Cache/redis", ID: "/subscriptions/test/resourceGroups/test/providers/Microsoft.Cache/redis/redis0"},
+			},
+		}, nil).
+		Times(1)
+	planClient.EXPECT().
+		PlanRecipe(gomock.Any(), environmentID, recipes.RecipePlanRequest{
+			ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/mongoDatabases/mongo",
+		}).
+		Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+		Times(1)
+	planClient.EXPECT().
+		PlanRecipe(gomock.Any(), environmentID, recipes.RecipePlanRequest{
+			ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/sqlDatabases/sql",
+		}).
+		Return(nil, &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: recipes.RecipePlanNotSupported}).
+		Times(1)
+
+	// Deploy is not mocked, so any attempt to deploy fails the test.
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory:   &connections.MockFactory{RecipePlanClient: planClient},
+		Deploy:              deploy.NewMockInterface(ctrl),
+		Output:              outputSink,
+		FilePath:            "app.bicep",
+		EnvironmentNameOrID: radcli.TestEnvironmentName,
+		WhatIf:              true,
+		Parameters:          map[string]map[string]any{},
+		Workspace: &workspaces.Workspace{
+			Name:  "test-workspace",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		},
+		Providers: &clients.Providers{
+			Radius: &clients.RadiusProvider{EnvironmentID: environmentID},
+		},
+		Template: template,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Planning template '%v' in environment '%v' from workspace '%v'...\n",
+			Params: []any{"app.bicep", radcli.TestEnvironmentName, "test-workspace"},
+		},
+		output.FormattedOutput{
+			Format: "table",
+			Obj: []whatIfChange{
+				{
+					Resource:           "Applications.Datastores/redisCaches/redis0",
+					Recipe:             "premium",
+					Action:             "Create",
+					OutputResourceType: "Microsoft.Cache/redis",
+					OutputResource:     "/subscriptions/test/resourceGroups/test/providers/Microsoft.Cache/redis/redis0",
+				},
+			},
+			Options: objectformats.GetRecipePlanTableFormat(),
+		},
+		output.LogOutput{
+			Format: "\nThe following resources were not planned:\n\n  - %s",
+			Params: []any{
+				"computed (Applications.Messaging/rabbitMQQueues): the resource name is computed during the deployment\n" +
+					"  - Applications.Datastores/mongoDatabases/mongo: the environment has no recipe \"default\" for resource type \"Applications.Datastores/mongoDatabases\"\n" +
+					"  - Applications.Datastores/sqlDatabases/sql: the driver of recipe \"default\" does not support planning",
+			},
+		},
+		output.LogOutput{
+			Format: "\nWhat-if complete. No resources were deployed.",
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
+
 func Test_setupRecipePacks(t *testing.T) {
 	scope := "/planes/radius/local/resourceGroups/test-group"
 
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// noChangeAction is the action displayed for a resource whose recipe would not change any output resources.
+	noChangeAction = "NoChange"
+
+	// extendersResourceType is the only Applications.Core resource type that can be deployed by a recipe.
+	extendersResourceType = "Applications.Core/extenders"
+)
+
+// parameterExpression matches a template expression that only references a parameter, e.g. "[parameters('name')]".
+var parameterExpression = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+// recipeResource is a resource in the template that would be deployed by a recipe.
+type recipeResource struct {
+	// Type is the resource type without the API version.
+	Type string
+	// Name is the name of the resource.
+	Name string
+	// Request is the recipe plan request for the resource.
+	Request recipes.RecipePlanRequest
+}
+
+// whatIfChange is a row of the `rad deploy --what-if` output.
+type whatIfChange struct {
+	Resource           string
+	Recipe             string
+	Action             string
+	OutputResourceType string
+	OutputResource     string
+}
+
+// runWhatIf plans the recipes of the resources in the template and displays the changes they would make to their
+// output resources. Nothing is deployed.
+func (r *Runner) runWhatIf(ctx context.Context, template map[string]any) error {
+	r.Output.LogInfo("Planning template '%v' in environment '%v' from workspace '%v'...\n", r.FilePath, r.EnvironmentNameOrID, r.Workspace.Name)
+
+	resources, skipped := r.findRecipeResources(template)
+
+	changes := []whatIfChange{}
+	if len(resources) > 0 {
+		client, err := r.ConnectionFactory.CreateRecipePlanClient(ctx, *r.Workspace)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources {
+			recipeName := resource.Request.RecipeName
+			if recipeName == "" {
+				recipeName = "default"
+			}
+			displayName := resource.Type + "/" + resource.Name
+
+			plan, err := client.PlanRecipe(ctx, r.Providers.Radius.EnvironmentID, resource.Request)
+			if clients.Is404Error(err) {
+				skipped = append(skipped, fmt.Sprintf("%s: the environment has no recipe %q for resource type %q", displayName, recipeName, resource.Type))
+				continue
+			} else if clients.IsRecipePlanNotSupportedError(err) {
+				skipped = append(skipped, fmt.Sprintf("%s: the driver of recipe %q does not support planning", displayName, recipeName))
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to plan recipe %q for %s: %w", recipeName, displayName, err)
+			}
+
+			if len(plan.Changes) == 0 {
+				changes = append(changes, whatIfChange{Resource: displayName, Recipe: recipeName, Action: noChangeAction})
+				continue
+			}
+
+			for _, change := range plan.Changes {
+				outputResource := change.ID
+				if outputResource == "" {
+					outputResource = change.Name
+				}
+
+				changes = append(changes, whatIfChange{
+					Resource:           displayName,
+					Recipe:             recipeName,
+					Action:             string(change.Action),
+					OutputResourceType: change.ResourceType,
+					OutputResource:     outputResource,
+				})
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		r.Output.LogInfo("No resources in the template are deployed by recipes.")
+	} else {
+		err := r.Output.WriteFormatted("table", changes, objectformats.GetRecipePlanTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(skipped) > 0 {
+		r.Output.LogInfo("\nThe following resources were not planned:\n\n  - %s", strings.Join(skipped, "\n  - "))
+	}
+
+	r.Output.LogInfo("\nWhat-if complete. No resources were deployed.")
+	return nil
+}
+
+// findRecipeResources returns the resources in the template that would be deployed by a recipe, in a stable order.
+// It also returns a description of each recipe resource that could not be planned because its name depends on
+// values only known during the deployment.
+func (r *Runner) findRecipeResources(template map[string]any) ([]recipeResource, []string) {
+	templateResources, ok := template["resources"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	symbolicNames := make([]string, 0, len(templateResources))
+	for symbolicName := range templateResources {
+		symbolicNames = append(symbolicNames, symbolicName)
+	}
+	sort.Strings(symbolicNames)
+
+	declaredParameters, _ := bicep.ExtractParameters(template)
+
+	result := []recipeResource{}
+	skipped := []string{}
+	for _, symbolicName := range symbolicNames {
+		resource, ok := templateResources[symbolicName].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		fullType, _ := resource["type"].(string)
+		resourceType, _, _ := strings.Cut(fullType, "@")
+		if !isRecipeResourceType(resourceType) {
+			continue
+		}
+
+		properties, _ := resource["properties"].(map[string]any)
+		if provisioning, _ := properties["resourceProvisioning"].(string); strings.EqualFold(provisioning, "manual") {
+			continue
+		}
+
+		name, ok := r.resolveTemplateValue(declaredParameters, resource["name"])
+		nameString, isString := name.(string)
+		if !ok || !isString || nameString == "" {
+			skipped = append(skipped, fmt.Sprintf("%s (%s): the resource name is computed during the deployment", symbolicName, resourceType))
+			continue
+		}
+
+		request := recipes.RecipePlanRequest{
+			ResourceID:    r.Workspace.Scope + "/providers/" + resourceType + "/" + nameString,
+			ApplicationID: r.Providers.Radius.ApplicationID,
+		}
+
+		resolvedProperties, _ := r.resolveTemplateValue(declaredParameters, properties)
+		if resolvedProperties, ok := resolvedProperties.(map[string]any); ok && len(resolvedProperties) > 0 {
+			request.Properties = resolvedProperties
+
+			if recipe, ok := resolvedProperties["recipe"].(map[string]any); ok {
+				request.RecipeName, _ = recipe["name"].(string)
+				request.Parameters, _ = recipe["parameters"].(map[string]any)
+			}
+		}
+
+		result = append(result, recipeResource{Type: resourceType, Name: nameString, Request: request})
+	}
+
+	return result, skipped
+}
+
+// resolveTemplateValue resolves the value of a template property. Literals are returned as-is and parameter
+// references are replaced by the provided or default value of the parameter. Other template expressions cannot be
+// evaluated before the deployment, so the second return value is false for them. Maps and slices are resolved
+// recursively and entries that cannot be resolved are dropped.
+func (r *Runner) resolveTemplateValue(declaredParameters map[string]any, value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "[[") {
+			// "[[" escapes a literal string that starts with "[".
+			return v[1:], true
+		}
+		if !strings.HasPrefix(v, "[") || !strings.HasSuffix(v, "]") {
+			return v, true
+		}
+
+		match := parameterExpression.FindStringSubmatch(v)
+		if match == nil {
+			return nil, false
+		}
+
+		return r.resolveParameter(declaredParameters, match[1])
+	case map[string]any:
+		resolved := map[string]any{}
+		for key, item := range v {
+			if resolvedItem, ok := r.resolveTemplateValue(declaredParameters, item); ok {
+				resolved[key] = resolvedItem
+			}
+		}
+		return resolved, true
+	case []any:
+		resolved := []any{}
+		for _, item := range v {
+			if resolvedItem, ok := r.resolveTemplateValue(declaredParameters, item); ok {
+				resolved = append(resolved, resolvedItem)
+			}
+		}
+		return resolved, true
+	default:
+		return v, true
+	}
+}
+
+// resolveParameter returns the provided value of the parameter, falling back to its default value.
+func (r *Runner) resolveParameter(declaredParameters map[string]any, name string) (any, bool) {
+	// Case-invariant lookup on the user-provided values
+	for provided, parameter := range r.Parameters {
+		if strings.EqualFold(provided, name) {
+			value, ok := parameter["value"]
+			return value, ok
+		}
+	}
+
+	for declared, parameter := range declaredParameters {
+		if !strings.EqualFold(declared, name) {
+			continue
+		}
+
+		defaultValue, ok := bicep.DefaultValue(parameter)
+		if !ok {
+			return nil, false
+		}
+		return r.resolveTemplateValue(declaredParameters, defaultValue)
+	}
+
+	return nil, false
+}
+
+// isRecipeResourceType returns true if resources of the given type can be deployed by a recipe.
+func isRecipeResourceType(resourceType string) bool {
+	if !bicep.IsRadiusResourceType(resourceType) {
+		return false
+	}
+
+	if strings.EqualFold(resourceType, extendersResourceType) {
+		return true
+	}
+
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	return !strings.EqualFold(namespace, appCoreProviderName) && !strings.EqualFold(namespace, radiusCoreProviderName)
+}
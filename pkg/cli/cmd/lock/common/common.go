@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// PlaneName is the name of the Radius plane that stores the locks.
+	PlaneName = "local"
+)
+
+// LongDescriptionBlurb is a blurb that's included in all of the command descriptions for 'lock'.
+// The newlines are intentional, don't make changes without looking at the formatting.
+const LongDescriptionBlurb = `
+
+Locks protect a scope, which is a plane, a resource group or a resource, and all the resources below it from
+accidental changes. A 'CanNotDelete' lock prevents the resources from being deleted. A 'ReadOnly' lock also
+prevents them from being created or updated.
+
+Deleting a scope is rejected while any lock applies to the scope or to one of the resources below it. The lock must
+be deleted first.`
+
+// Lock is the table view of a lock.
+type Lock struct {
+	Name  string
+	Level string
+	Scope string
+	Notes string
+}
+
+// NewLock creates the table view of a lock.
+func NewLock(resource *v20231001preview.LockResource) Lock {
+	view := Lock{Name: to.String(resource.Name)}
+	if resource.Properties != nil {
+		if resource.Properties.Level != nil {
+			view.Level = string(*resource.Properties.Level)
+		}
+		view.Scope = to.String(resource.Properties.Scope)
+		view.Notes = to.String(resource.Properties.Notes)
+	}
+
+	return view
+}
+
+// LockFormat returns the table format of Lock.
+func LockFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "LEVEL",
+				JSONPath: "{ .Level }",
+			},
+			{
+				Heading:  "SCOPE",
+				JSONPath: "{ .Scope }",
+			},
+			{
+				Heading:  "NOTES",
+				JSONPath: "{ .Notes }",
+			},
+		},
+	}
+}
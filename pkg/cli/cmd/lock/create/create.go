@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create or update a lock",
+		Long:  "Create or update a lock." + common.LongDescriptionBlurb,
+		Example: `
+# Prevent the resource group of the current workspace from being deleted
+rad lock create production
+
+# Prevent an environment from being modified or deleted
+rad lock create prod-env --level ReadOnly --scope /planes/radius/local/resourceGroups/production/providers/Applications.Core/environments/prod --notes "Frozen for the release"
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("level", string(v20231001preview.LockLevelCanNotDelete), "The level of the lock, either 'CanNotDelete' or 'ReadOnly'")
+	cmd.Flags().String("scope", "", "The plane, resource group or resource the lock applies to. Defaults to the scope of the workspace")
+	cmd.Flags().String("notes", "", "Notes describing why the lock was created")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Name              string
+	Level             v20231001preview.LockLevel
+	Scope             string
+	Notes             string
+}
+
+// NewRunner creates a new instance of the `rad lock create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	level, err := cmd.Flags().GetString("level")
+	if err != nil {
+		return err
+	}
+
+	r.Level = ""
+	for _, possible := range v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(level, string(possible)) {
+			r.Level = possible
+		}
+	}
+	if r.Level == "" {
+		return clierrors.Message("The lock level %q is not valid. Specify one of %q or %q with '--level'.", level, v20231001preview.LockLevelCanNotDelete, v20231001preview.LockLevelReadOnly)
+	}
+
+	r.Scope, err = cmd.Flags().GetString("scope")
+	if err != nil {
+		return err
+	}
+	if r.Scope == "" {
+		r.Scope = workspace.Scope
+	}
+	if r.Scope == "" {
+		return clierrors.Message("The workspace does not have a scope. Specify the scope of the lock with '--scope'.")
+	}
+
+	r.Notes, err = cmd.Flags().GetString("notes")
+	if err != nil {
+		return err
+	}
+
+	r.Name = args[0]
+	return nil
+}
+
+// Run runs the `rad lock create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateLockClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource := &v20231001preview.LockResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.LockProperties{
+			Level: to.Ptr(r.Level),
+			Scope: to.Ptr(r.Scope),
+		},
+	}
+	if r.Notes != "" {
+		resource.Properties.Notes = to.Ptr(r.Notes)
+	}
+
+	err = client.CreateOrUpdateLock(ctx, common.PlaneName, r.Name, resource)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock %q created.", r.Name)
+	return nil
+}
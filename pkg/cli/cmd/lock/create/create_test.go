@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command in the workspace scope",
+			Input:         []string{"production"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "production", r.Name)
+				require.Equal(t, v20231001preview.LockLevelCanNotDelete, r.Level)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+				require.Empty(t, r.Notes)
+			},
+		},
+		{
+			Name:          "Create Command with level, scope and notes",
+			Input:         []string{"prod-env", "--level", "readonly", "--scope", "/planes/radius/local/resourceGroups/production/providers/Applications.Core/environments/prod", "--notes", "Frozen"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, v20231001preview.LockLevelReadOnly, r.Level)
+				require.Equal(t, "/planes/radius/local/resourceGroups/production/providers/Applications.Core/environments/prod", r.Scope)
+				require.Equal(t, "Frozen", r.Notes)
+			},
+		},
+		{
+			Name:          "Create Command with invalid level",
+			Input:         []string{"production", "--level", "NoWrite"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Create Command without name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockLockClient(ctrl)
+	client.EXPECT().
+		CreateOrUpdateLock(gomock.Any(), "local", "production", &v20231001preview.LockResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.LockProperties{
+				Level: to.Ptr(v20231001preview.LockLevelCanNotDelete),
+				Scope: to.Ptr("/planes/radius/local/resourceGroups/production"),
+				Notes: to.Ptr("Protects the production environment."),
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{LockClient: client},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		Name:              "production",
+		Level:             v20231001preview.LockLevelCanNotDelete,
+		Scope:             "/planes/radius/local/resourceGroups/production",
+		Notes:             "Protects the production environment.",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		output.LogOutput{Format: "Lock %q created.", Params: []any{"production"}},
+	}, outputSink.Writes)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+const (
+	deleteConfirmationMsg = "Are you sure you want to delete lock '%s'?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock delete` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a lock",
+		Long:  "Delete a lock." + common.LongDescriptionBlurb,
+		Example: `
+# Delete a lock
+rad lock delete production
+
+# Delete a lock and bypass confirmation prompt
+rad lock delete production --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock delete` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Name              string
+	Confirm           bool
+}
+
+// NewRunner creates a new instance of the `rad lock delete` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad lock delete` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.Name = args[0]
+	return nil
+}
+
+// Run runs the `rad lock delete` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(deleteConfirmationMsg, r.Name), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateLockClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := client.DeleteLock(ctx, common.PlaneName, r.Name)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		r.Output.LogInfo("Lock %q deleted.", r.Name)
+	} else {
+		r.Output.LogInfo("Lock %q does not exist or has already been deleted.", r.Name)
+	}
+	return nil
+}
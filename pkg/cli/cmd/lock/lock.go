@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	lock_create "github.com/radius-project/radius/pkg/cli/cmd/lock/create"
+	lock_delete "github.com/radius-project/radius/pkg/cli/cmd/lock/delete"
+	lock_list "github.com/radius-project/radius/pkg/cli/cmd/lock/list"
+	"github.com/radius-project/radius/pkg/cli/framework"
+)
+
+// NewCommand creates an instance of the command for the `rad lock` command.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Manage locks that protect resources from being modified or deleted.",
+		Long:  "Manage locks that protect resources from being modified or deleted." + common.LongDescriptionBlurb,
+		Example: `
+# Prevent the resource group of the current workspace from being deleted
+rad lock create production --level CanNotDelete
+
+# List locks
+rad lock list
+
+# Delete a lock
+rad lock delete production
+`,
+	}
+
+	create, _ := lock_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	list, _ := lock_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	del, _ := lock_delete.NewCommand(factory)
+	cmd.AddCommand(del)
+
+	return cmd
+}
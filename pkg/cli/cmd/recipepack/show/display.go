@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"sigs.k8s.io/yaml"
@@ -60,6 +61,18 @@ func (r *Runner) display(recipePack v20250801preview.RecipePackResource) error {
 		r.Output.LogInfo("   Kind: %s", kind)
 		r.Output.LogInfo("   Source: %s", source)
 
+		if lock := definition.Lock; lock != nil && lock.Source != nil {
+			r.Output.LogInfo("   Locked: %s", *lock.Source)
+			if lock.Version != nil {
+				r.Output.LogInfo("   Version: %s", *lock.Version)
+			}
+			if lock.ResolvedAt != nil {
+				r.Output.LogInfo("   Resolved: %s", lock.ResolvedAt.UTC().Format(time.RFC3339))
+			}
+		} else if definition.LockError != nil {
+			r.Output.LogInfo("   Not locked: %s", *definition.LockError)
+		}
+
 		if len(definition.Parameters) > 0 {
 			formatted, err := formatRecipeParameters(definition.Parameters)
 			if err != nil {
@@ -18,6 +18,7 @@ package show
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -178,4 +179,52 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, "table", firstWrite.Format)
 		require.Equal(t, recipePack, firstWrite.Obj)
 	})
+	t.Run("table format with lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		lockedRecipePack := corerpv20250801preview.RecipePackResource{
+			Name: new("sample-pack"),
+			Properties: &corerpv20250801preview.RecipePackProperties{
+				Recipes: map[string]*corerpv20250801preview.RecipeDefinition{
+					"Radius.Core/example": {
+						Kind:   to.Ptr(corerpv20250801preview.RecipeKindBicep),
+						Source: new("ghcr.io/radius-project/recipes/example:latest"),
+						Lock: &corerpv20250801preview.RecipeLock{
+							Source:     new("ghcr.io/radius-project/recipes/example@sha256:abc"),
+							Revision:   new("sha256:abc"),
+							ResolvedAt: new(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+					"Radius.Core/unlocked": {
+						Kind:      to.Ptr(corerpv20250801preview.RecipeKindBicep),
+						Source:    new("private.example.com/recipes/example:latest"),
+						LockError: new("unauthorized"),
+					},
+				},
+			},
+		}
+
+		appMgmtClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appMgmtClient.EXPECT().
+			GetRecipePack(gomock.Any(), "sample-pack").
+			Return(lockedRecipePack, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appMgmtClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			RecipePackName:    "sample-pack",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "   Locked: %s", Params: []any{"ghcr.io/radius-project/recipes/example@sha256:abc"}})
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "   Resolved: %s", Params: []any{"2024-01-01T00:00:00Z"}})
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "   Not locked: %s", Params: []any{"unauthorized"}})
+	})
 }
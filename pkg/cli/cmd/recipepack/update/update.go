@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	statusUpdated         = "Updated"
+	statusUpdateAvailable = "Update available"
+	statusUpToDate        = "Up to date"
+)
+
+// NewCommand creates a new Cobra command for updating the locks of the recipes in a recipe pack.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update the locked recipe versions of a recipe pack",
+		Long: `Update the locked recipe versions of a recipe pack.
+
+When a recipe pack is created, the source of each recipe is resolved to an immutable reference: the digest of a Bicep
+recipe's OCI artifact, the commit of a Terraform module in a Git repository, or the version of a Terraform registry
+module. Recipes are deployed from this lock, so that a moved tag or branch doesn't change what gets deployed.
+
+This command resolves the sources of the recipes again and updates the locks to the references they currently point to.
+Use --check to report the available updates without applying them.`,
+		Args: cobra.ExactArgs(1),
+		Example: `
+# Update all recipes of a recipe pack to the latest versions of their sources
+rad recipe-pack update my-recipe-pack
+
+# Report the recipes of a recipe pack that have newer versions without updating them
+rad recipe-pack update my-recipe-pack --check
+
+# Update only the recipe for a resource type
+rad recipe-pack update my-recipe-pack --resource-type Radius.Data/redisCaches
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("check", false, "Report the available updates without updating the recipe pack")
+	cmd.Flags().StringSlice("resource-type", []string{}, "Only update the recipes for the specified resource types. Accepts comma-separated values.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe-pack update` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Workspace         *workspaces.Workspace
+	Output            output.Interface
+	Format            string
+	RecipePackName    string
+	ResourceTypes     []string
+	Check             bool
+}
+
+// recipeLockUpdate is the row displayed for each recipe of the recipe pack.
+type recipeLockUpdate struct {
+	ResourceType string
+	Locked       string
+	Latest       string
+	Status       string
+}
+
+// NewRunner creates a new instance of the `rad recipe-pack update` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe-pack update` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	recipePackName, err := cli.RequireRecipePackNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipePackName = recipePackName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.Check, err = cmd.Flags().GetBool("check")
+	if err != nil {
+		return err
+	}
+
+	r.ResourceTypes, err = cmd.Flags().GetStringSlice("resource-type")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe-pack update` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	recipePack, err := client.GetRecipePack(ctx, r.RecipePackName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The recipe pack %q was not found or has been deleted.", r.RecipePackName)
+	} else if err != nil {
+		return err
+	}
+
+	lockClient, err := r.ConnectionFactory.CreateRecipePackLockClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	result, err := lockClient.UpdateLocks(ctx, *recipePack.ID, recipes.RecipeLockUpdateRequest{
+		ResourceTypes: r.ResourceTypes,
+		DryRun:        r.Check,
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, result, objectformats.GetRecipeLockUpdateTableFormat())
+	}
+
+	if len(result.Recipes) == 0 {
+		r.Output.LogInfo("The recipe pack %q has no recipes.", r.RecipePackName)
+		return nil
+	}
+
+	rows := []recipeLockUpdate{}
+	for _, update := range result.Recipes {
+		rows = append(rows, recipeLockUpdate{
+			ResourceType: update.ResourceType,
+			Locked:       update.Locked,
+			Latest:       update.Latest,
+			Status:       status(update),
+		})
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, rows, objectformats.GetRecipeLockUpdateTableFormat())
+}
+
+// status returns the status of the lock of a recipe for display.
+func status(update recipes.RecipeLockUpdate) string {
+	switch {
+	case update.Error != "":
+		return "Error: " + update.Error
+	case update.Updated:
+		return statusUpdated
+	case update.UpdateAvailable:
+		return statusUpdateAvailable
+	default:
+		return statusUpToDate
+	}
+}
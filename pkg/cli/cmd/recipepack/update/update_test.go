@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const testRecipePackID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/recipePacks/sample-pack"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "missing recipe pack name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "invalid workspace reference",
+			Input:         []string{"sample-pack", "-w", "doesnotexist"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid",
+			Input:         []string{"sample-pack"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid with check and resource types",
+			Input:         []string{"sample-pack", "--check", "--resource-type", "Radius.Data/redisCaches,Radius.Data/mySqlDatabases"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.Check)
+				require.Equal(t, []string{"Radius.Data/redisCaches", "Radius.Data/mySqlDatabases"}, r.ResourceTypes)
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	recipePack := corerpv20250801preview.RecipePackResource{
+		ID:   new(testRecipePackID),
+		Name: new("sample-pack"),
+	}
+
+	updates := &recipes.RecipeLockUpdates{
+		Recipes: []recipes.RecipeLockUpdate{
+			{
+				ResourceType: "Radius.Data/mongoDatabases",
+				Source:       "oci://example.com/charts/mongo:1.0.0",
+				Error:        "source cannot be pinned",
+			},
+			{
+				ResourceType: "Radius.Data/mySqlDatabases",
+				Source:       "example/mysql/kubernetes",
+				Locked:       "1.0.0",
+				Latest:       "1.0.0",
+			},
+			{
+				ResourceType:    "Radius.Data/redisCaches",
+				Source:          "example.com/recipes/redis:latest",
+				Locked:          "sha256:abc",
+				Latest:          "sha256:def",
+				UpdateAvailable: true,
+				Updated:         true,
+			},
+		},
+	}
+
+	setup := func(t *testing.T, format string, check bool) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appMgmtClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appMgmtClient.EXPECT().
+			GetRecipePack(gomock.Any(), "sample-pack").
+			Return(recipePack, nil).
+			Times(1)
+
+		lockClient := clients.NewMockRecipePackLockClient(ctrl)
+		lockClient.EXPECT().
+			UpdateLocks(gomock.Any(), testRecipePackID, recipes.RecipeLockUpdateRequest{ResourceTypes: []string{}, DryRun: check}).
+			Return(updates, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appMgmtClient, RecipePackLockClient: lockClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			Format:            format,
+			RecipePackName:    "sample-pack",
+			ResourceTypes:     []string{},
+			Check:             check,
+		}, outputSink
+	}
+
+	t.Run("table format", func(t *testing.T) {
+		runner, outputSink := setup(t, "table", false)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []recipeLockUpdate{
+					{ResourceType: "Radius.Data/mongoDatabases", Status: "Error: source cannot be pinned"},
+					{ResourceType: "Radius.Data/mySqlDatabases", Locked: "1.0.0", Latest: "1.0.0", Status: "Up to date"},
+					{ResourceType: "Radius.Data/redisCaches", Locked: "sha256:abc", Latest: "sha256:def", Status: "Updated"},
+				},
+				Options: objectformats.GetRecipeLockUpdateTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		runner, outputSink := setup(t, "json", true)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     updates,
+				Options: objectformats.GetRecipeLockUpdateTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("recipe pack not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appMgmtClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appMgmtClient.EXPECT().
+			GetRecipePack(gomock.Any(), "sample-pack").
+			Return(corerpv20250801preview.RecipePackResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appMgmtClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            &output.MockOutput{},
+			RecipePackName:    "sample-pack",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The recipe pack %q was not found or has been deleted.", "sample-pack"), err)
+	})
+}
+
+func Test_Status(t *testing.T) {
+	require.Equal(t, "Error: failed", status(recipes.RecipeLockUpdate{Error: "failed", UpdateAvailable: true}))
+	require.Equal(t, "Updated", status(recipes.RecipeLockUpdate{UpdateAvailable: true, Updated: true}))
+	require.Equal(t, "Update available", status(recipes.RecipeLockUpdate{UpdateAvailable: true}))
+	require.Equal(t, "Up to date", status(recipes.RecipeLockUpdate{}))
+}
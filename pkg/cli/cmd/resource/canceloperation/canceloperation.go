@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canceloperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource cancel-operation` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel-operation [resourceType] [operationId]",
+		Short: "Cancel a running operation on a Radius resource",
+		Long: `Cancel a running operation on a Radius resource.
+
+Requests the cancellation of a long-running operation, such as a stuck recipe deployment. The operation is canceled asynchronously by the Radius control plane and its status becomes 'Canceled'. Operations that have already completed cannot be canceled.
+
+The operation ID is the last segment of the Azure-AsyncOperation or Location URL returned when the operation was started.`,
+		Example: `
+# Cancel an operation on a container
+rad resource cancel-operation Applications.Core/containers 2f4ae8bd-7f24-4ac8-9a7b-f1a0bd4f0a62`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource cancel-operation` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ResourceProviderNamespace string
+	OperationID               string
+}
+
+// NewRunner creates a new instance of the `rad resource cancel-operation` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource cancel-operation` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, _, err := cli.RequireFullyQualifiedResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceProviderNamespace = resourceProviderName
+
+	if _, err := uuid.Parse(args[1]); err != nil {
+		return clierrors.Message("'%s' is not a valid operation ID. The operation ID is the last segment of the Azure-AsyncOperation or Location URL of the operation.", args[1])
+	}
+	r.OperationID = args[1]
+
+	return nil
+}
+
+// Run runs the `rad resource cancel-operation` command.
+func (r *Runner) Run(ctx context.Context) error {
+	operationStatusID, err := r.operationStatusID()
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateOperationClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	status, err := client.CancelOperation(ctx, operationStatusID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found.", r.OperationID)
+	}
+
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) && responseError.StatusCode == http.StatusConflict {
+		return clierrors.Message("The operation %q has already completed and cannot be canceled.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Requested the cancellation of operation %s (status: %s). The operation will be marked as %s once it stops.", r.OperationID, status.Status, v1.ProvisioningStateCanceled)
+	return nil
+}
+
+// operationStatusID returns the ID of the operation status. Operation statuses are stored under the plane scope of
+// the resource provider.
+func (r *Runner) operationStatusID() (string, error) {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", scope.PlaneScope(), r.ResourceProviderNamespace, v1.LocationGlobal, r.OperationID), nil
+}
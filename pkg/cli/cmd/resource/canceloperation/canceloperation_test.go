@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canceloperation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testOperationID       = "2f4ae8bd-7f24-4ac8-9a7b-f1a0bd4f0a62"
+	testOperationStatusID = "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/" + testOperationID
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid cancel-operation command",
+			Input:         []string{"Applications.Core/containers", testOperationID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Core", r.ResourceProviderNamespace)
+				require.Equal(t, testOperationID, r.OperationID)
+			},
+		},
+		{
+			Name:          "cancel-operation command with invalid resource type",
+			Input:         []string{"containers", testOperationID},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "cancel-operation command with invalid operation ID",
+			Input:         []string{"Applications.Core/containers", "not-an-operation"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "cancel-operation command with insufficient args",
+			Input:         []string{"Applications.Core/containers"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(client clients.OperationClient, outputSink *output.MockOutput) *Runner {
+		return &Runner{
+			ConnectionFactory:         &connections.MockFactory{OperationClient: client},
+			Output:                    outputSink,
+			Workspace:                 &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceProviderNamespace: "Applications.Core",
+			OperationID:               testOperationID,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			CancelOperation(gomock.Any(), testOperationStatusID).
+			Return(&v1.AsyncOperationStatus{Name: testOperationID, Status: v1.ProvisioningStateUpdating}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(client, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Requested the cancellation of operation %s (status: %s). The operation will be marked as %s once it stops.",
+				Params: []any{testOperationID, v1.ProvisioningStateUpdating, v1.ProvisioningStateCanceled},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			CancelOperation(gomock.Any(), testOperationStatusID).
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		err := newRunner(client, &output.MockOutput{}).Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q was not found.", testOperationID), err)
+	})
+
+	t.Run("Already completed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			CancelOperation(gomock.Any(), testOperationStatusID).
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusConflict}).
+			Times(1)
+
+		err := newRunner(client, &output.MockOutput{}).Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q has already completed and cannot be canceled.", testOperationID), err)
+	})
+}
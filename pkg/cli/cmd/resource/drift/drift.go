@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource drift` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "drift [resourceType] [resourceName]",
+		Short: "Show the drift of a resource deployed by a recipe",
+		Long: `Show the drift of a resource deployed by a recipe.
+
+Radius periodically plans the recipe of each resource deployed by a recipe and compares the result with the output resources it deployed. Changes made to the output resources outside of Radius, such as edits in a cloud console, are reported as drift.
+
+Drift detection must be enabled in the Radius control plane. The result of the most recent check is shown.`,
+		Example: `
+# Show the drift of a Redis cache
+rad resource drift Applications.Datastores/redisCaches cache
+
+# Show the drift of a resource of a user-defined type in JSON format
+rad resource drift Radius.Data/postgreSqlDatabases db --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource drift` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource drift` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource drift` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource drift` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	} else if err != nil {
+		return err
+	}
+
+	status, err := driftStatus(resource.Properties)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return clierrors.Message("The resource %q has not been checked for drift. Drift is only checked for resources deployed by a recipe when drift detection is enabled.", r.ResourceName)
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, status, output.FormatterOptions{})
+	}
+
+	r.Output.LogInfo("Drift state of %s %q: %s (last checked %s)", r.FullyQualifiedResourceTypeName, r.ResourceName, status.State, status.LastCheckedTime.Format(time.RFC3339))
+	if status.Message != "" {
+		r.Output.LogInfo("%s", status.Message)
+	}
+
+	if len(status.Resources) == 0 {
+		return nil
+	}
+
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(r.Format, status.Resources, objectformats.GetDriftedResourceTableFormat())
+}
+
+// driftStatus returns the drift status stored in the resource status, or nil if the resource has not been checked.
+func driftStatus(properties map[string]any) (*rpv1.DriftStatus, error) {
+	resourceStatus, ok := properties["status"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	drift, ok := resourceStatus["drift"]
+	if !ok || drift == nil {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(drift)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &rpv1.DriftStatus{}
+	if err := json.Unmarshal(bs, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
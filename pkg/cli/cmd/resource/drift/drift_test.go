@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testResourceType = "Applications.Datastores/redisCaches"
+	testResourceName = "cache"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid drift command",
+			Input:         []string{testResourceType, testResourceName},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, testResourceType, r.FullyQualifiedResourceTypeName)
+				require.Equal(t, testResourceName, r.ResourceName)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Drift command with invalid resource type",
+			Input:         []string{"redisCaches", testResourceName},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift command with insufficient args",
+			Input:         []string{testResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	lastChecked := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newRunner := func(t *testing.T, properties map[string]any, format string) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource(testResourceType, testResourceName)
+		resource.Properties = properties
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(resource, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Format:                         format,
+		}, outputSink
+	}
+
+	t.Run("Drifted", func(t *testing.T) {
+		runner, outputSink := newRunner(t, map[string]any{
+			"status": map[string]any{
+				"drift": map[string]any{
+					"state":           "Drifted",
+					"lastCheckedTime": "2024-01-02T03:04:05Z",
+					"resources": []any{
+						map[string]any{"action": "Update", "resourceType": "Microsoft.Cache/redis", "name": "cache"},
+					},
+				},
+			},
+		}, "table")
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Drift state of %s %q: %s (last checked %s)",
+				Params: []any{testResourceType, testResourceName, rpv1.DriftStateDrifted, "2024-01-02T03:04:05Z"},
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []rpv1.DriftedResource{
+					{Action: "Update", ResourceType: "Microsoft.Cache/redis", Name: "cache"},
+				},
+				Options: objectformats.GetDriftedResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("In sync (JSON)", func(t *testing.T) {
+		runner, outputSink := newRunner(t, map[string]any{
+			"status": map[string]any{
+				"drift": map[string]any{
+					"state":           "InSync",
+					"lastCheckedTime": "2024-01-02T03:04:05Z",
+				},
+			},
+		}, "json")
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     &rpv1.DriftStatus{State: rpv1.DriftStateInSync, LastCheckedTime: lastChecked},
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not checked", func(t *testing.T) {
+		runner, outputSink := newRunner(t, map[string]any{"status": map[string]any{}}, "table")
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q has not been checked for drift. Drift is only checked for resources deployed by a recipe when drift detection is enabled.", testResourceName), err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource logs` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "logs [resourceType] [operationId] --recipe",
+		Short: "Show the recipe logs of an operation on a Radius resource",
+		Long: `Show the recipe logs of an operation on a Radius resource.
+
+Shows the logs captured while the operation executed recipes, such as the output of terraform init, plan and apply, and the operations of Bicep deployments. The logs are available once the operation completes, whether it succeeded or failed. The oldest entries are dropped when the logs exceed their size limit.
+
+The operation ID is the last segment of the Azure-AsyncOperation or Location URL returned when the operation was started.`,
+		Example: `
+# Show the recipe logs of an operation on a Redis cache
+rad resource logs Applications.Datastores/redisCaches 2f4ae8bd-7f24-4ac8-9a7b-f1a0bd4f0a62 --recipe
+
+# Show the recipe logs of an operation in JSON format
+rad resource logs Applications.Datastores/redisCaches 2f4ae8bd-7f24-4ac8-9a7b-f1a0bd4f0a62 --recipe --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("recipe", false, "Show the logs of the recipes executed by the operation (required)")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource logs` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ResourceProviderNamespace string
+	OperationID               string
+	Format                    string
+}
+
+// NewRunner creates a new instance of the `rad resource logs` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource logs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	// Recipe logs are the only logs captured for operations.
+	recipe, err := cmd.Flags().GetBool("recipe")
+	if err != nil {
+		return err
+	}
+	if !recipe {
+		return clierrors.Message("The --recipe flag is required. Only the logs of the recipes executed by an operation are available.")
+	}
+
+	resourceProviderName, _, err := cli.RequireFullyQualifiedResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceProviderNamespace = resourceProviderName
+
+	if _, err := uuid.Parse(args[1]); err != nil {
+		return clierrors.Message("'%s' is not a valid operation ID. The operation ID is the last segment of the Azure-AsyncOperation or Location URL of the operation.", args[1])
+	}
+	r.OperationID = args[1]
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource logs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	operationStatusID, err := r.operationStatusID()
+	if err != nil {
+		return err
+	}
+
+	client, err := r.ConnectionFactory.CreateOperationClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	logs, err := client.GetOperationLogs(ctx, operationStatusID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, logs, output.FormatterOptions{})
+	}
+
+	if len(logs.Value) == 0 && logs.DroppedEntries == 0 {
+		r.Output.LogInfo("No recipe logs were captured for operation %s. Logs are available once the operation completes.", r.OperationID)
+		return nil
+	}
+
+	if logs.DroppedEntries > 0 {
+		r.Output.LogInfo("(%d earlier entries were dropped to keep the logs within their size limit)", logs.DroppedEntries)
+	}
+
+	for _, entry := range logs.Value {
+		r.Output.LogInfo("%s", formatEntry(entry))
+	}
+
+	return nil
+}
+
+// operationStatusID returns the ID of the operation status. Operation statuses are stored under the plane scope of
+// the resource provider.
+func (r *Runner) operationStatusID() (string, error) {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", scope.PlaneScope(), r.ResourceProviderNamespace, v1.LocationGlobal, r.OperationID), nil
+}
+
+// formatEntry formats a log entry as a single line, marking the entries logged as errors.
+func formatEntry(entry v1.AsyncOperationLogEntry) string {
+	line := fmt.Sprintf("%s [%s] ", entry.Time.UTC().Format(time.RFC3339), entry.Source)
+	if entry.Level == v1.LogLevelError {
+		line += "ERROR "
+	}
+	return line + entry.Message
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testOperationID       = "2f4ae8bd-7f24-4ac8-9a7b-f1a0bd4f0a62"
+	testOperationStatusID = "/planes/radius/local/providers/Applications.Datastores/locations/global/operationStatuses/" + testOperationID
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid logs command",
+			Input:         []string{"Applications.Datastores/redisCaches", testOperationID, "--recipe"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores", r.ResourceProviderNamespace)
+				require.Equal(t, testOperationID, r.OperationID)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Valid logs command with JSON output",
+			Input:         []string{"Applications.Datastores/redisCaches", testOperationID, "--recipe", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "json", r.Format)
+			},
+		},
+		{
+			Name:          "logs command without --recipe",
+			Input:         []string{"Applications.Datastores/redisCaches", testOperationID},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "logs command with invalid resource type",
+			Input:         []string{"redisCaches", testOperationID, "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "logs command with invalid operation ID",
+			Input:         []string{"Applications.Datastores/redisCaches", "not-an-operation", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "logs command with insufficient args",
+			Input:         []string{"Applications.Datastores/redisCaches", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(client clients.OperationClient, outputSink *output.MockOutput, format string) *Runner {
+		return &Runner{
+			ConnectionFactory:         &connections.MockFactory{OperationClient: client},
+			Output:                    outputSink,
+			Workspace:                 &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceProviderNamespace: "Applications.Datastores",
+			OperationID:               testOperationID,
+			Format:                    format,
+		}
+	}
+
+	timestamp := time.Date(2026, 10, 18, 14, 0, 0, 0, time.UTC)
+	logs := &v1.AsyncOperationLogs{
+		Value: []v1.AsyncOperationLogEntry{
+			{Time: timestamp, Source: "terraform", Level: v1.LogLevelInfo, Message: "Initializing the backend..."},
+			{Time: timestamp, Source: "terraform", Level: v1.LogLevelError, Message: "Error: Invalid provider configuration"},
+		},
+		DroppedEntries: 3,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), testOperationStatusID).
+			Return(logs, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(client, outputSink, output.FormatTable).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "(%d earlier entries were dropped to keep the logs within their size limit)",
+				Params: []any{3},
+			},
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"2026-10-18T14:00:00Z [terraform] Initializing the backend..."},
+			},
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"2026-10-18T14:00:00Z [terraform] ERROR Error: Invalid provider configuration"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), testOperationStatusID).
+			Return(logs, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(client, outputSink, output.FormatJson).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     logs,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("No logs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), testOperationStatusID).
+			Return(&v1.AsyncOperationLogs{Value: []v1.AsyncOperationLogEntry{}}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(client, outputSink, output.FormatTable).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No recipe logs were captured for operation %s. Logs are available once the operation completes.",
+				Params: []any{testOperationID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockOperationClient(ctrl)
+		client.EXPECT().
+			GetOperationLogs(gomock.Any(), testOperationStatusID).
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		err := newRunner(client, &output.MockOutput{}, output.FormatTable).Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q was not found.", testOperationID), err)
+	})
+}
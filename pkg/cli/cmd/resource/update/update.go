@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v4/pkg/strvals"
+)
+
+// NewCommand creates an instance of the `rad resource update` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "update [resource type] [name] --set [key=value]",
+		Short: "Update properties of an existing resource",
+		Long: `Update properties of an existing resource
+
+Only the properties passed with --set are changed, all other properties of the resource are left as they are.
+The changes are sent as a JSON merge patch and validated against the schema of the resource type.
+
+Keys are relative to the resource's properties. Use dots to address nested properties and set a property to null to remove it.`,
+		Example: `
+# Set a single property
+rad resource update 'MyCompany.Resources/postgreSQL' mydb --set size=L
+
+# Set nested properties
+rad resource update 'MyCompany.Resources/webServices' frontend --set container.image=nginx:latest --set container.port=8080
+
+# Remove a property
+rad resource update 'MyCompany.Resources/postgreSQL' mydb --set backup=null`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set property values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	_ = cmd.MarkFlagRequired("set")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource update` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Set                            []string
+	Patch                          map[string]any
+}
+
+// NewRunner creates an instance of the runner for the `rad resource update` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource update` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	r.Patch, err = parseSetValues(r.Set)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseSetValues converts --set arguments into a JSON merge patch for the resource's properties.
+func parseSetValues(set []string) (map[string]any, error) {
+	properties := map[string]any{}
+	for _, arg := range set {
+		if err := strvals.ParseInto(arg, properties); err != nil {
+			return nil, clierrors.Message("Invalid --set value %q: %v", arg, err)
+		}
+	}
+
+	if len(properties) == 0 {
+		return nil, clierrors.Message("At least one property must be specified with --set.")
+	}
+
+	return map[string]any{"properties": properties}, nil
+}
+
+// Run runs the `rad resource update` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.UpdateResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, r.Patch)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, response, objectformats.GetGenericResourceTableFormat())
+	}
+
+	r.Output.LogInfo("%s/%s updated", r.FullyQualifiedResourceTypeName, r.ResourceName)
+	return nil
+}
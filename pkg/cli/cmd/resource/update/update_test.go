@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: single value",
+			Input:         []string{"Applications.Test/exampleResources", "my-example", "--set", "size=L"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: multiple values",
+			Input:         []string{"Applications.Test/exampleResources", "my-example", "--set", "size=L", "--set", "container.image=nginx,replicas=3"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: missing --set",
+			Input:         []string{"Applications.Test/exampleResources", "my-example"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: malformed --set",
+			Input:         []string{"Applications.Test/exampleResources", "my-example", "--set", "size"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: resource type not fully qualified",
+			Input:         []string{"exampleResources", "my-example", "--set", "size=L"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"Applications.Test/exampleResources", "my-example", "extra", "--set", "size=L"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_parseSetValues(t *testing.T) {
+	patch, err := parseSetValues([]string{"size=L", "container.image=nginx,replicas=3", "backup=null"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"properties": map[string]any{
+			"size": "L",
+			"container": map[string]any{
+				"image": "nginx",
+			},
+			"replicas": int64(3),
+			"backup":   nil,
+		},
+	}, patch)
+}
+
+func Test_Run(t *testing.T) {
+	patch := map[string]any{
+		"properties": map[string]any{
+			"size": "L",
+		},
+	}
+
+	t.Run("Success: resource updated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			UpdateResource(gomock.Any(), "Applications.Test/exampleResources", "my-example", patch).
+			Return(generated.GenericResource{Properties: patch["properties"].(map[string]any)}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+			ResourceName:                   "my-example",
+			Patch:                          patch,
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{
+				Format: "%s/%s updated",
+				Params: []any{"Applications.Test/exampleResources", "my-example"},
+			},
+		}, outputSink.Writes)
+	})
+
+	t.Run("Error: resource not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			UpdateResource(gomock.Any(), "Applications.Test/exampleResources", "my-example", patch).
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         &output.MockOutput{},
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+			ResourceName:                   "my-example",
+			Patch:                          patch,
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q was not found.", "my-example", "Applications.Test/exampleResources"), err)
+	})
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource watch` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "watch [resourceType]",
+		Short: "Watch changes to Radius resources",
+		Long: `Watch changes to Radius resources.
+
+Prints the resources of the given type in the resource group, and then each change to them as it happens, until the command is interrupted. When no resource type is given, all the resources of the resource group are watched.
+
+Each event has a resume token. Pass the token of the last event received to --resume-token to continue watching from that event without printing the current resources again. Resume tokens are only valid for a limited time on the server that returned them. When an interrupted watch cannot be resumed, the current resources are printed again.`,
+		Example: `
+# Watch the containers in the default resource group
+rad resource watch Applications.Core/containers
+
+# Watch all the resources in a resource group
+rad resource watch --group my-group
+
+# Watch the containers as JSON, continuing from a previous watch
+rad resource watch Applications.Core/containers --output json --resume-token 5f2b8c0e1d3a4b6c.42`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().String("resume-token", "", "Continue watching after the event the resume token was returned with")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource watch` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResumeToken                    string
+	Format                         string
+}
+
+// NewRunner creates a new instance of the `rad resource watch` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource watch` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	if len(args) > 0 {
+		resourceProviderName, resourceTypeName, err := cli.RequireFullyQualifiedResourceType(args)
+		if err != nil {
+			return err
+		}
+		r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	}
+
+	r.ResumeToken, err = cmd.Flags().GetString("resume-token")
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource watch` command.
+func (r *Runner) Run(ctx context.Context) error {
+	path := r.Workspace.Scope + "/resources"
+	apiVersion := v20231001preview.Version
+	if r.FullyQualifiedResourceTypeName != "" {
+		var err error
+		apiVersion, err = r.resolveAPIVersion(ctx)
+		if err != nil {
+			return err
+		}
+		path = r.Workspace.Scope + "/providers/" + r.FullyQualifiedResourceTypeName
+	}
+
+	client, err := r.ConnectionFactory.CreateWatchClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.WatchResources(ctx, path, apiVersion, r.ResumeToken, r.writeEvent)
+	if clients.IsResumeTokenExpiredError(err) {
+		return clierrors.Message("The resume token %q has expired. Watch again without --resume-token to get the current resources.", r.ResumeToken)
+	} else if clients.Is404Error(err) {
+		return clierrors.Message("The resource group %q could not be found.", r.Workspace.Scope)
+	}
+
+	return err
+}
+
+// resolveAPIVersion returns the API version used to watch the resource type, which is the default API version of the
+// resource type or else its latest API version.
+func (r *Runner) resolveAPIVersion(ctx context.Context) (string, error) {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return "", err
+	}
+
+	resourceProviderName, resourceTypeName, _ := strings.Cut(r.FullyQualifiedResourceTypeName, "/")
+	summary, err := client.GetResourceProviderSummary(ctx, scope.FindScope(resources_radius.PlaneTypeRadius), resourceProviderName)
+	if clients.Is404Error(err) {
+		return "", clierrors.Message("The resource provider %q could not be found.", resourceProviderName)
+	} else if err != nil {
+		return "", err
+	}
+
+	resourceType, ok := summary.ResourceTypes[resourceTypeName]
+	if !ok || resourceType == nil || len(resourceType.APIVersions) == 0 {
+		return "", clierrors.Message("The resource type %q could not be found.", r.FullyQualifiedResourceTypeName)
+	}
+
+	if defaultAPIVersion := to.String(resourceType.DefaultAPIVersion); defaultAPIVersion != "" {
+		return defaultAPIVersion, nil
+	}
+
+	apiVersions := maps.Keys(resourceType.APIVersions)
+	slices.Sort(apiVersions)
+	return apiVersions[len(apiVersions)-1], nil
+}
+
+// writeEvent writes an event as it is received.
+func (r *Runner) writeEvent(event *v1.WatchEvent) error {
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, event, output.FormatterOptions{})
+	}
+
+	line := fmt.Sprintf("%-8s %s", event.Type, event.ID)
+	if state := provisioningState(event.Resource); state != "" {
+		line += " (" + state + ")"
+	}
+	r.Output.LogInfo("%s", line)
+	return nil
+}
+
+// provisioningState returns the provisioning state of a watched resource, if it has one.
+func provisioningState(resource any) string {
+	obj, ok := resource.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	properties, ok := obj["properties"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	state, _ := properties["provisioningState"].(string)
+	return state
+}
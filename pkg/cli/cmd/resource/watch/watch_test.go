@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testResourceType = "Applications.Core/containers"
+	testScope        = "/planes/radius/local/resourceGroups/test-group"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid watch command with resource type",
+			Input:         []string{testResourceType, "--resume-token", "abc.1"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, testResourceType, r.FullyQualifiedResourceTypeName)
+				require.Equal(t, "abc.1", r.ResumeToken)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Valid watch command without resource type",
+			Input:         []string{"--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Empty(t, r.FullyQualifiedResourceTypeName)
+				require.Equal(t, "json", r.Format)
+			},
+		},
+		{
+			Name:          "Watch command with invalid resource type",
+			Input:         []string{"containers"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Watch command with too many args",
+			Input:         []string{testResourceType, "frontend"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	events := []*v1.WatchEvent{
+		{
+			Type:        v1.WatchEventTypeSaved,
+			ID:          testScope + "/providers/Applications.Core/containers/frontend",
+			Resource:    map[string]any{"properties": map[string]any{"provisioningState": "Succeeded"}},
+			ResumeToken: "abc.1",
+		},
+		{
+			Type:        v1.WatchEventTypeDeleted,
+			ID:          testScope + "/providers/Applications.Core/containers/frontend",
+			ResumeToken: "abc.2",
+		},
+	}
+
+	watch := func(ctx context.Context, path string, apiVersion string, resumeToken string, handler clients.WatchHandler) error {
+		for _, event := range events {
+			if err := handler(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("Resource type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Applications.Core").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"containers": {
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2023-10-01-preview": {},
+							"2024-01-01":         {},
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+
+		watchClient := clients.NewMockWatchClient(ctrl)
+		watchClient.EXPECT().
+			WatchResources(gomock.Any(), testScope+"/providers/"+testResourceType, "2024-01-01", "", gomock.Any()).
+			DoAndReturn(watch).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient, WatchClient: watchClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{Scope: testScope},
+			FullyQualifiedResourceTypeName: testResourceType,
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"Saved    " + events[0].ID + " (Succeeded)"},
+			},
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"Deleted  " + events[1].ID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Default API version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Applications.Core").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"containers": {
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2023-10-01-preview": {},
+							"2024-01-01":         {},
+						},
+						DefaultAPIVersion: to.Ptr("2023-10-01-preview"),
+					},
+				},
+			}, nil).
+			Times(1)
+
+		watchClient := clients.NewMockWatchClient(ctrl)
+		watchClient.EXPECT().
+			WatchResources(gomock.Any(), testScope+"/providers/"+testResourceType, "2023-10-01-preview", "", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient, WatchClient: watchClient},
+			Output:                         &output.MockOutput{},
+			Workspace:                      &workspaces.Workspace{Scope: testScope},
+			FullyQualifiedResourceTypeName: testResourceType,
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Resource group (JSON)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		watchClient := clients.NewMockWatchClient(ctrl)
+		watchClient.EXPECT().
+			WatchResources(gomock.Any(), testScope+"/resources", v20231001preview.Version, "abc.0", gomock.Any()).
+			DoAndReturn(watch).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{WatchClient: watchClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: testScope},
+			ResumeToken:       "abc.0",
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{Format: "json", Obj: events[0], Options: output.FormatterOptions{}},
+			output.FormattedOutput{Format: "json", Obj: events[1], Options: output.FormatterOptions{}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Resume token expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		watchClient := clients.NewMockWatchClient(ctrl)
+		watchClient.EXPECT().
+			WatchResources(gomock.Any(), testScope+"/resources", v20231001preview.Version, "abc.0", gomock.Any()).
+			Return(&azcore.ResponseError{StatusCode: http.StatusGone, ErrorCode: v1.CodeResumeTokenExpired}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{WatchClient: watchClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Scope: testScope},
+			ResumeToken:       "abc.0",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resume token %q has expired. Watch again without --resume-token to get the current resources.", "abc.0"), err)
+	})
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assignment
+
+import (
+	"github.com/spf13/cobra"
+
+	assignment_create "github.com/radius-project/radius/pkg/cli/cmd/role/assignment/create"
+	assignment_delete "github.com/radius-project/radius/pkg/cli/cmd/role/assignment/delete"
+	assignment_list "github.com/radius-project/radius/pkg/cli/cmd/role/assignment/list"
+	"github.com/radius-project/radius/pkg/cli/cmd/role/common"
+	"github.com/radius-project/radius/pkg/cli/framework"
+)
+
+// NewCommand creates an instance of the command for the `rad role assignment` command.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "assignment",
+		Short: "Manage role assignments.",
+		Long:  "Manage role assignments." + common.LongDescriptionBlurb,
+		Example: `
+# Allow a user to read every resource in the resource group of the current workspace
+rad role assignment create alice-reader --role reader --user alice
+
+# List role assignments
+rad role assignment list
+`,
+	}
+
+	create, _ := assignment_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	list, _ := assignment_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	del, _ := assignment_delete.NewCommand(factory)
+	cmd.AddCommand(del)
+
+	return cmd
+}
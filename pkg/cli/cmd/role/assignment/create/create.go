@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/role/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad role assignment create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create or update a role assignment",
+		Long:  "Create or update a role assignment." + common.LongDescriptionBlurb,
+		Example: `
+# Allow a user to read every resource in the resource group of the current workspace
+rad role assignment create alice-reader --role reader --user alice
+
+# Allow a group to manage the resources of a resource group
+rad role assignment create team-a-contributor --role contributor --group team-a --scope /planes/radius/local/resourceGroups/team-a
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("role", "", "The name or resource ID of the role definition to assign")
+	cmd.Flags().String("user", "", "The user to assign the role to")
+	cmd.Flags().String("group", "", "The group to assign the role to")
+	cmd.Flags().String("scope", "", "The plane or resource group the role is assigned over. Defaults to the scope of the workspace")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad role assignment create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Name              string
+	RoleDefinitionID  string
+	PrincipalType     v20231001preview.PrincipalType
+	PrincipalName     string
+	Scope             string
+}
+
+// NewRunner creates a new instance of the `rad role assignment create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad role assignment create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	role, err := cmd.Flags().GetString("role")
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return clierrors.Message("The role definition must be specified with '--role'.")
+	}
+	r.RoleDefinitionID = common.RoleDefinitionID(role)
+
+	user, err := cmd.Flags().GetString("user")
+	if err != nil {
+		return err
+	}
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return err
+	}
+
+	if (user == "") == (group == "") {
+		return clierrors.Message("Exactly one of '--user' or '--group' must be specified.")
+	}
+
+	if user != "" {
+		r.PrincipalType = v20231001preview.PrincipalTypeUser
+		r.PrincipalName = user
+	} else {
+		r.PrincipalType = v20231001preview.PrincipalTypeGroup
+		r.PrincipalName = group
+	}
+
+	r.Scope, err = cmd.Flags().GetString("scope")
+	if err != nil {
+		return err
+	}
+	if r.Scope == "" {
+		r.Scope = workspace.Scope
+	}
+	if r.Scope == "" {
+		return clierrors.Message("The workspace does not have a scope. Specify the scope of the role assignment with '--scope'.")
+	}
+
+	r.Name = args[0]
+	return nil
+}
+
+// Run runs the `rad role assignment create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateRoleClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource := &v20231001preview.RoleAssignmentResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.RoleAssignmentProperties{
+			PrincipalName:    to.Ptr(r.PrincipalName),
+			PrincipalType:    to.Ptr(r.PrincipalType),
+			RoleDefinitionID: to.Ptr(r.RoleDefinitionID),
+			Scope:            to.Ptr(r.Scope),
+		},
+	}
+
+	err = client.CreateOrUpdateRoleAssignment(ctx, common.PlaneName, r.Name, resource)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Role assignment %q created.", r.Name)
+	return nil
+}
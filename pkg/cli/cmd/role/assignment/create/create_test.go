@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command for a user in the workspace scope",
+			Input:         []string{"alice-reader", "--role", "reader", "--user", "alice"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "alice-reader", r.Name)
+				require.Equal(t, "/planes/radius/local/providers/System.Authorization/roleDefinitions/reader", r.RoleDefinitionID)
+				require.Equal(t, v20231001preview.PrincipalTypeUser, r.PrincipalType)
+				require.Equal(t, "alice", r.PrincipalName)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+			},
+		},
+		{
+			Name:          "Create Command for a group with a scope",
+			Input:         []string{"team-a", "--role", "/planes/radius/local/providers/System.Authorization/roleDefinitions/contributor", "--group", "team-a", "--scope", "/planes/radius/local"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/providers/System.Authorization/roleDefinitions/contributor", r.RoleDefinitionID)
+				require.Equal(t, v20231001preview.PrincipalTypeGroup, r.PrincipalType)
+				require.Equal(t, "team-a", r.PrincipalName)
+				require.Equal(t, "/planes/radius/local", r.Scope)
+			},
+		},
+		{
+			Name:          "Create Command without role",
+			Input:         []string{"alice-reader", "--user", "alice"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Create Command without principal",
+			Input:         []string{"alice-reader", "--role", "reader"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Create Command with user and group",
+			Input:         []string{"alice-reader", "--role", "reader", "--user", "alice", "--group", "team-a"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockRoleClient(ctrl)
+	client.EXPECT().
+		CreateOrUpdateRoleAssignment(gomock.Any(), "local", "alice-reader", &v20231001preview.RoleAssignmentResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.RoleAssignmentProperties{
+				PrincipalName:    to.Ptr("alice"),
+				PrincipalType:    to.Ptr(v20231001preview.PrincipalTypeUser),
+				RoleDefinitionID: to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/reader"),
+				Scope:            to.Ptr("/planes/radius/local"),
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{RoleClient: client},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		Name:              "alice-reader",
+		RoleDefinitionID:  "/planes/radius/local/providers/System.Authorization/roleDefinitions/reader",
+		PrincipalType:     v20231001preview.PrincipalTypeUser,
+		PrincipalName:     "alice",
+		Scope:             "/planes/radius/local",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		output.LogOutput{Format: "Role assignment %q created.", Params: []any{"alice-reader"}},
+	}, outputSink.Writes)
+}
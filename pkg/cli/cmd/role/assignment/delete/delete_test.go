@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Delete Command",
+			Input:         []string{"alice-reader", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "alice-reader", runner.(*Runner).Name)
+				require.True(t, runner.(*Runner).Confirm)
+			},
+		},
+		{
+			Name:          "Delete Command without name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockRoleClient(ctrl)
+		client.EXPECT().DeleteRoleAssignment(gomock.Any(), "local", "alice-reader").Return(true, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{RoleClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Name:              "alice-reader",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{Format: "Role assignment %q deleted.", Params: []any{"alice-reader"}},
+		}, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockRoleClient(ctrl)
+		client.EXPECT().DeleteRoleAssignment(gomock.Any(), "local", "alice-reader").Return(false, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{RoleClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Name:              "alice-reader",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{Format: "Role assignment %q does not exist or has already been deleted.", Params: []any{"alice-reader"}},
+		}, outputSink.Writes)
+	})
+
+	t.Run("Declined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockRoleClient(ctrl)
+		promptMock := prompt.NewMockInterface(ctrl)
+		promptMock.EXPECT().
+			GetListInput(gomock.Any(), gomock.Any()).
+			Return(prompt.ConfirmNo, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{RoleClient: client},
+			InputPrompter:     promptMock,
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Name:              "alice-reader",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/role/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "List Command with too many args",
+			Input:         []string{"a"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	assignments := []*v20231001preview.RoleAssignmentResource{
+		{
+			Name: to.Ptr("team-a-contributor"),
+			Properties: &v20231001preview.RoleAssignmentProperties{
+				PrincipalName:    to.Ptr("team-a"),
+				PrincipalType:    to.Ptr(v20231001preview.PrincipalTypeGroup),
+				RoleDefinitionID: to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/contributor"),
+				Scope:            to.Ptr("/planes/radius/local/resourceGroups/team-a"),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockRoleClient(ctrl)
+	client.EXPECT().ListRoleAssignments(gomock.Any(), "local").Return(assignments, nil).Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{RoleClient: client},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		Format:            "table",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format: "table",
+			Obj: []common.RoleAssignment{
+				{
+					Name:          "team-a-contributor",
+					PrincipalType: "Group",
+					PrincipalName: "team-a",
+					Role:          "contributor",
+					Scope:         "/planes/radius/local/resourceGroups/team-a",
+				},
+			},
+			Options: common.RoleAssignmentFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// PlaneName is the name of the Radius plane that stores the role definitions and role assignments.
+	PlaneName = "local"
+
+	// RoleDefinitionsPath is the path of the role definitions collection of the Radius plane.
+	RoleDefinitionsPath = "/planes/radius/" + PlaneName + "/providers/System.Authorization/roleDefinitions/"
+)
+
+// LongDescriptionBlurb is a blurb that's included in all of the command descriptions for 'role'.
+// The newlines are intentional, don't make changes without looking at the formatting.
+const LongDescriptionBlurb = `
+
+Role definitions list the actions a role allows, for example 'Applications.Core/*' or '*/read'. Actions have the
+form '<resource type>/<read|write|delete|action>' and may contain '*' wildcards.
+
+Role assignments grant a role definition to a user or a group over a scope, which is a plane or a resource group.
+Users and groups are the identities reported by Kubernetes for the caller.
+
+Role-based authorization must be enabled in the configuration of the Radius control-plane for role assignments to
+take effect.`
+
+// RoleDefinitionID returns the resource ID of the role definition with the given name. Resource IDs are returned
+// unchanged.
+func RoleDefinitionID(role string) string {
+	if strings.HasPrefix(role, resources.SegmentSeparator) {
+		return role
+	}
+
+	return RoleDefinitionsPath + role
+}
+
+// RoleDefinition is the table view of a role definition.
+type RoleDefinition struct {
+	Name        string
+	Actions     string
+	NotActions  string
+	Description string
+}
+
+// NewRoleDefinition creates the table view of a role definition.
+func NewRoleDefinition(resource *v20231001preview.RoleDefinitionResource) RoleDefinition {
+	view := RoleDefinition{Name: to.String(resource.Name)}
+	if resource.Properties != nil {
+		view.Actions = strings.Join(to.StringArray(resource.Properties.Actions), ", ")
+		view.NotActions = strings.Join(to.StringArray(resource.Properties.NotActions), ", ")
+		view.Description = to.String(resource.Properties.Description)
+	}
+
+	return view
+}
+
+// RoleDefinitionFormat returns the table format of RoleDefinition.
+func RoleDefinitionFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "ACTIONS",
+				JSONPath: "{ .Actions }",
+			},
+			{
+				Heading:  "NOT ACTIONS",
+				JSONPath: "{ .NotActions }",
+			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
+// RoleAssignment is the table view of a role assignment.
+type RoleAssignment struct {
+	Name          string
+	PrincipalType string
+	PrincipalName string
+	Role          string
+	Scope         string
+}
+
+// NewRoleAssignment creates the table view of a role assignment. Role definitions of the Radius plane are shown by
+// name.
+func NewRoleAssignment(resource *v20231001preview.RoleAssignmentResource) RoleAssignment {
+	view := RoleAssignment{Name: to.String(resource.Name)}
+	if resource.Properties != nil {
+		view.PrincipalName = to.String(resource.Properties.PrincipalName)
+		if resource.Properties.PrincipalType != nil {
+			view.PrincipalType = string(*resource.Properties.PrincipalType)
+		}
+
+		view.Role = to.String(resource.Properties.RoleDefinitionID)
+		if name, ok := strings.CutPrefix(strings.ToLower(view.Role), strings.ToLower(RoleDefinitionsPath)); ok {
+			view.Role = view.Role[len(view.Role)-len(name):]
+		}
+		view.Scope = to.String(resource.Properties.Scope)
+	}
+
+	return view
+}
+
+// RoleAssignmentFormat returns the table format of RoleAssignment.
+func RoleAssignmentFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "PRINCIPAL TYPE",
+				JSONPath: "{ .PrincipalType }",
+			},
+			{
+				Heading:  "PRINCIPAL",
+				JSONPath: "{ .PrincipalName }",
+			},
+			{
+				Heading:  "ROLE",
+				JSONPath: "{ .Role }",
+			},
+			{
+				Heading:  "SCOPE",
+				JSONPath: "{ .Scope }",
+			},
+		},
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/role/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad role definition create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create or update a role definition",
+		Long:  "Create or update a role definition." + common.LongDescriptionBlurb,
+		Example: `
+# Create a role that can read every resource
+rad role definition create reader --actions '*/read'
+
+# Create a role that can manage applications but not role assignments
+rad role definition create app-contributor --actions 'Applications.*' --actions 'System.Resources/resourceGroups/read' --not-actions 'System.Authorization/*'
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringSlice("actions", nil, "The actions allowed by the role, for example 'Applications.Core/*'. May be repeated")
+	cmd.Flags().StringSlice("not-actions", nil, "The actions excluded from the allowed actions. May be repeated")
+	cmd.Flags().String("description", "", "The description of the role")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad role definition create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Name              string
+	Actions           []string
+	NotActions        []string
+	Description       string
+}
+
+// NewRunner creates a new instance of the `rad role definition create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad role definition create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Actions, err = cmd.Flags().GetStringSlice("actions")
+	if err != nil {
+		return err
+	}
+	if len(r.Actions) == 0 {
+		return clierrors.Message("At least one action must be specified with '--actions'.")
+	}
+
+	r.NotActions, err = cmd.Flags().GetStringSlice("not-actions")
+	if err != nil {
+		return err
+	}
+
+	r.Description, err = cmd.Flags().GetString("description")
+	if err != nil {
+		return err
+	}
+
+	r.Name = args[0]
+	return nil
+}
+
+// Run runs the `rad role definition create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateRoleClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource := &v20231001preview.RoleDefinitionResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.RoleDefinitionProperties{
+			Actions: to.SliceOfPtrs(r.Actions...),
+		},
+	}
+	if len(r.NotActions) > 0 {
+		resource.Properties.NotActions = to.SliceOfPtrs(r.NotActions...)
+	}
+	if r.Description != "" {
+		resource.Properties.Description = to.Ptr(r.Description)
+	}
+
+	err = client.CreateOrUpdateRoleDefinition(ctx, common.PlaneName, r.Name, resource)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Role definition %q created.", r.Name)
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command",
+			Input:         []string{"reader", "--actions", "*/read", "--not-actions", "System.Authorization/*", "--description", "Reads everything"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "reader", r.Name)
+				require.Equal(t, []string{"*/read"}, r.Actions)
+				require.Equal(t, []string{"System.Authorization/*"}, r.NotActions)
+				require.Equal(t, "Reads everything", r.Description)
+			},
+		},
+		{
+			Name:          "Create Command without actions",
+			Input:         []string{"reader"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Create Command without name",
+			Input:         []string{"--actions", "*/read"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockRoleClient(ctrl)
+	client.EXPECT().
+		CreateOrUpdateRoleDefinition(gomock.Any(), "local", "reader", &v20231001preview.RoleDefinitionResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.RoleDefinitionProperties{
+				Actions:     to.SliceOfPtrs("*/read"),
+				Description: to.Ptr("Reads everything"),
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{RoleClient: client},
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{},
+		Name:              "reader",
+		Actions:           []string{"*/read"},
+		Description:       "Reads everything",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		output.LogOutput{Format: "Role definition %q created.", Params: []any{"reader"}},
+	}, outputSink.Writes)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/role/common"
+	definition_create "github.com/radius-project/radius/pkg/cli/cmd/role/definition/create"
+	definition_delete "github.com/radius-project/radius/pkg/cli/cmd/role/definition/delete"
+	definition_list "github.com/radius-project/radius/pkg/cli/cmd/role/definition/list"
+	definition_show "github.com/radius-project/radius/pkg/cli/cmd/role/definition/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+)
+
+// NewCommand creates an instance of the command for the `rad role definition` command.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "definition",
+		Short: "Manage role definitions.",
+		Long:  "Manage role definitions." + common.LongDescriptionBlurb,
+		Example: `
+# Create a role that can read every resource
+rad role definition create reader --actions '*/read'
+
+# List role definitions
+rad role definition list
+`,
+	}
+
+	create, _ := definition_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	list, _ := definition_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := definition_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	del, _ := definition_delete.NewCommand(factory)
+	cmd.AddCommand(del)
+
+	return cmd
+}
@@ -39,10 +39,18 @@ var DefaultFactory = &impl{}
 
 // ConnectionFactory is a mockable abstraction for our client-server interactions.
 type Factory interface {
+	CreateAuditClient(ctx context.Context, workspace workspaces.Workspace) (clients.AuditClient, error)
 	CreateDeploymentClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeploymentClient, error)
 	CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error)
 	CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error)
 	CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error)
+	CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error)
+	CreateLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.LockClient, error)
+	CreateOperationClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationClient, error)
+	CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error)
+	CreateRecipePackLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePackLockClient, error)
+	CreateRoleClient(ctx context.Context, workspace workspaces.Workspace) (clients.RoleClient, error)
+	CreateWatchClient(ctx context.Context, workspace workspaces.Workspace) (clients.WatchClient, error)
 }
 
 var _ Factory = (*impl)(nil)
@@ -201,3 +209,91 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 
 	return cpClient, nil
 }
+
+// CreateAuditClient connects to the workspace and returns a UCPAuditClient, or an error if the connection
+// cannot be established.
+func (*impl) CreateAuditClient(ctx context.Context, workspace workspaces.Workspace) (clients.AuditClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPAuditClient{Connection: connection}, nil
+}
+
+// CreateDeadLetterClient connects to the workspace and returns a UCPDeadLetterClient, or an error if the connection
+// cannot be established.
+func (*impl) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPDeadLetterClient{Connection: connection}, nil
+}
+
+// CreateOperationClient connects to the workspace and returns a UCPOperationClient, or an error if the connection
+// cannot be established.
+func (*impl) CreateOperationClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPOperationClient{Connection: connection}, nil
+}
+
+// CreateRecipePlanClient connects to the workspace and returns a UCPRecipePlanClient, or an error if the connection
+// cannot be established.
+func (*impl) CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPRecipePlanClient{ClientOptions: sdk.NewClientOptions(connection)}, nil
+}
+
+// CreateRecipePackLockClient connects to the workspace and returns a UCPRecipePackLockClient, or an error if the
+// connection cannot be established.
+func (*impl) CreateRecipePackLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePackLockClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPRecipePackLockClient{Connection: connection}, nil
+}
+
+// CreateLockClient connects to the workspace and returns a UCPLockClient, or an error if the connection cannot be
+// established.
+func (*impl) CreateLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.LockClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPLockClient{Connection: connection}, nil
+}
+
+// CreateRoleClient connects to the workspace and returns a UCPRoleClient, or an error if the connection cannot be
+// established.
+func (*impl) CreateRoleClient(ctx context.Context, workspace workspaces.Workspace) (clients.RoleClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPRoleClient{Connection: connection}, nil
+}
+
+// CreateWatchClient connects to the workspace and returns a UCPWatchClient, or an error if the connection cannot be
+// established.
+func (*impl) CreateWatchClient(ctx context.Context, workspace workspaces.Workspace) (clients.WatchClient, error) {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients.UCPWatchClient{Connection: connection}, nil
+}
@@ -28,8 +28,16 @@ var _ Factory = (*MockFactory)(nil)
 
 type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
+	AuditClient                  clients.AuditClient
 	CredentialManagementClient   cli_credential.CredentialManagementClient
+	DeadLetterClient             clients.DeadLetterClient
 	DiagnosticsClient            clients.DiagnosticsClient
+	LockClient                   clients.LockClient
+	OperationClient              clients.OperationClient
+	RecipePlanClient             clients.RecipePlanClient
+	RecipePackLockClient         clients.RecipePackLockClient
+	RoleClient                   clients.RoleClient
+	WatchClient                  clients.WatchClient
 }
 
 // CreateDeploymentClient function takes in a context and a workspace and returns a DeploymentClient and an error, if any.
@@ -52,3 +60,43 @@ func (f *MockFactory) CreateApplicationsManagementClient(ctx context.Context, wo
 func (f *MockFactory) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	return f.CredentialManagementClient, nil
 }
+
+// CreateAuditClient function takes in a context and a workspace and returns an AuditClient and does not return an error.
+func (f *MockFactory) CreateAuditClient(ctx context.Context, workspace workspaces.Workspace) (clients.AuditClient, error) {
+	return f.AuditClient, nil
+}
+
+// CreateDeadLetterClient function takes in a context and a workspace and returns a DeadLetterClient and does not return an error.
+func (f *MockFactory) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeadLetterClient, error) {
+	return f.DeadLetterClient, nil
+}
+
+// CreateOperationClient function takes in a context and a workspace and returns an OperationClient and does not return an error.
+func (f *MockFactory) CreateOperationClient(ctx context.Context, workspace workspaces.Workspace) (clients.OperationClient, error) {
+	return f.OperationClient, nil
+}
+
+// CreateRecipePlanClient function takes in a context and a workspace and returns a RecipePlanClient and does not return an error.
+func (f *MockFactory) CreateRecipePlanClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePlanClient, error) {
+	return f.RecipePlanClient, nil
+}
+
+// CreateRecipePackLockClient function takes in a context and a workspace and returns a RecipePackLockClient and does not return an error.
+func (f *MockFactory) CreateRecipePackLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.RecipePackLockClient, error) {
+	return f.RecipePackLockClient, nil
+}
+
+// CreateLockClient function takes in a context and a workspace and returns a LockClient and does not return an error.
+func (f *MockFactory) CreateLockClient(ctx context.Context, workspace workspaces.Workspace) (clients.LockClient, error) {
+	return f.LockClient, nil
+}
+
+// CreateRoleClient function takes in a context and a workspace and returns a RoleClient and does not return an error.
+func (f *MockFactory) CreateRoleClient(ctx context.Context, workspace workspaces.Workspace) (clients.RoleClient, error) {
+	return f.RoleClient, nil
+}
+
+// CreateWatchClient function takes in a context and a workspace and returns a WatchClient and does not return an error.
+func (f *MockFactory) CreateWatchClient(ctx context.Context, workspace workspaces.Workspace) (clients.WatchClient, error) {
+	return f.WatchClient, nil
+}
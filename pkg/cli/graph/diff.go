@@ -0,0 +1,365 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ChangeType classifies a resource or connection when comparing two graphs.
+type ChangeType string
+
+const (
+	// ChangeAdded means the item exists only in the target graph.
+	ChangeAdded ChangeType = "Added"
+
+	// ChangeRemoved means the item exists only in the base graph.
+	ChangeRemoved ChangeType = "Removed"
+
+	// ChangeModified means the resource exists in both graphs with different
+	// content.
+	ChangeModified ChangeType = "Modified"
+
+	// ChangeUnchanged means the resource exists in both graphs with the same
+	// content.
+	ChangeUnchanged ChangeType = "Unchanged"
+)
+
+// changeOrder is the order in which changes are reported.
+var changeOrder = []ChangeType{ChangeAdded, ChangeRemoved, ChangeModified, ChangeUnchanged}
+
+// ResourceDiff describes how a single resource changed between two graphs.
+type ResourceDiff struct {
+	// Change classifies the resource.
+	Change ChangeType `json:"change"`
+
+	// Name is the resource name.
+	Name string `json:"name"`
+
+	// Type is the resource type.
+	Type string `json:"type"`
+
+	// BaseID is the resource ID in the base graph, if present.
+	BaseID string `json:"baseId,omitempty"`
+
+	// TargetID is the resource ID in the target graph, if present.
+	TargetID string `json:"targetId,omitempty"`
+
+	// BaseDiffHash is the diff hash of the resource in the base graph, if any.
+	BaseDiffHash string `json:"baseDiffHash,omitempty"`
+
+	// TargetDiffHash is the diff hash of the resource in the target graph, if
+	// any.
+	TargetDiffHash string `json:"targetDiffHash,omitempty"`
+}
+
+// ConnectionDiff describes a connection that exists in only one of two
+// graphs. Source depends on Target.
+type ConnectionDiff struct {
+	// Change is either ChangeAdded or ChangeRemoved.
+	Change ChangeType `json:"change"`
+
+	// Source is the name of the resource that makes the connection.
+	Source string `json:"source"`
+
+	// Target is the name of the resource that is connected to.
+	Target string `json:"target"`
+}
+
+// GraphDiff is the result of comparing two application graphs.
+type GraphDiff struct {
+	// Resources holds one entry per resource in either graph, ordered by
+	// change, type and name.
+	Resources []ResourceDiff `json:"resources"`
+
+	// Connections holds the connections that were added or removed, ordered
+	// by change, source and target.
+	Connections []ConnectionDiff `json:"connections"`
+}
+
+// Count returns the number of resources with the given change.
+func (d *GraphDiff) Count(change ChangeType) int {
+	count := 0
+	for _, r := range d.Resources {
+		if r.Change == change {
+			count++
+		}
+	}
+	return count
+}
+
+// HasChanges reports whether any resource or connection changed.
+func (d *GraphDiff) HasChanges() bool {
+	return len(d.Connections) > 0 || d.Count(ChangeUnchanged) != len(d.Resources)
+}
+
+// Summary returns a one-line summary of the resource changes, for example
+// "1 added, 0 removed, 2 modified, 3 unchanged".
+func (d *GraphDiff) Summary() string {
+	parts := make([]string, 0, len(changeOrder))
+	for _, change := range changeOrder {
+		parts = append(parts, fmt.Sprintf("%d %s", d.Count(change), strings.ToLower(string(change))))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DiffGraphs compares base and target and classifies every resource as
+// added, removed, modified or unchanged.
+//
+// Resources are matched by type and name (case-insensitively) rather than by
+// ID, because modeled graphs use a placeholder resource group and would never
+// match a deployed graph by ID. A resource present in both graphs is modified
+// when both carry a diff hash and the hashes differ. When either side lacks a
+// diff hash (deployed graphs do not carry one) the resource is modified when
+// its outbound connections differ.
+func DiffGraphs(base, target *corerpv20250801preview.ApplicationGraphResponse) *GraphDiff {
+	baseResources := indexResources(base)
+	targetResources := indexResources(target)
+	baseEdges := collectEdges(base, baseResources)
+	targetEdges := collectEdges(target, targetResources)
+
+	diff := &GraphDiff{
+		Resources:   []ResourceDiff{},
+		Connections: []ConnectionDiff{},
+	}
+
+	for k, b := range baseResources {
+		t, ok := targetResources[k]
+		if !ok {
+			diff.Resources = append(diff.Resources, ResourceDiff{
+				Change:       ChangeRemoved,
+				Name:         b.name,
+				Type:         b.resourceType,
+				BaseID:       b.id,
+				BaseDiffHash: b.diffHash,
+			})
+			continue
+		}
+
+		change := ChangeUnchanged
+		if b.diffHash != "" && t.diffHash != "" {
+			if b.diffHash != t.diffHash {
+				change = ChangeModified
+			}
+		} else if !slices.Equal(outboundOf(baseEdges, k), outboundOf(targetEdges, k)) {
+			change = ChangeModified
+		}
+
+		diff.Resources = append(diff.Resources, ResourceDiff{
+			Change:         change,
+			Name:           t.name,
+			Type:           t.resourceType,
+			BaseID:         b.id,
+			TargetID:       t.id,
+			BaseDiffHash:   b.diffHash,
+			TargetDiffHash: t.diffHash,
+		})
+	}
+	for k, t := range targetResources {
+		if _, ok := baseResources[k]; ok {
+			continue
+		}
+		diff.Resources = append(diff.Resources, ResourceDiff{
+			Change:         ChangeAdded,
+			Name:           t.name,
+			Type:           t.resourceType,
+			TargetID:       t.id,
+			TargetDiffHash: t.diffHash,
+		})
+	}
+
+	for e, names := range baseEdges {
+		if _, ok := targetEdges[e]; !ok {
+			diff.Connections = append(diff.Connections, ConnectionDiff{Change: ChangeRemoved, Source: names[0], Target: names[1]})
+		}
+	}
+	for e, names := range targetEdges {
+		if _, ok := baseEdges[e]; !ok {
+			diff.Connections = append(diff.Connections, ConnectionDiff{Change: ChangeAdded, Source: names[0], Target: names[1]})
+		}
+	}
+
+	sort.Slice(diff.Resources, func(i, j int) bool {
+		a, b := diff.Resources[i], diff.Resources[j]
+		if a.Change != b.Change {
+			return slices.Index(changeOrder, a.Change) < slices.Index(changeOrder, b.Change)
+		}
+		if !strings.EqualFold(a.Type, b.Type) {
+			return strings.ToLower(a.Type) < strings.ToLower(b.Type)
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+	sort.Slice(diff.Connections, func(i, j int) bool {
+		a, b := diff.Connections[i], diff.Connections[j]
+		if a.Change != b.Change {
+			return slices.Index(changeOrder, a.Change) < slices.Index(changeOrder, b.Change)
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		return a.Target < b.Target
+	})
+
+	return diff
+}
+
+// RenderDiffMarkdown renders diff as GitHub-flavored Markdown suitable for a
+// pull request comment. Unchanged resources are counted in the summary but
+// not listed.
+func RenderDiffMarkdown(diff *GraphDiff) string {
+	out := &strings.Builder{}
+	out.WriteString("### Application graph diff\n\n")
+	fmt.Fprintf(out, "**Resources:** %s\n\n", diff.Summary())
+
+	if !diff.HasChanges() {
+		out.WriteString("No changes.\n")
+		return out.String()
+	}
+
+	changed := 0
+	for _, r := range diff.Resources {
+		if r.Change != ChangeUnchanged {
+			changed++
+		}
+	}
+	if changed > 0 {
+		out.WriteString("| Change | Name | Type |\n")
+		out.WriteString("| --- | --- | --- |\n")
+		for _, r := range diff.Resources {
+			if r.Change == ChangeUnchanged {
+				continue
+			}
+			fmt.Fprintf(out, "| %s | %s | %s |\n", r.Change, escapeMarkdownCell(r.Name), escapeMarkdownCell(r.Type))
+		}
+		out.WriteString("\n")
+	}
+
+	if len(diff.Connections) > 0 {
+		out.WriteString("**Connections:**\n\n")
+		out.WriteString("| Change | Source | Target |\n")
+		out.WriteString("| --- | --- | --- |\n")
+		for _, c := range diff.Connections {
+			fmt.Fprintf(out, "| %s | %s | %s |\n", c.Change, escapeMarkdownCell(c.Source), escapeMarkdownCell(c.Target))
+		}
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+
+// diffResource holds the fields of a resource that DiffGraphs compares.
+type diffResource struct {
+	id           string
+	name         string
+	resourceType string
+	diffHash     string
+}
+
+// indexResources returns the resources of graph keyed by resourceKey.
+func indexResources(graph *corerpv20250801preview.ApplicationGraphResponse) map[string]diffResource {
+	result := map[string]diffResource{}
+	if graph == nil {
+		return result
+	}
+	for _, r := range graph.Resources {
+		if r == nil {
+			continue
+		}
+		result[resourceKey(to.String(r.Type), to.String(r.Name))] = diffResource{
+			id:           to.String(r.ID),
+			name:         to.String(r.Name),
+			resourceType: to.String(r.Type),
+			diffHash:     to.String(r.DiffHash),
+		}
+	}
+	return result
+}
+
+// collectEdges returns the connections of graph, normalized so that the
+// first element of the key depends on the second. Each value holds the
+// display names of the two ends.
+func collectEdges(graph *corerpv20250801preview.ApplicationGraphResponse, index map[string]diffResource) map[[2]string][2]string {
+	result := map[[2]string][2]string{}
+	if graph == nil {
+		return result
+	}
+	for _, r := range graph.Resources {
+		if r == nil {
+			continue
+		}
+		self := resourceKey(to.String(r.Type), to.String(r.Name))
+		for _, conn := range r.Connections {
+			if conn == nil || conn.ID == nil {
+				continue
+			}
+			other, otherName := connectionKey(*conn.ID, index)
+			source, target := self, other
+			sourceName, targetName := to.String(r.Name), otherName
+			if conn.Direction != nil && *conn.Direction == corerpv20250801preview.DirectionInbound {
+				source, target = target, source
+				sourceName, targetName = targetName, sourceName
+			}
+			result[[2]string{source, target}] = [2]string{sourceName, targetName}
+		}
+	}
+	return result
+}
+
+// outboundOf returns the sorted targets of the edges that start at source.
+func outboundOf(edges map[[2]string][2]string, source string) []string {
+	var targets []string
+	for e := range edges {
+		if e[0] == source {
+			targets = append(targets, e[1])
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+// connectionKey returns the resourceKey and display name for a connection
+// target ID. IDs that cannot be parsed are compared verbatim.
+func connectionKey(id string, index map[string]diffResource) (string, string) {
+	parsed, err := resources.Parse(id)
+	if err != nil || parsed.Name() == "" {
+		return strings.ToLower(id), id
+	}
+	k := resourceKey(parsed.Type(), parsed.Name())
+	if r, ok := index[k]; ok {
+		return k, r.name
+	}
+	return k, parsed.Name()
+}
+
+func resourceKey(resourceType, name string) string {
+	return strings.ToLower(resourceType) + "|" + strings.ToLower(name)
+}
+
+// markdownCellReplacer escapes the characters that would end a Markdown
+// table cell or row.
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+func escapeMarkdownCell(s string) string {
+	return markdownCellReplacer.Replace(s)
+}
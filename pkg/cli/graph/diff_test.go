@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func diffTestResource(group, resourceType, name, hash string, outbound ...string) *corerpv20250801preview.ApplicationGraphResource {
+	r := &corerpv20250801preview.ApplicationGraphResource{
+		ID:          to.Ptr("/planes/radius/local/resourcegroups/" + group + "/providers/" + resourceType + "/" + name),
+		Name:        to.Ptr(name),
+		Type:        to.Ptr(resourceType),
+		Connections: []*corerpv20250801preview.ApplicationGraphConnection{},
+	}
+	if hash != "" {
+		r.DiffHash = to.Ptr(hash)
+	}
+	for _, target := range outbound {
+		r.Connections = append(r.Connections, &corerpv20250801preview.ApplicationGraphConnection{
+			ID:        to.Ptr("/planes/radius/local/resourcegroups/" + group + "/providers/" + target),
+			Direction: to.Ptr(corerpv20250801preview.DirectionOutbound),
+		})
+	}
+	return r
+}
+
+func TestDiffGraphs_ClassifiesResources(t *testing.T) {
+	t.Parallel()
+
+	base := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("default", "Applications.Core/containers", "frontend", "sha256:1", "Applications.Datastores/redisCaches/cache"),
+			diffTestResource("default", "Applications.Core/containers", "backend", "sha256:2"),
+			diffTestResource("default", "Applications.Datastores/redisCaches", "cache", "sha256:3"),
+		},
+	}
+	target := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("default", "Applications.Core/containers", "frontend", "sha256:1-changed", "Applications.Datastores/sqlDatabases/db"),
+			diffTestResource("default", "Applications.Core/containers", "backend", "sha256:2"),
+			diffTestResource("default", "Applications.Datastores/sqlDatabases", "db", "sha256:4"),
+		},
+	}
+
+	diff := DiffGraphs(base, target)
+
+	require.Equal(t, []ResourceDiff{
+		{Change: ChangeAdded, Name: "db", Type: "Applications.Datastores/sqlDatabases", TargetID: *target.Resources[2].ID, TargetDiffHash: "sha256:4"},
+		{Change: ChangeRemoved, Name: "cache", Type: "Applications.Datastores/redisCaches", BaseID: *base.Resources[2].ID, BaseDiffHash: "sha256:3"},
+		{Change: ChangeModified, Name: "frontend", Type: "Applications.Core/containers", BaseID: *base.Resources[0].ID, TargetID: *target.Resources[0].ID, BaseDiffHash: "sha256:1", TargetDiffHash: "sha256:1-changed"},
+		{Change: ChangeUnchanged, Name: "backend", Type: "Applications.Core/containers", BaseID: *base.Resources[1].ID, TargetID: *target.Resources[1].ID, BaseDiffHash: "sha256:2", TargetDiffHash: "sha256:2"},
+	}, diff.Resources)
+
+	require.Equal(t, []ConnectionDiff{
+		{Change: ChangeAdded, Source: "frontend", Target: "db"},
+		{Change: ChangeRemoved, Source: "frontend", Target: "cache"},
+	}, diff.Connections)
+
+	require.True(t, diff.HasChanges())
+	require.Equal(t, "1 added, 1 removed, 1 modified, 1 unchanged", diff.Summary())
+}
+
+func TestDiffGraphs_MatchesModeledAndDeployedByTypeAndName(t *testing.T) {
+	t.Parallel()
+
+	// Modeled graphs use a placeholder resource group and carry diff hashes;
+	// deployed graphs use the real group and do not.
+	modeled := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("default", "Applications.Core/containers", "frontend", "sha256:1", "Applications.Datastores/redisCaches/cache"),
+			diffTestResource("default", "Applications.Datastores/redisCaches", "cache", "sha256:2"),
+		},
+	}
+	deployed := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("prod", "applications.core/containers", "frontend", "", "Applications.Datastores/redisCaches/cache"),
+			diffTestResource("prod", "Applications.Datastores/redisCaches", "cache", ""),
+		},
+	}
+	// The deployed graph also records the inbound side of the connection.
+	deployed.Resources[1].Connections = append(deployed.Resources[1].Connections, &corerpv20250801preview.ApplicationGraphConnection{
+		ID:        deployed.Resources[0].ID,
+		Direction: to.Ptr(corerpv20250801preview.DirectionInbound),
+	})
+
+	diff := DiffGraphs(modeled, deployed)
+	require.False(t, diff.HasChanges())
+	require.Equal(t, 2, diff.Count(ChangeUnchanged))
+	require.Empty(t, diff.Connections)
+}
+
+func TestDiffGraphs_WithoutDiffHashComparesConnections(t *testing.T) {
+	t.Parallel()
+
+	base := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("rg", "Applications.Core/containers", "frontend", ""),
+		},
+	}
+	target := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			diffTestResource("rg", "Applications.Core/containers", "frontend", "", "Applications.Datastores/redisCaches/cache"),
+		},
+	}
+
+	diff := DiffGraphs(base, target)
+	require.Equal(t, ChangeModified, diff.Resources[0].Change)
+	require.Equal(t, []ConnectionDiff{{Change: ChangeAdded, Source: "frontend", Target: "cache"}}, diff.Connections)
+}
+
+func TestDiffGraphs_NilGraphs(t *testing.T) {
+	t.Parallel()
+
+	diff := DiffGraphs(nil, nil)
+	require.Empty(t, diff.Resources)
+	require.Empty(t, diff.Connections)
+	require.False(t, diff.HasChanges())
+}
+
+func TestRenderDiffMarkdown(t *testing.T) {
+	t.Parallel()
+
+	diff := &GraphDiff{
+		Resources: []ResourceDiff{
+			{Change: ChangeAdded, Name: "db", Type: "Applications.Datastores/sqlDatabases"},
+			{Change: ChangeUnchanged, Name: "backend", Type: "Applications.Core/containers"},
+		},
+		Connections: []ConnectionDiff{
+			{Change: ChangeAdded, Source: "front|end", Target: "db"},
+		},
+	}
+
+	expected := "### Application graph diff\n\n" +
+		"**Resources:** 1 added, 0 removed, 0 modified, 1 unchanged\n\n" +
+		"| Change | Name | Type |\n" +
+		"| --- | --- | --- |\n" +
+		"| Added | db | Applications.Datastores/sqlDatabases |\n\n" +
+		"**Connections:**\n\n" +
+		"| Change | Source | Target |\n" +
+		"| --- | --- | --- |\n" +
+		"| Added | front\\|end | db |\n\n"
+	require.Equal(t, expected, RenderDiffMarkdown(diff))
+}
+
+func TestRenderDiffMarkdown_EscapesNewlines(t *testing.T) {
+	t.Parallel()
+
+	diff := &GraphDiff{
+		Resources: []ResourceDiff{
+			{Change: ChangeModified, Name: "multi\nline", Type: "windows\r\nline\rend"},
+		},
+	}
+
+	require.Contains(t, RenderDiffMarkdown(diff), "| Modified | multi<br>line | windows<br>line<br>end |\n")
+}
+
+func TestRenderDiffMarkdown_NoChanges(t *testing.T) {
+	t.Parallel()
+
+	diff := &GraphDiff{
+		Resources: []ResourceDiff{{Change: ChangeUnchanged, Name: "backend", Type: "Applications.Core/containers"}},
+	}
+
+	require.Equal(t, "### Application graph diff\n\n**Resources:** 0 added, 0 removed, 0 modified, 1 unchanged\n\nNo changes.\n", RenderDiffMarkdown(diff))
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+// RenderDOT renders graph in the Graphviz DOT language. Resources are drawn
+// as rounded boxes, connection targets outside the graph as dashed boxes and
+// output resources as ellipses joined to their owner by a dashed edge.
+func RenderDOT(graph *corerpv20250801preview.ApplicationGraphResponse, opts ExportOptions) string {
+	g := buildExportGraph(graph, opts)
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "digraph %s {\n", dotQuote(g.title))
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box, style=rounded, fontname=\"sans-serif\"];\n")
+	out.WriteString("  edge [fontname=\"sans-serif\"];\n")
+
+	for _, n := range g.nodes {
+		label := n.label
+		if n.detail != "" {
+			label += "\n" + n.detail
+		}
+		attrs := ""
+		switch n.kind {
+		case nodeExternal:
+			attrs = ", style=\"rounded,dashed\""
+		case nodeOutput:
+			attrs = ", shape=ellipse, style=solid"
+		}
+		fmt.Fprintf(out, "  %s [label=%s%s];\n", n.id, dotQuote(label), attrs)
+	}
+
+	for _, e := range g.edges {
+		if e.output {
+			fmt.Fprintf(out, "  %s -> %s [style=dashed, arrowhead=none];\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(out, "  %s -> %s;\n", e.from, e.to)
+		}
+	}
+
+	out.WriteString("}\n")
+	return out.String()
+}
+
+// dotQuote returns s as a DOT quoted string. Newlines become centered line
+// breaks.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
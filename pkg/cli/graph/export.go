@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ExportFormatDOT renders a graph in the Graphviz DOT language.
+	ExportFormatDOT = "dot"
+
+	// ExportFormatMermaid renders a graph as a Mermaid flowchart.
+	ExportFormatMermaid = "mermaid"
+
+	// ExportFormatSVG renders a graph as a self-contained SVG image.
+	ExportFormatSVG = "svg"
+
+	// defaultExportTitle is used when ExportOptions.Title is empty.
+	defaultExportTitle = "application"
+)
+
+// ExportFormats returns the formats supported by Export.
+func ExportFormats() []string {
+	return []string{ExportFormatDOT, ExportFormatMermaid, ExportFormatSVG}
+}
+
+// ExportOptions controls how Export renders a graph.
+type ExportOptions struct {
+	// Title names the rendered graph, typically the application name.
+	Title string
+
+	// CollapseOutputResources hides output resources. Each resource instead
+	// notes how many output resources it has.
+	CollapseOutputResources bool
+}
+
+// Export renders graph in the given format, one of ExportFormats.
+func Export(graph *corerpv20250801preview.ApplicationGraphResponse, format string, opts ExportOptions) (string, error) {
+	switch strings.ToLower(format) {
+	case ExportFormatDOT:
+		return RenderDOT(graph, opts), nil
+	case ExportFormatMermaid:
+		return RenderMermaid(graph, opts), nil
+	case ExportFormatSVG:
+		return RenderSVG(graph, opts), nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q, supported formats are %s", format, strings.Join(ExportFormats(), ", "))
+	}
+}
+
+// ConvertGraph converts an application graph from another API version, such
+// as the v20231001preview graph returned for deployed applications, by
+// round-tripping it through JSON. The API versions share the same shape.
+func ConvertGraph(in any) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("marshal application graph: %w", err)
+	}
+	result := &corerpv20250801preview.ApplicationGraphResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("unmarshal application graph: %w", err)
+	}
+	return result, nil
+}
+
+// nodeKind distinguishes the nodes of an exportGraph.
+type nodeKind int
+
+const (
+	// nodeResource is a resource of the application graph.
+	nodeResource nodeKind = iota
+
+	// nodeExternal is the target of a connection that is not itself part of
+	// the graph.
+	nodeExternal
+
+	// nodeOutput is an output resource.
+	nodeOutput
+)
+
+// exportNode is a node of an exportGraph.
+type exportNode struct {
+	// id is a stable identifier that is safe to use unquoted in DOT and
+	// Mermaid.
+	id string
+
+	kind nodeKind
+
+	// label is the first line of the node text, usually the name.
+	label string
+
+	// detail is the second line of the node text, usually the type.
+	detail string
+}
+
+// exportEdge is an edge of an exportGraph. Data flows from "from" to "to":
+// "from" connects to, or owns, "to".
+type exportEdge struct {
+	from string
+	to   string
+
+	// output is true for the edge between a resource and one of its output
+	// resources.
+	output bool
+}
+
+// exportGraph is the renderer-independent form of an application graph.
+// Nodes and edges are in a deterministic order so that exports are stable.
+type exportGraph struct {
+	title string
+	nodes []exportNode
+	edges []exportEdge
+}
+
+// buildExportGraph converts graph for rendering. Resources are ordered by
+// type, name and ID. Connections are de-duplicated, since the graph usually
+// records both the outbound and inbound side of each one, and oriented by
+// ApplicationGraphConnection.Direction. A connection without a direction is
+// treated as outbound.
+func buildExportGraph(graph *corerpv20250801preview.ApplicationGraphResponse, opts ExportOptions) *exportGraph {
+	result := &exportGraph{title: opts.Title}
+	if result.title == "" {
+		result.title = defaultExportTitle
+	}
+	if graph == nil {
+		return result
+	}
+
+	graphResources := make([]*corerpv20250801preview.ApplicationGraphResource, 0, len(graph.Resources))
+	for _, r := range graph.Resources {
+		if r != nil {
+			graphResources = append(graphResources, r)
+		}
+	}
+	sort.SliceStable(graphResources, func(i, j int) bool {
+		a, b := graphResources[i], graphResources[j]
+		if to.String(a.Type) != to.String(b.Type) {
+			return to.String(a.Type) < to.String(b.Type)
+		}
+		if to.String(a.Name) != to.String(b.Name) {
+			return to.String(a.Name) < to.String(b.Name)
+		}
+		return to.String(a.ID) < to.String(b.ID)
+	})
+
+	ids := map[string]string{}
+	addNode := func(key string, kind nodeKind, label, detail string) string {
+		if id, ok := ids[key]; ok {
+			return id
+		}
+		id := fmt.Sprintf("n%d", len(result.nodes))
+		ids[key] = id
+		result.nodes = append(result.nodes, exportNode{id: id, kind: kind, label: label, detail: detail})
+		return id
+	}
+
+	for _, r := range graphResources {
+		detail := to.String(r.Type)
+		if opts.CollapseOutputResources && len(r.OutputResources) > 0 {
+			detail = fmt.Sprintf("%s (%d output resources)", detail, len(r.OutputResources))
+		}
+		addNode(strings.ToLower(to.String(r.ID)), nodeResource, to.String(r.Name), detail)
+	}
+
+	seen := map[exportEdge]bool{}
+	addEdge := func(e exportEdge) {
+		if !seen[e] {
+			seen[e] = true
+			result.edges = append(result.edges, e)
+		}
+	}
+
+	for _, r := range graphResources {
+		self := ids[strings.ToLower(to.String(r.ID))]
+		for _, conn := range r.Connections {
+			if conn == nil || conn.ID == nil {
+				continue
+			}
+			label, detail := *conn.ID, ""
+			if parsed, err := resources.Parse(*conn.ID); err == nil && parsed.Name() != "" {
+				label, detail = parsed.Name(), parsed.Type()
+			}
+			other := addNode(strings.ToLower(*conn.ID), nodeExternal, label, detail)
+			if conn.Direction != nil && *conn.Direction == corerpv20250801preview.DirectionInbound {
+				addEdge(exportEdge{from: other, to: self})
+			} else {
+				addEdge(exportEdge{from: self, to: other})
+			}
+		}
+	}
+
+	if !opts.CollapseOutputResources {
+		for _, r := range graphResources {
+			self := ids[strings.ToLower(to.String(r.ID))]
+			for _, or := range r.OutputResources {
+				if or == nil {
+					continue
+				}
+				// Prefix the key so that an output resource can never be
+				// mistaken for a resource or connection target.
+				key := "output|" + strings.ToLower(to.String(or.Type)) + "|" + strings.ToLower(to.String(or.ID))
+				out := addNode(key, nodeOutput, to.String(or.Name), to.String(or.Type))
+				addEdge(exportEdge{from: self, to: out, output: true})
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	exportFrontendID = "/planes/radius/local/resourcegroups/default/providers/Applications.Core/containers/frontend"
+	exportCacheID    = "/planes/radius/local/resourcegroups/default/providers/Applications.Datastores/redisCaches/cache"
+	exportSecretID   = "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/secret"
+)
+
+// exportTestGraph returns a graph in which frontend connects to cache, with
+// both sides of the connection recorded, and secret connects to frontend,
+// recorded only on the frontend side as an inbound connection.
+func exportTestGraph() *corerpv20250801preview.ApplicationGraphResponse {
+	return &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{
+				ID:   to.Ptr(exportFrontendID),
+				Name: to.Ptr("frontend"),
+				Type: to.Ptr("Applications.Core/containers"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(exportCacheID), Direction: to.Ptr(corerpv20250801preview.DirectionOutbound)},
+					{ID: to.Ptr(exportSecretID), Direction: to.Ptr(corerpv20250801preview.DirectionInbound)},
+				},
+				OutputResources: []*corerpv20250801preview.ApplicationGraphOutputResource{
+					{ID: to.Ptr("/apps/v1/Deployment/frontend"), Name: to.Ptr("frontend"), Type: to.Ptr("apps/Deployment")},
+				},
+			},
+			{
+				ID:   to.Ptr(exportCacheID),
+				Name: to.Ptr("cache"),
+				Type: to.Ptr("Applications.Datastores/redisCaches"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(exportFrontendID), Direction: to.Ptr(corerpv20250801preview.DirectionInbound)},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderDOT(t *testing.T) {
+	t.Parallel()
+
+	expected := `digraph "my-app" {
+  rankdir=LR;
+  node [shape=box, style=rounded, fontname="sans-serif"];
+  edge [fontname="sans-serif"];
+  n0 [label="frontend\nApplications.Core/containers"];
+  n1 [label="cache\nApplications.Datastores/redisCaches"];
+  n2 [label="secret\nApplications.Core/secretStores", style="rounded,dashed"];
+  n3 [label="frontend\napps/Deployment", shape=ellipse, style=solid];
+  n0 -> n1;
+  n2 -> n0;
+  n0 -> n3 [style=dashed, arrowhead=none];
+}
+`
+	require.Equal(t, expected, RenderDOT(exportTestGraph(), ExportOptions{Title: "my-app"}))
+}
+
+func TestRenderDOT_CollapseOutputResources(t *testing.T) {
+	t.Parallel()
+
+	dot := RenderDOT(exportTestGraph(), ExportOptions{CollapseOutputResources: true})
+	require.True(t, strings.HasPrefix(dot, `digraph "application" {`))
+	require.Contains(t, dot, `n0 [label="frontend\nApplications.Core/containers (1 output resources)"];`)
+	require.NotContains(t, dot, "apps/Deployment")
+	require.NotContains(t, dot, "style=dashed, arrowhead=none")
+}
+
+func TestRenderDOT_EscapesLabels(t *testing.T) {
+	t.Parallel()
+
+	graph := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{ID: to.Ptr("/x"), Name: to.Ptr(`say "hi"\`), Type: to.Ptr("T")},
+		},
+	}
+	require.Contains(t, RenderDOT(graph, ExportOptions{}), `n0 [label="say \"hi\"\\\nT"];`)
+}
+
+func TestRenderMermaid(t *testing.T) {
+	t.Parallel()
+
+	expected := `---
+title: "my-app"
+---
+flowchart LR
+  n0("frontend<br/>Applications.Core/containers")
+  n1("cache<br/>Applications.Datastores/redisCaches")
+  n2("secret<br/>Applications.Core/secretStores")
+  n3(["frontend<br/>apps/Deployment"])
+  n0 --> n1
+  n2 --> n0
+  n0 -.- n3
+  classDef external stroke-dasharray: 5 5
+  class n2 external
+`
+	require.Equal(t, expected, RenderMermaid(exportTestGraph(), ExportOptions{Title: "my-app"}))
+}
+
+func TestRenderMermaid_EscapesLabels(t *testing.T) {
+	t.Parallel()
+
+	graph := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{ID: to.Ptr("/x"), Name: to.Ptr(`a "b" <c> & d`), Type: to.Ptr("T")},
+		},
+	}
+	require.Contains(t, RenderMermaid(graph, ExportOptions{}), `n0("a #quot;b#quot; #lt;c#gt; #amp; d<br/>T")`)
+}
+
+func TestRenderSVG(t *testing.T) {
+	t.Parallel()
+
+	svg := RenderSVG(exportTestGraph(), ExportOptions{Title: "my-app & co"})
+
+	// The output must be well-formed XML.
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			require.EqualError(t, err, "EOF")
+			break
+		}
+	}
+
+	require.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	require.Contains(t, svg, "<title>my-app &amp; co</title>")
+	require.Equal(t, 4, strings.Count(svg, `text-anchor="middle" font-size="13"`))
+	require.Equal(t, 2, strings.Count(svg, `marker-end="url(#arrow)"`))
+	require.Equal(t, 1, strings.Count(svg, `fill="none" stroke="#999" stroke-dasharray="4 3"`), "output resource edge")
+}
+
+func TestRenderSVG_Empty(t *testing.T) {
+	t.Parallel()
+
+	svg := RenderSVG(nil, ExportOptions{})
+	require.Contains(t, svg, "<title>application</title>")
+	require.NotContains(t, svg, `fill="none"`)
+}
+
+func TestAssignLayers(t *testing.T) {
+	t.Parallel()
+
+	g := buildExportGraph(exportTestGraph(), ExportOptions{})
+	// secret -> frontend -> cache, and frontend owns its deployment.
+	require.Equal(t, [][]string{{"n2"}, {"n0"}, {"n1", "n3"}}, assignLayers(g))
+}
+
+func TestAssignLayers_Cycle(t *testing.T) {
+	t.Parallel()
+
+	g := &exportGraph{
+		nodes: []exportNode{{id: "a"}, {id: "b"}, {id: "c"}},
+		edges: []exportEdge{{from: "a", to: "b"}, {from: "b", to: "c"}, {from: "c", to: "a"}},
+	}
+	require.Equal(t, [][]string{{"a"}, {"b"}, {"c"}}, assignLayers(g))
+}
+
+func TestLayoutSVG_EdgesPointRight(t *testing.T) {
+	t.Parallel()
+
+	g := buildExportGraph(exportTestGraph(), ExportOptions{})
+	boxes, width, height := layoutSVG(g)
+	for _, e := range g.edges {
+		require.Greater(t, boxes[e.to].x, boxes[e.from].x+boxes[e.from].w, "edge %s -> %s", e.from, e.to)
+	}
+	for id, b := range boxes {
+		require.LessOrEqual(t, b.x+b.w, width, id)
+		require.LessOrEqual(t, b.y+b.h, height, id)
+	}
+
+	// The image size in the SVG matches the layout.
+	svg := RenderSVG(exportTestGraph(), ExportOptions{})
+	match := regexp.MustCompile(`width="(\d+)" height="(\d+)"`).FindStringSubmatch(svg)
+	require.Equal(t, strconv.Itoa(width), match[1])
+	require.Equal(t, strconv.Itoa(height), match[2])
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range ExportFormats() {
+		out, err := Export(exportTestGraph(), strings.ToUpper(format), ExportOptions{})
+		require.NoError(t, err, format)
+		require.NotEmpty(t, out, format)
+	}
+
+	_, err := Export(exportTestGraph(), "png", ExportOptions{})
+	require.ErrorContains(t, err, `unsupported export format "png"`)
+}
+
+func TestConvertGraph(t *testing.T) {
+	t.Parallel()
+
+	in := map[string]any{
+		"resources": []any{
+			map[string]any{"id": exportCacheID, "name": "cache", "type": "Applications.Datastores/redisCaches"},
+		},
+	}
+	out, err := ConvertGraph(in)
+	require.NoError(t, err)
+	require.Len(t, out.Resources, 1)
+	require.Equal(t, "cache", *out.Resources[0].Name)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+// RenderMermaid renders graph as a Mermaid flowchart, suitable for embedding
+// in Markdown. Resources are drawn as rounded boxes, connection targets
+// outside the graph with a dashed border and output resources as stadiums
+// joined to their owner by a dotted link.
+func RenderMermaid(graph *corerpv20250801preview.ApplicationGraphResponse, opts ExportOptions) string {
+	g := buildExportGraph(graph, opts)
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "---\ntitle: %q\n---\n", g.title)
+	out.WriteString("flowchart LR\n")
+
+	external := []string{}
+	for _, n := range g.nodes {
+		label := mermaidText(n.label)
+		if n.detail != "" {
+			label += "<br/>" + mermaidText(n.detail)
+		}
+		switch n.kind {
+		case nodeOutput:
+			fmt.Fprintf(out, "  %s([\"%s\"])\n", n.id, label)
+		case nodeExternal:
+			external = append(external, n.id)
+			fmt.Fprintf(out, "  %s(\"%s\")\n", n.id, label)
+		default:
+			fmt.Fprintf(out, "  %s(\"%s\")\n", n.id, label)
+		}
+	}
+
+	for _, e := range g.edges {
+		if e.output {
+			fmt.Fprintf(out, "  %s -.- %s\n", e.from, e.to)
+		} else {
+			fmt.Fprintf(out, "  %s --> %s\n", e.from, e.to)
+		}
+	}
+
+	if len(external) > 0 {
+		out.WriteString("  classDef external stroke-dasharray: 5 5\n")
+		fmt.Fprintf(out, "  class %s external\n", strings.Join(external, ","))
+	}
+
+	return out.String()
+}
+
+// mermaidText escapes s for use inside a quoted Mermaid label. Mermaid labels
+// accept HTML entities but not backslash escapes.
+func mermaidText(s string) string {
+	return strings.NewReplacer(
+		"&", "#amp;",
+		`"`, "#quot;",
+		"<", "#lt;",
+		">", "#gt;",
+		"\n", " ",
+	).Replace(s)
+}
@@ -78,6 +78,41 @@ func BuildModeledGraph(template map[string]any) (*corerpv20250801preview.Applica
 	return graph, nil
 }
 
+// ModeledEnvironmentID returns the resource ID of the environment that the
+// application declared in template is deployed to, in the same form as the
+// resource IDs of BuildModeledGraph. The application's "environment"
+// property may be a literal resource ID, a literal resourceId() expression
+// or, in languageVersion 2.0 templates, a reference() to a symbolic name.
+// It returns an empty string when the environment cannot be resolved
+// statically, for example when it is passed in as a template parameter.
+func ModeledEnvironmentID(template map[string]any) string {
+	raw := template["resources"]
+	var symbols map[string]symbolEntry
+	if m, ok := raw.(map[string]any); ok {
+		symbols = buildSymbolTable(m)
+	}
+
+	for _, entry := range collectResources(raw) {
+		if !strings.EqualFold(stringAt(entry, "type"), applicationsResourceType) {
+			continue
+		}
+		properties, _ := entry["properties"].(map[string]any)
+		expr := stringAt(properties, "environment")
+		if strings.HasPrefix(expr, "/") {
+			return expr
+		}
+		if id := resolveResourceIDExpression(expr); id != "" {
+			return id
+		}
+		if matches := symbolicReference.FindStringSubmatch(expr); len(matches) == 2 {
+			if sym, ok := symbols[matches[1]]; ok && sym.resourceType != "" && sym.name != "" {
+				return buildResourceID(sym.resourceType, sym.name)
+			}
+		}
+	}
+	return ""
+}
+
 // collectResources normalizes the "resources" section of an ARM JSON
 // template into a slice of resource entries in the classic (flat) shape
 // expected by buildModeledResource. Bicep emits two layouts:
@@ -224,6 +224,54 @@ func TestResolveResourceIDExpression(t *testing.T) {
 	}
 }
 
+func TestModeledEnvironmentID(t *testing.T) {
+	t.Parallel()
+
+	envID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/environments/myenv"
+	application := func(environment string) map[string]any {
+		return map[string]any{
+			"resources": []any{
+				map[string]any{"type": "Applications.Core/environments", "name": "myenv"},
+				map[string]any{"type": "Applications.Core/applications", "name": "myapp",
+					"properties": map[string]any{"environment": environment}},
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		template map[string]any
+		want     string
+	}{
+		{"resourceId expression", application("[resourceId('Applications.Core/environments', 'myenv')]"), envID},
+		{"literal ID", application("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"),
+			"/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"},
+		{"parameter", application("[parameters('environment')]"), ""},
+		{"no application", map[string]any{"resources": []any{
+			map[string]any{"type": "Applications.Core/environments", "name": "myenv"},
+		}}, ""},
+		{"symbolic reference", map[string]any{
+			"languageVersion": "2.0",
+			"resources": map[string]any{
+				"env": map[string]any{
+					"type":       "Applications.Core/environments@2023-10-01-preview",
+					"properties": map[string]any{"name": "myenv"},
+				},
+				"app": map[string]any{
+					"type": "Applications.Core/applications@2023-10-01-preview",
+					"properties": map[string]any{
+						"name":       "myapp",
+						"properties": map[string]any{"environment": "[reference('env').id]"},
+					},
+				},
+			},
+		}, envID},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.want, ModeledEnvironmentID(tc.template), tc.name)
+	}
+}
+
 func findResource(t *testing.T, g *corerpv20250801preview.ApplicationGraphResponse, name string) *corerpv20250801preview.ApplicationGraphResource {
 	t.Helper()
 	for _, r := range g.Resources {
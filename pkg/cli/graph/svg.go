@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+// Layout constants for RenderSVG, in pixels.
+const (
+	svgMargin       = 20
+	svgTitleHeight  = 32
+	svgNodeHeight   = 44
+	svgNodePadding  = 12
+	svgMinNodeWidth = 120
+	svgCharWidth    = 7
+	svgLayerGap     = 80
+	svgRowGap       = 24
+
+	// svgLoopDepth is how far below its endpoints an edge that runs
+	// backwards, or within a layer, is routed.
+	svgLoopDepth = 40
+)
+
+// svgBox is the position and size of a node in the SVG layout.
+type svgBox struct {
+	x, y, w, h int
+}
+
+// RenderSVG renders graph as a self-contained SVG image.
+//
+// The layout is computed here rather than by Graphviz so that no external
+// binary is needed. Nodes are placed in layers from left to right so that
+// every edge that is not part of a cycle points right; within a layer nodes
+// are ordered to keep connected nodes level with each other.
+func RenderSVG(graph *corerpv20250801preview.ApplicationGraphResponse, opts ExportOptions) string {
+	g := buildExportGraph(graph, opts)
+	boxes, width, height := layoutSVG(g)
+
+	out := &strings.Builder{}
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif">`+"\n", width, height, width, height)
+	fmt.Fprintf(out, "  <title>%s</title>\n", html.EscapeString(g.title))
+	out.WriteString(`  <defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto-start-reverse"><path d="M0,0 L10,5 L0,10 z" fill="#555"/></marker></defs>` + "\n")
+	fmt.Fprintf(out, `  <rect width="%d" height="%d" fill="#ffffff"/>`+"\n", width, height)
+	fmt.Fprintf(out, `  <text x="%d" y="%d" font-size="16" font-weight="bold" fill="#222">%s</text>`+"\n", svgMargin, svgMargin+16, html.EscapeString(g.title))
+
+	for _, e := range g.edges {
+		from, to := boxes[e.from], boxes[e.to]
+		var d string
+		if to.x > from.x+from.w {
+			x1, y1 := from.x+from.w, from.y+from.h/2
+			x2, y2 := to.x, to.y+to.h/2
+			dx := (x2 - x1) / 2
+			d = fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, y1, x1+dx, y1, x2-dx, y2, x2, y2)
+		} else {
+			x1, y1 := from.x+from.w/2, from.y+from.h
+			x2, y2 := to.x+to.w/2, to.y+to.h
+			d = fmt.Sprintf("M%d,%d C%d,%d %d,%d %d,%d", x1, y1, x1, y1+svgLoopDepth, x2, y2+svgLoopDepth, x2, y2)
+		}
+		if e.output {
+			fmt.Fprintf(out, `  <path d="%s" fill="none" stroke="#999" stroke-dasharray="4 3"/>`+"\n", d)
+		} else {
+			fmt.Fprintf(out, `  <path d="%s" fill="none" stroke="#555" marker-end="url(#arrow)"/>`+"\n", d)
+		}
+	}
+
+	for _, n := range g.nodes {
+		b := boxes[n.id]
+		switch n.kind {
+		case nodeOutput:
+			fmt.Fprintf(out, `  <rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="#f5f5f5" stroke="#999"/>`+"\n", b.x, b.y, b.w, b.h, b.h/2)
+		case nodeExternal:
+			fmt.Fprintf(out, `  <rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="#ffffff" stroke="#999" stroke-dasharray="4 3"/>`+"\n", b.x, b.y, b.w, b.h)
+		default:
+			fmt.Fprintf(out, `  <rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="#eef4ff" stroke="#3b6fd8"/>`+"\n", b.x, b.y, b.w, b.h)
+		}
+
+		cx := b.x + b.w/2
+		if n.detail == "" {
+			fmt.Fprintf(out, `  <text x="%d" y="%d" text-anchor="middle" font-size="13" font-weight="bold" fill="#222">%s</text>`+"\n", cx, b.y+b.h/2+5, html.EscapeString(n.label))
+			continue
+		}
+		fmt.Fprintf(out, `  <text x="%d" y="%d" text-anchor="middle" font-size="13" font-weight="bold" fill="#222">%s</text>`+"\n", cx, b.y+18, html.EscapeString(n.label))
+		fmt.Fprintf(out, `  <text x="%d" y="%d" text-anchor="middle" font-size="11" fill="#666">%s</text>`+"\n", cx, b.y+34, html.EscapeString(n.detail))
+	}
+
+	out.WriteString("</svg>\n")
+	return out.String()
+}
+
+// layoutSVG places the nodes of g and returns their boxes along with the
+// size of the image.
+func layoutSVG(g *exportGraph) (map[string]svgBox, int, int) {
+	layers := assignLayers(g)
+
+	boxes := map[string]svgBox{}
+	nodeWidth := map[string]int{}
+	for _, n := range g.nodes {
+		w := max(len(n.label), len(n.detail))*svgCharWidth + 2*svgNodePadding
+		nodeWidth[n.id] = max(w, svgMinNodeWidth)
+	}
+
+	tallest := 0
+	for _, layer := range layers {
+		tallest = max(tallest, len(layer)*svgNodeHeight+max(len(layer)-1, 0)*svgRowGap)
+	}
+
+	x := svgMargin
+	for _, layer := range layers {
+		layerWidth := 0
+		for _, id := range layer {
+			layerWidth = max(layerWidth, nodeWidth[id])
+		}
+		layerHeight := len(layer)*svgNodeHeight + (len(layer)-1)*svgRowGap
+		y := svgMargin + svgTitleHeight + (tallest-layerHeight)/2
+		for _, id := range layer {
+			w := nodeWidth[id]
+			boxes[id] = svgBox{x: x + (layerWidth-w)/2, y: y, w: w, h: svgNodeHeight}
+			y += svgNodeHeight + svgRowGap
+		}
+		x += layerWidth + svgLayerGap
+	}
+
+	width := max(x-svgLayerGap+svgMargin, 2*svgMargin+svgMinNodeWidth)
+	// Leave room below the lowest node for edges routed underneath it.
+	height := svgMargin + svgTitleHeight + tallest + svgLoopDepth + svgMargin
+	return boxes, width, height
+}
+
+// assignLayers returns the node IDs of g grouped into layers, left to right.
+//
+// Each node is placed one layer to the right of its furthest predecessor,
+// ignoring the edges that close a cycle. Within a layer, nodes are ordered by
+// the average position of their predecessors, falling back to the order of
+// g.nodes.
+func assignLayers(g *exportGraph) [][]string {
+	successors := map[string][]string{}
+	for _, e := range g.edges {
+		successors[e.from] = append(successors[e.from], e.to)
+	}
+
+	// Find the edges that close a cycle with a depth-first search.
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	backEdges := map[[2]string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		for _, next := range successors[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				backEdges[[2]string{id, next}] = true
+			}
+		}
+		state[id] = done
+	}
+	for _, n := range g.nodes {
+		if state[n.id] == unvisited {
+			visit(n.id)
+		}
+	}
+
+	// Longest-path layering over the remaining acyclic edges, visiting
+	// nodes in topological order.
+	predecessors := map[string][]string{}
+	inDegree := map[string]int{}
+	for _, e := range g.edges {
+		if backEdges[[2]string{e.from, e.to}] {
+			continue
+		}
+		predecessors[e.to] = append(predecessors[e.to], e.from)
+		inDegree[e.to]++
+	}
+	layerOf := map[string]int{}
+	queue := []string{}
+	for _, n := range g.nodes {
+		if inDegree[n.id] == 0 {
+			queue = append(queue, n.id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, next := range successors[id] {
+			if backEdges[[2]string{id, next}] {
+				continue
+			}
+			layerOf[next] = max(layerOf[next], layerOf[id]+1)
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	layers := [][]string{}
+	for _, n := range g.nodes {
+		l := layerOf[n.id]
+		for len(layers) <= l {
+			layers = append(layers, []string{})
+		}
+		layers[l] = append(layers[l], n.id)
+	}
+
+	// Order each layer by the barycenter of its predecessors.
+	position := map[string]int{}
+	for _, layer := range layers {
+		weight := map[string]float64{}
+		for i, id := range layer {
+			weight[id] = float64(i)
+			if preds := predecessors[id]; len(preds) > 0 {
+				sum := 0
+				for _, p := range preds {
+					sum += position[p]
+				}
+				weight[id] = float64(sum) / float64(len(preds))
+			}
+		}
+		sort.SliceStable(layer, func(i, j int) bool {
+			return weight[layer[i]] < weight[layer[j]]
+		})
+		for i, id := range layer {
+			position[id] = i
+		}
+	}
+
+	return layers
+}
@@ -165,3 +165,79 @@ func GetProvidersForEnvironmentTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetRecipePlanTableFormat returns the fields to output from the planned recipe changes of `rad deploy --what-if`.
+func GetRecipePlanTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "RECIPE",
+				JSONPath: "{ .Recipe }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "OUTPUT RESOURCE TYPE",
+				JSONPath: "{ .OutputResourceType }",
+			},
+			{
+				Heading:  "OUTPUT RESOURCE",
+				JSONPath: "{ .OutputResource }",
+			},
+		},
+	}
+}
+
+// GetDriftedResourceTableFormat returns the fields to output from the drifted output resources of `rad resource drift`.
+func GetDriftedResourceTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
+
+// GetRecipeLockUpdateTableFormat returns the fields to output from the recipe lock updates of `rad recipe-pack update`.
+func GetRecipeLockUpdateTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "LOCKED",
+				JSONPath: "{ .Locked }",
+			},
+			{
+				Heading:  "LATEST",
+				JSONPath: "{ .Latest }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+		},
+	}
+}
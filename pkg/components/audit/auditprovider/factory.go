@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	defaultRetentionDays  = 90
+	defaultMaxSizeMB      = 100
+	defaultMaxBackups     = 5
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// NewSink creates the audit sink configured by the options. It returns nil when auditing is disabled.
+// The database client is used by the database sink.
+func NewSink(ctx context.Context, options Options, databaseClient database.Client) (audit.Sink, error) {
+	switch options.Provider {
+	case TypeNone:
+		return nil, nil
+
+	case TypeDatabase:
+		if databaseClient == nil {
+			return nil, errors.New("a database client is required for the database audit provider")
+		}
+
+		retentionDays := options.Database.RetentionDays
+		if retentionDays == 0 {
+			retentionDays = defaultRetentionDays
+		}
+
+		return audit.NewDatabaseSink(databaseClient, retentionDays), nil
+
+	case TypeFile:
+		if options.File.Path == "" {
+			return nil, errors.New("file.path is required for the file audit provider")
+		}
+		maxSizeMB := options.File.MaxSizeMB
+		if maxSizeMB <= 0 {
+			maxSizeMB = defaultMaxSizeMB
+		}
+		maxBackups := options.File.MaxBackups
+		if maxBackups <= 0 {
+			maxBackups = defaultMaxBackups
+		}
+		sink, err := audit.NewFileSink(options.File.Path, maxSizeMB*1024*1024, maxBackups)
+		if err != nil {
+			return nil, err
+		}
+		return sink, nil
+
+	case TypeWebhook:
+		if options.Webhook.URL == "" {
+			return nil, errors.New("webhook.url is required for the webhook audit provider")
+		}
+		timeout := options.Webhook.Timeout
+		if timeout <= 0 {
+			timeout = defaultWebhookTimeout
+		}
+		return audit.NewWebhookSink(options.Webhook.URL, &http.Client{Timeout: timeout}), nil
+	}
+
+	return nil, fmt.Errorf("unsupported audit provider: %q", options.Provider)
+}
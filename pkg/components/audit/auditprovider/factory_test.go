@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditprovider
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSink(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		sink, err := NewSink(context.Background(), Options{}, nil)
+		require.NoError(t, err)
+		require.Nil(t, sink)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		sink, err := NewSink(context.Background(), Options{Provider: TypeDatabase}, inmemory.NewClient())
+		require.NoError(t, err)
+		require.IsType(t, &audit.DatabaseSink{}, sink)
+	})
+
+	t.Run("database without client", func(t *testing.T) {
+		_, err := NewSink(context.Background(), Options{Provider: TypeDatabase}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		options := Options{Provider: TypeFile, File: FileOptions{Path: filepath.Join(t.TempDir(), "audit.log")}}
+		sink, err := NewSink(context.Background(), options, nil)
+		require.NoError(t, err)
+		require.IsType(t, &audit.FileSink{}, sink)
+	})
+
+	t.Run("file without path", func(t *testing.T) {
+		_, err := NewSink(context.Background(), Options{Provider: TypeFile}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		options := Options{Provider: TypeWebhook, Webhook: WebhookOptions{URL: "https://audit.example.com"}}
+		sink, err := NewSink(context.Background(), options, nil)
+		require.NoError(t, err)
+		require.IsType(t, &audit.WebhookSink{}, sink)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := NewSink(context.Background(), Options{Provider: "invalid"}, nil)
+		require.ErrorContains(t, err, "unsupported audit provider")
+	})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditprovider
+
+import "time"
+
+// Options represents the audit provider options.
+type Options struct {
+	// Provider configures the audit sink. Auditing is disabled when empty.
+	Provider AuditProviderType `yaml:"provider,omitempty"`
+
+	// Database configures options for the database sink.
+	Database DatabaseOptions `yaml:"database,omitempty"`
+
+	// File configures options for the file sink.
+	File FileOptions `yaml:"file,omitempty"`
+
+	// Webhook configures options for the webhook sink.
+	Webhook WebhookOptions `yaml:"webhook,omitempty"`
+}
+
+// DatabaseOptions represents the options of the database sink.
+type DatabaseOptions struct {
+	// RetentionDays is the number of days records are kept for. Defaults to 90. Records are kept forever when negative.
+	RetentionDays int `yaml:"retentionDays,omitempty"`
+}
+
+// FileOptions represents the options of the file sink.
+type FileOptions struct {
+	// Path is the path of the audit log file.
+	Path string `yaml:"path"`
+
+	// MaxSizeMB is the size in megabytes at which the file is rotated. Defaults to 100.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+
+	// MaxBackups is the number of rotated files to keep. Defaults to 5.
+	MaxBackups int `yaml:"maxBackups,omitempty"`
+}
+
+// WebhookOptions represents the options of the webhook sink.
+type WebhookOptions struct {
+	// URL is the URL audit records are posted to.
+	URL string `yaml:"url"`
+
+	// Timeout is the timeout of each request. Defaults to 10 seconds.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditprovider
+
+// AuditProviderType represents the type of audit sink.
+type AuditProviderType string
+
+const (
+	// TypeNone disables auditing.
+	TypeNone AuditProviderType = ""
+
+	// TypeDatabase represents the audit sink that stores records in the database.
+	TypeDatabase AuditProviderType = "database"
+
+	// TypeFile represents the audit sink that appends records to a rotating file.
+	TypeFile AuditProviderType = "file"
+
+	// TypeWebhook represents the audit sink that posts records to a webhook.
+	TypeWebhook AuditProviderType = "webhook"
+)
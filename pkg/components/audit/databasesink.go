@@ -0,0 +1,282 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// RecordResourceType is the resource type used to store audit records in the database.
+	RecordResourceType = "System.Audit/records"
+
+	// RecordScope is the scope audit records are stored in. Records are grouped by the UTC day they were written on,
+	// in a scope nested in RecordScope, so that a query only reads the days it selects.
+	RecordScope = "/planes/radius/local"
+
+	// recordDayScopeType is the type of the scope that groups the records written on the same day.
+	recordDayScopeType = "auditDays"
+
+	// recordDayLayout is the layout of the name of the scope that groups the records written on the same day.
+	recordDayLayout = "20060102"
+
+	// DefaultQueryDays is the number of days read by a query of the DatabaseSink that does not select a start time.
+	DefaultQueryDays = 90
+
+	// retentionStateID is the ID of the object that stores the oldest day that may still have records.
+	retentionStateID = RecordScope + "/providers/System.Audit/recordRetention/default"
+)
+
+// retentionState is the state of the retention of the records stored in the database.
+type retentionState struct {
+	// OldestDay is the oldest day that may still have records, formatted with recordDayLayout. Days before it have
+	// no records, so that pruning never reads them again.
+	OldestDay string `json:"oldestDay"`
+}
+
+var _ Sink = (*DatabaseSink)(nil)
+var _ Reader = (*DatabaseSink)(nil)
+
+// DatabaseSink stores audit records in the database.
+//
+// Records are kept for the retention window of the sink. The days that fall out of the window are pruned at most
+// once a day, when a record is written. The oldest day that may still have records is stored in the database, so
+// that each day is pruned once, whatever the number of days between two prunes.
+type DatabaseSink struct {
+	client        database.Client
+	retentionDays int
+
+	// mu guards oldestDay and prunedDay.
+	mu sync.Mutex
+
+	// oldestDay is the oldest day that may still have records. It is zero until read from the database.
+	oldestDay time.Time
+
+	// prunedDay is the day of the last prune.
+	prunedDay time.Time
+}
+
+// NewDatabaseSink creates a new DatabaseSink that deletes the records written more than retentionDays days ago.
+// Records are kept forever when retentionDays is zero or negative.
+func NewDatabaseSink(client database.Client, retentionDays int) *DatabaseSink {
+	return &DatabaseSink{client: client, retentionDays: retentionDays}
+}
+
+// Write stores the audit record, and prunes the days that fell out of the retention window if it was not done
+// today.
+func (s *DatabaseSink) Write(ctx context.Context, record *Record) error {
+	err := s.client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: recordID(record.Timestamp, record.ID)},
+		Data:     record,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save audit record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.trackOldestDay(ctx, startOfDay(record.Timestamp)); err != nil {
+		return err
+	}
+
+	today := startOfDay(time.Now())
+	if s.retentionDays <= 0 || s.prunedDay.Equal(today) {
+		return nil
+	}
+
+	if err := s.prune(ctx, today.AddDate(0, 0, -s.retentionDays)); err != nil {
+		return err
+	}
+
+	s.prunedDay = today
+	return nil
+}
+
+// trackOldestDay makes sure that the stored oldest day is not after the given day, which has a record.
+func (s *DatabaseSink) trackOldestDay(ctx context.Context, day time.Time) error {
+	if s.oldestDay.IsZero() {
+		oldestDay, err := s.getOldestDay(ctx)
+		if err != nil {
+			return err
+		}
+		s.oldestDay = oldestDay
+	}
+
+	if !s.oldestDay.IsZero() && !day.Before(s.oldestDay) {
+		return nil
+	}
+
+	return s.saveOldestDay(ctx, day)
+}
+
+// prune deletes the records written before the cutoff day.
+func (s *DatabaseSink) prune(ctx context.Context, cutoff time.Time) error {
+	if !s.oldestDay.Before(cutoff) {
+		return nil
+	}
+
+	for day := s.oldestDay; day.Before(cutoff); day = day.AddDate(0, 0, 1) {
+		if err := s.deleteDay(ctx, day); err != nil {
+			return err
+		}
+	}
+
+	return s.saveOldestDay(ctx, cutoff)
+}
+
+// deleteDay deletes the records written on the given day.
+func (s *DatabaseSink) deleteDay(ctx context.Context, day time.Time) error {
+	ids := []string{}
+	paginationToken := ""
+	for {
+		result, err := s.client.Query(ctx, database.Query{RootScope: recordDayScope(day), ResourceType: RecordResourceType}, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return fmt.Errorf("failed to query expired audit records: %w", err)
+		}
+
+		for _, item := range result.Items {
+			ids = append(ids, item.ID)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		paginationToken = result.PaginationToken
+	}
+
+	for _, id := range ids {
+		err := s.client.Delete(ctx, id)
+		if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+			return fmt.Errorf("failed to delete expired audit record %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// getOldestDay returns the stored oldest day, or the zero time if none was stored.
+func (s *DatabaseSink) getOldestDay(ctx context.Context) (time.Time, error) {
+	obj, err := s.client.Get(ctx, retentionStateID)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get audit record retention state: %w", err)
+	}
+
+	state := &retentionState{}
+	if err := obj.As(state); err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode audit record retention state: %w", err)
+	}
+
+	day, err := time.Parse(recordDayLayout, state.OldestDay)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse audit record retention state: %w", err)
+	}
+
+	return day, nil
+}
+
+// saveOldestDay stores the oldest day.
+func (s *DatabaseSink) saveOldestDay(ctx context.Context, day time.Time) error {
+	err := s.client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: retentionStateID},
+		Data:     &retentionState{OldestDay: day.UTC().Format(recordDayLayout)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save audit record retention state: %w", err)
+	}
+
+	s.oldestDay = day
+	return nil
+}
+
+// Query returns the stored records selected by the query, newest first.
+//
+// The days selected by the query are read from the newest to the oldest, and reading stops once Top records are
+// found. A query without a start time reads the last DefaultQueryDays days before its end time.
+func (s *DatabaseSink) Query(ctx context.Context, query Query) ([]*Record, error) {
+	until := query.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	since := query.Since
+	if since.IsZero() {
+		since = until.AddDate(0, 0, -DefaultQueryDays)
+	}
+
+	records := []*Record{}
+	first := startOfDay(since)
+	for day := startOfDay(until); !day.Before(first); day = day.AddDate(0, 0, -1) {
+		dayRecords, err := s.queryDay(ctx, day)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, selectRecords(dayRecords, Query{ResourceID: query.ResourceID, Since: query.Since, Until: query.Until})...)
+		if query.Top > 0 && len(records) >= query.Top {
+			break
+		}
+	}
+
+	return selectRecords(records, query), nil
+}
+
+// queryDay returns the records written on the given day.
+func (s *DatabaseSink) queryDay(ctx context.Context, day time.Time) ([]*Record, error) {
+	records := []*Record{}
+	paginationToken := ""
+	for {
+		result, err := s.client.Query(ctx, database.Query{RootScope: recordDayScope(day), ResourceType: RecordResourceType}, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query audit records: %w", err)
+		}
+
+		for _, item := range result.Items {
+			record := &Record{}
+			if err := item.As(record); err != nil {
+				return nil, fmt.Errorf("failed to decode audit record %q: %w", item.ID, err)
+			}
+			records = append(records, record)
+		}
+
+		if result.PaginationToken == "" {
+			return records, nil
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+// startOfDay returns the start of the UTC day of t.
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// recordDayScope returns the scope of the records written on the UTC day of t.
+func recordDayScope(t time.Time) string {
+	return RecordScope + "/" + recordDayScopeType + "/" + t.UTC().Format(recordDayLayout)
+}
+
+func recordID(timestamp time.Time, id string) string {
+	return recordDayScope(timestamp) + "/providers/" + RecordResourceType + "/" + id
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DatabaseSink_WriteAndQuery(t *testing.T) {
+	sink := NewDatabaseSink(inmemory.NewClient(), 0)
+
+	now := time.Now().UTC()
+	records := []*Record{
+		{ID: "1", Timestamp: now.Add(-time.Hour), ResourceID: "/planes/radius/local/resourceGroups/a/providers/Applications.Core/containers/c", StatusCode: 200},
+		{ID: "2", Timestamp: now, ResourceID: "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/c", StatusCode: 201},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	selected, err := sink.Query(context.Background(), Query{})
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	require.Equal(t, "2", selected[0].ID)
+	require.Equal(t, "1", selected[1].ID)
+
+	selected, err = sink.Query(context.Background(), Query{ResourceID: "/planes/radius/local/resourceGroups/a"})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, records[0].ResourceID, selected[0].ResourceID)
+	require.Equal(t, 200, selected[0].StatusCode)
+}
+
+func Test_DatabaseSink_Query_Days(t *testing.T) {
+	sink := NewDatabaseSink(inmemory.NewClient(), 0)
+
+	now := time.Now().UTC()
+	records := []*Record{
+		{ID: "today", Timestamp: now},
+		{ID: "two-days-ago", Timestamp: now.AddDate(0, 0, -2)},
+		{ID: "expired", Timestamp: now.AddDate(0, 0, -DefaultQueryDays-10)},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+
+	ids := func(records []*Record) []string {
+		result := []string{}
+		for _, record := range records {
+			result = append(result, record.ID)
+		}
+		return result
+	}
+
+	// Without a start time, only the last DefaultQueryDays days are read.
+	selected, err := sink.Query(context.Background(), Query{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"today", "two-days-ago"}, ids(selected))
+
+	selected, err = sink.Query(context.Background(), Query{Since: now.AddDate(0, 0, -DefaultQueryDays-20)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"today", "two-days-ago", "expired"}, ids(selected))
+
+	selected, err = sink.Query(context.Background(), Query{Until: now.AddDate(0, 0, -1)})
+	require.NoError(t, err)
+	require.Equal(t, []string{"two-days-ago"}, ids(selected))
+
+	selected, err = sink.Query(context.Background(), Query{Top: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"today"}, ids(selected))
+}
+
+func Test_DatabaseSink_Write_PrunesExpiredDays(t *testing.T) {
+	client := inmemory.NewClient()
+	now := time.Now().UTC()
+
+	// Records written while retention was disabled are pruned once it is enabled.
+	unlimited := NewDatabaseSink(client, 0)
+	for _, record := range []*Record{
+		{ID: "ten-days-ago", Timestamp: now.AddDate(0, 0, -10)},
+		{ID: "five-days-ago", Timestamp: now.AddDate(0, 0, -5)},
+		{ID: "two-days-ago", Timestamp: now.AddDate(0, 0, -2)},
+	} {
+		require.NoError(t, unlimited.Write(context.Background(), record))
+	}
+
+	sink := NewDatabaseSink(client, 3)
+	require.NoError(t, sink.Write(context.Background(), &Record{ID: "today", Timestamp: now}))
+
+	ids := func() []string {
+		selected, err := sink.Query(context.Background(), Query{Since: now.AddDate(0, 0, -30)})
+		require.NoError(t, err)
+
+		result := []string{}
+		for _, record := range selected {
+			result = append(result, record.ID)
+		}
+		return result
+	}
+	require.Equal(t, []string{"today", "two-days-ago"}, ids())
+
+	oldestDay, err := sink.getOldestDay(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, startOfDay(now).AddDate(0, 0, -3), oldestDay)
+
+	// Expired days are pruned once a day.
+	require.NoError(t, unlimited.Write(context.Background(), &Record{ID: "late", Timestamp: now.AddDate(0, 0, -7)}))
+	require.NoError(t, sink.Write(context.Background(), &Record{ID: "later-today", Timestamp: now}))
+	require.ElementsMatch(t, []string{"later-today", "today", "two-days-ago", "late"}, ids())
+}
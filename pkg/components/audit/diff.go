@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// Diff returns the changes between two versions of a stored resource. The 'properties' and 'tags' of the
+// resource are compared. Sensitive field paths are relative to 'properties', as returned by
+// schema.ExtractSensitiveFieldPaths, and the values of those fields are replaced with RedactedValue.
+func Diff(before map[string]any, after map[string]any, sensitiveFieldPaths []string) []Change {
+	redactedBefore := redact(before, sensitiveFieldPaths)
+	redactedAfter := redact(after, sensitiveFieldPaths)
+
+	changes := []Change{}
+	for _, key := range []string{"properties", "tags"} {
+		diffValues([]string{key}, before[key], after[key], func(path []string, old any, new any) {
+			changes = append(changes, Change{
+				Path: strings.Join(path, "."),
+				Old:  redactedValue(redactedBefore, path, old),
+				New:  redactedValue(redactedAfter, path, new),
+			})
+		})
+	}
+
+	return changes
+}
+
+// diffValues calls fn for every leaf that differs between old and new. Objects are compared field by field,
+// any other values, including arrays, are compared as a whole.
+func diffValues(path []string, old any, new any, fn func(path []string, old any, new any)) {
+	oldMap, oldIsMap := old.(map[string]any)
+	newMap, newIsMap := new.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		if !reflect.DeepEqual(old, new) {
+			fn(path, old, new)
+		}
+		return
+	}
+
+	keys := []string{}
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diffValues(append(append([]string{}, path...), key), oldMap[key], newMap[key], fn)
+	}
+}
+
+// redact returns a copy of the resource with the sensitive fields of its properties set to nil.
+func redact(resource map[string]any, sensitiveFieldPaths []string) map[string]any {
+	copied, _ := deepCopy(resource).(map[string]any)
+	if properties, ok := copied["properties"].(map[string]any); ok {
+		schema.RedactFields(properties, sensitiveFieldPaths)
+	}
+
+	return copied
+}
+
+// redactedValue returns the value at path in the redacted resource. Values removed by redaction are replaced
+// with RedactedValue.
+func redactedValue(redacted map[string]any, path []string, original any) any {
+	if original == nil {
+		return nil
+	}
+
+	var current any = redacted
+	for _, key := range path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return RedactedValue
+		}
+		current = m[key]
+	}
+
+	return mask(original, current)
+}
+
+// mask replaces the values that are set in original but were removed from redacted with RedactedValue.
+func mask(original any, redacted any) any {
+	if original != nil && redacted == nil {
+		return RedactedValue
+	}
+
+	switch r := redacted.(type) {
+	case map[string]any:
+		o, _ := original.(map[string]any)
+		for key, value := range r {
+			r[key] = mask(o[key], value)
+		}
+	case []any:
+		o, _ := original.([]any)
+		for i, value := range r {
+			if i < len(o) {
+				r[i] = mask(o[i], value)
+			}
+		}
+	}
+
+	return redacted
+}
+
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, item := range v {
+			copied[key] = deepCopy(item)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, item := range v {
+			copied[i] = deepCopy(item)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diff(t *testing.T) {
+	before := map[string]any{
+		"id": "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/c",
+		"properties": map[string]any{
+			"container": map[string]any{
+				"image": "nginx:1",
+				"ports": []any{80},
+			},
+			"secret":  "old-secret",
+			"removed": "gone",
+		},
+		"tags": map[string]any{"env": "dev"},
+	}
+	after := map[string]any{
+		"id": "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/c",
+		"properties": map[string]any{
+			"container": map[string]any{
+				"image": "nginx:2",
+				"ports": []any{80, 443},
+			},
+			"secret": "new-secret",
+			"added":  "here",
+		},
+		"tags": map[string]any{"env": "prod"},
+	}
+
+	changes := Diff(before, after, []string{"secret"})
+	require.Equal(t, []Change{
+		{Path: "properties.added", New: "here"},
+		{Path: "properties.container.image", Old: "nginx:1", New: "nginx:2"},
+		{Path: "properties.container.ports", Old: []any{80}, New: []any{80, 443}},
+		{Path: "properties.removed", Old: "gone"},
+		{Path: "properties.secret", Old: RedactedValue, New: RedactedValue},
+		{Path: "tags.env", Old: "dev", New: "prod"},
+	}, changes)
+
+	// The inputs are not modified by redaction.
+	require.Equal(t, "old-secret", before["properties"].(map[string]any)["secret"])
+}
+
+func Test_Diff_NestedSensitiveField(t *testing.T) {
+	before := map[string]any{
+		"properties": map[string]any{
+			"credentials": map[string]any{"password": "a", "username": "admin"},
+		},
+	}
+	after := map[string]any{
+		"properties": map[string]any{
+			"credentials": map[string]any{"password": "b", "username": "root"},
+		},
+	}
+
+	changes := Diff(before, after, []string{"credentials.password"})
+	require.Equal(t, []Change{
+		{Path: "properties.credentials.password", Old: RedactedValue, New: RedactedValue},
+		{Path: "properties.credentials.username", Old: "admin", New: "root"},
+	}, changes)
+}
+
+func Test_Diff_Created(t *testing.T) {
+	after := map[string]any{
+		"properties": map[string]any{
+			"data": map[string]any{"key": "value"},
+		},
+	}
+
+	changes := Diff(nil, after, []string{"data"})
+	require.Equal(t, []Change{
+		{Path: "properties", New: map[string]any{"data": RedactedValue}},
+	}, changes)
+}
+
+func Test_Diff_RedactAll(t *testing.T) {
+	before := map[string]any{"properties": map[string]any{"a": "1"}}
+	after := map[string]any{"properties": map[string]any{"a": "2"}}
+
+	changes := Diff(before, after, allFieldPaths)
+	require.Equal(t, []Change{
+		{Path: "properties.a", Old: RedactedValue, New: RedactedValue},
+	}, changes)
+}
+
+func Test_Diff_NoChanges(t *testing.T) {
+	resource := map[string]any{"properties": map[string]any{"a": "1"}}
+	require.Empty(t, Diff(resource, resource, nil))
+}
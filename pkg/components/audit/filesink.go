@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+var _ Sink = (*FileSink)(nil)
+var _ Reader = (*FileSink)(nil)
+
+// FileSink appends audit records to a file as JSON lines. When the file would grow beyond the maximum size it is
+// rotated: the file is renamed to '<path>.1', existing backups are shifted to '<path>.2' and so on, and the
+// oldest backup beyond the maximum number of backups is removed.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu sync.Mutex
+}
+
+// NewFileSink creates a new FileSink writing to path.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of the audit log: %w", err)
+	}
+
+	return &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}, nil
+}
+
+// Write appends the audit record to the file, rotating the file first if needed.
+func (s *FileSink) Write(ctx context.Context, record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err == nil && info.Size() > 0 && info.Size()+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate the audit log: %w", err)
+		}
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the audit log: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(line)
+	return err
+}
+
+// Query reads the records of the file and its backups and returns those selected by the query, newest first.
+func (s *FileSink) Query(ctx context.Context, query Query) ([]*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := []*Record{}
+	for i := s.maxBackups; i >= 0; i-- {
+		read, err := readRecords(s.backupPath(i))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, read...)
+	}
+
+	return selectRecords(records, query), nil
+}
+
+func (s *FileSink) rotate() error {
+	if s.maxBackups <= 0 {
+		return os.Remove(s.path)
+	}
+
+	if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	for i := s.maxBackups - 1; i >= 0; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backupPath returns the path of the nth backup. The 0th backup is the current file.
+func (s *FileSink) backupPath(n int) string {
+	if n == 0 {
+		return s.path
+	}
+
+	return s.path + "." + strconv.Itoa(n)
+}
+
+func readRecords(path string) ([]*Record, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records := []*Record{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, fmt.Errorf("failed to decode audit record in %q: %w", path, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileSink_WriteAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	sink, err := NewFileSink(path, 1024*1024, 2)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	for i := 0; i < 3; i++ {
+		err := sink.Write(context.Background(), &Record{
+			ID:         strconv.Itoa(i),
+			Timestamp:  now.Add(time.Duration(i) * time.Minute),
+			ResourceID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/c" + strconv.Itoa(i),
+		})
+		require.NoError(t, err)
+	}
+
+	records, err := sink.Query(context.Background(), Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "2", records[0].ID)
+	require.Equal(t, "0", records[2].ID)
+
+	records, err = sink.Query(context.Background(), Query{ResourceID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/c1"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "1", records[0].ID)
+}
+
+func Test_FileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// Every record is larger than the maximum size, so each write rotates the file.
+	sink, err := NewFileSink(path, 10, 2)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	for i := 0; i < 5; i++ {
+		err := sink.Write(context.Background(), &Record{ID: strconv.Itoa(i), Timestamp: now.Add(time.Duration(i) * time.Minute)})
+		require.NoError(t, err)
+	}
+
+	require.FileExists(t, path)
+	require.FileExists(t, path+".1")
+	require.FileExists(t, path+".2")
+	require.NoFileExists(t, path+".3")
+
+	// The oldest records were removed with the oldest backups.
+	records, err := sink.Query(context.Background(), Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "4", records[0].ID)
+	require.Equal(t, "2", records[2].ID)
+}
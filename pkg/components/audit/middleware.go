@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var (
+	// allFieldPaths redacts every property. It is used when the sensitive fields of a resource cannot be determined.
+	allFieldPaths = []string{"[*]"}
+
+	// defaultSensitiveFieldPaths are redacted in the properties of every resource. They cover the secrets of the
+	// built-in resource types, which do not have a schema with sensitive field annotations.
+	defaultSensitiveFieldPaths = []string{"secrets", "data"}
+)
+
+// SensitiveFieldPathsFunc returns the paths of the sensitive fields of a resource, relative to its properties.
+type SensitiveFieldPathsFunc func(ctx context.Context, id resources.ID, apiVersion string) ([]string, error)
+
+// MiddlewareOptions configures the audit middleware.
+type MiddlewareOptions struct {
+	// Sink is the sink audit records are written to.
+	Sink Sink
+
+	// Source is the name of the service recorded in audit records, for example 'ucp'.
+	Source string
+
+	// DatabaseClient is used to read the stored resource before and after the operation to record the changes.
+	// Changes are not recorded when it is nil.
+	DatabaseClient database.Client
+
+	// SensitiveFieldPaths returns the sensitive fields of a resource. When nil, only the 'secrets' and 'data'
+	// properties used by the built-in resource types are redacted.
+	SensitiveFieldPaths SensitiveFieldPathsFunc
+
+	// Authenticator identifies the caller recorded in audit records. When nil, every caller is recorded as
+	// anonymous.
+	Authenticator *authorization.Authenticator
+
+	// PathBase is the base path of the server, removed from the request path to get the resource ID. When empty,
+	// it is parsed from the request path.
+	PathBase string
+}
+
+// Middleware returns a middleware that writes an audit record for every mutating request (PUT, PATCH, DELETE
+// and POST). It must run after the ARM request context middleware.
+//
+// The resource ID is parsed from the request path rather than taken from the ARM request context because the
+// ARM request context prefers the Referer header, which is controlled by the caller.
+//
+// Failures to write the audit record are logged and do not fail the request.
+func Middleware(options MiddlewareOptions) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if !isMutating(r.Method) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			rpcCtx := v1.ARMRequestContextFromContext(ctx)
+			id := requestResourceID(r, options.PathBase)
+
+			before := readState(ctx, options.DatabaseClient, id)
+
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			h.ServeHTTP(recorder, r)
+
+			// The request may have been cancelled by the client, the record is still written.
+			ctx = context.WithoutCancel(ctx)
+			logger := ucplog.FromContextOrDiscard(ctx)
+
+			record := &Record{
+				ID:            uuid.New().String(),
+				Timestamp:     time.Now().UTC(),
+				Source:        options.Source,
+				OperationType: rpcCtx.OperationType.String(),
+				Method:        r.Method,
+				ResourceID:    id.String(),
+				APIVersion:    rpcCtx.APIVersion,
+				CorrelationID: rpcCtx.CorrelationID,
+				StatusCode:    recorder.statusCode,
+			}
+
+			principal := options.Authenticator.PrincipalFromRequest(r)
+			record.User = principal.User
+			record.Groups = principal.Groups
+
+			if record.ResourceID == "" {
+				// Requests that do not target a resource, such as the administrative APIs, are recorded by path.
+				record.ResourceID = r.URL.Path
+			}
+			if rpcCtx.OperationType.Type == "" {
+				record.OperationType = v1.OperationType{Type: id.Type(), Method: v1.OperationMethod(r.Method)}.String()
+			}
+
+			after := readState(ctx, options.DatabaseClient, id)
+			if before != nil || after != nil {
+				record.Changes = Diff(before, after, sensitiveFieldPaths(ctx, options.SensitiveFieldPaths, id, rpcCtx.APIVersion))
+			}
+
+			if err := options.Sink.Write(ctx, record); err != nil {
+				logger.Error(err, "failed to write audit record", "resourceId", record.ResourceID, "operationType", record.OperationType)
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// requestResourceID returns the ID of the resource targeted by the request path, or an empty ID if the path is not
+// a resource ID.
+func requestResourceID(r *http.Request, pathBase string) resources.ID {
+	if pathBase == "" {
+		pathBase = v1.ParsePathBase(r.URL.Path)
+	}
+
+	id, err := resources.ParseByMethod(strings.TrimPrefix(r.URL.Path, pathBase), r.Method)
+	if err != nil {
+		return resources.ID{}
+	}
+
+	return id
+}
+
+func sensitiveFieldPaths(ctx context.Context, fn SensitiveFieldPathsFunc, id resources.ID, apiVersion string) []string {
+	paths := append([]string{}, defaultSensitiveFieldPaths...)
+	if fn == nil {
+		return paths
+	}
+
+	found, err := fn(ctx, id, apiVersion)
+	if err != nil {
+		// Fail-safe: redact everything rather than risk recording sensitive values.
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to fetch sensitive field paths for audit, redacting all properties", "resourceId", id.String())
+		return allFieldPaths
+	}
+
+	return append(paths, found...)
+}
+
+// readState returns the stored resource with the given ID, or nil if it does not exist or cannot be read.
+func readState(ctx context.Context, client database.Client, id resources.ID) map[string]any {
+	if client == nil || !id.IsResource() {
+		return nil
+	}
+
+	obj, err := client.Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, fmt.Sprintf("failed to read %q for audit", id.String()))
+		return nil
+	}
+
+	state := map[string]any{}
+	if err := obj.As(&state); err != nil {
+		return nil
+	}
+
+	return state
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	}
+
+	return false
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush flushes the response if the underlying writer supports it.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/r1"
+
+type testSink struct {
+	records []*Record
+	err     error
+}
+
+func (s *testSink) Write(ctx context.Context, record *Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func newTestHandler(options MiddlewareOptions, handler http.HandlerFunc) http.Handler {
+	return servicecontext.ARMRequestCtx("", "global")(Middleware(options)(handler))
+}
+
+// newFrontProxyCertificate returns a self-signed front proxy client certificate and an authenticator that
+// trusts it.
+func newFrontProxyCertificate(t *testing.T) (*x509.Certificate, *authorization.Authenticator) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "front-proxy-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+	return certificate, authorization.NewAuthenticator(pool, nil, nil)
+}
+
+func Test_Middleware_RecordsChanges(t *testing.T) {
+	client := inmemory.NewClient()
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testResourceID},
+		Data: map[string]any{
+			"id":         testResourceID,
+			"properties": map[string]any{"value": "old", "password": "old-password"},
+		},
+	})
+	require.NoError(t, err)
+
+	certificate, authenticator := newFrontProxyCertificate(t)
+
+	sink := &testSink{}
+	handler := newTestHandler(MiddlewareOptions{
+		Sink:           sink,
+		Source:         "ucp",
+		DatabaseClient: client,
+		Authenticator:  authenticator,
+		SensitiveFieldPaths: func(ctx context.Context, id resources.ID, apiVersion string) ([]string, error) {
+			require.Equal(t, "2023-01-01", apiVersion)
+			return []string{"password"}, nil
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		err := client.Save(r.Context(), &database.Object{
+			Metadata: database.Metadata{ID: testResourceID},
+			Data: map[string]any{
+				"id":         testResourceID,
+				"properties": map[string]any{"value": "new", "password": "new-password"},
+			},
+		})
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPut, testResourceID+"?api-version=2023-01-01", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+	req.Header.Set(authorization.RemoteUserHeader, "alice")
+	req.Header.Set(authorization.RemoteGroupHeader, "admins")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Len(t, sink.records, 1)
+
+	record := sink.records[0]
+	require.NotEmpty(t, record.ID)
+	require.False(t, record.Timestamp.IsZero())
+	require.Equal(t, "ucp", record.Source)
+	require.Equal(t, "alice", record.User)
+	require.Equal(t, []string{"admins"}, record.Groups)
+	require.Equal(t, "APPLICATIONS.TEST/TESTRESOURCES|PUT", record.OperationType)
+	require.Equal(t, http.MethodPut, record.Method)
+	require.Equal(t, testResourceID, record.ResourceID)
+	require.Equal(t, "2023-01-01", record.APIVersion)
+	require.Equal(t, http.StatusCreated, record.StatusCode)
+	require.Equal(t, []Change{
+		{Path: "properties.password", Old: RedactedValue, New: RedactedValue},
+		{Path: "properties.value", Old: "old", New: "new"},
+	}, record.Changes)
+}
+
+func Test_Middleware_SpoofedHeadersRecordedAsAnonymous(t *testing.T) {
+	_, authenticator := newFrontProxyCertificate(t)
+
+	sink := &testSink{}
+	handler := newTestHandler(MiddlewareOptions{Sink: sink, Authenticator: authenticator}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// The headers are not sent by the front proxy, so they must not be recorded as the caller.
+	req := httptest.NewRequest(http.MethodDelete, testResourceID+"?api-version=2023-01-01", nil)
+	req.Header.Set(authorization.RemoteUserHeader, "alice")
+	req.Header.Set(authorization.RemoteGroupHeader, "system:masters")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, sink.records, 1)
+	require.Equal(t, authorization.AnonymousUser, sink.records[0].User)
+	require.Equal(t, []string{authorization.UnauthenticatedGroup}, sink.records[0].Groups)
+}
+
+func Test_Middleware_SpoofedRefererIgnored(t *testing.T) {
+	const otherResourceID = "/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/other"
+
+	client := inmemory.NewClient()
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: otherResourceID},
+		Data:     map[string]any{"id": otherResourceID, "properties": map[string]any{"value": "other"}},
+	})
+	require.NoError(t, err)
+
+	sink := &testSink{}
+	handler := newTestHandler(MiddlewareOptions{Sink: sink, DatabaseClient: client}, func(w http.ResponseWriter, r *http.Request) {
+		err := client.Save(r.Context(), &database.Object{
+			Metadata: database.Metadata{ID: testResourceID},
+			Data:     map[string]any{"id": testResourceID, "properties": map[string]any{"value": "new"}},
+		})
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	// The Referer names another resource, but the record must describe the resource of the request path.
+	req := httptest.NewRequest(http.MethodPut, testResourceID+"?api-version=2023-01-01", nil)
+	req.Header.Set(v1.RefererHeader, "http://localhost"+otherResourceID+"?api-version=2023-01-01")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, sink.records, 1)
+	require.Equal(t, testResourceID, sink.records[0].ResourceID)
+	require.Equal(t, []Change{{Path: "properties", Old: nil, New: map[string]any{"value": "new"}}}, sink.records[0].Changes)
+}
+
+func Test_Middleware_SkipsReads(t *testing.T) {
+	sink := &testSink{}
+	handler := newTestHandler(MiddlewareOptions{Sink: sink}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, testResourceID+"?api-version=2023-01-01", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Empty(t, sink.records)
+}
+
+func Test_Middleware_SensitiveFieldPathsError(t *testing.T) {
+	client := inmemory.NewClient()
+	sink := &testSink{}
+	handler := newTestHandler(MiddlewareOptions{
+		Sink:           sink,
+		DatabaseClient: client,
+		SensitiveFieldPaths: func(ctx context.Context, id resources.ID, apiVersion string) ([]string, error) {
+			return nil, errors.New("schema not found")
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		err := client.Save(r.Context(), &database.Object{
+			Metadata: database.Metadata{ID: testResourceID},
+			Data:     map[string]any{"properties": map[string]any{"value": "new"}},
+		})
+		require.NoError(t, err)
+	})
+
+	req := httptest.NewRequest(http.MethodPut, testResourceID+"?api-version=2023-01-01", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, sink.records, 1)
+	require.Equal(t, []Change{
+		{Path: "properties", New: map[string]any{"value": RedactedValue}},
+	}, sink.records[0].Changes)
+}
+
+func Test_Middleware_SinkErrorDoesNotFailRequest(t *testing.T) {
+	sink := &testSink{err: errors.New("sink unavailable")}
+	handler := newTestHandler(MiddlewareOptions{Sink: sink}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, testResourceID+"?api-version=2023-01-01", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+	require.Len(t, sink.records, 1)
+	require.Equal(t, authorization.AnonymousUser, sink.records[0].User)
+	require.Equal(t, "APPLICATIONS.TEST/TESTRESOURCES|DELETE", sink.records[0].OperationType)
+	require.Empty(t, sink.records[0].Changes)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// RedactedValue replaces the values of sensitive fields in recorded changes.
+	RedactedValue = "[REDACTED]"
+)
+
+var (
+	// ErrQueryUnsupported is returned by sinks that can only write records, such as the webhook sink.
+	ErrQueryUnsupported = errors.New("the audit sink does not support queries")
+)
+
+// Record is an entry of the audit log. A record is written for every mutating operation, whether it succeeded
+// or not.
+type Record struct {
+	// ID is the unique ID of the record.
+	ID string `json:"id"`
+
+	// Timestamp is the time the operation completed.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Source is the name of the service that handled the operation, for example 'ucp'.
+	Source string `json:"source"`
+
+	// User is the name of the caller.
+	User string `json:"user"`
+
+	// Groups is the list of groups of the caller.
+	Groups []string `json:"groups,omitempty"`
+
+	// OperationType is the type of the operation, for example 'APPLICATIONS.CORE/CONTAINERS|PUT'.
+	OperationType string `json:"operationType"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// ResourceID is the ID of the resource the operation was performed on.
+	ResourceID string `json:"resourceId"`
+
+	// APIVersion is the API version of the request.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// CorrelationID is the correlation ID of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// Changes is the list of changes the operation made to the stored resource. Values of sensitive fields
+	// are replaced with RedactedValue.
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Change is a change to a field of a resource.
+type Change struct {
+	// Path is the path of the field, for example 'properties.container.image'.
+	Path string `json:"path"`
+
+	// Old is the value before the operation, or nil if the field was added.
+	Old any `json:"old,omitempty"`
+
+	// New is the value after the operation, or nil if the field was removed.
+	New any `json:"new,omitempty"`
+}
+
+// Query selects audit records.
+type Query struct {
+	// ResourceID selects the records of the resource and of the resources nested in it. Matching is
+	// case-insensitive. All records are selected when empty.
+	ResourceID string
+
+	// Since selects the records written at or after the time. Unbounded when zero.
+	Since time.Time
+
+	// Until selects the records written before the time. Unbounded when zero.
+	Until time.Time
+
+	// Top limits the number of records returned. Unlimited when zero.
+	Top int
+}
+
+// Matches returns true if the record is selected by the query.
+func (q Query) Matches(record *Record) bool {
+	if !q.Since.IsZero() && record.Timestamp.Before(q.Since) {
+		return false
+	}
+
+	if !q.Until.IsZero() && !record.Timestamp.Before(q.Until) {
+		return false
+	}
+
+	if q.ResourceID != "" {
+		scope := strings.ToLower(strings.TrimSuffix(q.ResourceID, "/"))
+		id := strings.ToLower(record.ResourceID)
+		if id != scope && !strings.HasPrefix(id, scope+"/") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sink writes audit records.
+type Sink interface {
+	// Write writes an audit record.
+	Write(ctx context.Context, record *Record) error
+}
+
+// Reader queries audit records.
+type Reader interface {
+	// Query returns the records selected by the query, newest first.
+	Query(ctx context.Context, query Query) ([]*Record, error)
+}
+
+// selectRecords returns the records selected by the query, newest first.
+func selectRecords(records []*Record, query Query) []*Record {
+	selected := []*Record{}
+	for _, record := range records {
+		if query.Matches(record) {
+			selected = append(selected, record)
+		}
+	}
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].Timestamp.After(selected[j].Timestamp)
+	})
+
+	if query.Top > 0 && len(selected) > query.Top {
+		selected = selected[:query.Top]
+	}
+
+	return selected
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Query_Matches(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	record := &Record{
+		Timestamp:  now,
+		ResourceID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/frontend",
+	}
+
+	tests := []struct {
+		name    string
+		query   Query
+		matches bool
+	}{
+		{name: "empty", query: Query{}, matches: true},
+		{name: "since inclusive", query: Query{Since: now}, matches: true},
+		{name: "since after", query: Query{Since: now.Add(time.Second)}, matches: false},
+		{name: "until exclusive", query: Query{Until: now}, matches: false},
+		{name: "until after", query: Query{Until: now.Add(time.Second)}, matches: true},
+		{name: "same resource", query: Query{ResourceID: record.ResourceID}, matches: true},
+		{name: "case insensitive", query: Query{ResourceID: "/planes/radius/local/resourcegroups/TEST/providers/Applications.Core/containers/frontend"}, matches: true},
+		{name: "scope", query: Query{ResourceID: "/planes/radius/local/resourceGroups/test/"}, matches: true},
+		{name: "segment boundary", query: Query{ResourceID: "/planes/radius/local/resourceGroups/te"}, matches: false},
+		{name: "other resource", query: Query{ResourceID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/backend"}, matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matches, tt.query.Matches(record))
+		})
+	}
+}
+
+func Test_selectRecords(t *testing.T) {
+	now := time.Now().UTC()
+	records := []*Record{
+		{ID: "1", Timestamp: now.Add(-2 * time.Hour)},
+		{ID: "2", Timestamp: now},
+		{ID: "3", Timestamp: now.Add(-time.Hour)},
+	}
+
+	selected := selectRecords(records, Query{Top: 2})
+	require.Equal(t, []*Record{records[1], records[2]}, selected)
+
+	selected = selectRecords(records, Query{Since: now.Add(-90 * time.Minute)})
+	require.Equal(t, []*Record{records[1], records[2]}, selected)
+}
@@ -44,6 +44,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -353,6 +354,90 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 	return err
 }
 
+// ExecuteBatch applies the operations one by one since the API Server does not support transactions across objects.
+// If an operation fails, the operations applied so far are rolled back on a best-effort basis by restoring the state
+// read before the batch was applied. The rollback is not atomic: a concurrent write to the same resources between the
+// batch and the rollback is overwritten.
+func (c *APIServerClient) ExecuteBatch(ctx context.Context, operations []database.BatchOperation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	for _, op := range operations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Read the current state of every object so that the applied operations can be rolled back. A nil entry means
+	// that the object does not exist yet.
+	previous := make([]*database.Object, len(operations))
+	etags := make([]database.ETag, len(operations))
+	for i, op := range operations {
+		obj, err := c.Get(ctx, op.TargetID())
+		if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+			return err
+		}
+		previous[i] = obj
+
+		if op.Kind == database.BatchOperationSave {
+			etags[i] = op.Object.ETag
+		}
+	}
+
+	for i, op := range operations {
+		err := c.apply(ctx, op)
+		if err == nil {
+			continue
+		}
+
+		for j := i - 1; j >= 0; j-- {
+			if rollbackErr := c.rollback(ctx, operations[j].TargetID(), previous[j]); rollbackErr != nil {
+				logger := ucplog.FromContextOrDiscard(ctx)
+				logger.Error(rollbackErr, "failed to roll back batch operation", "id", operations[j].TargetID())
+			}
+		}
+
+		for j, op := range operations {
+			if op.Kind == database.BatchOperationSave {
+				op.Object.ETag = etags[j]
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// apply applies a single batch operation.
+func (c *APIServerClient) apply(ctx context.Context, op database.BatchOperation) error {
+	if op.Options.ETag == "" {
+		if op.Kind == database.BatchOperationSave {
+			return c.Save(ctx, op.Object)
+		}
+		return c.Delete(ctx, op.ID)
+	}
+
+	if op.Kind == database.BatchOperationSave {
+		return c.Save(ctx, op.Object, database.WithETag(op.Options.ETag))
+	}
+	return c.Delete(ctx, op.ID, database.WithETag(op.Options.ETag))
+}
+
+// rollback restores the state of the object with the given id to previous, deleting the object if previous is nil.
+func (c *APIServerClient) rollback(ctx context.Context, id string, previous *database.Object) error {
+	if previous == nil {
+		err := c.Delete(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil
+		}
+		return err
+	}
+
+	return c.Save(ctx, previous)
+}
+
 func (c *APIServerClient) doWithRetry(action func() (bool, error)) error {
 	for range RetryCount {
 		retryable, err := action()
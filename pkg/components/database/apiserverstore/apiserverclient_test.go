@@ -130,6 +130,7 @@ func Test_APIServer_Client(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+	shared.RunChangesTest(t, client, clear)
 
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
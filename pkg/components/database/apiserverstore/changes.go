@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ database.ChangeSource = (*APIServerClient)(nil)
+
+// Changes watches the Resource objects of the namespace and reports the changes to the entries they hold. It returns
+// database.ErrChangesUnsupported if the Kubernetes client cannot watch.
+//
+// The API server ends watches periodically. They are started again from the last resource version that was seen, and
+// when that version has expired the objects are listed again to report the changes made in between. The channel is
+// only closed when ctx is done or when the API server cannot be reached.
+func (c *APIServerClient) Changes(ctx context.Context) (<-chan database.Event, error) {
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, database.ErrChangesUnsupported
+	}
+
+	// A Resource object can hold multiple entries because of hash collisions, and removing one of them modifies the
+	// object. The entries are listed first so that such removals can be reported, and the watch starts from the
+	// resource version of the list so that no change is missed in between.
+	known := map[string]map[string]entryState{}
+	resourceVersion, err := c.listChanges(ctx, wc, known, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := c.watchResources(ctx, wc, resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan database.Event)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		for {
+			var expired bool
+			resourceVersion, expired = c.forwardChanges(ctx, w, known, resourceVersion, out)
+			w.Stop()
+			if ctx.Err() != nil {
+				return
+			}
+
+			if !expired {
+				w, err = c.watchResources(ctx, wc, resourceVersion)
+				expired = apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+				if err != nil && !expired {
+					logger.Error(err, "failed to watch resources")
+					return
+				}
+			}
+
+			if expired {
+				// The changes since the resource version are no longer available from the API server. The objects
+				// are listed again, and the differences with the known entries are reported instead.
+				var changes []database.Event
+				resourceVersion, err = c.listChanges(ctx, wc, known, &changes)
+				if err != nil {
+					logger.Error(err, "failed to list resources")
+					return
+				}
+
+				if !send(ctx, out, changes) {
+					return
+				}
+
+				w, err = c.watchResources(ctx, wc, resourceVersion)
+				if err != nil {
+					logger.Error(err, "failed to watch resources")
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// watchResources watches the Resource objects of the namespace from resourceVersion.
+func (c *APIServerClient) watchResources(ctx context.Context, wc runtimeclient.WithWatch, resourceVersion string) (watch.Interface, error) {
+	return wc.Watch(
+		ctx, &ucpv1alpha1.ResourceList{},
+		runtimeclient.InNamespace(c.namespace),
+		&runtimeclient.ListOptions{Raw: &v1.ListOptions{ResourceVersion: resourceVersion, AllowWatchBookmarks: true}})
+}
+
+// listChanges lists the Resource objects of the namespace and replaces known with their entries. If changes is not nil,
+// the entries that changed since known was updated are appended to it. It returns the resource version of the list.
+func (c *APIServerClient) listChanges(ctx context.Context, wc runtimeclient.WithWatch, known map[string]map[string]entryState, changes *[]database.Event) (string, error) {
+	rs := ucpv1alpha1.ResourceList{}
+	err := wc.List(ctx, &rs, runtimeclient.InNamespace(c.namespace))
+	if err != nil {
+		return "", err
+	}
+
+	listed := map[string]map[string]entryState{}
+	for i := range rs.Items {
+		resource := &rs.Items[i]
+		listed[resource.Name] = entryStates(resource)
+		if changes != nil {
+			*changes = append(*changes, changedEntries(ctx, resource, known[resource.Name], listed[resource.Name])...)
+		}
+	}
+
+	for name, previous := range known {
+		if _, ok := listed[name]; !ok {
+			if changes != nil {
+				*changes = append(*changes, changedEntries(ctx, &ucpv1alpha1.Resource{}, previous, nil)...)
+			}
+			delete(known, name)
+		}
+	}
+
+	for name, current := range listed {
+		known[name] = current
+	}
+
+	return rs.ResourceVersion, nil
+}
+
+// forwardChanges sends an event on out for every entry changed by the events of w, until w or ctx is done. known holds
+// the state of the entries of every Resource object by object name, and is updated as the objects change.
+//
+// It returns the resource version of the last event, or resourceVersion if there was none, and true if the watch
+// failed because the resource version has expired.
+func (c *APIServerClient) forwardChanges(ctx context.Context, w watch.Interface, known map[string]map[string]entryState, resourceVersion string, out chan<- database.Event) (string, bool) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for {
+		var event watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return resourceVersion, false
+		case event, ok = <-w.ResultChan():
+			if !ok {
+				return resourceVersion, false
+			}
+		}
+
+		if event.Type == watch.Error {
+			// Changes could have been missed whatever the error is, so the objects are listed again.
+			logger.Info("watch of resources failed", "error", apierrors.FromObject(event.Object))
+			return resourceVersion, true
+		}
+
+		resource, ok := event.Object.(*ucpv1alpha1.Resource)
+		if !ok {
+			continue
+		}
+
+		if resource.ResourceVersion != "" {
+			resourceVersion = resource.ResourceVersion
+		}
+
+		// Bookmarks only advance the resource version of the watch.
+		if event.Type == watch.Bookmark {
+			continue
+		}
+
+		current := entryStates(resource)
+		if event.Type == watch.Deleted {
+			// The entries of a deleted object may not be known yet if it was created and deleted in quick succession.
+			previous := known[resource.Name]
+			if previous == nil {
+				previous = map[string]entryState{}
+			}
+			for key, state := range current {
+				if _, ok := previous[key]; !ok {
+					previous[key] = state
+				}
+			}
+			known[resource.Name] = previous
+			current = map[string]entryState{}
+		}
+
+		changes := changedEntries(ctx, resource, known[resource.Name], current)
+
+		if event.Type == watch.Deleted {
+			delete(known, resource.Name)
+		} else {
+			known[resource.Name] = current
+		}
+
+		if !send(ctx, out, changes) {
+			return resourceVersion, false
+		}
+	}
+}
+
+// changedEntries returns the changes between the previous and current states of the entries of resource. The entries
+// that are in current with another etag than in previous are reported as saved, and the entries that are no longer in
+// current as deleted.
+func changedEntries(ctx context.Context, resource *ucpv1alpha1.Resource, previous map[string]entryState, current map[string]entryState) []database.Event {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	changes := []database.Event{}
+	for _, entry := range resource.Entries {
+		state, ok := current[strings.ToLower(entry.ID)]
+		if !ok {
+			continue
+		}
+		if old, ok := previous[strings.ToLower(entry.ID)]; ok && old.etag == state.etag {
+			continue
+		}
+
+		obj, err := readEntry(&entry)
+		if err != nil {
+			logger.Error(err, "failed to read changed resource", "id", entry.ID)
+			continue
+		}
+		changes = append(changes, database.Event{Type: database.EventTypeSaved, ID: entry.ID, Object: obj})
+	}
+	for key, state := range previous {
+		if _, ok := current[key]; !ok {
+			changes = append(changes, database.Event{Type: database.EventTypeDeleted, ID: state.id})
+		}
+	}
+
+	return changes
+}
+
+// send sends changes on out. It returns false if ctx is done first.
+func send(ctx context.Context, out chan<- database.Event, changes []database.Event) bool {
+	for _, change := range changes {
+		select {
+		case out <- change:
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
+
+// entryState is the state of an entry of a Resource object that is needed to report its changes.
+type entryState struct {
+	id   string
+	etag string
+}
+
+// entryStates returns the state of the entries of resource by lowercase entry id.
+func entryStates(resource *ucpv1alpha1.Resource) map[string]entryState {
+	states := map[string]entryState{}
+	for _, entry := range resource.Entries {
+		states[strings.ToLower(entry.ID)] = entryState{id: entry.ID, etag: entry.ETag}
+	}
+
+	return states
+}
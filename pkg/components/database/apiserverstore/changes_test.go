@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserverstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testNamespace = "radius-test"
+	testID1       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/resource1"
+	testID2       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/resource2"
+)
+
+func newFakeClient(t *testing.T) runtimeclient.WithWatch {
+	scheme := runtime.NewScheme()
+	require.NoError(t, ucpv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+func receiveChange(t *testing.T, changes <-chan database.Event) database.Event {
+	select {
+	case e, ok := <-changes:
+		require.True(t, ok, "the changes were closed")
+		return e
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for a change")
+		return database.Event{}
+	}
+}
+
+func Test_APIServerClient_Changes(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	client := NewAPIServerClient(newFakeClient(t), testNamespace)
+
+	changes, err := client.Changes(ctx)
+	require.NoError(t, err)
+
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testID1}, Data: map[string]any{"value": "1"}})
+	require.NoError(t, err)
+
+	e := receiveChange(t, changes)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID1, e.ID)
+	require.Equal(t, map[string]any{"value": "1"}, e.Object.Data)
+
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testID1}, Data: map[string]any{"value": "2"}})
+	require.NoError(t, err)
+
+	e = receiveChange(t, changes)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, map[string]any{"value": "2"}, e.Object.Data)
+
+	err = client.Delete(ctx, testID1)
+	require.NoError(t, err)
+
+	e = receiveChange(t, changes)
+	require.Equal(t, database.EventTypeDeleted, e.Type)
+	require.Equal(t, testID1, e.ID)
+	require.Nil(t, e.Object)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-changes
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_APIServerClient_Changes_Collision(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	rc := newFakeClient(t)
+	client := NewAPIServerClient(rc, testNamespace)
+
+	// Simulate a hash collision: both resources are stored in the same object.
+	resource := ucpv1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "collision", Namespace: testNamespace},
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{ID: testID1, ETag: "etag-1", Data: &runtime.RawExtension{Raw: []byte(`{"value":"1"}`)}},
+			{ID: testID2, ETag: "etag-2", Data: &runtime.RawExtension{Raw: []byte(`{"value":"2"}`)}},
+		},
+	}
+	require.NoError(t, rc.Create(ctx, &resource))
+
+	changes, err := client.Changes(ctx)
+	require.NoError(t, err)
+
+	// Updating one entry only reports that entry.
+	resource.Entries[1].ETag = "etag-3"
+	resource.Entries[1].Data = &runtime.RawExtension{Raw: []byte(`{"value":"3"}`)}
+	require.NoError(t, rc.Update(ctx, &resource))
+
+	e := receiveChange(t, changes)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID2, e.ID)
+	require.Equal(t, map[string]any{"value": "3"}, e.Object.Data)
+
+	// Removing an entry modifies the object, and is reported as a deletion.
+	resource.Entries = resource.Entries[1:]
+	require.NoError(t, rc.Update(ctx, &resource))
+
+	e = receiveChange(t, changes)
+	require.Equal(t, database.EventTypeDeleted, e.Type)
+	require.Equal(t, testID1, e.ID)
+}
+
+// controlledWatchClient is a Kubernetes client whose watches are controlled by the test.
+type controlledWatchClient struct {
+	runtimeclient.WithWatch
+
+	// watches receives the watches started by the client, and resourceVersions the resource version they start from.
+	watches          chan *watch.FakeWatcher
+	resourceVersions chan string
+}
+
+func newControlledWatchClient(t *testing.T) *controlledWatchClient {
+	return &controlledWatchClient{
+		WithWatch:        newFakeClient(t),
+		watches:          make(chan *watch.FakeWatcher, 10),
+		resourceVersions: make(chan string, 10),
+	}
+}
+
+func (c *controlledWatchClient) Watch(ctx context.Context, list runtimeclient.ObjectList, opts ...runtimeclient.ListOption) (watch.Interface, error) {
+	options := runtimeclient.ListOptions{}
+	options.ApplyOptions(opts)
+
+	w := watch.NewFake()
+	c.resourceVersions <- options.Raw.ResourceVersion
+	c.watches <- w
+	return w, nil
+}
+
+func (c *controlledWatchClient) nextWatch(t *testing.T) (*watch.FakeWatcher, string) {
+	select {
+	case w := <-c.watches:
+		return w, <-c.resourceVersions
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for a watch")
+		return nil, ""
+	}
+}
+
+func Test_APIServerClient_Changes_WatchEnded(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	rc := newControlledWatchClient(t)
+	client := NewAPIServerClient(rc, testNamespace)
+
+	changes, err := client.Changes(ctx)
+	require.NoError(t, err)
+
+	w, _ := rc.nextWatch(t)
+	w.Add(&ucpv1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "resource1", Namespace: testNamespace, ResourceVersion: "42"},
+		Entries:    []ucpv1alpha1.ResourceEntry{{ID: testID1, ETag: "etag-1", Data: &runtime.RawExtension{Raw: []byte(`{"value":"1"}`)}}},
+	})
+
+	e := receiveChange(t, changes)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID1, e.ID)
+
+	// The API server ends the watch: it is started again from the last resource version.
+	w.Stop()
+
+	w, resourceVersion := rc.nextWatch(t)
+	require.Equal(t, "42", resourceVersion)
+
+	w.Modify(&ucpv1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "resource1", Namespace: testNamespace, ResourceVersion: "43"},
+		Entries:    []ucpv1alpha1.ResourceEntry{{ID: testID1, ETag: "etag-2", Data: &runtime.RawExtension{Raw: []byte(`{"value":"2"}`)}}},
+	})
+
+	e = receiveChange(t, changes)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, map[string]any{"value": "2"}, e.Object.Data)
+}
+
+func Test_APIServerClient_Changes_ResourceVersionExpired(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	rc := newControlledWatchClient(t)
+	client := NewAPIServerClient(rc, testNamespace)
+
+	resource1 := ucpv1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "resource1", Namespace: testNamespace},
+		Entries:    []ucpv1alpha1.ResourceEntry{{ID: testID1, ETag: "etag-1", Data: &runtime.RawExtension{Raw: []byte(`{"value":"1"}`)}}},
+	}
+	require.NoError(t, rc.Create(ctx, &resource1))
+
+	changes, err := client.Changes(ctx)
+	require.NoError(t, err)
+
+	w, _ := rc.nextWatch(t)
+
+	// Changes are made while the resource version of the watch expires.
+	require.NoError(t, rc.Delete(ctx, &resource1))
+	resource2 := ucpv1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "resource2", Namespace: testNamespace},
+		Entries:    []ucpv1alpha1.ResourceEntry{{ID: testID2, ETag: "etag-2", Data: &runtime.RawExtension{Raw: []byte(`{"value":"2"}`)}}},
+	}
+	require.NoError(t, rc.Create(ctx, &resource2))
+
+	w.Error(&metav1.Status{Status: metav1.StatusFailure, Code: 410, Reason: metav1.StatusReasonExpired})
+
+	// The objects are listed again and the differences are reported.
+	received := map[string]database.EventType{}
+	for i := 0; i < 2; i++ {
+		e := receiveChange(t, changes)
+		received[e.ID] = e.Type
+	}
+	require.Equal(t, map[string]database.EventType{testID1: database.EventTypeDeleted, testID2: database.EventTypeSaved}, received)
+
+	// The watch is started again from the resource version of the list.
+	rc.nextWatch(t)
+}
+
+func Test_APIServerClient_Changes_Unsupported(t *testing.T) {
+	// Embedding the interface hides the Watch method of the fake client.
+	client := NewAPIServerClient(struct{ runtimeclient.Client }{newFakeClient(t)}, testNamespace)
+
+	_, err := client.Changes(context.Background())
+	require.ErrorIs(t, err, database.ErrChangesUnsupported)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import "fmt"
+
+// BatchOperationKind is the kind of a write in a batch.
+type BatchOperationKind string
+
+const (
+	// BatchOperationSave saves an object. It has the same semantics as Client.Save.
+	BatchOperationSave BatchOperationKind = "Save"
+
+	// BatchOperationDelete deletes an object. It has the same semantics as Client.Delete.
+	BatchOperationDelete BatchOperationKind = "Delete"
+)
+
+// BatchOperation is a single write in a batch passed to Client.ExecuteBatch. Use NewSaveOperation or
+// NewDeleteOperation to create one.
+type BatchOperation struct {
+	// Kind is the kind of the write.
+	Kind BatchOperationKind
+
+	// Object is the object to save. Object is required for BatchOperationSave. The ETag field of Object is
+	// updated once the batch has been written.
+	Object *Object
+
+	// ID is the resource id of the object to delete. ID is required for BatchOperationDelete.
+	ID string
+
+	// Options are the options of the write, such as the ETag for optimistic concurrency control.
+	Options DatabaseOptions
+}
+
+// NewSaveOperation creates a batch operation that saves obj.
+func NewSaveOperation(obj *Object, options ...SaveOptions) BatchOperation {
+	return BatchOperation{Kind: BatchOperationSave, Object: obj, Options: NewSaveConfig(options...)}
+}
+
+// NewDeleteOperation creates a batch operation that deletes the object with the given id.
+func NewDeleteOperation(id string, options ...DeleteOptions) BatchOperation {
+	return BatchOperation{Kind: BatchOperationDelete, ID: id, Options: NewDeleteConfig(options...)}
+}
+
+// TargetID returns the resource id of the object written by the operation.
+func (o BatchOperation) TargetID() string {
+	if o.Kind == BatchOperationSave && o.Object != nil {
+		return o.Object.ID
+	}
+	return o.ID
+}
+
+// Validate validates the BatchOperation.
+func (o BatchOperation) Validate() error {
+	switch o.Kind {
+	case BatchOperationSave:
+		if o.Object == nil {
+			return &ErrInvalid{Message: "invalid argument. 'Object' is required for Save operations"}
+		}
+	case BatchOperationDelete:
+		if o.ID == "" {
+			return &ErrInvalid{Message: "invalid argument. 'ID' is required for Delete operations"}
+		}
+	default:
+		return &ErrInvalid{Message: fmt.Sprintf("invalid argument. unsupported batch operation kind %q", o.Kind)}
+	}
+
+	return nil
+}
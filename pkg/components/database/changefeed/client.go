@@ -0,0 +1,401 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changefeed
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// DefaultBufferSize is the default number of changes kept to resume watches.
+	DefaultBufferSize = 1000
+
+	tokenSeparator = "."
+
+	reasonOtherEpoch = "the token was returned by another replica, or before the change feed was restarted. " +
+		"Resume tokens are only valid on the replica that returned them"
+	reasonTrimmed = "the changes since the token are no longer kept"
+)
+
+var _ database.Client = (*Client)(nil)
+var _ database.Watcher = (*Client)(nil)
+
+// Client is a database.Client that publishes changes to watchers. Reads are passed through to the wrapped client.
+//
+// When the wrapped client implements database.ChangeSource, the changes are read from the database, so watchers see
+// the changes made by every process sharing it. Otherwise only the changes made through this client are published.
+type Client struct {
+	database.Client
+
+	// source reports the changes made to the database. It is nil when the changes made through the client are
+	// published instead.
+	source     database.ChangeSource
+	bufferSize int
+
+	// followMu is held while starting and stopping to follow source, and while watches start.
+	followMu sync.Mutex
+	// following is true while the changes reported by source are published.
+	following bool
+
+	mu sync.Mutex
+	// epoch identifies the lifetime of the change feed. Resume tokens from another epoch have expired.
+	epoch string
+	// entries holds the latest changes, ordered by sequence.
+	entries []entry
+	// last is the sequence of the latest change.
+	last uint64
+	// changed is closed and replaced when a change is published.
+	changed chan struct{}
+}
+
+type entry struct {
+	sequence uint64
+	event    database.Event
+}
+
+// New creates a new Client wrapping client. bufferSize is the number of changes kept to resume watches; the
+// default is used when it is not positive.
+func New(client database.Client, bufferSize int) *Client {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	source, _ := client.(database.ChangeSource)
+	return &Client{
+		Client:     client,
+		source:     source,
+		epoch:      newEpoch(),
+		bufferSize: bufferSize,
+		changed:    make(chan struct{}),
+	}
+}
+
+// Save implements database.Client.
+func (c *Client) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if err := c.Client.Save(ctx, obj, options...); err != nil {
+		return err
+	}
+
+	// The change is reported by the source when there is one.
+	if c.source == nil {
+		c.publish(savedEvent(obj))
+	}
+	return nil
+}
+
+// Delete implements database.Client.
+func (c *Client) Delete(ctx context.Context, id string, options ...database.DeleteOptions) error {
+	if err := c.Client.Delete(ctx, id, options...); err != nil {
+		return err
+	}
+
+	if c.source == nil {
+		c.publish(database.Event{Type: database.EventTypeDeleted, ID: id})
+	}
+	return nil
+}
+
+// ExecuteBatch implements database.Client.
+func (c *Client) ExecuteBatch(ctx context.Context, operations []database.BatchOperation) error {
+	if err := c.Client.ExecuteBatch(ctx, operations); err != nil {
+		return err
+	}
+
+	if c.source != nil {
+		return nil
+	}
+
+	events := make([]database.Event, 0, len(operations))
+	for _, operation := range operations {
+		if operation.Kind == database.BatchOperationSave {
+			events = append(events, savedEvent(operation.Object))
+		} else {
+			events = append(events, database.Event{Type: database.EventTypeDeleted, ID: operation.ID})
+		}
+	}
+
+	c.publish(events...)
+	return nil
+}
+
+// Watch implements database.Watcher.
+func (c *Client) Watch(ctx context.Context, query database.Query, resumeToken string) (<-chan database.Event, error) {
+	if err := query.Validate(); err != nil {
+		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
+	}
+
+	epoch, start, err := c.start(resumeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan database.Event)
+	go func() {
+		defer close(events)
+
+		// Changes made while the current objects are listed are sent afterwards, so the watcher may see an object
+		// twice but never misses a change.
+		if resumeToken == "" && !c.sendCurrent(ctx, query, formatToken(epoch, start), events) {
+			return
+		}
+
+		last := start
+		for {
+			c.mu.Lock()
+			// The feed was reset because changes may have been missed.
+			current := c.epoch == epoch
+			available := c.available(last)
+			pending := c.since(last)
+			changed := c.changed
+			c.mu.Unlock()
+
+			// The watcher fell behind the changes kept in the buffer.
+			if !current || !available {
+				return
+			}
+
+			for _, e := range pending {
+				last = e.sequence
+				if !matches(e.event, query) {
+					continue
+				}
+
+				select {
+				case events <- e.event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// start returns the epoch and the sequence a watch starts from. The source is followed before the sequence is read, so
+// that the changes made after it are published.
+func (c *Client) start(resumeToken string) (string, uint64, error) {
+	c.followMu.Lock()
+	defer c.followMu.Unlock()
+
+	if c.source != nil && !c.following {
+		if err := c.follow(); err != nil {
+			return "", 0, err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	start := c.last
+	if resumeToken != "" {
+		epoch, sequence, ok := parseToken(resumeToken)
+		if !ok {
+			return "", 0, &database.ErrResumeTokenExpired{Token: resumeToken, Reason: "the token is invalid"}
+		}
+		if epoch != c.epoch || sequence > c.last {
+			return "", 0, &database.ErrResumeTokenExpired{Token: resumeToken, Reason: reasonOtherEpoch}
+		}
+		if !c.available(sequence) {
+			return "", 0, &database.ErrResumeTokenExpired{Token: resumeToken, Reason: reasonTrimmed}
+		}
+		start = sequence
+	}
+
+	return c.epoch, start, nil
+}
+
+// follow publishes the changes reported by the source until it fails. Sources recover from the interruptions they can
+// detect, such as the end of a watch, so changes may have been missed at that point. The feed is reset and the source
+// is followed again by the next watch. c.followMu must be held.
+func (c *Client) follow() error {
+	// The source outlives the watch that started it, so that interrupted watches can be resumed.
+	changes, err := c.source.Changes(context.Background())
+	if err != nil {
+		return err
+	}
+	c.following = true
+
+	go func() {
+		for e := range changes {
+			c.publish(e)
+		}
+
+		c.followMu.Lock()
+		defer c.followMu.Unlock()
+
+		c.reset()
+		c.following = false
+	}()
+
+	return nil
+}
+
+// sendCurrent sends an EventTypeSaved event for every object matching the query. It returns false if the watch
+// should stop.
+func (c *Client) sendCurrent(ctx context.Context, query database.Query, resumeToken string, events chan<- database.Event) bool {
+	paginationToken := ""
+	for {
+		result, err := c.Client.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return false
+		}
+
+		for i := range result.Items {
+			item := &result.Items[i]
+			select {
+			case events <- database.Event{Type: database.EventTypeSaved, ID: item.ID, Object: item, ResumeToken: resumeToken}:
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return true
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+// publish appends events to the buffer and wakes up watchers.
+func (c *Client) publish(events ...database.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range events {
+		c.last++
+		e.ResumeToken = c.token(c.last)
+		c.entries = append(c.entries, entry{sequence: c.last, event: e})
+	}
+
+	// Trim in batches to avoid copying the buffer on every change.
+	if len(c.entries) > 2*c.bufferSize {
+		c.entries = append([]entry(nil), c.entries[len(c.entries)-c.bufferSize:]...)
+	}
+
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// reset discards the buffered changes and starts a new epoch, which closes the watches and expires their resume tokens.
+func (c *Client) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch = newEpoch()
+	c.entries = nil
+
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// available returns true if every change after sequence is still in the buffer. c.mu must be held.
+func (c *Client) available(sequence uint64) bool {
+	if sequence == c.last {
+		return true
+	}
+
+	return len(c.entries) > 0 && c.entries[0].sequence <= sequence+1
+}
+
+// since returns the changes after sequence. c.mu must be held.
+func (c *Client) since(sequence uint64) []entry {
+	if len(c.entries) == 0 || sequence >= c.last {
+		return nil
+	}
+
+	index := 0
+	if sequence >= c.entries[0].sequence {
+		index = int(sequence - c.entries[0].sequence + 1)
+	}
+
+	return append([]entry(nil), c.entries[index:]...)
+}
+
+func newEpoch() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")
+}
+
+// token returns the resume token of sequence in the current epoch. c.mu must be held.
+func (c *Client) token(sequence uint64) string {
+	return formatToken(c.epoch, sequence)
+}
+
+func formatToken(epoch string, sequence uint64) string {
+	return epoch + tokenSeparator + strconv.FormatUint(sequence, 10)
+}
+
+func parseToken(token string) (string, uint64, bool) {
+	epoch, value, ok := strings.Cut(token, tokenSeparator)
+	if !ok || epoch == "" {
+		return "", 0, false
+	}
+
+	sequence, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return epoch, sequence, true
+}
+
+// matches returns true if the event is a change to an object matching the query. Filters are only applied to
+// saved objects: deletions of objects of the matching scope and type are always returned.
+func matches(event database.Event, query database.Query) bool {
+	id, err := resources.Parse(event.ID)
+	if err != nil {
+		return false
+	}
+
+	if !databaseutil.IDMatchesQuery(id, query) {
+		return false
+	}
+
+	if event.Object == nil || len(query.Filters) == 0 {
+		return true
+	}
+
+	match, err := event.Object.MatchesFilters(query.Filters)
+	return err == nil && match
+}
+
+// savedEvent creates the event of a saved object. The object is copied so that later changes made by the caller
+// are not visible to watchers.
+func savedEvent(obj *database.Object) database.Event {
+	copied, err := obj.DeepCopy()
+	if err != nil {
+		copied = obj
+	}
+
+	return database.Event{Type: database.EventTypeSaved, ID: obj.ID, Object: copied}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changefeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+)
+
+const (
+	testScope = "/planes/radius/local/resourceGroups/test"
+	testType  = "Applications.Test/testResources"
+)
+
+func testID(name string) string {
+	return testScope + "/providers/" + testType + "/" + name
+}
+
+func save(t *testing.T, client *Client, id string) {
+	err := client.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"name": id}})
+	require.NoError(t, err)
+}
+
+func receive(t *testing.T, events <-chan database.Event) database.Event {
+	select {
+	case e, ok := <-events:
+		require.True(t, ok, "the watch was closed")
+		return e
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for an event")
+		return database.Event{}
+	}
+}
+
+func Test_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := New(inmemory.NewClient(), 0)
+	save(t, client, testID("existing"))
+
+	events, err := client.Watch(ctx, database.Query{RootScope: testScope, ResourceType: testType}, "")
+	require.NoError(t, err)
+
+	// Current objects are sent first.
+	e := receive(t, events)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID("existing"), e.ID)
+	require.NotNil(t, e.Object)
+
+	// Changes of other scopes and types are filtered out.
+	save(t, client, "/planes/radius/local/resourceGroups/other/providers/"+testType+"/other")
+	save(t, client, testScope+"/providers/Applications.Test/otherResources/other")
+	save(t, client, testID("new"))
+
+	e = receive(t, events)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID("new"), e.ID)
+	require.NotEmpty(t, e.ResumeToken)
+
+	require.NoError(t, client.Delete(ctx, testID("new")))
+	e = receive(t, events)
+	require.Equal(t, database.EventTypeDeleted, e.Type)
+	require.Equal(t, testID("new"), e.ID)
+	require.Nil(t, e.Object)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+}
+
+func Test_Watch_Resume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := New(inmemory.NewClient(), 0)
+	query := database.Query{RootScope: testScope, ResourceType: testType}
+
+	save(t, client, testID("a"))
+	events, err := client.Watch(ctx, query, "")
+	require.NoError(t, err)
+	token := receive(t, events).ResumeToken
+	cancel()
+
+	// Changes made while nobody is watching are returned when resuming.
+	save(t, client, testID("b"))
+	require.NoError(t, client.ExecuteBatch(context.Background(), []database.BatchOperation{
+		database.NewDeleteOperation(testID("a")),
+	}))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	events, err = client.Watch(ctx, query, token)
+	require.NoError(t, err)
+
+	e := receive(t, events)
+	require.Equal(t, database.EventTypeSaved, e.Type)
+	require.Equal(t, testID("b"), e.ID)
+
+	e = receive(t, events)
+	require.Equal(t, database.EventTypeDeleted, e.Type)
+	require.Equal(t, testID("a"), e.ID)
+}
+
+func Test_Watch_ResumeTokenExpired(t *testing.T) {
+	client := New(inmemory.NewClient(), 2)
+	query := database.Query{RootScope: testScope, ResourceType: testType}
+
+	t.Run("other epoch", func(t *testing.T) {
+		other := New(inmemory.NewClient(), 2)
+		save(t, other, testID("a"))
+
+		_, err := client.Watch(context.Background(), query, other.token(1))
+		require.ErrorIs(t, err, &database.ErrResumeTokenExpired{})
+		require.Contains(t, err.Error(), "another replica")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.Watch(context.Background(), query, "invalid")
+		require.ErrorIs(t, err, &database.ErrResumeTokenExpired{})
+	})
+
+	t.Run("trimmed", func(t *testing.T) {
+		for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+			save(t, client, testID(name))
+		}
+
+		_, err := client.Watch(context.Background(), query, client.token(1))
+		require.ErrorIs(t, err, &database.ErrResumeTokenExpired{})
+
+		// The latest changes can still be resumed.
+		_, err = client.Watch(context.Background(), query, client.token(5))
+		require.NoError(t, err)
+	})
+}
+
+func Test_Save_PublishesCopy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := New(inmemory.NewClient(), 0)
+	events, err := client.Watch(ctx, database.Query{RootScope: testScope, ResourceType: testType}, "")
+	require.NoError(t, err)
+
+	data := map[string]any{"value": "original"}
+	require.NoError(t, client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testID("a")}, Data: data}))
+	data["value"] = "changed"
+
+	e := receive(t, events)
+	require.Equal(t, "original", e.Object.Data.(map[string]any)["value"])
+}
+
+// testSource is a database.ChangeSource that reports the changes sent on its channel, as if they were made by
+// another process.
+type testSource struct {
+	database.Client
+
+	// changes is the channel returned by the latest call to Changes.
+	changes chan database.Event
+	calls   int
+}
+
+func (s *testSource) Changes(ctx context.Context) (<-chan database.Event, error) {
+	s.calls++
+	s.changes = make(chan database.Event)
+	return s.changes, nil
+}
+
+func Test_Watch_Source(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	source := &testSource{Client: inmemory.NewClient()}
+	client := New(source, 0)
+	query := database.Query{RootScope: testScope, ResourceType: testType}
+
+	// Changes are not read from the source until the first watch.
+	save(t, client, testID("existing"))
+	require.Equal(t, 0, source.calls)
+
+	events, err := client.Watch(ctx, query, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, source.calls)
+
+	e := receive(t, events)
+	require.Equal(t, testID("existing"), e.ID)
+
+	// Changes made through the client are reported by the source rather than by the client.
+	save(t, client, testID("local"))
+	source.changes <- database.Event{Type: database.EventTypeDeleted, ID: testID("remote")}
+
+	e = receive(t, events)
+	require.Equal(t, database.EventTypeDeleted, e.Type)
+	require.Equal(t, testID("remote"), e.ID)
+	require.NotEmpty(t, e.ResumeToken)
+
+	// A second watch shares the source.
+	other, err := client.Watch(ctx, query, e.ResumeToken)
+	require.NoError(t, err)
+	require.Equal(t, 1, source.calls)
+
+	// When the source fails, watches are closed and their resume tokens expire.
+	close(source.changes)
+	_, ok := <-events
+	require.False(t, ok)
+	_, ok = <-other
+	require.False(t, ok)
+
+	_, err = client.Watch(ctx, query, e.ResumeToken)
+	require.ErrorIs(t, err, &database.ErrResumeTokenExpired{})
+	require.Equal(t, 2, source.calls)
+
+	_, err = client.Watch(ctx, query, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, source.calls)
+}
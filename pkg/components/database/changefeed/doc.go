@@ -0,0 +1,27 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// changefeed contains a database client that publishes changes to watchers. It wraps the client of any database
+// provider to implement database.Watcher.
+//
+// When the wrapped client implements database.ChangeSource, the changes are read from the notifications of the
+// database, so a watch sees the changes made by every process sharing it. Otherwise, as with the in-memory database,
+// only the changes made through the client are published.
+//
+// In both cases the changes are kept in a bounded buffer of the process to resume watches, so resume tokens are only
+// valid on the process that returned them. A token returned by another replica is rejected with
+// database.ErrResumeTokenExpired, which explains that resuming is limited to the replica that returned the token.
+package changefeed
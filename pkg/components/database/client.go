@@ -80,6 +80,14 @@ type Client interface {
 	// When providing an ETag, Save will return ErrConcurrency if the resource has been
 	// modified OR deleted since the ETag was retrieved.
 	Save(ctx context.Context, obj *Object, options ...SaveOptions) error
+
+	// ExecuteBatch applies a batch of Save and Delete operations atomically: either all of the operations are
+	// applied or none of them are. Each operation has the same semantics as the corresponding Save or Delete call,
+	// including optimistic concurrency control when an ETag is provided.
+	//
+	// ExecuteBatch returns the error of the first operation that fails, such as ErrNotFound or ErrConcurrency.
+	// The ETag field of the saved objects is only updated if the whole batch has been written.
+	ExecuteBatch(ctx context.Context, operations []BatchOperation) error
 }
 
 // Query specifies the structure of a query. RootScope and ResourceType are required and other fields are optional.
@@ -20,12 +20,14 @@ import (
 	context "context"
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	store "github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/database/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/components/database/changefeed"
 	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/components/database/postgres"
 	"github.com/radius-project/radius/pkg/kubeutil"
@@ -35,12 +37,44 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// envVarRegex matches a URL that refers to an environment variable, eg: ${DATABASE_URL}.
+var envVarRegex = regexp.MustCompile(`^\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}$`)
+
+// ResolveURL returns the value of the environment variable url refers to when it has the format ${ENV_VAR_NAME}, and
+// url itself otherwise.
+func ResolveURL(url string) (string, error) {
+	matches := envVarRegex.FindStringSubmatch(url)
+	if len(matches) < 2 {
+		return url, nil
+	}
+
+	value := os.Getenv(matches[1])
+	if value == "" {
+		return "", fmt.Errorf("environment variable %q is not set", matches[1])
+	}
+
+	return value, nil
+}
+
 type databaseClientFactoryFunc func(ctx context.Context, options Options) (store.Client, error)
 
 var databaseClientFactory = map[DatabaseProviderType]databaseClientFactoryFunc{
-	TypeAPIServer:  initAPIServerClient,
-	TypeInMemory:   initInMemoryClient,
-	TypePostgreSQL: initPostgreSQLClient,
+	TypeAPIServer:  withChangeFeed(initAPIServerClient),
+	TypeInMemory:   withChangeFeed(initInMemoryClient),
+	TypePostgreSQL: withChangeFeed(initPostgreSQLClient),
+}
+
+// withChangeFeed wraps the client created by factory so that the changes to the database can be watched. The changes are
+// read from the database when the client implements store.ChangeSource.
+func withChangeFeed(factory databaseClientFactoryFunc) databaseClientFactoryFunc {
+	return func(ctx context.Context, options Options) (store.Client, error) {
+		client, err := factory(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return changefeed.New(client, 0), nil
+	}
 }
 
 func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error) {
@@ -70,7 +104,8 @@ func initAPIServerClient(ctx context.Context, opt Options) (store.Client, error)
 		Scheme: scheme,
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The client needs to watch to report the changes to resources.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -90,12 +125,9 @@ func initPostgreSQLClient(ctx context.Context, opt Options) (store.Client, error
 		return nil, errors.New("failed to initialize PostgreSQL client: URL is required")
 	}
 
-	url := opt.PostgreSQL.URL
-	regex := regexp.MustCompile(`$\{([a-zA-Z_]+)\}`)
-	matches := regex.FindSubmatch([]byte(opt.PostgreSQL.URL))
-	if len(matches) > 1 {
-		// Extract the captured expression.
-		url = string(matches[1])
+	url, err := ResolveURL(opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
 	}
 
 	pool, err := pgxpool.New(ctx, url)
@@ -127,3 +127,18 @@ func TestInitialize(t *testing.T) {
 	require.NoError(t, result.err)
 	require.NotNil(t, result.client)
 }
+
+func Test_ResolveURL(t *testing.T) {
+	t.Setenv("TEST_DATABASE_URL", "postgresql://radius@localhost:5432/radius")
+
+	url, err := ResolveURL("${TEST_DATABASE_URL}")
+	require.NoError(t, err)
+	require.Equal(t, "postgresql://radius@localhost:5432/radius", url)
+
+	url, err = ResolveURL("postgresql://other@localhost:5432/radius")
+	require.NoError(t, err)
+	require.Equal(t, "postgresql://other@localhost:5432/radius", url)
+
+	_, err = ResolveURL("${TEST_DATABASE_URL_NOT_SET}")
+	require.EqualError(t, err, `environment variable "TEST_DATABASE_URL_NOT_SET" is not set`)
+}
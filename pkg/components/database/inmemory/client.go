@@ -149,9 +149,12 @@ func (c *Client) Delete(ctx context.Context, id string, options ...database.Dele
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	config := database.NewDeleteConfig(options...)
+	return deleteEntry(c.resources, id, converted, database.NewDeleteConfig(options...))
+}
 
-	entry, ok := c.resources[strings.ToLower(converted.String())]
+// deleteEntry deletes the entry of the converted resource id from entries. The caller must hold the mutex.
+func deleteEntry(entries map[string]entry, id string, converted resources.ID, config database.DatabaseOptions) error {
+	entry, ok := entries[strings.ToLower(converted.String())]
 	if !ok && config.ETag != "" {
 		return &database.ErrConcurrency{}
 	} else if !ok {
@@ -160,7 +163,7 @@ func (c *Client) Delete(ctx context.Context, id string, options ...database.Dele
 		return &database.ErrConcurrency{}
 	}
 
-	delete(c.resources, strings.ToLower(converted.String()))
+	delete(entries, strings.ToLower(converted.String()))
 
 	return nil
 }
@@ -245,9 +248,12 @@ func (c *Client) Save(ctx context.Context, obj *database.Object, options ...data
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	config := database.NewSaveConfig(options...)
+	return saveEntry(c.resources, obj, converted, database.NewSaveConfig(options...))
+}
 
-	entry, ok := c.resources[strings.ToLower(converted.String())]
+// saveEntry saves obj as the entry of the converted resource id in entries. The caller must hold the mutex.
+func saveEntry(entries map[string]entry, obj *database.Object, converted resources.ID, config database.DatabaseOptions) error {
+	entry, ok := entries[strings.ToLower(converted.String())]
 	if !ok && config.ETag != "" {
 		return &database.ErrConcurrency{}
 	} else if ok && config.ETag != "" && config.ETag != entry.obj.ETag {
@@ -275,7 +281,72 @@ func (c *Client) Save(ctx context.Context, obj *database.Object, options ...data
 
 	entry.obj = *copy
 
-	c.resources[strings.ToLower(converted.String())] = entry
+	entries[strings.ToLower(converted.String())] = entry
+
+	return nil
+}
+
+// ExecuteBatch implements database.Client.
+//
+// The operations are applied to a copy of the stored entries while holding the mutex, and the copy replaces the
+// stored entries only if all of the operations succeed.
+func (c *Client) ExecuteBatch(ctx context.Context, operations []database.BatchOperation) error {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	converted := make([]resources.ID, len(operations))
+	for i, op := range operations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+
+		parsed, err := resources.Parse(op.TargetID())
+		if err != nil {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		if op.Kind == database.BatchOperationDelete && (parsed.IsEmpty() || parsed.IsResourceCollection() || parsed.IsScopeCollection()) {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		}
+
+		converted[i], err = databaseutil.ConvertScopeIDToResourceID(parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// saveEntry updates the ETag of the object, so we restore the original ETags if the batch fails.
+	etags := make([]database.ETag, len(operations))
+	for i, op := range operations {
+		if op.Kind == database.BatchOperationSave {
+			etags[i] = op.Object.ETag
+		}
+	}
+
+	staged := maps.Clone(c.resources)
+	for i, op := range operations {
+		var err error
+		switch op.Kind {
+		case database.BatchOperationSave:
+			err = saveEntry(staged, op.Object, converted[i], op.Options)
+		case database.BatchOperationDelete:
+			err = deleteEntry(staged, op.ID, converted[i], op.Options)
+		}
+
+		if err != nil {
+			for j, op := range operations {
+				if op.Kind == database.BatchOperationSave {
+					op.Object.ETag = etags[j]
+				}
+			}
+			return err
+		}
+	}
+
+	c.resources = staged
 
 	return nil
 }
@@ -83,6 +83,44 @@ func (c *MockClientDeleteCall) DoAndReturn(f func(context.Context, string, ...De
 	return c
 }
 
+// ExecuteBatch mocks base method.
+func (m *MockClient) ExecuteBatch(ctx context.Context, operations []BatchOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteBatch", ctx, operations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteBatch indicates an expected call of ExecuteBatch.
+func (mr *MockClientMockRecorder) ExecuteBatch(ctx, operations any) *MockClientExecuteBatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteBatch", reflect.TypeOf((*MockClient)(nil).ExecuteBatch), ctx, operations)
+	return &MockClientExecuteBatchCall{Call: call}
+}
+
+// MockClientExecuteBatchCall wrap *gomock.Call
+type MockClientExecuteBatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientExecuteBatchCall) Return(arg0 error) *MockClientExecuteBatchCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientExecuteBatchCall) Do(f func(context.Context, []BatchOperation) error) *MockClientExecuteBatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientExecuteBatchCall) DoAndReturn(f func(context.Context, []BatchOperation) error) *MockClientExecuteBatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockClient) Get(ctx context.Context, id string, options ...GetOptions) (*Object, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// changesChannel is the channel used with NOTIFY and LISTEN to report the changes to resources. The payload is a
+// changeNotification.
+const changesChannel = "resource_changes"
+
+var _ database.ChangeSource = (*PostgresClient)(nil)
+
+// ConnectionAcquirer is implemented by pgxpool.Pool. LISTEN needs a dedicated connection, which PostgresAPI cannot
+// provide.
+type ConnectionAcquirer interface {
+	// Acquire returns a connection from the pool.
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// changeNotification is the payload of the notifications sent by Save, Delete and ExecuteBatch.
+type changeNotification struct {
+	// Type is the type of the change.
+	Type database.EventType `json:"type"`
+
+	// ID is the resource id of the changed object.
+	ID string `json:"id"`
+}
+
+func changePayload(eventType database.EventType, id string) (string, error) {
+	b, err := json.Marshal(changeNotification{Type: eventType, ID: id})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// Changes listens for the notifications sent by Save, Delete and ExecuteBatch of every client sharing the database.
+// It returns database.ErrChangesUnsupported if the client was not created with a connection pool.
+func (p *PostgresClient) Changes(ctx context.Context) (<-chan database.Event, error) {
+	acquirer, ok := p.api.(ConnectionAcquirer)
+	if !ok {
+		return nil, database.ErrChangesUnsupported
+	}
+
+	conn, err := listen(ctx, acquirer)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan database.Event)
+	go func() {
+		defer close(out)
+		defer closeConn(conn)
+
+		// Notifications sent while the listener is down are lost, so the channel is closed instead of reconnecting.
+		err := p.forwardChanges(ctx, conn, out)
+		if ctx.Err() == nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "lost connection listening for resource changes")
+		}
+	}()
+
+	return out, nil
+}
+
+// listen takes a connection out of the pool and subscribes it to changesChannel. The connection is removed from the
+// pool because it stays subscribed until it is closed.
+func listen(ctx context.Context, acquirer ConnectionAcquirer) (*pgx.Conn, error) {
+	pooled, err := acquirer.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := pooled.Hijack()
+	_, err = conn.Exec(ctx, "LISTEN "+changesChannel)
+	if err != nil {
+		closeConn(conn)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// forwardChanges sends an event on out for every notification until the connection fails or ctx is done.
+func (p *PostgresClient) forwardChanges(ctx context.Context, conn *pgx.Conn, out chan<- database.Event) error {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		change := changeNotification{}
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			continue
+		}
+
+		event := database.Event{Type: change.Type, ID: change.ID}
+		if change.Type == database.EventTypeSaved {
+			// Notifications only carry the id, so the object is read back.
+			event.Object, err = p.Get(ctx, change.ID)
+			if errors.Is(err, &database.ErrNotFound{}) {
+				// The object was deleted since, which is notified next.
+				continue
+			} else if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func closeConn(conn *pgx.Conn) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+	_ = conn.Close(ctx)
+}
@@ -42,6 +42,8 @@ type PostgresAPI interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	// Query executes a query that returns rows.
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	// Begin starts a transaction.
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 // NewPostgresClient creates a new PostgresClient.
@@ -78,20 +80,32 @@ func (p *PostgresClient) Delete(ctx context.Context, id string, options ...datab
 		return err
 	}
 
-	config := database.NewDeleteConfig(options...)
+	return p.delete(ctx, p.api, id, converted, database.NewDeleteConfig(options...))
+}
+
+// delete deletes the row of the converted resource id using api, which is either the connection pool or a transaction.
+func (p *PostgresClient) delete(ctx context.Context, api PostgresAPI, id string, converted resources.ID, config database.DatabaseOptions) error {
 	var etag *string
 	if config.ETag != "" {
 		etag = &config.ETag
 	}
 
+	payload, err := changePayload(database.EventTypeDeleted, id)
+	if err != nil {
+		return err
+	}
+
 	// We need different SQL for the case where an etag is provided vs not provided.
 	//
 	// The key behavior difference is that if an etag is provided, should report failure differently.
+	//
+	// pg_notify reports the change to the listeners started by Changes. The notification is only delivered if the
+	// DELETE commits.
 	sql := `
 WITH deleted AS (
 	DELETE FROM resources
 	WHERE id = $1
-	RETURNING id
+	RETURNING id, pg_notify($2, $3)
 )
 SELECT
 CASE
@@ -100,7 +114,7 @@ CASE
 	ELSE 'ErrNotFound'
 END AS result;`
 
-	args := []any{databaseutil.NormalizePart(converted.String())}
+	args := []any{databaseutil.NormalizePart(converted.String()), changesChannel, payload}
 
 	if config.ETag != "" {
 		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
@@ -108,7 +122,7 @@ END AS result;`
 WITH deleted AS (
 	DELETE FROM resources
 	WHERE id = $1 AND etag = $2
-	RETURNING id
+	RETURNING id, pg_notify($3, $4)
 )
 SELECT
 CASE
@@ -117,11 +131,11 @@ CASE
 	ELSE 'ErrConcurrency'
 END AS result;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), etag}
+		args = []any{databaseutil.NormalizePart(converted.String()), etag, changesChannel, payload}
 	}
 
 	result := ""
-	err = p.api.QueryRow(ctx, sql, args...).Scan(&result)
+	err = api.QueryRow(ctx, sql, args...).Scan(&result)
 	if err != nil {
 		return err
 	} else if result == "ErrNotFound" {
@@ -324,8 +338,12 @@ func (p *PostgresClient) Save(ctx context.Context, obj *database.Object, options
 		return err
 	}
 
-	config := database.NewSaveConfig(options...)
+	return p.save(ctx, p.api, obj, converted, database.NewSaveConfig(options...))
+}
 
+// save saves obj as the row of the converted resource id using api, which is either the connection pool or a
+// transaction.
+func (p *PostgresClient) save(ctx context.Context, api PostgresAPI, obj *database.Object, converted resources.ID, config database.DatabaseOptions) error {
 	// Compute ETag for the current state of the object.
 	raw, err := json.Marshal(obj.Data)
 	if err != nil {
@@ -334,18 +352,26 @@ func (p *PostgresClient) Save(ctx context.Context, obj *database.Object, options
 
 	obj.ETag = etag.New(raw)
 
+	payload, err := changePayload(database.EventTypeSaved, obj.ID)
+	if err != nil {
+		return err
+	}
+
 	// We need different SQL for the case where an etag is provided vs not provided.
 	//
 	// The key behavior difference is that if an etag is provided, we should not perform inserts, only updates.
 
 	// This is the more complex query that handles "upserts". It does not process etags.
+	//
+	// pg_notify reports the change to the listeners started by Changes. The notification is only delivered if the
+	// statement commits.
 	sql := `
 WITH updated AS (
 	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
 	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	ON CONFLICT (id) 
 	DO UPDATE SET resource_data = $7
-	RETURNING id
+	RETURNING id, pg_notify($8, $9)
 )
 SELECT
 CASE
@@ -362,6 +388,8 @@ END AS result;`
 		databaseutil.NormalizePart(converted.RoutingScope()),
 		obj.ETag,
 		obj.Data,
+		changesChannel,
+		payload,
 	}
 
 	if config.ETag != "" {
@@ -371,7 +399,7 @@ END AS result;`
 WITH updated AS (
 	UPDATE resources SET resource_data = $2
 	WHERE id = $1 AND etag = $3
-	RETURNING id
+	RETURNING id, pg_notify($4, $5)
 )
 SELECT
 CASE
@@ -380,11 +408,11 @@ CASE
 	ELSE 'ErrConcurrency'
 END AS result;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag}
+		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag, changesChannel, payload}
 	}
 
 	result := ""
-	err = p.api.QueryRow(ctx, sql, args...).Scan(&result)
+	err = api.QueryRow(ctx, sql, args...).Scan(&result)
 	if err != nil {
 		return err
 	} else if result == "ErrNotFound" {
@@ -396,6 +424,79 @@ END AS result;`
 	return nil
 }
 
+// ExecuteBatch implements database.Client.
+//
+// The operations are executed in a single transaction, which is rolled back if any of the operations fails.
+func (p *PostgresClient) ExecuteBatch(ctx context.Context, operations []database.BatchOperation) (err error) {
+	if ctx == nil {
+		return &database.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+
+	converted := make([]resources.ID, len(operations))
+	for i, op := range operations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+
+		parsed, err := resources.Parse(op.TargetID())
+		if err != nil {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+		if parsed.IsEmpty() {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+		}
+		if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+			return &database.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		}
+
+		converted[i], err = databaseutil.ConvertScopeIDToResourceID(parsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	// save updates the ETag of the object, so we restore the original ETags if the transaction is rolled back.
+	etags := make([]database.ETag, len(operations))
+	for i, op := range operations {
+		if op.Kind == database.BatchOperationSave {
+			etags[i] = op.Object.ETag
+		}
+	}
+
+	tx, err := p.api.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		// Rollback is a no-op if the transaction has already been committed.
+		_ = tx.Rollback(ctx)
+		for i, op := range operations {
+			if op.Kind == database.BatchOperationSave {
+				op.Object.ETag = etags[i]
+			}
+		}
+	}()
+
+	for i, op := range operations {
+		switch op.Kind {
+		case database.BatchOperationSave:
+			err = p.save(ctx, tx, op.Object, converted[i], op.Options)
+		case database.BatchOperationDelete:
+			err = p.delete(ctx, tx, op.ID, converted[i], op.Options)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
 // createPaginationToken converts a timestamp to a base64 encoded string.
 //
 // We use ISO8601/RFC3339 format which postgres understands and can be used for comparison.
@@ -56,6 +56,9 @@ func Test_PostgresClient(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+
+	// LISTEN needs the connection pool, which the logger does not expose.
+	shared.RunChangesTest(t, NewPostgresClient(pool), clear)
 }
 
 var _ PostgresAPI = (*postgresLogger)(nil)
@@ -72,6 +75,12 @@ func (l *postgresLogger) Exec(ctx context.Context, sql string, args ...any) (pgc
 	return l.pool.Exec(ctx, sql, args...)
 }
 
+// Begin implements PostgresAPI.
+func (l *postgresLogger) Begin(ctx context.Context) (pgx.Tx, error) {
+	l.t.Logf("Beginning transaction")
+	return l.pool.Begin(ctx)
+}
+
 // Query implements PostgresAPI.
 func (l *postgresLogger) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
 	l.t.Logf("Executing: %s", sql)
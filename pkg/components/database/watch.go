@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrChangesUnsupported represents the error when a database client cannot report the changes made to the database.
+	ErrChangesUnsupported = errors.New("database client does not support change notifications")
+)
+
+// EventType is the type of a change to a stored object.
+type EventType string
+
+const (
+	// EventTypeSaved is the type of the event published when an object is created or updated.
+	EventTypeSaved EventType = "Saved"
+
+	// EventTypeDeleted is the type of the event published when an object is deleted.
+	EventTypeDeleted EventType = "Deleted"
+)
+
+// Event is a change to a stored object.
+type Event struct {
+	// Type is the type of the change.
+	Type EventType
+
+	// ID is the resource id of the object.
+	ID string
+
+	// Object is the object after the change. Object is nil for EventTypeDeleted.
+	Object *Object
+
+	// ResumeToken is an opaque token that resumes watching after this event.
+	ResumeToken string
+}
+
+// Watcher is implemented by clients that publish changes to stored objects.
+type Watcher interface {
+	// Watch returns the changes to the objects matching the query. The channel is closed when the context is
+	// cancelled, or when the watcher falls too far behind the changes. Callers should then watch again from
+	// the resume token of the last event they received.
+	//
+	// When resumeToken is empty, Watch first returns an EventTypeSaved event for every object that currently
+	// matches the query, and then the changes that follow. Otherwise only the changes made after the event the
+	// token was returned with are returned.
+	//
+	// Watch will return ErrResumeTokenExpired if the changes since the token are no longer available, for
+	// example because the process restarted or the token was returned by another process. Callers should then
+	// watch again without a resume token.
+	Watch(ctx context.Context, query Query, resumeToken string) (<-chan Event, error)
+}
+
+// ChangeSource is implemented by database clients that can report the changes made to the database by any client,
+// including the clients of other processes sharing the database. It is used to implement Watcher on top of the
+// notifications of the database.
+type ChangeSource interface {
+	// Changes returns the changes made to the database after Changes returns. The ResumeToken of the events is not set.
+	// The Object of an EventTypeSaved event can be more recent than the change that was reported.
+	//
+	// The channel is closed when ctx is done, or when changes may have been missed, for example because the
+	// connection to the database was lost. The caller should call Changes again and assume that any change could
+	// have happened in between.
+	Changes(ctx context.Context) (<-chan Event, error)
+}
+
+var _ error = (*ErrResumeTokenExpired)(nil)
+
+// ErrResumeTokenExpired is returned by Watcher when the changes since a resume token are no longer available.
+type ErrResumeTokenExpired struct {
+	// Token is the expired resume token.
+	Token string
+
+	// Reason explains why the token has expired. It is optional.
+	Reason string
+}
+
+// Error returns the error message for ErrResumeTokenExpired error.
+func (e *ErrResumeTokenExpired) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("the resume token %q has expired: %s", e.Token, e.Reason)
+	}
+	return fmt.Sprintf("the resume token %q has expired", e.Token)
+}
+
+// Is checks if the target error is an instance of ErrResumeTokenExpired.
+func (e *ErrResumeTokenExpired) Is(target error) bool {
+	_, ok := target.(*ErrResumeTokenExpired)
+	return ok
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// driftCheckCount is the metric name for the number of drift checks of recipe output resources.
+	driftCheckCount = "recipe.drift.check"
+
+	// driftCheckDuration is the metric name for the duration of a drift check of recipe output resources.
+	driftCheckDuration = "recipe.drift.check.duration"
+
+	// driftedResourceCount is the metric name for the number of drifted output resources found by drift checks.
+	driftedResourceCount = "recipe.drift.resource"
+
+	// driftStateAttrKey is the attribute name for the result of a drift check.
+	driftStateAttrKey = attribute.Key("drift_state")
+)
+
+type driftMetrics struct {
+	counters       map[string]metric.Int64Counter
+	valueRecorders map[string]metric.Float64Histogram
+}
+
+func newDriftMetrics() *driftMetrics {
+	return &driftMetrics{
+		counters:       make(map[string]metric.Int64Counter),
+		valueRecorders: make(map[string]metric.Float64Histogram),
+	}
+}
+
+// Init initializes the drift detection metrics.
+func (m *driftMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("recipe-drift-metrics")
+
+	var err error
+	m.counters[driftCheckCount], err = meter.Int64Counter(driftCheckCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[driftedResourceCount], err = meter.Int64Counter(driftedResourceCount)
+	if err != nil {
+		return err
+	}
+
+	m.valueRecorders[driftCheckDuration], err = meter.Float64Histogram(driftCheckDuration)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordDriftCheck records the result and duration of a drift check of the resource of the given type, and the number of
+// output resources that drifted.
+func (m *driftMetrics) RecordDriftCheck(ctx context.Context, startTime time.Time, resourceType string, state string, driftedResources int) {
+	attrs := metric.WithAttributes(NewDriftAttributes(resourceType, state)...)
+
+	if m.counters[driftCheckCount] != nil {
+		m.counters[driftCheckCount].Add(ctx, 1, attrs)
+	}
+
+	if m.counters[driftedResourceCount] != nil && driftedResources > 0 {
+		m.counters[driftedResourceCount].Add(ctx, int64(driftedResources), attrs)
+	}
+
+	if m.valueRecorders[driftCheckDuration] != nil {
+		elapsedTime := float64(time.Since(startTime)) / float64(time.Millisecond)
+		m.valueRecorders[driftCheckDuration].Record(ctx, elapsedTime, attrs)
+	}
+}
+
+// NewDriftAttributes generates common attributes for drift checks.
+func NewDriftAttributes(resourceType string, state string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0)
+
+	if resourceType != "" {
+		attrs = append(attrs, resourceTypeAttrKey.String(normalizeAttrValue(resourceType)))
+	}
+
+	if state != "" {
+		attrs = append(attrs, driftStateAttrKey.String(normalizeAttrValue(state)))
+	}
+
+	return attrs
+}
@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultDriftMetrics holds recipe drift detection metrics definitions.
+	DefaultDriftMetrics = newDriftMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultDriftMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -46,12 +46,27 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// recipeCacheHitCount is the metric name for the number of recipe artifacts found in the recipe artifact cache.
+	recipeCacheHitCount = "recipe.cache.hit"
+
+	// recipeCacheMissCount is the metric name for the number of recipe artifacts not found in the recipe artifact cache.
+	recipeCacheMissCount = "recipe.cache.miss"
+
+	// recipeCacheEvictionCount is the metric name for the number of recipe artifacts evicted from the recipe artifact cache.
+	recipeCacheEvictionCount = "recipe.cache.eviction"
+
+	// recipeCacheArtifactAttrKey is the attribute name for the kind of a cached recipe artifact.
+	recipeCacheArtifactAttrKey = attribute.Key("recipe_cache_artifact")
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -96,6 +111,21 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeCacheHitCount], err = meter.Int64Counter(recipeCacheHitCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeCacheMissCount], err = meter.Int64Counter(recipeCacheMissCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeCacheEvictionCount], err = meter.Int64Counter(recipeCacheEvictionCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -148,6 +178,27 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordRecipeCacheHit records a lookup of a recipe artifact of the given kind that was found in the recipe artifact cache.
+func (m *recipeEngineMetrics) RecordRecipeCacheHit(ctx context.Context, artifact string) {
+	if m.counters[recipeCacheHitCount] != nil {
+		m.counters[recipeCacheHitCount].Add(ctx, 1, metric.WithAttributes(recipeCacheArtifactAttrKey.String(artifact)))
+	}
+}
+
+// RecordRecipeCacheMiss records a lookup of a recipe artifact of the given kind that was not found in the recipe artifact cache.
+func (m *recipeEngineMetrics) RecordRecipeCacheMiss(ctx context.Context, artifact string) {
+	if m.counters[recipeCacheMissCount] != nil {
+		m.counters[recipeCacheMissCount].Add(ctx, 1, metric.WithAttributes(recipeCacheArtifactAttrKey.String(artifact)))
+	}
+}
+
+// RecordRecipeCacheEviction records the eviction of a recipe artifact of the given kind from the recipe artifact cache.
+func (m *recipeEngineMetrics) RecordRecipeCacheEviction(ctx context.Context, artifact string) {
+	if m.counters[recipeCacheEvictionCount] != nil {
+		m.counters[recipeCacheEvictionCount].Add(ctx, 1, metric.WithAttributes(recipeCacheArtifactAttrKey.String(artifact)))
+	}
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
@@ -75,12 +75,27 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelDeadLetter is the label marking a message that was moved to the dead-letter queue. Dequeue skips messages
+	// with this label.
+	LabelDeadLetter = "ucp.dev/deadletter"
+
+	// AnnotationDeadLetterReason is the annotation holding queue.DeadLetterInfo.Reason.
+	AnnotationDeadLetterReason = "ucp.dev/deadletter-reason"
+	// AnnotationDeadLetterError is the annotation holding queue.DeadLetterInfo.Error.
+	AnnotationDeadLetterError = "ucp.dev/deadletter-error"
+	// AnnotationDeadLetterStack is the annotation holding queue.DeadLetterInfo.Stack.
+	AnnotationDeadLetterStack = "ucp.dev/deadletter-stack"
+	// AnnotationDeadLetteredAt is the annotation holding the time when the message was dead-lettered.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
 )
 
-var _ queue.Client = (*Client)(nil)
+var (
+	_ queue.Client           = (*Client)(nil)
+	_ queue.DeadLetterClient = (*Client)(nil)
+)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -208,7 +223,13 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 		return nil, err
 	}
 
-	return selector.Add(*nameLabel), nil
+	// Dead-lettered messages stay in the same queue but must never be dequeued.
+	deadLetterLabel, err := labels.NewRequirement(LabelDeadLetter, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return selector.Add(*nameLabel, *deadLetterLabel), nil
 }
 
 // getQueueMessage fetches the first item which is the message in the current queue. We can
@@ -120,6 +120,8 @@ func TestClient(t *testing.T) {
 	}
 
 	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+	sharedtest.RunNotifyTest(t, cli, clear)
 
 	t.Run("ExtendMessage is failed when machine's clock is skewed", func(t *testing.T) {
 		clear(t)
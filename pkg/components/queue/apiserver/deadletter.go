@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	v1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/components/queue"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Dead-lettered messages are kept as QueueMessage resources in the same queue. DeadLetterMessage adds the
+// LabelDeadLetter label, which hides the message from Dequeue, and records queue.DeadLetterInfo in annotations so that
+// the QueueMessage CRD does not need to change. ReplayDeadLetter removes them again.
+
+// DeadLetterMessage moves the leased message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, info queue.DeadLetterInfo) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	// NewDeadLetter truncates the stack to fit in an annotation.
+	letter := queue.NewDeadLetter(msg, info, now)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result := &v1alpha1.QueueMessage{}
+		err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(err) {
+			return queue.ErrInvalidMessage
+		} else if err != nil {
+			return err
+		}
+
+		// Another client leased the message after our lease expired.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return queue.ErrDequeuedMessage
+		}
+
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Labels[LabelDeadLetter] = "true"
+		result.Annotations[AnnotationDeadLetterReason] = letter.Reason
+		result.Annotations[AnnotationDeadLetterError] = letter.Error
+		result.Annotations[AnnotationDeadLetterStack] = letter.Stack
+		result.Annotations[AnnotationDeadLetteredAt] = letter.DeadLetteredAt.Format(time.RFC3339Nano)
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue, oldest first.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	ql := &v1alpha1.QueueMessageList{}
+	err := c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabels{LabelQueueName: c.opts.Name, LabelDeadLetter: "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*queue.DeadLetter, 0, len(ql.Items))
+	for i := range ql.Items {
+		result = append(result, toDeadLetter(&ql.Items[i]))
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].DeadLetteredAt.Before(result[j].DeadLetteredAt)
+	})
+	return result, nil
+}
+
+// GetDeadLetter gets a message in the dead-letter queue by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	item, err := c.getDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDeadLetter(item), nil
+}
+
+// ReplayDeadLetter moves a message from the dead-letter queue back to the queue with its dequeue count reset.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		item, err := c.getDeadLetter(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		delete(item.Labels, LabelDeadLetter)
+		delete(item.Annotations, AnnotationDeadLetterReason)
+		delete(item.Annotations, AnnotationDeadLetterError)
+		delete(item.Annotations, AnnotationDeadLetterStack)
+		delete(item.Annotations, AnnotationDeadLetteredAt)
+		item.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+		item.Spec.DequeueCount = 0
+		item.Spec.ExpireAt = metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()}
+
+		return c.client.Update(ctx, item)
+	})
+}
+
+// PurgeDeadLetter deletes a message from the dead-letter queue.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		item, err := c.getDeadLetter(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		options := &runtimeclient.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID:             &item.UID,
+				ResourceVersion: &item.ResourceVersion,
+			},
+		}
+		err = c.client.Delete(ctx, item, options)
+		if apierrors.IsNotFound(err) {
+			return queue.ErrDeadLetterNotFound
+		}
+		return err
+	})
+}
+
+// getDeadLetter fetches the dead-lettered QueueMessage with the given id from this queue.
+func (c *Client) getDeadLetter(ctx context.Context, id string) (*v1alpha1.QueueMessage, error) {
+	if id == "" {
+		return nil, queue.ErrDeadLetterNotFound
+	}
+
+	item := &v1alpha1.QueueMessage{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, item)
+	if apierrors.IsNotFound(err) {
+		return nil, queue.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if item.Labels[LabelQueueName] != c.opts.Name || item.Labels[LabelDeadLetter] != "true" {
+		return nil, queue.ErrDeadLetterNotFound
+	}
+	return item, nil
+}
+
+// toDeadLetter converts a dead-lettered QueueMessage.
+func toDeadLetter(item *v1alpha1.QueueMessage) *queue.DeadLetter {
+	msg := &queue.Message{}
+	copyMessage(msg, item)
+
+	deadLetteredAt, err := time.Parse(time.RFC3339Nano, item.Annotations[AnnotationDeadLetteredAt])
+	if err != nil {
+		deadLetteredAt = time.Time{}
+	}
+
+	return queue.NewDeadLetter(msg, queue.DeadLetterInfo{
+		Reason: item.Annotations[AnnotationDeadLetterReason],
+		Error:  item.Annotations[AnnotationDeadLetterError],
+		Stack:  item.Annotations[AnnotationDeadLetterStack],
+	}, deadLetteredAt)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchRetryInterval is the interval between attempts to restart the watch of QueueMessage resources.
+const watchRetryInterval = time.Duration(5) * time.Second
+
+var _ queue.Notifier = (*Client)(nil)
+
+// Notify watches the QueueMessage resources of the queue and signals when a message is created or replayed. It
+// returns queue.ErrNotifyUnsupported if the Kubernetes client cannot watch.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, queue.ErrNotifyUnsupported
+	}
+
+	// Start the first watch here so that the caller can fall back to polling if it fails.
+	w, err := c.watch(ctx, wc)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan struct{}, 1)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		for {
+			c.forwardEvents(ctx, w, out)
+			w.Stop()
+
+			// The API server closes watches periodically, so restart the watch until ctx is done.
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(watchRetryInterval):
+				}
+
+				w, err = c.watch(ctx, wc)
+				if err == nil {
+					break
+				}
+				logger.Error(err, "failed to watch queue messages", "queue", c.opts.Name)
+			}
+
+			// Messages may have been enqueued while the watch was down.
+			queue.Signal(out)
+		}
+	}()
+
+	return out, nil
+}
+
+func (c *Client) watch(ctx context.Context, wc runtimeclient.WithWatch) (watch.Interface, error) {
+	return wc.Watch(
+		ctx, &v1alpha1.QueueMessageList{},
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabels{LabelQueueName: c.opts.Name})
+}
+
+// forwardEvents signals out for every event of w which makes a message visible, until w or ctx is done.
+func (c *Client) forwardEvents(ctx context.Context, w watch.Interface, out chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+
+			item, ok := event.Object.(*v1alpha1.QueueMessage)
+			if !ok {
+				continue
+			}
+
+			// Dequeue and ExtendMessage also modify messages but move LabelNextVisibleAt into the future. Only
+			// created messages and replayed dead letters are visible right away.
+			if (event.Type == watch.Added || event.Type == watch.Modified) && isVisible(item, time.Now()) {
+				queue.Signal(out)
+			}
+		}
+	}
+}
+
+func isVisible(item *v1alpha1.QueueMessage, now time.Time) bool {
+	if _, ok := item.Labels[LabelDeadLetter]; ok {
+		return false
+	}
+	return mustParseInt64(item.Labels[LabelNextVisibleAt]) <= now.UnixNano()
+}
@@ -58,20 +58,40 @@ type Client interface {
 	ExtendMessage(ctx context.Context, msg *Message) error
 }
 
-// StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
+// StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel. If cli implements
+// Notifier, the dequeuer wakes up as soon as a message arrives and polls only every NotifyFallbackIntervalDuration.
+// Otherwise it polls every DequeueIntervalDuration.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
 	out := make(chan *Message, 1)
 
+	queueconfig := NewDequeueConfig(opts...)
+
+	interval := queueconfig.DequeueIntervalDuration
+	var notifyCh <-chan struct{}
+	if notifier, ok := cli.(Notifier); ok {
+		ch, err := notifier.Notify(ctx)
+		if err == nil {
+			notifyCh = ch
+			if queueconfig.NotifyFallbackIntervalDuration > 0 {
+				interval = queueconfig.NotifyFallbackIntervalDuration
+			}
+		} else {
+			// Polling adds up to DequeueIntervalDuration of latency to every message.
+			ucplog.Warn(log, "queue notifications are not available, falling back to polling", "error", err.Error(), "interval", interval.String())
+		}
+	}
+
 	go func() {
 		for {
-			var queueconfig QueueClientConfig
-			if opts != nil {
-				queueconfig = NewDequeueConfig(opts...)
-			}
 			msg, err := cli.Dequeue(ctx, queueconfig)
 			if err == nil {
 				out <- msg
+
+				// Notifications can be coalesced, so look for the next message right away.
+				if notifyCh != nil && ctx.Err() == nil {
+					continue
+				}
 			} else if !errors.Is(err, ErrMessageNotFound) {
 				log.Error(err, "fails to dequeue the message")
 			}
@@ -80,9 +100,16 @@ func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-c
 			case <-ctx.Done():
 				close(out)
 				return
-			case <-time.After(queueconfig.DequeueIntervalDuration):
+			case _, ok := <-notifyCh:
+				if !ok {
+					if ctx.Err() == nil {
+						ucplog.Warn(log, "queue notifications stopped, falling back to polling", "interval", queueconfig.DequeueIntervalDuration.String())
+					}
+					notifyCh = nil
+					interval = queueconfig.DequeueIntervalDuration
+				}
+			case <-time.After(interval):
 			}
-
 		}
 	}()
 
@@ -21,8 +21,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 const (
@@ -79,3 +84,73 @@ func TestStartDequeuer(t *testing.T) {
 
 	require.Equal(t, 1, recvCnt)
 }
+
+type notifyingClient struct {
+	*MockClient
+	notifyCh chan struct{}
+}
+
+func (c *notifyingClient) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.notifyCh, nil
+}
+
+func TestStartDequeuer_Notify(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	cli := &notifyingClient{MockClient: NewMockClient(mctrl), notifyCh: make(chan struct{}, 1)}
+
+	msg := &Message{Metadata: Metadata{ID: "testID", DequeueCount: 1}, ContentType: JSONContentType, Data: []byte("{}")}
+	firstCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound)
+	secondCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(msg, nil).After(firstCall.Call)
+	cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).AnyTimes().After(secondCall)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The intervals are much longer than the test, so the message can only be dequeued through the notification.
+	msgCh, err := StartDequeuer(ctx, cli, WithDequeueInterval(time.Hour), WithNotifyFallbackInterval(time.Hour))
+	require.NoError(t, err)
+
+	Signal(cli.notifyCh)
+
+	select {
+	case received := <-msgCh:
+		require.Equal(t, msg, received)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "message was not dequeued after notification")
+	}
+}
+
+func TestStartDequeuer_NotifyStopped(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	cli := &notifyingClient{MockClient: NewMockClient(mctrl), notifyCh: make(chan struct{})}
+
+	msg := &Message{Metadata: Metadata{ID: "testID", DequeueCount: 1}, ContentType: JSONContentType, Data: []byte("{}")}
+	firstCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound)
+	secondCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(msg, nil).After(firstCall.Call)
+	cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).AnyTimes().After(secondCall)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx, cancel := context.WithCancel(logr.NewContext(context.TODO(), zapr.NewLogger(zap.New(core))))
+	defer cancel()
+
+	msgCh, err := StartDequeuer(ctx, cli, WithDequeueInterval(defaultTestDequeueInterval), WithNotifyFallbackInterval(time.Hour))
+	require.NoError(t, err)
+
+	// Closing the notification channel falls back to polling with the dequeue interval.
+	close(cli.notifyCh)
+
+	select {
+	case received := <-msgCh:
+		require.Equal(t, msg, received)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "message was not dequeued after notifications stopped")
+	}
+
+	// The fallback is logged as a warning because it adds latency to every message.
+	warnings := logs.FilterLevelExact(zapcore.WarnLevel).FilterMessage("queue notifications stopped, falling back to polling")
+	require.Equal(t, 1, warnings.Len())
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	// DeadLetterReasonMaxDequeueCountExceeded is the reason recorded when a message was dequeued more times than the
+	// consumer allows.
+	DeadLetterReasonMaxDequeueCountExceeded = "MaxDequeueCountExceeded"
+
+	// DeadLetterReasonPanic is the reason recorded when the consumer panicked while processing a message.
+	DeadLetterReasonPanic = "Panic"
+
+	// maxDeadLetterStackLength bounds the stack recorded for a dead-lettered message.
+	maxDeadLetterStackLength = 16 * 1024
+)
+
+var (
+	// ErrDeadLetterNotFound represents the error when a dead-lettered message does not exist.
+	ErrDeadLetterNotFound = errors.New("dead-lettered message not found")
+
+	// ErrDeadLetterUnsupported represents the error when a queue client does not support dead-lettering.
+	ErrDeadLetterUnsupported = errors.New("queue client does not support dead-lettering")
+)
+
+// DeadLetterInfo describes why a message was moved to the dead-letter queue.
+type DeadLetterInfo struct {
+	// Reason is a short machine-readable reason, such as DeadLetterReasonPanic.
+	Reason string `json:"reason"`
+
+	// Error is the last error observed while processing the message.
+	Error string `json:"error,omitempty"`
+
+	// Stack is the stack trace captured when processing panicked.
+	Stack string `json:"stack,omitempty"`
+}
+
+// DeadLetter represents a message in the dead-letter queue.
+type DeadLetter struct {
+	DeadLetterInfo
+
+	// ID is the unique id of the original message.
+	ID string `json:"id"`
+
+	// DequeueCount is the number of times the message was dequeued before it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueueAt is the time when the message was first enqueued.
+	EnqueueAt time.Time `json:"enqueueAt"`
+
+	// DeadLetteredAt is the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+
+	// ContentType is the content type of Data.
+	ContentType string `json:"contentType"`
+
+	// Data is the payload of the original message.
+	Data json.RawMessage `json:"data"`
+}
+
+// NewDeadLetter creates a DeadLetter for msg. The stack in info is truncated to keep dead letters small enough for
+// every queue implementation to store.
+func NewDeadLetter(msg *Message, info DeadLetterInfo, now time.Time) *DeadLetter {
+	if len(info.Stack) > maxDeadLetterStackLength {
+		info.Stack = info.Stack[:maxDeadLetterStackLength]
+	}
+
+	data := make([]byte, len(msg.Data))
+	copy(data, msg.Data)
+
+	return &DeadLetter{
+		DeadLetterInfo: info,
+		ID:             msg.ID,
+		DequeueCount:   msg.DequeueCount,
+		EnqueueAt:      msg.EnqueueAt,
+		DeadLetteredAt: now.UTC(),
+		ContentType:    msg.ContentType,
+		Data:           data,
+	}
+}
+
+//go:generate go tool mockgen -typed -destination=./mock_deadletterclient.go -package=queue -self_package github.com/radius-project/radius/pkg/components/queue github.com/radius-project/radius/pkg/components/queue DeadLetterClient
+
+// DeadLetterClient is implemented by queue clients that can move poisoned messages out of the queue so that they can
+// be inspected, replayed or purged.
+type DeadLetterClient interface {
+	// DeadLetterMessage moves msg, which must be leased by the caller, to the dead-letter queue. It returns
+	// ErrInvalidMessage or ErrDequeuedMessage if the lease has been lost.
+	DeadLetterMessage(ctx context.Context, msg *Message, info DeadLetterInfo) error
+
+	// ListDeadLetters lists the messages in the dead-letter queue, oldest first.
+	ListDeadLetters(ctx context.Context) ([]*DeadLetter, error)
+
+	// GetDeadLetter gets a message in the dead-letter queue by id. It returns ErrDeadLetterNotFound if there is none.
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+
+	// ReplayDeadLetter moves a message from the dead-letter queue back to the queue with its dequeue count reset. It
+	// returns ErrDeadLetterNotFound if there is none.
+	ReplayDeadLetter(ctx context.Context, id string) error
+
+	// PurgeDeadLetter deletes a message from the dead-letter queue. It returns ErrDeadLetterNotFound if there is none.
+	PurgeDeadLetter(ctx context.Context, id string) error
+}
@@ -25,6 +25,8 @@ import (
 
 var namedQueue = &sync.Map{}
 var _ queue.Client = (*Client)(nil)
+var _ queue.DeadLetterClient = (*Client)(nil)
+var _ queue.Notifier = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -89,3 +91,47 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	}
 	return err
 }
+
+// DeadLetterMessage moves the leased message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, info queue.DeadLetterInfo) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, info)
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue, oldest first.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetter gets a message in the dead-letter queue by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	for _, d := range c.queue.DeadLetters() {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return nil, queue.ErrDeadLetterNotFound
+}
+
+// ReplayDeadLetter moves a message from the dead-letter queue back to the queue.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	return c.queue.Replay(id)
+}
+
+// PurgeDeadLetter deletes a message from the dead-letter queue.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	return c.queue.Purge(id)
+}
+
+// Notify returns a channel which receives a value when a message is enqueued.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	ch := c.queue.Subscribe()
+	go func() {
+		<-ctx.Done()
+		c.queue.Unsubscribe(ch)
+	}()
+	return ch, nil
+}
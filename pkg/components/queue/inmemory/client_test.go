@@ -52,4 +52,6 @@ func TestClient(t *testing.T) {
 	}
 
 	sharedtest.RunTest(t, cli, clean)
+	sharedtest.RunDeadLetterTest(t, cli, clean)
+	sharedtest.RunNotifyTest(t, cli, clean)
 }
@@ -43,9 +43,22 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// dead holds the dead-lettered messages, oldest first. It is guarded by vMu.
+	dead []*deadLetterEntry
+
+	// subscribers are notified when a message is enqueued. It is guarded by subMu.
+	subscribers map[chan struct{}]struct{}
+	subMu       sync.Mutex
+
 	lockDuration time.Duration
 }
 
+// deadLetterEntry is a dead-lettered message along with the original message, which is needed to replay it.
+type deadLetterEntry struct {
+	letter *queue.DeadLetter
+	msg    *queue.Message
+}
+
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
 	return &InmemQueue{
 		v:            &list.List{},
@@ -63,6 +76,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	q.dead = nil
 }
 
 func (q *InmemQueue) Enqueue(msg *queue.Message) {
@@ -77,6 +91,7 @@ func (q *InmemQueue) Enqueue(msg *queue.Message) {
 	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
 
 	q.v.PushBack(&element{val: msg, visible: true})
+	q.notify()
 }
 
 func (q *InmemQueue) Dequeue() *queue.Message {
@@ -141,6 +156,119 @@ func (q *InmemQueue) Extend(msg *queue.Message) error {
 	return nil
 }
 
+// DeadLetter moves the leased message to the dead-letter queue.
+func (q *InmemQueue) DeadLetter(msg *queue.Message, info queue.DeadLetterInfo) error {
+	found := false
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == msg.ID {
+			if elem.val.DequeueCount != msg.DequeueCount {
+				return true
+			}
+			found = true
+			q.v.Remove(e)
+			q.dead = append(q.dead, &deadLetterEntry{
+				letter: queue.NewDeadLetter(elem.val, info, time.Now()),
+				msg:    elem.val,
+			})
+			return true
+		}
+		return false
+	})
+
+	if !found {
+		return queue.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// DeadLetters returns the dead-lettered messages, oldest first.
+func (q *InmemQueue) DeadLetters() []*queue.DeadLetter {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	result := make([]*queue.DeadLetter, 0, len(q.dead))
+	for _, d := range q.dead {
+		copied := *d.letter
+		result = append(result, &copied)
+	}
+	return result
+}
+
+// Replay moves the dead-lettered message with the given id back to the queue with its dequeue count reset.
+func (q *InmemQueue) Replay(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	entry := q.removeDeadLetter(id)
+	if entry == nil {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	entry.msg.DequeueCount = 0
+	entry.msg.NextVisibleAt = time.Time{}
+	entry.msg.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	q.v.PushBack(&element{val: entry.msg, visible: true})
+	q.notify()
+	return nil
+}
+
+// Purge deletes the dead-lettered message with the given id.
+func (q *InmemQueue) Purge(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	if q.removeDeadLetter(id) == nil {
+		return queue.ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+// removeDeadLetter removes and returns the dead-lettered message with the given id. q.vMu must be held.
+func (q *InmemQueue) removeDeadLetter(id string) *deadLetterEntry {
+	for i, d := range q.dead {
+		if d.letter.ID == id {
+			q.dead = append(q.dead[:i], q.dead[i+1:]...)
+			return d
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel which receives a value when a message is enqueued or replayed. The channel must be
+// released with Unsubscribe.
+func (q *InmemQueue) Subscribe() chan struct{} {
+	q.subMu.Lock()
+	defer q.subMu.Unlock()
+
+	if q.subscribers == nil {
+		q.subscribers = map[chan struct{}]struct{}{}
+	}
+	ch := make(chan struct{}, 1)
+	q.subscribers[ch] = struct{}{}
+	return ch
+}
+
+// Unsubscribe removes and closes a channel returned by Subscribe.
+func (q *InmemQueue) Unsubscribe(ch chan struct{}) {
+	q.subMu.Lock()
+	defer q.subMu.Unlock()
+
+	if _, ok := q.subscribers[ch]; ok {
+		delete(q.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (q *InmemQueue) notify() {
+	q.subMu.Lock()
+	defer q.subMu.Unlock()
+
+	for ch := range q.subscribers {
+		queue.Signal(ch)
+	}
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
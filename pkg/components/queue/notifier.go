@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNotifyUnsupported represents the error when a queue client cannot notify about new messages.
+	ErrNotifyUnsupported = errors.New("queue client does not support notifications")
+)
+
+// Notifier is implemented by queue clients that can tell when new messages arrive. StartDequeuer uses it to dequeue
+// as soon as a message is enqueued instead of waiting for the next poll.
+type Notifier interface {
+	// Notify returns a channel that receives a value when a message may have become available to Dequeue.
+	// Notifications can be coalesced or spurious, so a Dequeue after a notification can still return
+	// ErrMessageNotFound. Messages which become visible again after their lease expired are not notified.
+	//
+	// The channel is closed when ctx is done, or when the client can no longer deliver notifications. The caller
+	// should fall back to polling in that case.
+	Notify(ctx context.Context) (<-chan struct{}, error)
+}
+
+// Signal sends a notification on ch without blocking. Notifications are coalesced if ch is full.
+func Signal(ch chan<- struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -41,6 +41,11 @@ type (
 type QueueClientConfig struct {
 	// DequeueIntervalDuration is the time duration between 2 successive dequeue attempts on the queue
 	DequeueIntervalDuration time.Duration
+
+	// NotifyFallbackIntervalDuration is the time duration between 2 successive dequeue attempts when the queue client
+	// implements Notifier. Polling is still needed to pick up messages whose lease expired. DequeueIntervalDuration is
+	// used if it is not set.
+	NotifyFallbackIntervalDuration time.Duration
 }
 
 type dequeueOptions struct {
@@ -62,6 +67,16 @@ func WithDequeueInterval(t time.Duration) DequeueOptions {
 	}
 }
 
+// WithNotifyFallbackInterval sets dequeueing interval used when the queue client notifies about new messages.
+func WithNotifyFallbackInterval(t time.Duration) DequeueOptions {
+	return &dequeueOptions{
+		fn: func(cfg QueueClientConfig) QueueClientConfig {
+			cfg.NotifyFallbackIntervalDuration = t
+			return cfg
+		},
+	}
+}
+
 func (q dequeueOptions) private() {}
 
 // NewDequeueConfig returns new queue config for StartDequeuer().
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgres is a PostgreSQL based queue implementation. Messages of every queue are stored as rows of the
+// queue_messages table (see deploy/init-db/db.sql.txt) and are told apart by the queue_name column.
+//
+// We need four operations for the queue:
+//
+//  1. Enqueue: Inserts a row which is visible immediately.
+//  2. Dequeue: Leases the oldest visible row by moving next_visible_at forward by the message lock duration and
+//     incrementing dequeue_count. The row is selected with FOR UPDATE SKIP LOCKED so that concurrent clients never
+//     wait on each other or lease the same message. Rows past expire_at are never leased; visible ones are deleted.
+//  3. FinishMessage: Deletes the leased row.
+//  4. ExtendMessage: Moves next_visible_at of the leased row forward again to postpone the re-queue.
+//
+// Enqueue also sends a NOTIFY on the queue_messages channel with the queue name as payload, so that Notify can wake
+// up consumers through LISTEN instead of waiting for them to poll.
+//
+// All timestamps are taken from the database clock rather than the clock of the client, so there is no clock skew
+// between clients on different nodes. Like the apiserver queue, dequeue_count is used as the revision number of a
+// message: ExtendMessage returns ErrDequeuedMessage if another client has leased the message since, and
+// ErrInvalidMessage if the lease has already expired.
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	dbpostgres "github.com/radius-project/radius/pkg/components/database/postgres"
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+
+	resultSuccess         = "Success"
+	resultDequeuedMessage = "ErrDequeuedMessage"
+)
+
+var (
+	_ queue.Client           = (*Client)(nil)
+	_ queue.DeadLetterClient = (*Client)(nil)
+)
+
+// Client is the queue client backed by PostgreSQL.
+type Client struct {
+	api dbpostgres.PostgresAPI
+
+	opts Options
+}
+
+// Options is the options to create PostgreSQL queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by PostgreSQL. name is unique name for each service which will consume the queue.
+func New(api dbpostgres.PostgresAPI, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	return &Client{api: api, opts: options}, nil
+}
+
+// Enqueue implements queue.Client.
+func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return queue.ErrEmptyMessage
+	}
+
+	if msg.ContentType != queue.JSONContentType {
+		return queue.ErrUnsupportedContentType
+	}
+
+	// pg_notify wakes up the listeners started by Notify. The notification is only delivered if the INSERT commits.
+	_, err := c.api.Exec(
+		ctx, `
+WITH inserted AS (
+	INSERT INTO queue_messages (id, queue_name, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data)
+	VALUES ($1, $2, 0, now(), now() + $3::interval, now(), $4, $5)
+	RETURNING queue_name
+)
+SELECT pg_notify($6, queue_name) FROM inserted`,
+		uuid.NewString(), c.opts.Name, c.opts.ExpiryDuration, msg.ContentType, msg.Data, notifyChannel)
+	return err
+}
+
+// Dequeue implements queue.Client.
+func (c *Client) Dequeue(ctx context.Context, cfg queue.QueueClientConfig) (*queue.Message, error) {
+	// SKIP LOCKED lets concurrent clients lease different messages without blocking each other. The inner
+	// SELECT picks the oldest visible message that has not expired and the UPDATE leases it in the same statement.
+	// Visible messages that have expired are deleted by the same statement. Leased messages are left to the client
+	// processing them.
+	msg := &queue.Message{}
+	err := c.api.QueryRow(
+		ctx, `
+WITH expired AS (
+	DELETE FROM queue_messages
+	WHERE id IN (
+		SELECT id FROM queue_messages
+		WHERE queue_name = $1 AND dead_lettered_at IS NULL AND next_visible_at <= now() AND expire_at <= now()
+		FOR UPDATE SKIP LOCKED
+	)
+)
+UPDATE queue_messages
+SET dequeue_count = dequeue_count + 1, next_visible_at = now() + $2::interval
+WHERE id = (
+	SELECT id FROM queue_messages
+	WHERE queue_name = $1 AND dead_lettered_at IS NULL AND next_visible_at <= now() AND expire_at > now()
+	ORDER BY next_visible_at, enqueue_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING id, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data`,
+		c.opts.Name, c.opts.MessageLockDuration).Scan(
+		&msg.ID, &msg.DequeueCount, &msg.EnqueueAt, &msg.ExpireAt, &msg.NextVisibleAt, &msg.ContentType, &msg.Data)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, queue.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FinishMessage implements queue.Client.
+func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	tag, err := c.api.Exec(
+		ctx,
+		"DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL",
+		msg.ID, c.opts.Name)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return queue.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// ExtendMessage implements queue.Client.
+func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	// The message can only be extended while the lease of the caller is still valid. The CASE reports why the
+	// message could not be extended otherwise.
+	result := ""
+	var nextVisibleAt *time.Time
+	err := c.api.QueryRow(
+		ctx, `
+WITH updated AS (
+	UPDATE queue_messages
+	SET next_visible_at = now() + $4::interval
+	WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL AND dequeue_count = $3 AND next_visible_at >= now()
+	RETURNING next_visible_at
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM updated) THEN 'Success'
+	WHEN EXISTS (SELECT 1 FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL AND dequeue_count <> $3) THEN 'ErrDequeuedMessage'
+	ELSE 'ErrInvalidMessage'
+END AS result,
+(SELECT next_visible_at FROM updated) AS next_visible_at;`,
+		msg.ID, c.opts.Name, msg.DequeueCount, c.opts.MessageLockDuration).Scan(&result, &nextVisibleAt)
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case resultSuccess:
+		msg.NextVisibleAt = *nextVisibleAt
+		return nil
+	case resultDequeuedMessage:
+		return queue.ErrDequeuedMessage
+	default:
+		return queue.ErrInvalidMessage
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, Options{})
+	require.Error(t, err)
+
+	cli, err := New(nil, Options{Name: "radius"})
+	require.NoError(t, err)
+	require.Equal(t, defaultMessageLockDuration, cli.opts.MessageLockDuration)
+	require.Equal(t, defaultExpiryDuration, cli.opts.ExpiryDuration)
+}
+
+func TestClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	// You can get the right value for this by running the command: make db-init
+	url := os.Getenv("TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("TEST_POSTGRES_URL is not set.")
+		return
+	}
+
+	pool, err := pgxpool.New(ctx, url)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	cli, err := New(pool, Options{
+		Name:                "test",
+		MessageLockDuration: sharedtest.TestMessageLockTime,
+	})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		tag, err := pool.Exec(ctx, "DELETE FROM queue_messages WHERE queue_name = $1", "test")
+		require.NoError(t, err)
+		t.Logf("Queue reset ... %d messages deleted", tag.RowsAffected())
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	sharedtest.RunTest(t, cli, clear)
+	sharedtest.RunDeadLetterTest(t, cli, clear)
+	sharedtest.RunNotifyTest(t, cli, clear)
+
+	t.Run("expired messages are not dequeued", func(t *testing.T) {
+		clear(t)
+
+		expiring, err := New(pool, Options{Name: "test", ExpiryDuration: time.Millisecond})
+		require.NoError(t, err)
+		require.NoError(t, expiring.Enqueue(ctx, queue.NewMessage("expired")))
+		time.Sleep(10 * time.Millisecond)
+
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		// Dequeue deletes the expired message.
+		count := 0
+		require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM queue_messages WHERE queue_name = $1", "test").Scan(&count))
+		require.Zero(t, count)
+	})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// Dead-lettered messages stay in the queue_messages table. DeadLetterMessage sets dead_lettered_at, which hides the
+// message from Dequeue, and records queue.DeadLetterInfo in the dead_letter_* columns. ReplayDeadLetter clears them
+// again.
+
+const deadLetterColumns = "id, dequeue_count, enqueue_at, content_type, data, dead_letter_reason, dead_letter_error, dead_letter_stack, dead_lettered_at"
+
+// DeadLetterMessage moves the leased message to the dead-letter queue.
+func (c *Client) DeadLetterMessage(ctx context.Context, msg *queue.Message, info queue.DeadLetterInfo) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	// NewDeadLetter truncates the stack.
+	letter := queue.NewDeadLetter(msg, info, time.Now())
+
+	result := ""
+	err := c.api.QueryRow(
+		ctx, `
+WITH updated AS (
+	UPDATE queue_messages
+	SET dead_letter_reason = $4, dead_letter_error = $5, dead_letter_stack = $6, dead_lettered_at = now()
+	WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL AND dequeue_count = $3
+	RETURNING id
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM updated) THEN 'Success'
+	WHEN EXISTS (SELECT 1 FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NULL) THEN 'ErrDequeuedMessage'
+	ELSE 'ErrInvalidMessage'
+END AS result;`,
+		msg.ID, c.opts.Name, msg.DequeueCount, letter.Reason, letter.Error, letter.Stack).Scan(&result)
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case resultSuccess:
+		return nil
+	case resultDequeuedMessage:
+		return queue.ErrDequeuedMessage
+	default:
+		return queue.ErrInvalidMessage
+	}
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue, oldest first.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*queue.DeadLetter, error) {
+	rows, err := c.api.Query(
+		ctx,
+		"SELECT "+deadLetterColumns+" FROM queue_messages WHERE queue_name = $1 AND dead_lettered_at IS NOT NULL ORDER BY dead_lettered_at",
+		c.opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*queue.DeadLetter{}
+	for rows.Next() {
+		letter, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, letter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetDeadLetter gets a message in the dead-letter queue by id.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*queue.DeadLetter, error) {
+	letter, err := scanDeadLetter(c.api.QueryRow(
+		ctx,
+		"SELECT "+deadLetterColumns+" FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL",
+		id, c.opts.Name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, queue.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return letter, nil
+}
+
+// ReplayDeadLetter moves a message from the dead-letter queue back to the queue with its dequeue count reset.
+func (c *Client) ReplayDeadLetter(ctx context.Context, id string) error {
+	tag, err := c.api.Exec(
+		ctx, `
+WITH replayed AS (
+	UPDATE queue_messages
+	SET dequeue_count = 0, next_visible_at = now(), expire_at = now() + $3::interval,
+		dead_letter_reason = NULL, dead_letter_error = NULL, dead_letter_stack = NULL, dead_lettered_at = NULL
+	WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL
+	RETURNING queue_name
+)
+SELECT pg_notify($4, queue_name) FROM replayed`,
+		id, c.opts.Name, c.opts.ExpiryDuration, notifyChannel)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeadLetter deletes a message from the dead-letter queue.
+func (c *Client) PurgeDeadLetter(ctx context.Context, id string) error {
+	tag, err := c.api.Exec(
+		ctx,
+		"DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2 AND dead_lettered_at IS NOT NULL",
+		id, c.opts.Name)
+	if err != nil {
+		return err
+	} else if tag.RowsAffected() == 0 {
+		return queue.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+// scanDeadLetter scans a row selected with deadLetterColumns.
+func scanDeadLetter(row pgx.Row) (*queue.DeadLetter, error) {
+	msg := &queue.Message{}
+	var reason, errorMessage, stack *string
+	var deadLetteredAt time.Time
+	err := row.Scan(&msg.ID, &msg.DequeueCount, &msg.EnqueueAt, &msg.ContentType, &msg.Data, &reason, &errorMessage, &stack, &deadLetteredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue.NewDeadLetter(msg, queue.DeadLetterInfo{
+		Reason: to.String(reason),
+		Error:  to.String(errorMessage),
+		Stack:  to.String(stack),
+	}, deadLetteredAt), nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// notifyChannel is the channel used with NOTIFY and LISTEN. The payload is the queue name.
+	notifyChannel = "queue_messages"
+
+	// listenRetryInterval is the interval between attempts to reconnect the listener.
+	listenRetryInterval = time.Duration(5) * time.Second
+)
+
+var _ queue.Notifier = (*Client)(nil)
+
+// ConnectionAcquirer is implemented by pgxpool.Pool. LISTEN needs a dedicated connection, which PostgresAPI cannot
+// provide.
+type ConnectionAcquirer interface {
+	// Acquire returns a connection from the pool.
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// Notify listens for the notifications sent by Enqueue and ReplayDeadLetter. It returns queue.ErrNotifyUnsupported
+// if the client was not created with a connection pool.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	acquirer, ok := c.api.(ConnectionAcquirer)
+	if !ok {
+		return nil, queue.ErrNotifyUnsupported
+	}
+
+	// Start listening here so that the caller can fall back to polling if it fails.
+	conn, err := c.listen(ctx, acquirer)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan struct{}, 1)
+	go func() {
+		defer close(out)
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		for {
+			err := c.forwardNotifications(ctx, conn, out)
+			closeConn(conn)
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error(err, "lost connection listening for queue messages", "queue", c.opts.Name)
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(listenRetryInterval):
+				}
+
+				conn, err = c.listen(ctx, acquirer)
+				if err == nil {
+					break
+				}
+				logger.Error(err, "failed to listen for queue messages", "queue", c.opts.Name)
+			}
+
+			// Messages may have been enqueued while the listener was down.
+			queue.Signal(out)
+		}
+	}()
+
+	return out, nil
+}
+
+// listen takes a connection out of the pool and subscribes it to notifyChannel. The connection is removed from the pool
+// because it stays subscribed until it is closed.
+func (c *Client) listen(ctx context.Context, acquirer ConnectionAcquirer) (*pgx.Conn, error) {
+	pooled, err := acquirer.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := pooled.Hijack()
+	_, err = conn.Exec(ctx, "LISTEN "+notifyChannel)
+	if err != nil {
+		closeConn(conn)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// forwardNotifications signals out for every notification of this queue until the connection fails or ctx is done.
+func (c *Client) forwardNotifications(ctx context.Context, conn *pgx.Conn, out chan<- struct{}) error {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		if notification.Payload == c.opts.Name {
+			queue.Signal(out)
+		}
+	}
+}
+
+func closeConn(conn *pgx.Conn) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(5)*time.Second)
+	defer cancel()
+	_ = conn.Close(ctx)
+}
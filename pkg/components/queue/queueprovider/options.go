@@ -16,6 +16,8 @@ limitations under the License.
 
 package queueprovider
 
+import "time"
+
 // QueueProviderOptions represents the queueprovider options.
 type QueueProviderOptions struct {
 	// Provider configures the queue provider.
@@ -29,6 +31,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// PostgreSQL configures options for the PostgreSQL queue. (Optional)
+	PostgreSQL PostgreSQLOptions `yaml:"postgresql,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +49,22 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// PostgreSQLOptions represents options for the PostgreSQL queue.
+type PostgreSQLOptions struct {
+	// URL is the connection information for the PostgreSQL database in URL format. The database must contain
+	// the queue_messages table from deploy/init-db/db.sql.txt.
+	//
+	// The URL should be formatted according to:
+	// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING-URIS
+	//
+	// The URL can contain secrets like passwords so it must be treated as sensitive.
+	//
+	// In place of the actual URL, you can substitute an environment variable by using the format:
+	// 	${ENV_VAR_NAME}
+	URL string `yaml:"url"`
+
+	// MessageLockDuration is the duration a dequeued message stays invisible to other consumers unless its lease is
+	// extended. Defaults to 5 minutes.
+	MessageLockDuration time.Duration `yaml:"messageLockDuration,omitempty"`
+}
@@ -64,3 +64,23 @@ func (p *QueueProvider) GetClient(ctx context.Context) (queue.Client, error) {
 func (p *QueueProvider) SetClient(client queue.Client) {
 	p.queueClient = client
 }
+
+// GetDeadLetterClient creates or gets the queue client as a queue.DeadLetterClient. It returns
+// queue.ErrDeadLetterUnsupported if the queue provider does not support dead-lettering.
+func (p *QueueProvider) GetDeadLetterClient(ctx context.Context) (queue.DeadLetterClient, error) {
+	client, err := p.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dlc, ok := client.(queue.DeadLetterClient)
+	if !ok {
+		return nil, queue.ErrDeadLetterUnsupported
+	}
+	return dlc, nil
+}
+
+// Options returns the options the QueueProvider was created with.
+func (p *QueueProvider) Options() QueueProviderOptions {
+	return p.options
+}
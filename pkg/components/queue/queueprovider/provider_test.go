@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/queue"
 )
 
 func TestGetClient_ValidQueue(t *testing.T) {
@@ -47,3 +49,59 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedQueueProvider, err)
 }
+
+func TestGetDeadLetterClient(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeInmemory,
+		InMemory: &InMemoryQueueOptions{},
+	})
+
+	cli, err := p.GetDeadLetterClient(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, cli)
+
+	p.SetClient(queue.NewMockClient(nil))
+	_, err = p.GetDeadLetterClient(context.TODO())
+	require.ErrorIs(t, err, queue.ErrDeadLetterUnsupported)
+}
+
+func TestGetClient_PostgreSQL(t *testing.T) {
+	t.Run("url is required", func(t *testing.T) {
+		p := New(QueueProviderOptions{
+			Name:     "radius",
+			Provider: TypePostgreSQL,
+		})
+
+		_, err := p.GetClient(context.TODO())
+		require.EqualError(t, err, "failed to initialize PostgreSQL client: URL is required")
+	})
+
+	t.Run("url from environment variable", func(t *testing.T) {
+		t.Setenv("TEST_QUEUE_POSTGRES_URL", "postgresql://radius@localhost:5432/radius")
+		p := New(QueueProviderOptions{
+			Name:       "radius",
+			Provider:   TypePostgreSQL,
+			PostgreSQL: PostgreSQLOptions{URL: "${TEST_QUEUE_POSTGRES_URL}"},
+		})
+
+		// The pool connects lazily, so no database is needed to create the client.
+		cli, err := p.GetClient(context.TODO())
+		require.NoError(t, err)
+		require.NotNil(t, cli)
+
+		_, err = p.GetDeadLetterClient(context.TODO())
+		require.NoError(t, err)
+	})
+
+	t.Run("environment variable is not set", func(t *testing.T) {
+		p := New(QueueProviderOptions{
+			Name:       "radius",
+			Provider:   TypePostgreSQL,
+			PostgreSQL: PostgreSQLOptions{URL: "${TEST_QUEUE_POSTGRES_URL_NOT_SET}"},
+		})
+
+		_, err := p.GetClient(context.TODO())
+		require.EqualError(t, err, `failed to initialize PostgreSQL client: environment variable "TEST_QUEUE_POSTGRES_URL_NOT_SET" is not set`)
+	})
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The condition types follow the conventions understood by kstatus based tools like `kubectl wait`, Argo CD
+// and Flux. Reconciling and Stalled are "abnormal-true" conditions: they are only present while they are True.
+const (
+	// ConditionReady is the type of the condition reporting whether the resource is in its desired state.
+	ConditionReady = "Ready"
+
+	// ConditionReconciling is the type of the condition reporting that the controller is making progress
+	// towards the desired state of the resource, such as while a Radius operation is in progress.
+	ConditionReconciling = "Reconciling"
+
+	// ConditionStalled is the type of the condition reporting that the last attempt to reach the desired
+	// state of the resource failed. The controller keeps retrying, but the failure usually requires a change
+	// to the resource or its dependencies.
+	ConditionStalled = "Stalled"
+)
+
+const (
+	// ReasonReconciled indicates that the resource is in its desired state.
+	ReasonReconciled = "Reconciled"
+
+	// ReasonUpdating indicates that an operation creating or updating the resource is in progress.
+	ReasonUpdating = "Updating"
+
+	// ReasonDeleting indicates that an operation deleting the resource is in progress.
+	ReasonDeleting = "Deleting"
+
+	// ReasonDeleted indicates that the resources created for the resource were deleted, and that the resource
+	// is being removed.
+	ReasonDeleted = "Deleted"
+
+	// ReasonCleaningUp indicates that the resource was deployed and resources it no longer contains are
+	// being deleted.
+	ReasonCleaningUp = "CleaningUp"
+
+	// ReasonDependencyError indicates that the dependencies of the resource, such as its environment or
+	// application, could not be resolved.
+	ReasonDependencyError = "DependencyError"
+
+	// ReasonResourceError indicates that an operation on the resource failed. When the failure reports an
+	// error code, such as the code of a recipe error, the error code is used as the reason instead.
+	ReasonResourceError = "ResourceError"
+)
+
+// GetConditions returns the status conditions of the Recipe.
+func (r *Recipe) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the Recipe.
+func (r *Recipe) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the DeploymentTemplate.
+func (dt *DeploymentTemplate) GetConditions() []metav1.Condition {
+	return dt.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the DeploymentTemplate.
+func (dt *DeploymentTemplate) SetConditions(conditions []metav1.Condition) {
+	dt.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the DeploymentResource.
+func (dr *DeploymentResource) GetConditions() []metav1.Condition {
+	return dr.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the DeploymentResource.
+func (dr *DeploymentResource) SetConditions(conditions []metav1.Condition) {
+	dr.Status.Conditions = conditions
+}
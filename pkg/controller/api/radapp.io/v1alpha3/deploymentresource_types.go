@@ -41,6 +41,13 @@ type DeploymentResourceStatus struct {
 
 	// Phrase indicates the current status of the Deployment Resource.
 	Phrase DeploymentResourcePhrase `json:"phrase,omitempty"`
+
+	// Conditions describe the current state of the DeploymentResource using the Ready, Reconciling and Stalled
+	// condition types.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // DeploymentResourcePhrase is a string representation of the current status of a Deployment Resource.
@@ -63,6 +70,7 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource is ready",priority=1
 // +kubebuilder:resource:categories={"all","radius"}
 
 // DeploymentResource is the Schema for the DeploymentResources API
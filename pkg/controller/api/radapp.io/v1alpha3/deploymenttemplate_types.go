@@ -53,6 +53,13 @@ type DeploymentTemplateStatus struct {
 
 	// Phrase indicates the current status of the Deployment Template.
 	Phrase DeploymentTemplatePhrase `json:"phrase,omitempty"`
+
+	// Conditions describe the current state of the DeploymentTemplate using the Ready, Reconciling and Stalled
+	// condition types.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // DeploymentTemplatePhrase is a string representation of the current status of a Deployment Template.
@@ -83,6 +90,7 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource is ready",priority=1
 // +kubebuilder:resource:categories={"all","radius"}
 
 // DeploymentTemplate is the Schema for the deploymenttemplates API
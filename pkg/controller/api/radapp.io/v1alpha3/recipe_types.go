@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -41,6 +42,20 @@ type RecipeSpec struct {
 	// Application is the name of the Radius application to use. If unset the namespace of the
 	// Recipe will be used as the application name.
 	Application string `json:"application,omitempty"`
+
+	// RecipeName is the name of the recipe to use from the recipes registered in the environment. If unset
+	// the recipe named 'default' will be used.
+	// +kubebuilder:validation:Optional
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Parameters are the parameters passed to the recipe. Values can be of any JSON type.
+	// +kubebuilder:validation:Optional
+	Parameters map[string]apiextensionsv1.JSON `json:"parameters,omitempty"`
+
+	// ConfigMapName is the name of a Kubernetes config map to create with the non-sensitive outputs of the
+	// recipe once the resource is created. Sensitive outputs are only written to the secret.
+	// +kubebuilder:validation:Optional
+	ConfigMapName string `json:"configMapName,omitempty"`
 }
 
 // RecipePhrase is a string representation of the current status of a Recipe.
@@ -98,6 +113,22 @@ type RecipeStatus struct {
 	// Secret specifies a reference to the secret being managed by this Recipe.
 	// +kubebuilder:validation:Optional
 	Secret corev1.ObjectReference `json:"secret,omitempty"`
+
+	// StatusHash is a hash of the recipe name and parameters used to create or update the resource.
+	// +kubebuilder:validation:Optional
+	StatusHash string `json:"statusHash,omitempty"`
+
+	// ConfigMap specifies a reference to the config map being managed by this Recipe.
+	// +kubebuilder:validation:Optional
+	ConfigMap corev1.ObjectReference `json:"configMap,omitempty"`
+
+	// Conditions describe the current state of the Recipe using the Ready, Reconciling and Stalled
+	// condition types. The reason and message of the conditions report the errors of the recipe, such as
+	// the failure of its deployment.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // ResourceOperation describes the status of an in-progress provisioning operation.
@@ -124,7 +155,10 @@ const (
 //+kubebuilder:resource:categories={"all","radius"}
 //+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of resource the recipe should create"
 //+kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Name of the secret to create"
+//+kubebuilder:printcolumn:name="Recipe",type="string",JSONPath=".spec.recipeName",description="Name of the recipe to use",priority=1
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource is ready",priority=1
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason of the status of the resource",priority=1
 //+kubebuilder:subresource:status
 
 // Recipe is the Schema for the recipes API
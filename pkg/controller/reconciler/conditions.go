@@ -0,0 +1,157 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azcoreruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxConditionMessageLength is the maximum length of the message of a condition accepted by the API server.
+	maxConditionMessageLength = 32768
+)
+
+// conditionReasonPattern is the pattern the reason of a condition must match.
+var conditionReasonPattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// conditionsObject is a radapp.io resource reporting its state with status conditions.
+type conditionsObject interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// markReady marks the object as being in its desired state.
+func markReady(recorder record.EventRecorder, obj conditionsObject, reason string, message string) {
+	setConditions(recorder, obj, metav1.ConditionTrue, "", "", reason, message)
+}
+
+// markReconciling marks the object as making progress towards its desired state.
+func markReconciling(recorder record.EventRecorder, obj conditionsObject, reason string, message string) {
+	setConditions(recorder, obj, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling, radappiov1alpha3.ConditionStalled, reason, message)
+}
+
+// markStalled marks the object as having failed to reach its desired state.
+func markStalled(recorder record.EventRecorder, obj conditionsObject, reason string, message string) {
+	setConditions(recorder, obj, metav1.ConditionFalse, radappiov1alpha3.ConditionStalled, radappiov1alpha3.ConditionReconciling, reason, message)
+}
+
+// markDeleted marks the object as having its resources deleted, right before it is removed.
+func markDeleted(recorder record.EventRecorder, obj conditionsObject, message string) {
+	setConditions(recorder, obj, metav1.ConditionFalse, "", "", radappiov1alpha3.ReasonDeleted, message)
+}
+
+// setConditions sets the Ready condition of the object along with the given abnormal-true condition, and removes
+// the other abnormal-true condition.
+//
+// A Normal event is recorded when the status or reason of the Ready condition changes, so that transitions are
+// visible with `kubectl describe` without recording an event for every reconciliation. A Warning event is recorded
+// for every failed reconciliation, even if it fails for the same reason as the previous one.
+func setConditions(recorder record.EventRecorder, obj conditionsObject, ready metav1.ConditionStatus, set string, remove string, reason string, message string) {
+	conditions := obj.GetConditions()
+	previous := meta.FindStatusCondition(conditions, radappiov1alpha3.ConditionReady)
+	transition := previous == nil || previous.Status != ready || previous.Reason != reason
+
+	meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               radappiov1alpha3.ConditionReady,
+		Status:             ready,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+
+	if set != "" {
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               set,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: obj.GetGeneration(),
+			Reason:             reason,
+			Message:            message,
+		})
+	} else {
+		meta.RemoveStatusCondition(&conditions, radappiov1alpha3.ConditionReconciling)
+		meta.RemoveStatusCondition(&conditions, radappiov1alpha3.ConditionStalled)
+	}
+
+	if remove != "" {
+		meta.RemoveStatusCondition(&conditions, remove)
+	}
+
+	obj.SetConditions(conditions)
+
+	if recorder == nil {
+		return
+	}
+
+	if set == radappiov1alpha3.ConditionStalled {
+		recorder.Event(obj, corev1.EventTypeWarning, reason, message)
+	} else if transition {
+		recorder.Event(obj, corev1.EventTypeNormal, reason, message)
+	}
+}
+
+// conditionErrorResponse is the ARM error envelope returned by Radius for failed requests and operations.
+type conditionErrorResponse struct {
+	Error *struct {
+		Code    string `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"error,omitempty"`
+}
+
+// conditionReasonForError returns the reason of a condition reporting the given error. The error code returned by
+// Radius is used when present, such as the code of a recipe error, so that users can tell failures apart without
+// reading the message.
+func conditionReasonForError(err error) string {
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) && conditionReasonPattern.MatchString(responseError.ErrorCode) {
+		return responseError.ErrorCode
+	}
+
+	return radappiov1alpha3.ReasonResourceError
+}
+
+// conditionMessageForError returns the message of a condition reporting the given error. The error message returned
+// by Radius is used when present instead of the full HTTP response dump of azcore.ResponseError.
+func conditionMessageForError(err error) string {
+	message := err.Error()
+
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) && responseError.RawResponse != nil {
+		payload, payloadErr := azcoreruntime.Payload(responseError.RawResponse)
+		response := conditionErrorResponse{}
+		if payloadErr == nil && json.Unmarshal(payload, &response) == nil && response.Error != nil && response.Error.Message != "" {
+			message = response.Error.Message
+		}
+	}
+
+	if len(message) > maxConditionMessageLength {
+		message = message[:maxConditionMessageLength]
+	}
+
+	return message
+}
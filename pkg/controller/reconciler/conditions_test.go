@@ -0,0 +1,154 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_ConditionReasonForError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "error code",
+			err:      &azcore.ResponseError{ErrorCode: "RecipeDeploymentFailed"},
+			expected: "RecipeDeploymentFailed",
+		},
+		{
+			name:     "wrapped error code",
+			err:      errors.Join(errors.New("failed"), &azcore.ResponseError{ErrorCode: "RecipeDeploymentFailed"}),
+			expected: "RecipeDeploymentFailed",
+		},
+		{
+			name:     "invalid error code",
+			err:      &azcore.ResponseError{ErrorCode: "Not a reason"},
+			expected: radappiov1alpha3.ReasonResourceError,
+		},
+		{
+			name:     "missing error code",
+			err:      &azcore.ResponseError{},
+			expected: radappiov1alpha3.ReasonResourceError,
+		},
+		{
+			name:     "other error",
+			err:      errors.New("oops"),
+			expected: radappiov1alpha3.ReasonResourceError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, conditionReasonForError(tt.err))
+		})
+	}
+}
+
+func Test_ConditionMessageForError(t *testing.T) {
+	newResponseError := func(body string) error {
+		return &azcore.ResponseError{
+			ErrorCode:  "RecipeDeploymentFailed",
+			StatusCode: http.StatusOK,
+			RawResponse: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    httptest.NewRequest(http.MethodGet, "http://localhost/operationStatuses/test", nil),
+			},
+		}
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		err := newResponseError(`{"status":"Failed","error":{"code":"RecipeDeploymentFailed","message":"failed to deploy recipe default"}}`)
+		require.Equal(t, "failed to deploy recipe default", conditionMessageForError(err))
+	})
+
+	t.Run("missing error message", func(t *testing.T) {
+		err := newResponseError(`{"status":"Failed"}`)
+		require.Contains(t, conditionMessageForError(err), "ERROR CODE: RecipeDeploymentFailed")
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		require.Equal(t, "oops", conditionMessageForError(errors.New("oops")))
+	})
+
+	t.Run("long message", func(t *testing.T) {
+		message := conditionMessageForError(errors.New(strings.Repeat("a", maxConditionMessageLength+1)))
+		require.Len(t, message, maxConditionMessageLength)
+	})
+}
+
+func Test_SetConditions(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	recipe := &radappiov1alpha3.Recipe{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Generation: 2}}
+
+	requireConditions := func(t *testing.T, ready metav1.ConditionStatus, abnormal string, reason string) {
+		condition := meta.FindStatusCondition(recipe.Status.Conditions, radappiov1alpha3.ConditionReady)
+		require.NotNil(t, condition)
+		require.Equal(t, ready, condition.Status)
+		require.Equal(t, reason, condition.Reason)
+		require.Equal(t, int64(2), condition.ObservedGeneration)
+
+		for _, conditionType := range []string{radappiov1alpha3.ConditionReconciling, radappiov1alpha3.ConditionStalled} {
+			if conditionType == abnormal {
+				require.True(t, meta.IsStatusConditionTrue(recipe.Status.Conditions, conditionType))
+			} else {
+				require.Nil(t, meta.FindStatusCondition(recipe.Status.Conditions, conditionType))
+			}
+		}
+	}
+
+	markReconciling(recorder, recipe, radappiov1alpha3.ReasonUpdating, "Creating or updating the resource.")
+	requireConditions(t, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling, radappiov1alpha3.ReasonUpdating)
+	require.Equal(t, "Normal Updating Creating or updating the resource.", <-recorder.Events)
+
+	// No event is recorded when the Ready condition doesn't change.
+	markReconciling(recorder, recipe, radappiov1alpha3.ReasonUpdating, "Creating or updating the resource.")
+	require.Empty(t, recorder.Events)
+
+	markStalled(recorder, recipe, "RecipeDeploymentFailed", "failed to deploy recipe")
+	requireConditions(t, metav1.ConditionFalse, radappiov1alpha3.ConditionStalled, "RecipeDeploymentFailed")
+	require.Equal(t, "Warning RecipeDeploymentFailed failed to deploy recipe", <-recorder.Events)
+
+	// A Warning event is recorded for every failure, even when the reason doesn't change.
+	markStalled(recorder, recipe, "RecipeDeploymentFailed", "failed to deploy recipe")
+	require.Equal(t, "Warning RecipeDeploymentFailed failed to deploy recipe", <-recorder.Events)
+
+	markReady(recorder, recipe, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
+	requireConditions(t, metav1.ConditionTrue, "", radappiov1alpha3.ReasonReconciled)
+	require.Equal(t, "Normal Reconciled Successfully reconciled resource.", <-recorder.Events)
+
+	markReady(recorder, recipe, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
+	require.Empty(t, recorder.Events)
+
+	markDeleted(recorder, recipe, "Successfully deleted the resource.")
+	requireConditions(t, metav1.ConditionFalse, "", radappiov1alpha3.ReasonDeleted)
+	require.Equal(t, "Normal Deleted Successfully deleted the resource.", <-recorder.Events)
+}
@@ -36,7 +36,6 @@ import (
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
-	corev1 "k8s.io/api/core/v1"
 )
 
 // DeploymentResourceReconciler reconciles a DeploymentResource object.
@@ -119,12 +118,12 @@ func (r *DeploymentResourceReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 	deploymentResource.Status.Phrase = radappiov1alpha3.DeploymentResourcePhraseReady
 	deploymentResource.Status.Id = deploymentResource.Spec.Id
+	markReady(r.EventRecorder, &deploymentResource, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
 	err = r.Client.Status().Update(ctx, &deploymentResource)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(&deploymentResource, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
 	return ctrl.Result{}, nil
 }
 
@@ -163,10 +162,9 @@ func (r *DeploymentResourceReconciler) reconcileOperation(ctx context.Context, d
 			}
 
 			// Operation failed, reset state and retry.
-			r.EventRecorder.Event(deploymentResource, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Delete failed.")
 
-			if statusErr := r.updateFailedStatus(ctx, deploymentResource); statusErr != nil {
+			if statusErr := r.updateFailedStatus(ctx, deploymentResource, err); statusErr != nil {
 				return ctrl.Result{}, statusErr
 			}
 
@@ -190,9 +188,10 @@ func (r *DeploymentResourceReconciler) reconcileOperation(ctx context.Context, d
 
 	// If we get here, this was an unknown operation kind. This is a bug in our code, or someone
 	// tampered with the status of the object. Just reset the state and move on.
-	logger.Error(fmt.Errorf("unknown operation kind: %s", deploymentResource.Status.Operation.OperationKind), "Unknown operation kind.")
+	err := fmt.Errorf("unknown operation kind: %s", deploymentResource.Status.Operation.OperationKind)
+	logger.Error(err, "Unknown operation kind.")
 
-	if err := r.updateFailedStatus(ctx, deploymentResource); err != nil {
+	if err := r.updateFailedStatus(ctx, deploymentResource, err); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -228,6 +227,7 @@ func (r *DeploymentResourceReconciler) reconcileDelete(ctx context.Context, depl
 	// fully processed any status changes until the async operation completes.
 	deploymentResource.Status.ObservedGeneration = deploymentResource.Generation
 	deploymentResource.Status.Phrase = radappiov1alpha3.DeploymentResourcePhraseDeleting
+	markReconciling(r.EventRecorder, deploymentResource, radappiov1alpha3.ReasonDeleting, "Deleting the resource.")
 	err = r.Client.Status().Update(ctx, deploymentResource)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -236,8 +236,7 @@ func (r *DeploymentResourceReconciler) reconcileDelete(ctx context.Context, depl
 	deletePoller, err := r.startDeleteOperation(ctx, deploymentResource)
 	if err != nil {
 		logger.Error(err, "Unable to delete resource.")
-		r.EventRecorder.Event(deploymentResource, corev1.EventTypeWarning, "ResourceError", err.Error())
-		if statusErr := r.updateFailedStatus(ctx, deploymentResource); statusErr != nil {
+		if statusErr := r.updateFailedStatus(ctx, deploymentResource, err); statusErr != nil {
 			return ctrl.Result{}, statusErr
 		}
 		// Bounded retry; see reconcileOperation.
@@ -266,9 +265,8 @@ func (r *DeploymentResourceReconciler) reconcileDelete(ctx context.Context, depl
 			} else {
 				// Delete failed, update status and return error
 				logger.Error(err, "Synchronous delete failed.")
-				r.EventRecorder.Event(deploymentResource, corev1.EventTypeWarning, "ResourceError", err.Error())
 
-				if statusErr := r.updateFailedStatus(ctx, deploymentResource); statusErr != nil {
+				if statusErr := r.updateFailedStatus(ctx, deploymentResource, err); statusErr != nil {
 					return ctrl.Result{}, statusErr
 				}
 				// Bounded retry; see reconcileOperation.
@@ -335,11 +333,12 @@ func (r *DeploymentResourceReconciler) completeDeleteOperation(ctx context.Conte
 	return nil
 }
 
-// updateFailedStatus updates the resource status to failed state and clears the operation.
-// This helper reduces duplication when handling operation failures.
-func (r *DeploymentResourceReconciler) updateFailedStatus(ctx context.Context, deploymentResource *radappiov1alpha3.DeploymentResource) error {
+// updateFailedStatus updates the resource status to failed state, reporting the error in its conditions, and clears
+// the operation. This helper reduces duplication when handling operation failures.
+func (r *DeploymentResourceReconciler) updateFailedStatus(ctx context.Context, deploymentResource *radappiov1alpha3.DeploymentResource, err error) error {
 	deploymentResource.Status.Operation = nil
 	deploymentResource.Status.Phrase = radappiov1alpha3.DeploymentResourcePhraseFailed
+	markStalled(r.EventRecorder, deploymentResource, conditionReasonForError(err), conditionMessageForError(err))
 	return r.Client.Status().Update(ctx, deploymentResource)
 }
 
@@ -204,6 +204,7 @@ func waitForDeploymentResourceStateReady(t *testing.T, client k8sClient.Client,
 		logger.Logf("DeploymentResource.Status: %+v", current.Status)
 		if assert.Equal(t, radappiov1alpha3.DeploymentResourcePhraseReady, current.Status.Phrase) {
 			assert.Empty(t, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionTrue, "")
 		}
 	}, DeploymentResourceTestWaitDuration, DeploymentResourceTestWaitInterval, "failed to enter ready state")
 
@@ -228,6 +229,7 @@ func waitForDeploymentResourceStateDeleting(t *testing.T, client k8sClient.Clien
 		if assert.Equal(t, radappiov1alpha3.DeploymentResourcePhraseDeleting, current.Status.Phrase) {
 			assert.NotEmpty(t, current.Status.Operation)
 			assert.NotEqual(t, oldOperation, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
 		}
 	}, DeploymentResourceTestWaitDuration, DeploymentResourceTestWaitInterval, "failed to enter deleting state")
 
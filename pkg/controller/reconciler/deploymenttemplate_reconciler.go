@@ -39,7 +39,6 @@ import (
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
-	corev1 "k8s.io/api/core/v1"
 )
 
 // DeploymentTemplateReconciler reconciles a DeploymentTemplate object.
@@ -159,10 +158,9 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 			}
 
 			// Operation failed, reset state and schedule delayed retry.
-			r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Update failed.")
 
-			if err := r.updateFailedStatus(ctx, deploymentTemplate); err != nil {
+			if err := r.updateFailedStatus(ctx, deploymentTemplate, err); err != nil {
 				return ctrl.Result{}, err
 			}
 
@@ -288,8 +286,10 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 		deploymentTemplate.Status.StatusHash = hash
 		if residualsPresent {
 			deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReadyPendingCleanup
+			markReconciling(r.EventRecorder, deploymentTemplate, radappiov1alpha3.ReasonCleaningUp, "Waiting for the resources removed from the template to be deleted.")
 		} else {
 			deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReady
+			markReady(r.EventRecorder, deploymentTemplate, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
 		}
 
 		err = r.Client.Status().Update(ctx, deploymentTemplate)
@@ -311,10 +311,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 	errorMessage := fmt.Errorf("unknown operation kind: %s", deploymentTemplate.Status.Operation.OperationKind)
 	logger.Error(errorMessage, "Unknown operation kind.")
 
-	deploymentTemplate.Status.Operation = nil
-	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseFailed
-	err := r.Client.Status().Update(ctx, deploymentTemplate)
-	if err != nil {
+	if err := r.updateFailedStatus(ctx, deploymentTemplate, errorMessage); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -343,9 +340,8 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 	updatePoller, err := r.startPutOperationIfNeeded(ctx, deploymentTemplate)
 	if err != nil {
 		logger.Error(err, "Unable to create or update resource.")
-		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
 
-		if statusErr := r.updateFailedStatus(ctx, deploymentTemplate); statusErr != nil {
+		if statusErr := r.updateFailedStatus(ctx, deploymentTemplate, err); statusErr != nil {
 			return ctrl.Result{}, statusErr
 		}
 
@@ -361,6 +357,7 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 
 		deploymentTemplate.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
 		deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseUpdating
+		markReconciling(r.EventRecorder, deploymentTemplate, radappiov1alpha3.ReasonUpdating, "Deploying the template.")
 		err = r.Client.Status().Update(ctx, deploymentTemplate)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -386,12 +383,12 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 	}
 
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReady
+	markReady(r.EventRecorder, deploymentTemplate, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
 	err = r.Client.Status().Update(ctx, deploymentTemplate)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
 	return ctrl.Result{}, nil
 }
 
@@ -406,6 +403,7 @@ func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, depl
 	// fully processed any status changes until the async operation completes.
 	deploymentTemplate.Status.ObservedGeneration = deploymentTemplate.Generation
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseDeleting
+	markReconciling(r.EventRecorder, deploymentTemplate, radappiov1alpha3.ReasonDeleting, "Deleting the resources created by the template.")
 	err := r.Client.Status().Update(ctx, deploymentTemplate)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -454,7 +452,7 @@ func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, depl
 			return ctrl.Result{}, err
 		}
 
-		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+		markDeleted(r.EventRecorder, deploymentTemplate, "Successfully deleted the resources created by the template.")
 		return ctrl.Result{}, nil
 	}
 
@@ -622,11 +620,12 @@ func isUpToDate(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) bool {
 	return deploymentTemplate.Status.StatusHash == hash
 }
 
-// updateFailedStatus updates the deployment template status to failed state and clears the operation.
-// This helper reduces duplication when handling operation failures.
-func (r *DeploymentTemplateReconciler) updateFailedStatus(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) error {
+// updateFailedStatus updates the deployment template status to failed state, reporting the error in its conditions,
+// and clears the operation. This helper reduces duplication when handling operation failures.
+func (r *DeploymentTemplateReconciler) updateFailedStatus(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate, err error) error {
 	deploymentTemplate.Status.Operation = nil
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseFailed
+	markStalled(r.EventRecorder, deploymentTemplate, conditionReasonForError(err), conditionMessageForError(err))
 	return r.Client.Status().Update(ctx, deploymentTemplate)
 }
 
@@ -35,6 +35,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -979,6 +980,7 @@ func waitForDeploymentTemplateStateUpdating(t *testing.T, client k8sclient.Clien
 		if assert.Equal(t, radappiov1alpha3.DeploymentTemplatePhraseUpdating, current.Status.Phrase) {
 			assert.NotEmpty(t, current.Status.Operation)
 			assert.NotEqual(t, oldOperation, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
 		}
 
 	}, deploymentTemplateTestWaitDuration, deploymentTemplateTestWaitInterval, "failed to enter updating state")
@@ -1003,6 +1005,7 @@ func waitForDeploymentTemplateStateReadyPendingCleanup(t *testing.T, client k8sc
 
 		if assert.Equal(t, radappiov1alpha3.DeploymentTemplatePhraseReadyPendingCleanup, current.Status.Phrase) {
 			assert.Empty(t, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
 		}
 	}, deploymentTemplateTestWaitDuration, deploymentTemplateTestWaitInterval, "failed to enter ready-pending-cleanup state")
 
@@ -1026,6 +1029,7 @@ func waitForDeploymentTemplateStateReady(t *testing.T, client k8sclient.Client,
 
 		if assert.Equal(t, radappiov1alpha3.DeploymentTemplatePhraseReady, current.Status.Phrase) {
 			assert.Empty(t, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionTrue, "")
 		}
 	}, deploymentTemplateTestWaitDuration, deploymentTemplateTestWaitInterval, "failed to enter ready state")
 
@@ -1047,7 +1051,9 @@ func waitForDeploymentTemplateStateDeleting(t *testing.T, client k8sclient.Clien
 		logger.Logf("DeploymentTemplate.Status: %+v", current.Status)
 		assert.Equal(t, status.ObservedGeneration, current.Generation, "Status is not updated")
 
-		assert.Equal(t, radappiov1alpha3.DeploymentTemplatePhraseDeleting, current.Status.Phrase)
+		if assert.Equal(t, radappiov1alpha3.DeploymentTemplatePhraseDeleting, current.Status.Phrase) {
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
+		}
 	}, deploymentTemplateTestWaitDuration, deploymentTemplateTestWaitInterval, "failed to enter deleting state")
 
 	return status
@@ -18,6 +18,9 @@ package reconciler
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -139,11 +142,11 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		_, err = poller.Result(ctx)
 		if err != nil {
 			// Operation failed, reset state and retry.
-			r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Update failed.")
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			markStalled(r.EventRecorder, recipe, conditionReasonForError(err), conditionMessageForError(err))
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -156,8 +159,14 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		// If we get here, the operation was a success. Update the status and continue.
 		//
 		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		hash, err := computeRecipeHash(recipe)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
 		recipe.Status.Operation = nil
 		recipe.Status.Resource = recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name
+		recipe.Status.StatusHash = hash
 		return ctrl.Result{}, nil
 
 	} else if recipe.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
@@ -179,11 +188,11 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		_, err = poller.Result(ctx)
 		if err != nil {
 			// Operation failed, reset state and retry.
-			r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Delete failed.")
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			markStalled(r.EventRecorder, recipe, conditionReasonForError(err), conditionMessageForError(err))
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -203,12 +212,14 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 
 	// If we get here, this was an unknown operation kind. This is a bug in our code, or someone
 	// tampered with the status of the object. Just reset the state and move on.
-	logger.Error(fmt.Errorf("unknown operation kind: %s", recipe.Status.Operation.OperationKind), "Unknown operation kind.")
+	err := fmt.Errorf("unknown operation kind: %s", recipe.Status.Operation.OperationKind)
+	logger.Error(err, "Unknown operation kind.")
 
 	recipe.Status.Operation = nil
 	recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+	markStalled(r.EventRecorder, recipe, radappiov1alpha3.ReasonResourceError, err.Error())
 
-	err := r.Client.Status().Update(ctx, recipe)
+	err = r.Client.Status().Update(ctx, recipe)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -245,8 +256,10 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 
 	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", environmentName, applicationName)
 	if err != nil {
-		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "DependencyError", err.Error())
 		logger.Error(err, "Unable to resolve dependencies.")
+
+		markStalled(r.EventRecorder, recipe, radappiov1alpha3.ReasonDependencyError, conditionMessageForError(err))
+		r.updateStatusAfterError(ctx, recipe)
 		return ctrl.Result{}, fmt.Errorf("failed to resolve dependencies: %w", err)
 	}
 
@@ -257,7 +270,9 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	updatePoller, deletePoller, err := r.startPutOrDeleteOperationIfNeeded(ctx, recipe)
 	if err != nil {
 		logger.Error(err, "Unable to create or update resource.")
-		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+
+		markStalled(r.EventRecorder, recipe, conditionReasonForError(err), conditionMessageForError(err))
+		r.updateStatusAfterError(ctx, recipe)
 		return ctrl.Result{}, err
 	} else if updatePoller != nil {
 		// We've successfully started an operation. Update the status and requeue.
@@ -268,6 +283,7 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseUpdating
+		markReconciling(r.EventRecorder, recipe, radappiov1alpha3.ReasonUpdating, "Creating or updating the resource.")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -283,6 +299,7 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		markReconciling(r.EventRecorder, recipe, radappiov1alpha3.ReasonUpdating, "Deleting the resource created for the previous environment or application.")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -296,16 +313,27 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 
 	err = r.updateSecret(ctx, recipe)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
+		err = fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
+		markStalled(r.EventRecorder, recipe, radappiov1alpha3.ReasonResourceError, conditionMessageForError(err))
+		r.updateStatusAfterError(ctx, recipe)
+		return ctrl.Result{}, err
+	}
+
+	err = r.updateConfigMap(ctx, recipe)
+	if err != nil {
+		err = fmt.Errorf("failed to process config map %s: %w", recipe.Spec.ConfigMapName, err)
+		markStalled(r.EventRecorder, recipe, radappiov1alpha3.ReasonResourceError, conditionMessageForError(err))
+		r.updateStatusAfterError(ctx, recipe)
+		return ctrl.Result{}, err
 	}
 
 	recipe.Status.Phrase = radappiov1alpha3.PhraseReady
+	markReady(r.EventRecorder, recipe, radappiov1alpha3.ReasonReconciled, "Successfully reconciled resource.")
 	err = r.Client.Status().Update(ctx, recipe)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(recipe, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
 	return ctrl.Result{}, nil
 }
 
@@ -321,7 +349,9 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 	poller, err := r.startDeleteOperationIfNeeded(ctx, recipe)
 	if err != nil {
 		logger.Error(err, "Unable to delete resource.")
-		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
+
+		markStalled(r.EventRecorder, recipe, conditionReasonForError(err), conditionMessageForError(err))
+		r.updateStatusAfterError(ctx, recipe)
 		return ctrl.Result{}, err
 	} else if poller != nil {
 		// We've successfully started an operation. Update the status and requeue.
@@ -332,6 +362,7 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 
 		recipe.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
 		recipe.Status.Phrase = radappiov1alpha3.PhraseDeleting
+		markReconciling(r.EventRecorder, recipe, radappiov1alpha3.ReasonDeleting, "Deleting the resource.")
 		err = r.Client.Status().Update(ctx, recipe)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -347,6 +378,11 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
 	}
 
+	err = r.deleteConfigMap(ctx, recipe)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to process config map %s: %w", recipe.Spec.ConfigMapName, err)
+	}
+
 	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
 	// recipe.
 	if controllerutil.RemoveFinalizer(recipe, RecipeFinalizer) {
@@ -359,12 +395,12 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 	}
 
 	recipe.Status.Phrase = radappiov1alpha3.PhraseDeleted
+	markDeleted(r.EventRecorder, recipe, "Successfully deleted the resource.")
 	err = r.Client.Status().Update(ctx, recipe)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(recipe, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
 	return ctrl.Result{}, nil
 }
 
@@ -389,8 +425,16 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 		recipe.Status.Resource = ""
 	}
 
+	hash, err := computeRecipeHash(recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Note: we separate this check from the previous block, because it could complete synchronously.
-	if recipe.Status.Resource != "" {
+	//
+	// The hash covers the recipe name and parameters, so changing them (or retrying a failed update of them)
+	// will update the resource. Other changes, like the name of the secret, don't require an update.
+	if recipe.Status.Resource != "" && recipe.Status.StatusHash == hash {
 		logger.Info("Resource is already created and is up-to-date.")
 		return nil, nil, nil
 	}
@@ -402,6 +446,13 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 		"resourceProvisioning": "recipe",
 	}
 
+	recipeProperties, err := makeRecipeProperties(recipe)
+	if err != nil {
+		return nil, nil, err
+	} else if recipeProperties != nil {
+		properties["recipe"] = recipeProperties
+	}
+
 	poller, err := createOrUpdateResource(ctx, r.Radius, resourceID, properties)
 	if err != nil {
 		return nil, nil, err
@@ -411,6 +462,7 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 
 	// Update was synchronous
 	recipe.Status.Resource = resourceID
+	recipe.Status.StatusHash = hash
 	return nil, nil, nil
 }
 
@@ -553,6 +605,159 @@ func (r *RecipeReconciler) deleteSecret(ctx context.Context, recipe *radappiov1a
 	return nil
 }
 
+func (r *RecipeReconciler) updateConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// If the config map name changed, delete the old config map.
+	if recipe.Spec.ConfigMapName != recipe.Status.ConfigMap.Name && recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting stale config map", "configMap", recipe.Status.ConfigMap.Name)
+		err := r.Client.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Status.ConfigMap.Name,
+				Namespace: recipe.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale config map %s: %w", recipe.Status.ConfigMap.Name, err)
+		}
+	}
+
+	if recipe.Spec.ConfigMapName == "" {
+		logger.Info("No config map name specified, skipping config map creation")
+		recipe.Status.ConfigMap = corev1.ObjectReference{}
+		return nil
+	}
+
+	logger.Info("Creating or updating config map.", "configMap", recipe.Spec.ConfigMapName)
+	result, err := fetchResource(ctx, r.Radius, recipe.Status.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to read resource: %w", err)
+	}
+
+	// Only the non-sensitive values of the resource are written to the config map. Secrets returned by
+	// the listSecrets operation are only written to the secret.
+	values, err := resourceToConnectionValues(result.GenericResource)
+	if err != nil {
+		return fmt.Errorf("failed to read connection values: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: recipe.Spec.ConfigMapName}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Spec.ConfigMapName,
+				Namespace: recipe.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(recipe, radappiov1alpha3.GroupVersion.WithKind("Recipe")),
+				},
+			},
+			Data: values,
+		}
+
+		err = r.Client.Create(ctx, configMap)
+		if err != nil {
+			return fmt.Errorf("failed to create config map %s: %w", configMap.Name, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to fetch config map %s: %w", recipe.Spec.ConfigMapName, err)
+	} else {
+		configMap.Data = values
+		err = r.Client.Update(ctx, configMap)
+		if err != nil {
+			return fmt.Errorf("failed to update config map %s: %w", configMap.Name, err)
+		}
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  configMap.Namespace,
+		Name:       configMap.Name,
+		UID:        configMap.UID,
+	}
+
+	return nil
+}
+
+func (r *RecipeReconciler) deleteConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting config map.", "configMap", recipe.Status.ConfigMap.Name)
+		err := r.Client.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Status.ConfigMap.Name,
+				Namespace: recipe.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete config map %s: %w", recipe.Status.ConfigMap.Name, err)
+		}
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{}
+	return nil
+}
+
+// updateStatusAfterError saves the status of the recipe after a failure so the error is surfaced in its
+// conditions. The original error is returned to the caller, so a failure to save the status is only logged.
+func (r *RecipeReconciler) updateStatusAfterError(ctx context.Context, recipe *radappiov1alpha3.Recipe) {
+	err := r.Client.Status().Update(ctx, recipe)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Unable to update status.")
+	}
+}
+
+// makeRecipeProperties returns the value of the recipe property of the resource, or nil if the recipe does
+// not specify a recipe name or parameters.
+func makeRecipeProperties(recipe *radappiov1alpha3.Recipe) (map[string]any, error) {
+	if recipe.Spec.RecipeName == "" && len(recipe.Spec.Parameters) == 0 {
+		return nil, nil
+	}
+
+	properties := map[string]any{}
+	if recipe.Spec.RecipeName != "" {
+		properties["name"] = recipe.Spec.RecipeName
+	}
+
+	if len(recipe.Spec.Parameters) > 0 {
+		parameters := map[string]any{}
+		for name, value := range recipe.Spec.Parameters {
+			var v any
+			err := json.Unmarshal(value.Raw, &v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read parameter %q: %w", name, err)
+			}
+
+			parameters[name] = v
+		}
+
+		properties["parameters"] = parameters
+	}
+
+	return properties, nil
+}
+
+// computeRecipeHash computes a hash of the recipe name and parameters of the Recipe to save in the status.
+// The hash is empty when neither is specified, which matches Recipes created before they were supported.
+func computeRecipeHash(recipe *radappiov1alpha3.Recipe) (string, error) {
+	properties, err := makeRecipeProperties(recipe)
+	if err != nil {
+		return "", err
+	} else if properties == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func (r *RecipeReconciler) requeueDelay() time.Duration {
 	delay := r.DelayInterval
 	if delay == 0 {
@@ -567,5 +772,6 @@ func (r *RecipeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&radappiov1alpha3.Recipe{}).
 		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		Complete(r)
 }
@@ -23,11 +23,15 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -359,3 +363,169 @@ func Test_RecipeReconciler_WithSecret(t *testing.T) {
 		return apierrors.IsNotFound(err)
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "secret should be deleted")
 }
+
+func Test_RecipeReconciler_WithRecipeNameParametersAndConfigMap(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius, client := SetupRecipeTest(t)
+
+	name := types.NamespacedName{Namespace: "recipe-withparameters", Name: "test-recipe-withparameters"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	recipe := makeRecipe(name, "Applications.Core/extenders")
+	recipe.Spec.RecipeName = "custom"
+	recipe.Spec.Parameters = map[string]apiextensionsv1.JSON{
+		"size":     {Raw: []byte(`"large"`)},
+		"replicas": {Raw: []byte(`3`)},
+	}
+	recipe.Spec.SecretName = name.Name
+	recipe.Spec.ConfigMapName = name.Name
+
+	err = client.Create(ctx, recipe)
+	require.NoError(t, err)
+
+	// Recipe will be waiting for environment to be created.
+	createEnvironment(radius, "default", "default")
+
+	// Recipe will be waiting for extender to complete provisioning.
+	status := waitForRecipeStateUpdating(t, client, name, nil)
+	condition := meta.FindStatusCondition(status.Conditions, radappiov1alpha3.ConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, radappiov1alpha3.ReasonUpdating, condition.Reason)
+
+	extender, err := radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	expectedRecipe := map[string]any{
+		"name": "custom",
+		"parameters": map[string]any{
+			"size":     "large",
+			"replicas": float64(3),
+		},
+	}
+	require.Equal(t, expectedRecipe, extender.Properties["recipe"])
+
+	// Update the resource with computed values as part of completing the operation.
+	radius.CompleteOperation(status.Operation.ResumeToken, func(state *sdkclients.OperationState) {
+		resource, ok := radius.resources[state.ResourceID]
+		require.True(t, ok, "failed to find resource")
+
+		resource.Properties["a-value"] = "a"
+		resource.Properties["secrets"] = map[string]string{
+			"b-secret": "b",
+		}
+		state.Value = generated.GenericResourcesClientCreateOrUpdateResponse{GenericResource: resource}
+	})
+
+	// Recipe will update after operation completes
+	status = waitForRecipeStateReady(t, client, name)
+	condition = meta.FindStatusCondition(status.Conditions, radappiov1alpha3.ConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, radappiov1alpha3.ReasonReconciled, condition.Reason)
+
+	// The config map only contains the non-sensitive values.
+	configMap := corev1.ConfigMap{}
+	err = client.Get(ctx, name, &configMap)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a-value": "a"}, configMap.Data)
+
+	secret := corev1.Secret{}
+	err = client.Get(ctx, name, &secret)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"a-value": []byte("a"), "b-secret": []byte("b")}, secret.Data)
+
+	// Now we'll change the parameters, which will update the resource.
+	err = client.Get(ctx, name, recipe)
+	require.NoError(t, err)
+
+	recipe.Spec.Parameters["size"] = apiextensionsv1.JSON{Raw: []byte(`"small"`)}
+	err = client.Update(ctx, recipe)
+	require.NoError(t, err)
+
+	status = waitForRecipeStateUpdating(t, client, name, nil)
+
+	extender, err = radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	require.Equal(t, "small", extender.Properties["recipe"].(map[string]any)["parameters"].(map[string]any)["size"])
+
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+	_ = waitForRecipeStateReady(t, client, name)
+
+	// Now we'll change the config map name, which doesn't update the resource.
+	err = client.Get(ctx, name, recipe)
+	require.NoError(t, err)
+
+	recipe.Spec.ConfigMapName = "new-config-map-name"
+	err = client.Update(ctx, recipe)
+	require.NoError(t, err)
+
+	status = waitForRecipeStateReady(t, client, name)
+	require.Equal(t, "new-config-map-name", status.ConfigMap.Name)
+
+	// The old config map should be (eventually) deleted - the client reads from
+	// the informer cache so the deletion may not be visible immediately.
+	require.Eventuallyf(t, func() bool {
+		old := corev1.ConfigMap{}
+		err := client.Get(ctx, name, &old)
+		return apierrors.IsNotFound(err)
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "old config map should be deleted")
+
+	configMap = corev1.ConfigMap{}
+	err = client.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "new-config-map-name"}, &configMap)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a-value": "a"}, configMap.Data)
+
+	// Now we'll delete the recipe.
+	err = client.Delete(ctx, recipe)
+	require.NoError(t, err)
+
+	// Deletion of the recipe is in progress.
+	status = waitForRecipeStateDeleting(t, client, name, nil)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	// Now deleting of the deployment object can complete.
+	waitForRecipeDeleted(t, client, name)
+
+	// The config map should be (eventually) deleted.
+	require.Eventuallyf(t, func() bool {
+		c := corev1.ConfigMap{}
+		err := client.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "new-config-map-name"}, &c)
+		return apierrors.IsNotFound(err)
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "config map should be deleted")
+}
+
+func Test_MakeRecipeProperties(t *testing.T) {
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Core/extenders")
+
+	properties, err := makeRecipeProperties(recipe)
+	require.NoError(t, err)
+	require.Nil(t, properties)
+
+	hash, err := computeRecipeHash(recipe)
+	require.NoError(t, err)
+	require.Empty(t, hash)
+
+	recipe.Spec.RecipeName = "custom"
+	recipe.Spec.Parameters = map[string]apiextensionsv1.JSON{
+		"tags": {Raw: []byte(`{"team":"a"}`)},
+	}
+
+	properties, err = makeRecipeProperties(recipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "custom", "parameters": map[string]any{"tags": map[string]any{"team": "a"}}}, properties)
+
+	hash, err = computeRecipeHash(recipe)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+
+	// Changing the parameters changes the hash.
+	recipe.Spec.Parameters["tags"] = apiextensionsv1.JSON{Raw: []byte(`{"team":"b"}`)}
+	updated, err := computeRecipeHash(recipe)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, updated)
+
+	recipe.Spec.Parameters["tags"] = apiextensionsv1.JSON{Raw: []byte(`{`)}
+	_, err = makeRecipeProperties(recipe)
+	require.Error(t, err)
+}
@@ -31,6 +31,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -84,6 +85,7 @@ func waitForRecipeStateUpdating(t *testing.T, client client.Client, name types.N
 		if assert.Equal(t, radappiov1alpha3.PhraseUpdating, current.Status.Phrase) {
 			assert.NotEmpty(t, current.Status.Operation)
 			assert.NotEqual(t, oldOperation, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
 		}
 
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "failed to enter updating state")
@@ -108,6 +110,7 @@ func waitForRecipeStateReady(t *testing.T, client client.Client, name types.Name
 
 		if assert.Equal(t, radappiov1alpha3.PhraseReady, current.Status.Phrase) {
 			assert.Empty(t, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionTrue, "")
 		}
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "failed to enter updating state")
 
@@ -132,6 +135,7 @@ func waitForRecipeStateDeleting(t *testing.T, client client.Client, name types.N
 		if assert.Equal(t, radappiov1alpha3.PhraseDeleting, current.Status.Phrase) {
 			assert.NotEmpty(t, current.Status.Operation)
 			assert.NotEqual(t, oldOperation, current.Status.Operation)
+			assertConditions(t, current.Status.Conditions, metav1.ConditionFalse, radappiov1alpha3.ConditionReconciling)
 		}
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "failed to enter deleting state")
 
@@ -156,6 +160,23 @@ func waitForRecipeDeleted(t *testing.T, client client.Client, name types.Namespa
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "recipe still exists")
 }
 
+// assertConditions asserts that the conditions report the given status of the Ready condition, and that the given
+// abnormal-true condition (Reconciling or Stalled) is the only one present.
+func assertConditions(t assert.TestingT, conditions []metav1.Condition, ready metav1.ConditionStatus, abnormal string) {
+	condition := meta.FindStatusCondition(conditions, radappiov1alpha3.ConditionReady)
+	if assert.NotNil(t, condition, "Ready condition is not set") {
+		assert.Equal(t, ready, condition.Status)
+	}
+
+	for _, conditionType := range []string{radappiov1alpha3.ConditionReconciling, radappiov1alpha3.ConditionStalled} {
+		if conditionType == abnormal {
+			assert.True(t, meta.IsStatusConditionTrue(conditions, conditionType), "%s condition is not set", conditionType)
+		} else {
+			assert.Nil(t, meta.FindStatusCondition(conditions, conditionType), "%s condition is set", conditionType)
+		}
+	}
+}
+
 func makeDeployment(name types.NamespacedName) *appsv1.Deployment {
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -79,6 +79,7 @@ func (dst *ExtenderResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(extender.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(extender.Properties.Status.Recipe),
+			Drift:           fromDriftStatus(extender.Properties.Status.Drift),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(extender.InternalMetadata.AsyncProvisioningState),
 		Environment:          new(extender.Properties.Environment),
@@ -135,6 +136,32 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 	return status
 }
 
+func fromDriftStatus(driftStatus *rpv1.DriftStatus) *DriftStatus {
+	if driftStatus == nil {
+		return nil
+	}
+
+	status := &DriftStatus{
+		State:           new(DriftState(driftStatus.State)),
+		LastCheckedTime: new(driftStatus.LastCheckedTime),
+	}
+
+	if driftStatus.Message != "" {
+		status.Message = new(driftStatus.Message)
+	}
+
+	for _, resource := range driftStatus.Resources {
+		status.Resources = append(status.Resources, &DriftedResource{
+			Action:       new(resource.Action),
+			ResourceType: new(resource.ResourceType),
+			Name:         new(resource.Name),
+			ID:           new(resource.ID),
+		})
+	}
+
+	return status
+}
+
 func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
 	return &Recipe{
 		Name:       new(r.Name),
@@ -141,6 +141,27 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// DriftState - The result of a drift check.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The output resources no longer match what the recipe would deploy
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateFailed - The drift check could not be completed
+	DriftStateFailed DriftState = "Failed"
+	// DriftStateInSync - The output resources match what the recipe would deploy
+	DriftStateInSync DriftState = "InSync"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{
+		DriftStateDrifted,
+		DriftStateFailed,
+		DriftStateInSync,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -320,6 +341,30 @@ func PossibleResourceProvisioningValues() []ResourceProvisioning {
 	}
 }
 
+// RecipePlanAction - The kind of change a recipe deployment would make to an output resource.
+type RecipePlanAction string
+
+const (
+	// RecipePlanActionCreate - The output resource would be created.
+	RecipePlanActionCreate RecipePlanAction = "Create"
+	// RecipePlanActionDelete - The output resource would be deleted.
+	RecipePlanActionDelete RecipePlanAction = "Delete"
+	// RecipePlanActionReplace - The output resource would be deleted and created again.
+	RecipePlanActionReplace RecipePlanAction = "Replace"
+	// RecipePlanActionUpdate - The output resource would be updated in place.
+	RecipePlanActionUpdate RecipePlanAction = "Update"
+)
+
+// PossibleRecipePlanActionValues returns the possible values for the RecipePlanAction const type.
+func PossibleRecipePlanActionValues() []RecipePlanAction {
+	return []RecipePlanAction{
+		RecipePlanActionCreate,
+		RecipePlanActionDelete,
+		RecipePlanActionReplace,
+		RecipePlanActionUpdate,
+	}
+}
+
 // RestartPolicy - Restart policy for the container
 type RestartPolicy string
 
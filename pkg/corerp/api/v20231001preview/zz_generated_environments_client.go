@@ -311,6 +311,68 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// PlanRecipe - Plans the recipe deployment for a resource and returns the changes it would make to its output resources,
+// without making them.
+// If the operation fails it returns an *azcore.ResponseError type.
+//   - rootScope - The scope in which the resource is present. UCP Scope is /planes/{planeType}/{planeName}/resourceGroup/{resourcegroupID}
+//     and Azure resource scope is /subscriptions/{subscriptionID}/resourceGroup/{resourcegroupID}
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe
+//     method.
+func (client *EnvironmentsClient) PlanRecipe(ctx context.Context, rootScope string, environmentName string, body RecipePlanRequest, options *EnvironmentsClientPlanRecipeOptions) (EnvironmentsClientPlanRecipeResponse, error) {
+	var err error
+	req, err := client.planRecipeCreateRequest(ctx, rootScope, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	resp, err := client.planRecipeHandleResponse(httpResp)
+	return resp, err
+}
+
+// planRecipeCreateRequest creates the PlanRecipe request.
+func (client *EnvironmentsClient) planRecipeCreateRequest(ctx context.Context, rootScope string, environmentName string, body RecipePlanRequest, _ *EnvironmentsClientPlanRecipeOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/planRecipe"
+	if rootScope == "" {
+		return nil, errors.New("parameter rootScope cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", version20231001Preview)
+	req.Raw().URL.RawQuery = strings.ReplaceAll(reqQP.Encode(), "+", "%20")
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	req.Raw().Header["Content-Type"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// planRecipeHandleResponse handles the PlanRecipe response.
+func (client *EnvironmentsClient) planRecipeHandleResponse(resp *http.Response) (EnvironmentsClientPlanRecipeResponse, error) {
+	result := EnvironmentsClientPlanRecipeResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //   - rootScope - The scope in which the resource is present. UCP Scope is /planes/{planeType}/{planeName}/resourceGroup/{resourcegroupID}
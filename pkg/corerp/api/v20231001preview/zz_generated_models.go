@@ -416,6 +416,36 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DriftStatus - The result of a drift check of the output resources deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The time of the drift check that first found the current result.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The result of the drift check.
+	State *DriftState
+
+	// The reason the drift check failed.
+	Message *string
+
+	// The output resources that no longer match what the recipe would deploy.
+	Resources []*DriftedResource
+}
+
+// DriftedResource - An output resource that no longer matches what the recipe would deploy.
+type DriftedResource struct {
+	// REQUIRED; The change that executing the recipe again would make to the resource.
+	Action *string
+
+	// The resource ID of the resource.
+	ID *string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	ResourceType *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -1127,6 +1157,46 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
+// RecipePlanRequest - Represents the request body of the planRecipe action.
+type RecipePlanRequest struct {
+	// REQUIRED; The fully qualified resource ID of the resource the recipe would be deployed for. The resource does not need
+	// to exist.
+	ResourceID *string
+
+	// The fully qualified resource ID of the application the resource belongs to.
+	ApplicationID *string
+
+	// The key/value parameters to pass to the recipe template.
+	Parameters map[string]any
+
+	// The properties of the resource, which are passed to the recipe context.
+	Properties map[string]any
+
+	// The name of the recipe to plan. Defaults to 'default'.
+	RecipeName *string
+}
+
+// RecipePlanResponse - The changes that deploying a recipe would make to its output resources.
+type RecipePlanResponse struct {
+	// REQUIRED; The planned changes to the output resources of the recipe.
+	Changes []*RecipePlannedResourceChange
+}
+
+// RecipePlannedResourceChange - A planned change to a single output resource of a recipe.
+type RecipePlannedResourceChange struct {
+	// REQUIRED; The kind of change.
+	Action *RecipePlanAction
+
+	// The resource ID of the output resource when it is known ahead of the deployment.
+	ID *string
+
+	// The name of the output resource within the recipe. For example: the Terraform resource address.
+	Name *string
+
+	// The type of the output resource. For example: 'Microsoft.Cache/redis' or 'aws_s3_bucket'.
+	ResourceType *string
+}
+
 // RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -1175,6 +1245,9 @@ type ResourceStatus struct {
 	// Properties of an output resource
 	OutputResources []*OutputResource
 
+	// READ-ONLY; The result of the last drift check of the output resources deployed by the recipe
+	Drift *DriftStatus
+
 	// READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -87,6 +87,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe method.
+type EnvironmentsClientPlanRecipeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -36,6 +36,10 @@ type EnvironmentsServer struct {
 	// HTTP status codes to indicate success: http.StatusOK
 	NewListByScopePager func(rootScope string, options *v20250801preview.EnvironmentsClientListByScopeOptions) (resp azfake.PagerResponder[v20250801preview.EnvironmentsClientListByScopeResponse])
 
+	// PlanRecipe is the fake for method EnvironmentsClient.PlanRecipe
+	// HTTP status codes to indicate success: http.StatusOK
+	PlanRecipe func(ctx context.Context, rootScope string, environmentName string, body v20250801preview.RecipePlanRequest, options *v20250801preview.EnvironmentsClientPlanRecipeOptions) (resp azfake.Responder[v20250801preview.EnvironmentsClientPlanRecipeResponse], errResp azfake.ErrorResponder)
+
 	// Update is the fake for method EnvironmentsClient.Update
 	// HTTP status codes to indicate success: http.StatusOK
 	Update func(ctx context.Context, rootScope string, environmentName string, properties v20250801preview.EnvironmentResource, options *v20250801preview.EnvironmentsClientUpdateOptions) (resp azfake.Responder[v20250801preview.EnvironmentsClientUpdateResponse], errResp azfake.ErrorResponder)
@@ -87,6 +91,8 @@ func (e *EnvironmentsServerTransport) dispatchToMethodFake(req *http.Request, me
 				res.resp, res.err = e.dispatchGet(req)
 			case "EnvironmentsClient.NewListByScopePager":
 				res.resp, res.err = e.dispatchNewListByScopePager(req)
+			case "EnvironmentsClient.PlanRecipe":
+				res.resp, res.err = e.dispatchPlanRecipe(req)
 			case "EnvironmentsClient.Update":
 				res.resp, res.err = e.dispatchUpdate(req)
 			default:
@@ -245,6 +251,43 @@ func (e *EnvironmentsServerTransport) dispatchNewListByScopePager(req *http.Requ
 	return resp, nil
 }
 
+func (e *EnvironmentsServerTransport) dispatchPlanRecipe(req *http.Request) (*http.Response, error) {
+	if e.srv.PlanRecipe == nil {
+		return nil, &nonRetriableError{errors.New("fake for method PlanRecipe not implemented")}
+	}
+	const regexStr = `/(?P<rootScope>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Radius\.Core/environments/(?P<environmentName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/planRecipe`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if len(matches) < 3 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	body, err := server.UnmarshalRequestAsJSON[v20250801preview.RecipePlanRequest](req)
+	if err != nil {
+		return nil, err
+	}
+	rootScopeParam, err := url.PathUnescape(matches[regex.SubexpIndex("rootScope")])
+	if err != nil {
+		return nil, err
+	}
+	environmentNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("environmentName")])
+	if err != nil {
+		return nil, err
+	}
+	respr, errRespr := e.srv.PlanRecipe(req.Context(), rootScopeParam, environmentNameParam, body, nil)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !slices.Contains([]int{http.StatusOK}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK", respContent.HTTPStatus)}
+	}
+	resp, err := server.MarshalResponseAsJSON(respContent, server.GetResponse(respr).RecipePlanResponse, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (e *EnvironmentsServerTransport) dispatchUpdate(req *http.Request) (*http.Response, error) {
 	if e.srv.Update == nil {
 		return nil, &nonRetriableError{errors.New("fake for method Update not implemented")}
@@ -53,6 +53,11 @@ func (src *RecipePackResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.ReferencedBy = to.StringArray(src.Properties.ReferencedBy)
 	}
 
+	// Convert TrustedKeys
+	if src.Properties.TrustedKeys != nil {
+		converted.Properties.TrustedKeys = to.StringArray(src.Properties.TrustedKeys)
+	}
+
 	return converted, nil
 }
 
@@ -83,6 +88,11 @@ func (dst *RecipePackResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.ReferencedBy = to.ArrayofStringPtrs(recipePack.Properties.ReferencedBy)
 	}
 
+	// Convert TrustedKeys
+	if len(recipePack.Properties.TrustedKeys) > 0 {
+		dst.Properties.TrustedKeys = to.ArrayofStringPtrs(recipePack.Properties.TrustedKeys)
+	}
+
 	return nil
 }
 
@@ -113,17 +123,40 @@ func fromRecipesDataModel(recipes map[string]*datamodel.RecipeDefinition) map[st
 	result := make(map[string]*RecipeDefinition)
 	for key, recipe := range recipes {
 		if recipe != nil {
-			result[key] = &RecipeDefinition{
+			definition := &RecipeDefinition{
 				Kind:       fromRecipeKindDataModel(recipe.Kind),
 				Source:     new(recipe.Source),
 				Parameters: recipe.Parameters,
 				PlainHTTP:  new(recipe.PlainHTTP),
+				Lock:       fromRecipeLockDataModel(recipe.Lock),
 			}
+			if recipe.LockError != "" {
+				definition.LockError = new(recipe.LockError)
+			}
+			result[key] = definition
 		}
 	}
 	return result
 }
 
+func fromRecipeLockDataModel(lock *datamodel.RecipeLock) *RecipeLock {
+	if lock == nil {
+		return nil
+	}
+
+	result := &RecipeLock{
+		Source:     new(lock.Source),
+		ResolvedAt: new(lock.ResolvedAt),
+	}
+	if lock.Version != "" {
+		result.Version = new(lock.Version)
+	}
+	if lock.Revision != "" {
+		result.Revision = new(lock.Revision)
+	}
+	return result
+}
+
 func toRecipeKindDataModel(kind *RecipeKind) string {
 	if kind == nil {
 		return ""
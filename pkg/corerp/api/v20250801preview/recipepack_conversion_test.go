@@ -19,6 +19,7 @@ package v20250801preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -26,6 +27,8 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const testTrustedKey = "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE\n-----END PUBLIC KEY-----\n"
+
 func TestRecipePackConvertVersionedToDataModel(t *testing.T) {
 	// Load test data
 	data := testutil.ReadFixture("recipepackresource.json")
@@ -66,6 +69,7 @@ func TestRecipePackConvertVersionedToDataModel(t *testing.T) {
 	require.NotNil(t, stateStore)
 	require.Equal(t, "terraform", stateStore.Kind)
 	require.Equal(t, "oci://ghcr.io/radius-project/recipes/terraform/redis:latest", stateStore.Source)
+	require.Equal(t, []string{testTrustedKey}, recipePack.Properties.TrustedKeys)
 }
 
 func TestRecipePackConvertDataModelToVersioned(t *testing.T) {
@@ -97,9 +101,18 @@ func TestRecipePackConvertDataModelToVersioned(t *testing.T) {
 	require.NotNil(t, stateStore.Kind)
 	require.Equal(t, RecipeKind("terraform"), *stateStore.Kind)
 	require.Equal(t, "oci://ghcr.io/radius-project/recipes/terraform/redis:latest", *stateStore.Source)
+	require.Nil(t, stateStore.Lock)
+	require.Equal(t, "source cannot be pinned to an immutable reference", *stateStore.LockError)
 
 	container := versionedResource.Properties.Recipes["Applications.Core/containers"]
 	require.NotNil(t, container)
+	require.Equal(t, &RecipeLock{
+		Source:     new("ghcr.io/radius-project/recipes/kubernetes-container@sha256:1234567890123456789012345678901234567890123456789012345678901234"),
+		Revision:   new("sha256:1234567890123456789012345678901234567890123456789012345678901234"),
+		ResolvedAt: new(time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)),
+	}, container.Lock)
+	require.Nil(t, container.LockError)
+	require.Equal(t, []*string{new(testTrustedKey)}, versionedResource.Properties.TrustedKeys)
 }
 
 func TestRecipePackConvertInvalidModel(t *testing.T) {
@@ -86,6 +86,27 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// DriftState - The result of a drift check.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The output resources no longer match what the recipe would deploy
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateFailed - The drift check could not be completed
+	DriftStateFailed DriftState = "Failed"
+	// DriftStateInSync - The output resources match what the recipe would deploy
+	DriftStateInSync DriftState = "InSync"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{
+		DriftStateDrifted,
+		DriftStateFailed,
+		DriftStateInSync,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
@@ -177,6 +198,8 @@ type RecipeKind string
 const (
 	// RecipeKindBicep - Bicep recipe
 	RecipeKindBicep RecipeKind = "bicep"
+	// RecipeKindHelm - Helm chart recipe
+	RecipeKindHelm RecipeKind = "helm"
 	// RecipeKindTerraform - Terraform recipe
 	RecipeKindTerraform RecipeKind = "terraform"
 )
@@ -185,6 +208,31 @@ const (
 func PossibleRecipeKindValues() []RecipeKind {
 	return []RecipeKind{
 		RecipeKindBicep,
+		RecipeKindHelm,
 		RecipeKindTerraform,
 	}
 }
+
+// RecipePlanAction - The kind of change a recipe deployment would make to an output resource.
+type RecipePlanAction string
+
+const (
+	// RecipePlanActionCreate - The output resource would be created.
+	RecipePlanActionCreate RecipePlanAction = "Create"
+	// RecipePlanActionDelete - The output resource would be deleted.
+	RecipePlanActionDelete RecipePlanAction = "Delete"
+	// RecipePlanActionReplace - The output resource would be deleted and created again.
+	RecipePlanActionReplace RecipePlanAction = "Replace"
+	// RecipePlanActionUpdate - The output resource would be updated in place.
+	RecipePlanActionUpdate RecipePlanAction = "Update"
+)
+
+// PossibleRecipePlanActionValues returns the possible values for the RecipePlanAction const type.
+func PossibleRecipePlanActionValues() []RecipePlanAction {
+	return []RecipePlanAction{
+		RecipePlanActionCreate,
+		RecipePlanActionDelete,
+		RecipePlanActionReplace,
+		RecipePlanActionUpdate,
+	}
+}
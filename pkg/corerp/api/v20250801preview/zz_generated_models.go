@@ -193,6 +193,36 @@ type BicepRegistryAuthentication struct {
 	BasicAuthSecretID *string
 }
 
+// DriftStatus - The result of a drift check of the output resources deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The time of the drift check that first found the current result.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The result of the drift check.
+	State *DriftState
+
+	// The reason the drift check failed.
+	Message *string
+
+	// The output resources that no longer match what the recipe would deploy.
+	Resources []*DriftedResource
+}
+
+// DriftedResource - An output resource that no longer matches what the recipe would deploy.
+type DriftedResource struct {
+	// REQUIRED; The change that executing the recipe again would make to the resource.
+	Action *string
+
+	// The resource ID of the resource.
+	ID *string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	ResourceType *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -410,11 +440,13 @@ type ProvidersKubernetes struct {
 
 // RecipeDefinition - Recipe definition for a specific resource type
 type RecipeDefinition struct {
-	// REQUIRED; The type of recipe (e.g., Terraform, Bicep)
+	// REQUIRED; The type of recipe (e.g., Terraform, Bicep). Other kinds select a recipe driver plugin registered with the
+	// control plane.
 	Kind *RecipeKind
 
 	// REQUIRED; The source of the recipe. For Bicep recipes this is the OCI registry reference. For Terraform recipes this is
-	// the module source.
+	// the module source. For Helm recipes this is the chart reference, either
+	// oci://<registry>/<repository>/<chart>[:<version>] or https://<repository URL>/<chart>[:<version>].
 	Source *string
 
 	// Parameters to pass to the recipe
@@ -423,6 +455,29 @@ type RecipeDefinition struct {
 	// Connect to the source using HTTP (not HTTPS). This should be used when the source is known not to support HTTPS, for example
 	// in a locally hosted registry for Bicep recipes. Defaults to false (use HTTPS/TLS)
 	PlainHTTP *bool
+
+	// READ-ONLY; The immutable reference the source of the recipe was resolved to. Recipes are deployed from the locked source
+	// until the lock is updated.
+	Lock *RecipeLock
+
+	// READ-ONLY; The reason the source of the recipe could not be resolved to an immutable reference. Recipes without a lock
+	// are deployed from their source.
+	LockError *string
+}
+
+// RecipeLock - The immutable reference the source of a recipe is pinned to
+type RecipeLock struct {
+	// REQUIRED; The time the source was resolved.
+	ResolvedAt *time.Time
+
+	// REQUIRED; The source of the recipe pinned to an immutable reference, such as an OCI digest or a Git commit.
+	Source *string
+
+	// The digest of the OCI artifact or the commit of the Git repository the source was resolved to.
+	Revision *string
+
+	// The version of the module the recipe is pinned to, for Terraform registry modules.
+	Version *string
 }
 
 // RecipePackProperties - Recipe Pack properties
@@ -430,6 +485,10 @@ type RecipePackProperties struct {
 	// REQUIRED; Map of resource types to their recipe configurations
 	Recipes map[string]*RecipeDefinition
 
+	// PEM encoded public keys trusted to sign the recipes of the recipe pack. When set, Bicep and Terraform recipes must be
+	// signed with one of the keys, and other kinds of recipes are not allowed.
+	TrustedKeys []*string
+
 	// READ-ONLY; The status of the asynchronous operation
 	ProvisioningState *ProvisioningState
 
@@ -475,6 +534,46 @@ type RecipeParameterValue struct {
 	AdditionalProperties map[string]any
 }
 
+// RecipePlanRequest - Represents the request body of the planRecipe action.
+type RecipePlanRequest struct {
+	// REQUIRED; The fully qualified resource ID of the resource the recipe would be deployed for. The resource does not need
+	// to exist.
+	ResourceID *string
+
+	// The fully qualified resource ID of the application the resource belongs to.
+	ApplicationID *string
+
+	// The key/value parameters to pass to the recipe template.
+	Parameters map[string]any
+
+	// The properties of the resource, which are passed to the recipe context.
+	Properties map[string]any
+
+	// The name of the recipe to plan. Defaults to 'default'.
+	RecipeName *string
+}
+
+// RecipePlanResponse - The changes that deploying a recipe would make to its output resources.
+type RecipePlanResponse struct {
+	// REQUIRED; The planned changes to the output resources of the recipe.
+	Changes []*RecipePlannedResourceChange
+}
+
+// RecipePlannedResourceChange - A planned change to a single output resource of a recipe.
+type RecipePlannedResourceChange struct {
+	// REQUIRED; The kind of change.
+	Action *RecipePlanAction
+
+	// The resource ID of the output resource when it is known ahead of the deployment.
+	ID *string
+
+	// The name of the output resource within the recipe. For example: the Terraform resource address.
+	Name *string
+
+	// The type of the output resource. For example: 'Microsoft.Cache/redis' or 'aws_s3_bucket'.
+	ResourceType *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -495,6 +594,9 @@ type ResourceStatus struct {
 	// Properties of an output resource
 	OutputResources []*OutputResource
 
+	// READ-ONLY; The result of the last drift check of the output resources deployed by the recipe
+	Drift *DriftStatus
+
 	// READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
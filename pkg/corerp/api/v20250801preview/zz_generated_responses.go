@@ -90,6 +90,12 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientPlanRecipeResponse contains the response from method EnvironmentsClient.PlanRecipe.
+type EnvironmentsClientPlanRecipeResponse struct {
+	// The changes that deploying a recipe would make to its output resources.
+	RecipePlanResponse
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
 
@@ -45,6 +47,10 @@ type RecipePackProperties struct {
 
 	// ReferencedBy is a list of environment IDs that reference this recipe pack.
 	ReferencedBy []string `json:"referencedBy,omitempty"`
+
+	// TrustedKeys is the list of PEM encoded public keys trusted to sign the recipes of the recipe pack. The signatures
+	// of the recipes are not verified if empty.
+	TrustedKeys []string `json:"trustedKeys,omitempty"`
 }
 
 // RecipeDefinition represents a recipe definition in the datamodel.
@@ -60,4 +66,26 @@ type RecipeDefinition struct {
 
 	// PlainHTTP connects to the source using HTTP (not-HTTPS).
 	PlainHTTP bool `json:"plainHTTP,omitempty"`
+
+	// Lock is the immutable reference the source was resolved to. It is nil if the source could not be pinned.
+	Lock *RecipeLock `json:"lock,omitempty"`
+
+	// LockError is the reason the source could not be pinned. It is empty if the recipe is locked.
+	LockError string `json:"lockError,omitempty"`
+}
+
+// RecipeLock represents the immutable reference the source of a recipe is pinned to.
+type RecipeLock struct {
+	// Source is the source of the recipe pinned to an immutable reference. Recipes are deployed from it in place of
+	// the source of the recipe definition.
+	Source string `json:"source"`
+
+	// Version is the version of the module the recipe is pinned to, for Terraform registry modules.
+	Version string `json:"version,omitempty"`
+
+	// Revision is the digest of the OCI artifact or the commit of the Git repository the source was resolved to.
+	Revision string `json:"revision,omitempty"`
+
+	// ResolvedAt is the time the source was resolved.
+	ResolvedAt time.Time `json:"resolvedAt"`
 }
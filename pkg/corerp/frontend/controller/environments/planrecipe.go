@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// defaultRecipeName is the name of the recipe used when the plan request does not specify one.
+	defaultRecipeName = "default"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to plan the recipe deployment for a resource in the environment. It
+// returns the changes the recipe would make to its output resources without making them.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning recipe deployments in an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run plans the recipe deployment for the resource in the request body and returns the planned changes.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return PlanRecipeInEnvironment(ctx, r.Engine, serviceCtx.ResourceID.String(), req)
+}
+
+// PlanRecipeInEnvironment reads a recipe plan request from the request body and plans the recipe for the requested
+// resource in the environment with the given ID. It is shared by the controllers of all environment types.
+func PlanRecipeInEnvironment(ctx context.Context, eng engine.Engine, environmentID string, req *http.Request) (rest.Response, error) {
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	planRequest := recipes.RecipePlanRequest{}
+	if err := json.Unmarshal(content, &planRequest); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid recipe plan request: %s", err.Error())), nil
+	}
+
+	if _, err := resources.ParseResource(planRequest.ResourceID); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("invalid resource ID %q in recipe plan request", planRequest.ResourceID)), nil
+	}
+
+	recipeName := planRequest.RecipeName
+	if recipeName == "" {
+		recipeName = defaultRecipeName
+	}
+
+	plan, err := eng.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          recipeName,
+				EnvironmentID: environmentID,
+				ApplicationID: planRequest.ApplicationID,
+				ResourceID:    planRequest.ResourceID,
+				Parameters:    planRequest.Parameters,
+				Properties:    planRequest.Properties,
+			},
+		},
+	})
+	if err != nil {
+		recipeError := &recipes.RecipeError{}
+		if !errors.As(err, &recipeError) {
+			return nil, err
+		}
+
+		if recipeError.ErrorDetails.Code == recipes.RecipeNotFoundFailure {
+			return rest.NewNotFoundMessageResponse(recipeError.ErrorDetails.Message), nil
+		}
+
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: &recipeError.ErrorDetails}), nil
+	}
+
+	return rest.NewOKResponse(plan), nil
+}
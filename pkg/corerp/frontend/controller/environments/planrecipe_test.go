@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPlanRecipeRun(t *testing.T) {
+	const (
+		environmentID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
+		resourceID    = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/redis0"
+	)
+
+	_, envDataModel, _ := getTestModelsGetRecipeMetadata20231001preview()
+
+	setup := func(t *testing.T, body any) (*database.MockClient, *engine.MockEngine, *httptest.ResponseRecorder, *http.Request, context.Context) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		mEngine := engine.NewMockEngine(mctrl)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, body)
+		require.NoError(t, err)
+
+		return databaseClient, mEngine, w, req, rpctest.NewARMRequestContext(req)
+	}
+
+	expectEnvironment := func(databaseClient *database.MockClient) {
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil
+			})
+	}
+
+	run := func(t *testing.T, databaseClient *database.MockClient, mEngine *engine.MockEngine, w *httptest.ResponseRecorder, req *http.Request, ctx context.Context) {
+		ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+	}
+
+	t.Run("plan recipe", func(t *testing.T) {
+		databaseClient, mEngine, w, req, ctx := setup(t, recipes.RecipePlanRequest{
+			ResourceID: resourceID,
+			Parameters: map[string]any{"port": 6379},
+		})
+		expectEnvironment(databaseClient)
+
+		plan := &recipes.RecipePlan{
+			Changes: []recipes.PlannedResourceChange{
+				{Action: recipes.PlanActionCreate, ResourceType: "Microsoft.Cache/redis", Name: "redis0"},
+			},
+		}
+		mEngine.EXPECT().Plan(ctx, engine.ExecuteOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "default",
+					EnvironmentID: environmentID,
+					ResourceID:    resourceID,
+					Parameters:    map[string]any{"port": float64(6379)},
+				},
+			},
+		}).Return(plan, nil)
+
+		run(t, databaseClient, mEngine, w, req, ctx)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := &recipes.RecipePlan{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, plan, actual)
+	})
+
+	t.Run("recipe not found", func(t *testing.T) {
+		databaseClient, mEngine, w, req, ctx := setup(t, recipes.RecipePlanRequest{
+			ResourceID: resourceID,
+			RecipeName: "missing",
+		})
+		expectEnvironment(databaseClient)
+
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipeNotFoundFailure, "could not find recipe \"missing\"", ""))
+
+		run(t, databaseClient, mEngine, w, req, ctx)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("recipe plan failure", func(t *testing.T) {
+		databaseClient, mEngine, w, req, ctx := setup(t, recipes.RecipePlanRequest{
+			ResourceID: resourceID,
+		})
+		expectEnvironment(databaseClient)
+
+		mEngine.EXPECT().Plan(ctx, gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipePlanFailed, "terraform plan failure", ""))
+
+		run(t, databaseClient, mEngine, w, req, ctx)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+		actual := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, recipes.RecipePlanFailed, actual.Error.Code)
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		databaseClient, mEngine, w, req, ctx := setup(t, recipes.RecipePlanRequest{
+			ResourceID: "invalid",
+		})
+		expectEnvironment(databaseClient)
+
+		run(t, databaseClient, mEngine, w, req, ctx)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		databaseClient, mEngine, w, req, ctx := setup(t, recipes.RecipePlanRequest{
+			ResourceID: resourceID,
+		})
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{})
+
+		run(t, databaseClient, mEngine, w, req, ctx)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
@@ -20,4 +20,5 @@ const (
 	ResourceTypeName = "Applications.Core/environments"
 	// User defined operation names
 	OperationGetRecipeMetadata = "GETRECIPEMETADATA"
+	OperationPlanRecipe        = "PLANRECIPE"
 )
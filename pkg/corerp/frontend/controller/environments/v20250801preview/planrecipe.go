@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20250801preview
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/corerp/frontend/controller/environments"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+var _ ctrl.Controller = (*PlanRecipev20250801preview)(nil)
+
+// PlanRecipev20250801preview is the controller implementation to plan the recipe deployment for a resource in a
+// Radius.Core/environments resource.
+type PlanRecipev20250801preview struct {
+	ctrl.Operation[*datamodel.Environment_v20250801preview, datamodel.Environment_v20250801preview]
+	engine.Engine
+}
+
+// NewPlanRecipev20250801preview creates a new controller for planning recipe deployments in a Radius.Core/environments resource.
+func NewPlanRecipev20250801preview(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipev20250801preview{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment_v20250801preview]{
+				RequestConverter:  converter.Environment20250801DataModelFromVersioned,
+				ResponseConverter: converter.Environment20250801DataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run plans the recipe deployment for the resource in the request body and returns the planned changes.
+func (r *PlanRecipev20250801preview) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return environments.PlanRecipeInEnvironment(ctx, r.Engine, serviceCtx.ResourceID.String(), req)
+}
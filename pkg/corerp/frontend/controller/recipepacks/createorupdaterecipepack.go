@@ -18,13 +18,19 @@ package recipepacks
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/signature"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -33,6 +39,7 @@ var _ ctrl.Controller = (*CreateOrUpdateRecipePack)(nil)
 // CreateOrUpdateRecipePack is the controller implementation to create or update recipe pack resource.
 type CreateOrUpdateRecipePack struct {
 	ctrl.Operation[*datamodel.RecipePack, datamodel.RecipePack]
+	newResolver func(ctx context.Context, options *ctrl.Options, recipePack *datamodel.RecipePack) sourcelock.Resolver
 }
 
 // NewCreateOrUpdateRecipePack creates a new controller for creating or updating a recipe pack resource.
@@ -44,10 +51,12 @@ func NewCreateOrUpdateRecipePack(opts ctrl.Options) (ctrl.Controller, error) {
 				ResponseConverter: converter.RecipePackDataModelToVersioned,
 			},
 		),
+		newEnvironmentResolver,
 	}, nil
 }
 
-// Run creates or updates a recipe pack resource.
+// Run creates or updates a recipe pack resource. The sources of new or changed recipes are locked to the immutable
+// references they resolve to, with the registry credentials of the environments referencing the recipe pack.
 func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -64,8 +73,14 @@ func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWrite
 		return resp, err
 	}
 
+	if err := validateTrustedKeys(newResource); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	logger.Info("Creating or updating recipe pack", "resourceID", serviceCtx.ResourceID.String())
 
+	lockRecipes(ctx, r.newResolver(ctx, r.Options(), newResource), newResource, old)
+
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := r.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
 	if err != nil {
@@ -74,3 +89,26 @@ func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWrite
 
 	return r.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateTrustedKeys validates the trusted keys of the recipe pack. Signatures can only be verified for Bicep and
+// Terraform recipes, so a recipe pack with trusted keys can't have recipes of other kinds.
+func validateTrustedKeys(recipePack *datamodel.RecipePack) error {
+	if len(recipePack.Properties.TrustedKeys) == 0 {
+		return nil
+	}
+
+	if _, err := signature.NewVerifier(recipePack.Properties.TrustedKeys); err != nil {
+		return err
+	}
+
+	for _, resourceType := range slices.Sorted(maps.Keys(recipePack.Properties.Recipes)) {
+		recipe := recipePack.Properties.Recipes[resourceType]
+		if recipe == nil || recipe.Kind == recipes.TemplateKindBicep || recipe.Kind == recipes.TemplateKindTerraform {
+			continue
+		}
+
+		return fmt.Errorf("the signature of the %s recipe for resource type %q can't be verified, only bicep and terraform recipes are allowed in a recipe pack with trusted keys", recipe.Kind, resourceType)
+	}
+
+	return nil
+}
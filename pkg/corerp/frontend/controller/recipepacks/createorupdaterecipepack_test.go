@@ -18,7 +18,14 @@ package recipepacks
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -33,9 +40,28 @@ import (
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
 )
 
+// newUnsupportedResolver returns a resolver that cannot pin any source, which leaves all recipes unlocked.
+func newUnsupportedResolver(mctrl *gomock.Controller) sourcelock.Resolver {
+	resolver := sourcelock.NewMockResolver(mctrl)
+	resolver.EXPECT().
+		Resolve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, sourcelock.ErrUnsupportedSource).
+		AnyTimes()
+	return resolver
+}
+
+// withResolver returns a function creating the resolver of the controllers that always returns resolver.
+func withResolver(resolver sourcelock.Resolver) func(context.Context, *ctrl.Options, *datamodel.RecipePack) sourcelock.Resolver {
+	return func(context.Context, *ctrl.Options, *datamodel.RecipePack) sourcelock.Resolver {
+		return resolver
+	}
+}
+
 func TestNewCreateOrUpdateRecipePack(t *testing.T) {
 	mctrl := gomock.NewController(t)
 	defer mctrl.Finish()
@@ -88,6 +114,7 @@ func TestCreateOrUpdateRecipePackRun_CreateNew(t *testing.T) {
 
 	ctl, err := NewCreateOrUpdateRecipePack(opts)
 	require.NoError(t, err)
+	ctl.(*CreateOrUpdateRecipePack).newResolver = withResolver(newUnsupportedResolver(mctrl))
 	resp, err := ctl.Run(ctx, w, req)
 	require.NoError(t, err)
 	_ = resp.Apply(ctx, w, req)
@@ -137,6 +164,7 @@ func TestCreateOrUpdateRecipePackRun_UpdateExisting(t *testing.T) {
 
 	ctl, err := NewCreateOrUpdateRecipePack(opts)
 	require.NoError(t, err)
+	ctl.(*CreateOrUpdateRecipePack).newResolver = withResolver(newUnsupportedResolver(mctrl))
 	resp, err := ctl.Run(ctx, w, req)
 	require.NoError(t, err)
 	_ = resp.Apply(ctx, w, req)
@@ -148,6 +176,181 @@ func TestCreateOrUpdateRecipePackRun_UpdateExisting(t *testing.T) {
 	require.Equal(t, v20250801preview.ProvisioningStateSucceeded, *actualOutput.Properties.ProvisioningState)
 }
 
+func TestCreateOrUpdateRecipePackRun_LocksRecipes(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	resolver := sourcelock.NewMockResolver(mctrl)
+
+	recipePackInput := &v20250801preview.RecipePackResource{
+		Location: new("global"),
+		Properties: &v20250801preview.RecipePackProperties{
+			Recipes: map[string]*v20250801preview.RecipeDefinition{
+				"Radius.Resources/postgreSQL": {
+					Kind:   to.Ptr(v20250801preview.RecipeKindBicep),
+					Source: new("ghcr.io/radius-project/recipes/local-dev/postgresql:latest"),
+					// The lock is read-only, so the lock in the request is ignored.
+					Lock: &v20250801preview.RecipeLock{Source: new("ghcr.io/radius-project/recipes/local-dev/postgresql@sha256:old")},
+				},
+			},
+		},
+	}
+
+	jsonPayload, err := json.Marshal(recipePackInput)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack?api-version=2025-08-01-preview", strings.NewReader(string(jsonPayload)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	ctx := rpctest.NewARMRequestContext(req)
+
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "bicep", "ghcr.io/radius-project/recipes/local-dev/postgresql:latest", false).
+		Return(&sourcelock.Lock{Source: "ghcr.io/radius-project/recipes/local-dev/postgresql@sha256:new", Revision: "sha256:new"}, nil)
+
+	databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &database.ErrNotFound{})
+
+	var saved *datamodel.RecipePack
+	databaseClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*datamodel.RecipePack)
+			return nil
+		})
+
+	ctl, err := NewCreateOrUpdateRecipePack(ctrl.Options{DatabaseClient: databaseClient})
+	require.NoError(t, err)
+	ctl.(*CreateOrUpdateRecipePack).newResolver = withResolver(resolver)
+
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, 200, w.Result().StatusCode)
+
+	lock := saved.Properties.Recipes["Radius.Resources/postgreSQL"].Lock
+	require.NotNil(t, lock)
+	require.Equal(t, "ghcr.io/radius-project/recipes/local-dev/postgresql@sha256:new", lock.Source)
+	require.Equal(t, "sha256:new", lock.Revision)
+	require.False(t, lock.ResolvedAt.IsZero())
+
+	actualOutput := &v20250801preview.RecipePackResource{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), actualOutput))
+	require.Equal(t, "ghcr.io/radius-project/recipes/local-dev/postgresql@sha256:new", *actualOutput.Properties.Recipes["Radius.Resources/postgreSQL"].Lock.Source)
+}
+
+func TestCreateOrUpdateRecipePackRun_InvalidTrustedKeys(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return nil, &database.ErrNotFound{ID: id}
+		})
+
+	recipePackInput, _, _ := getTestModels()
+	recipePackInput.Properties.TrustedKeys = []*string{new("not a key")}
+
+	jsonPayload, err := json.Marshal(recipePackInput)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack?api-version=2025-08-01-preview", strings.NewReader(string(jsonPayload)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewCreateOrUpdateRecipePack(ctrl.Options{DatabaseClient: databaseClient})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), "invalid trusted key at index 0: key is not PEM encoded")
+}
+
+func TestValidateTrustedKeys(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	trustedKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	newRecipePack := func(trustedKeys []string, kinds ...string) *datamodel.RecipePack {
+		recipePack := &datamodel.RecipePack{Properties: datamodel.RecipePackProperties{
+			Recipes:     map[string]*datamodel.RecipeDefinition{},
+			TrustedKeys: trustedKeys,
+		}}
+		for i, kind := range kinds {
+			recipePack.Properties.Recipes[fmt.Sprintf("Radius.Resources/type%d", i)] = &datamodel.RecipeDefinition{Kind: kind}
+		}
+		return recipePack
+	}
+
+	require.NoError(t, validateTrustedKeys(newRecipePack(nil, "bicep", "helm")))
+	require.NoError(t, validateTrustedKeys(newRecipePack([]string{trustedKey}, "bicep", "terraform")))
+
+	err = validateTrustedKeys(newRecipePack([]string{trustedKey}, "bicep", "helm"))
+	require.EqualError(t, err, `the signature of the helm recipe for resource type "Radius.Resources/type1" can't be verified, only bicep and terraform recipes are allowed in a recipe pack with trusted keys`)
+
+	err = validateTrustedKeys(newRecipePack([]string{"not a key"}, "bicep"))
+	require.EqualError(t, err, "invalid trusted key at index 0: key is not PEM encoded")
+}
+
+func TestLockRecipes(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	existingLock := &datamodel.RecipeLock{Source: "example.com/recipes/redis@sha256:abc", Revision: "sha256:abc"}
+	old := &datamodel.RecipePack{
+		Properties: datamodel.RecipePackProperties{
+			Recipes: map[string]*datamodel.RecipeDefinition{
+				"Radius.Resources/redis":    {Kind: "bicep", Source: "example.com/recipes/redis:1.0", Lock: existingLock},
+				"Radius.Resources/postgres": {Kind: "bicep", Source: "example.com/recipes/postgres:1.0", Lock: existingLock},
+			},
+		},
+	}
+	recipePack := &datamodel.RecipePack{
+		Properties: datamodel.RecipePackProperties{
+			Recipes: map[string]*datamodel.RecipeDefinition{
+				"Radius.Resources/redis":    {Kind: "bicep", Source: "example.com/recipes/redis:1.0"},
+				"Radius.Resources/postgres": {Kind: "bicep", Source: "example.com/recipes/postgres:2.0"},
+				"Radius.Resources/mongo":    {Kind: "helm", Source: "oci://example.com/charts/mongo:1.0"},
+				"Radius.Resources/mysql":    {Kind: "bicep", Source: "private.example.com/recipes/mysql:1.0"},
+			},
+		},
+	}
+
+	// The unchanged recipe keeps its lock without resolving its source again.
+	resolver := sourcelock.NewMockResolver(mctrl)
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "bicep", "example.com/recipes/postgres:2.0", false).
+		Return(&sourcelock.Lock{Source: "example.com/recipes/postgres@sha256:def", Revision: "sha256:def"}, nil)
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "helm", "oci://example.com/charts/mongo:1.0", false).
+		Return(nil, sourcelock.ErrUnsupportedSource)
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "bicep", "private.example.com/recipes/mysql:1.0", false).
+		Return(nil, errors.New("unauthorized"))
+
+	lockRecipes(testcontext.New(t), resolver, recipePack, old)
+
+	require.Same(t, existingLock, recipePack.Properties.Recipes["Radius.Resources/redis"].Lock)
+	require.Equal(t, "example.com/recipes/postgres@sha256:def", recipePack.Properties.Recipes["Radius.Resources/postgres"].Lock.Source)
+	require.Empty(t, recipePack.Properties.Recipes["Radius.Resources/postgres"].LockError)
+	require.Nil(t, recipePack.Properties.Recipes["Radius.Resources/mongo"].Lock)
+	require.Equal(t, sourcelock.ErrUnsupportedSource.Error(), recipePack.Properties.Recipes["Radius.Resources/mongo"].LockError)
+	require.Nil(t, recipePack.Properties.Recipes["Radius.Resources/mysql"].Lock)
+	require.Equal(t, "unauthorized", recipePack.Properties.Recipes["Radius.Resources/mysql"].LockError)
+}
+
 func getTestModels() (*v20250801preview.RecipePackResource, *datamodel.RecipePack, *v20250801preview.RecipePackResource) {
 	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack"
 	resourceName := "testrecipepack"
@@ -218,11 +421,13 @@ func getTestModels() (*v20250801preview.RecipePackResource, *datamodel.RecipePac
 					Kind:      to.Ptr(v20250801preview.RecipeKindBicep),
 					Source:    new("ghcr.io/radius-project/recipes/local-dev/extender-postgresql:0.50.0"),
 					PlainHTTP: new(false),
+					LockError: new(sourcelock.ErrUnsupportedSource.Error()),
 				},
 				"Radius.Resources/postgreSQL": {
 					Kind:      to.Ptr(v20250801preview.RecipeKindBicep),
 					Source:    new("ghcr.io/radius-project/recipes/local-dev/extender-postgresql:0.50.0"),
 					PlainHTTP: new(false),
+					LockError: new(sourcelock.ErrUnsupportedSource.Error()),
 				},
 				"Applications.Datastores/redisCaches": {
 					Kind:   to.Ptr(v20250801preview.RecipeKindBicep),
@@ -231,6 +436,7 @@ func getTestModels() (*v20250801preview.RecipePackResource, *datamodel.RecipePac
 						"tier": "basic",
 					},
 					PlainHTTP: new(false),
+					LockError: new(sourcelock.ErrUnsupportedSource.Error()),
 				},
 			},
 		},
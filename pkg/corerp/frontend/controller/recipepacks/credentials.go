@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	// basicAuthUsernameKey and basicAuthPasswordKey are the keys of the secret stores referenced by the basic
+	// authentication of Bicep registries.
+	basicAuthUsernameKey = "username"
+	basicAuthPasswordKey = "password"
+)
+
+// newEnvironmentResolver creates a resolver of the sources of the recipe pack that uses the registry credentials of
+// the environments referencing it, which are the credentials its recipes are deployed with. Credentials that can't be
+// read are skipped, which leaves the recipes that need them unlocked.
+//
+// Environments don't configure credentials for Git repositories, so Terraform modules in Git repositories are resolved
+// anonymously, like they are deployed.
+func newEnvironmentResolver(ctx context.Context, options *ctrl.Options, recipePack *datamodel.RecipePack) sourcelock.Resolver {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resolverOptions := sourcelock.ResolverOptions{
+		RegistryCredentials: map[string]auth.Credential{},
+		TerraformTokens:     map[string]string{},
+	}
+
+	// Environments are visited in order so that the same credentials are used when several environments configure the
+	// same host.
+	environmentIDs := slices.Sorted(slices.Values(recipePack.Properties.ReferencedBy))
+	for _, environmentID := range environmentIDs {
+		if err := addEnvironmentCredentials(ctx, options, environmentID, &resolverOptions); err != nil {
+			logger.Error(err, "Failed to read the registry credentials of environment", "environmentID", environmentID)
+		}
+	}
+
+	return sourcelock.NewResolver(resolverOptions)
+}
+
+// addEnvironmentCredentials adds the credentials of the Bicep and Terraform registries configured by the environment
+// to the resolver options. Hosts that already have credentials are skipped.
+func addEnvironmentCredentials(ctx context.Context, options *ctrl.Options, environmentID string, resolverOptions *sourcelock.ResolverOptions) error {
+	environment, err := database.GetResource[datamodel.Environment_v20250801preview](ctx, options.DatabaseClient, environmentID)
+	if err != nil {
+		return err
+	}
+
+	if environment.Properties.BicepConfig != "" {
+		bicepConfig, err := database.GetResource[datamodel.BicepConfig](ctx, options.DatabaseClient, environment.Properties.BicepConfig)
+		if err != nil {
+			return err
+		}
+
+		for host, authentication := range bicepConfig.Properties.RegistryAuthentications {
+			// Only basic authentication is used to deploy Bicep recipes from a bicepConfig.
+			if _, ok := resolverOptions.RegistryCredentials[host]; ok || authentication.BasicAuthSecretId == "" {
+				continue
+			}
+
+			secrets, err := readSecrets(ctx, options, authentication.BasicAuthSecretId, basicAuthUsernameKey, basicAuthPasswordKey)
+			if err != nil {
+				return err
+			}
+
+			resolverOptions.RegistryCredentials[host] = auth.Credential{
+				Username: secrets[basicAuthUsernameKey],
+				Password: secrets[basicAuthPasswordKey],
+			}
+		}
+	}
+
+	if environment.Properties.TerraformConfig != "" {
+		terraformConfig, err := database.GetResource[datamodel.TerraformConfig](ctx, options.DatabaseClient, environment.Properties.TerraformConfig)
+		if err != nil {
+			return err
+		}
+
+		for host, credential := range terraformConfig.Properties.Terraformrc.Credentials {
+			if _, ok := resolverOptions.TerraformTokens[host]; ok || credential.Secret == "" {
+				continue
+			}
+
+			secrets, err := readSecrets(ctx, options, credential.Secret, terraform.TerraformCredentialsTokenKey)
+			if err != nil {
+				return err
+			}
+
+			resolverOptions.TerraformTokens[host] = secrets[terraform.TerraformCredentialsTokenKey]
+		}
+	}
+
+	return nil
+}
+
+// readSecrets returns the values of the keys of the secret store.
+func readSecrets(ctx context.Context, options *ctrl.Options, secretStoreID string, keys ...string) (map[string]string, error) {
+	secretStore, err := database.GetResource[datamodel.SecretStore](ctx, options.DatabaseClient, secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := secretstores.ListSecretValues(secretStore, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret store %q: %w", secretStoreID, err)
+	}
+
+	values := map[string]string{}
+	for _, key := range keys {
+		value, ok := secrets.Data[key]
+		if !ok || value.Value == nil {
+			return nil, fmt.Errorf("'%s' secret key was not found in secret store '%s'", key, secretStoreID)
+		}
+		values[key] = *value.Value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testScope          = "/planes/radius/local/resourceGroups/test-group/providers"
+	testEnvironmentID  = testScope + "/Radius.Core/environments/env0"
+	testBicepConfigID  = testScope + "/Radius.Core/bicepConfigs/bicep0"
+	testTerraformID    = testScope + "/Radius.Core/terraformConfigs/terraform0"
+	testRegistrySecret = testScope + "/Applications.Core/secretStores/registry"
+	testTokenSecret    = testScope + "/Applications.Core/secretStores/token"
+)
+
+// newTestSecretStore returns a secret store of the keys of the Kubernetes secret with the given name.
+func newTestSecretStore(name string, keys ...string) *datamodel.SecretStore {
+	secretStore := &datamodel.SecretStore{
+		Properties: &datamodel.SecretStoreProperties{
+			Data: map[string]*datamodel.SecretStoreDataValue{},
+		},
+	}
+	secretStore.Properties.Status.OutputResources = []rpv1.OutputResource{
+		{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "default", name)},
+	}
+	for _, key := range keys {
+		secretStore.Properties.Data[key] = &datamodel.SecretStoreDataValue{}
+	}
+
+	return secretStore
+}
+
+func Test_AddEnvironmentCredentials(t *testing.T) {
+	ctx := testcontext.New(t)
+	databaseClient := inmemory.NewClient()
+
+	objects := map[string]any{
+		testEnvironmentID: &datamodel.Environment_v20250801preview{
+			Properties: datamodel.EnvironmentProperties_v20250801preview{
+				BicepConfig:     testBicepConfigID,
+				TerraformConfig: testTerraformID,
+			},
+		},
+		testBicepConfigID: &datamodel.BicepConfig{
+			Properties: datamodel.BicepConfigResourceProperties{
+				RegistryAuthentications: map[string]datamodel.BicepRegistryAuthentication{
+					"private.example.com": {AuthenticationMethod: "BasicAuth", BasicAuthSecretId: testRegistrySecret},
+					"azure.example.com":   {AuthenticationMethod: "AzureWI", AzureWiClientId: "client"},
+				},
+			},
+		},
+		testTerraformID: &datamodel.TerraformConfig{
+			Properties: datamodel.TerraformConfigResourceProperties{
+				Terraformrc: datamodel.TerraformrcConfig{
+					Credentials: map[string]datamodel.TerraformCredentialConfig{
+						"terraform.example.com": {Secret: testTokenSecret},
+					},
+				},
+			},
+		},
+		testRegistrySecret: newTestSecretStore("registry", "username", "password"),
+		testTokenSecret:    newTestSecretStore("token", "token"),
+	}
+	for id, data := range objects {
+		require.NoError(t, databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data}))
+	}
+
+	kubeClient := k8sutil.NewFakeKubeClient(nil,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "default"},
+			Data:       map[string][]byte{"username": []byte("user"), "password": []byte("password")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		})
+	options := &ctrl.Options{DatabaseClient: databaseClient, KubeClient: kubeClient}
+
+	t.Run("reads the registry credentials", func(t *testing.T) {
+		resolverOptions := sourcelock.ResolverOptions{RegistryCredentials: map[string]auth.Credential{}, TerraformTokens: map[string]string{}}
+		err := addEnvironmentCredentials(ctx, options, testEnvironmentID, &resolverOptions)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]auth.Credential{"private.example.com": {Username: "user", Password: "password"}}, resolverOptions.RegistryCredentials)
+		require.Equal(t, map[string]string{"terraform.example.com": "secret-token"}, resolverOptions.TerraformTokens)
+	})
+
+	t.Run("keeps existing credentials", func(t *testing.T) {
+		resolverOptions := sourcelock.ResolverOptions{
+			RegistryCredentials: map[string]auth.Credential{"private.example.com": {Username: "other"}},
+			TerraformTokens:     map[string]string{"terraform.example.com": "other"},
+		}
+		err := addEnvironmentCredentials(ctx, options, testEnvironmentID, &resolverOptions)
+		require.NoError(t, err)
+
+		require.Equal(t, "other", resolverOptions.RegistryCredentials["private.example.com"].Username)
+		require.Equal(t, "other", resolverOptions.TerraformTokens["terraform.example.com"])
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		resolverOptions := sourcelock.ResolverOptions{RegistryCredentials: map[string]auth.Credential{}, TerraformTokens: map[string]string{}}
+		err := addEnvironmentCredentials(ctx, options, testScope+"/Radius.Core/environments/missing", &resolverOptions)
+		require.ErrorIs(t, err, &database.ErrNotFound{})
+	})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// resolveTimeout is the maximum time spent resolving the source of a single recipe.
+	resolveTimeout = 30 * time.Second
+
+	// maxConcurrentResolves is the maximum number of recipe sources resolved at the same time.
+	maxConcurrentResolves = 8
+)
+
+// lockRecipes pins the source of each recipe in the recipe pack to the immutable reference it resolves to. Recipes
+// whose kind and source didn't change keep the lock of the existing recipe pack, so that updating a recipe pack
+// doesn't move its other recipes to newer versions. Recipes whose source can't be resolved are left unlocked, and the
+// reason is recorded in their lock error.
+func lockRecipes(ctx context.Context, resolver sourcelock.Resolver, recipePack *datamodel.RecipePack, old *datamodel.RecipePack) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	g := errgroup.Group{}
+	g.SetLimit(maxConcurrentResolves)
+	for resourceType, recipe := range recipePack.Properties.Recipes {
+		if recipe == nil {
+			continue
+		}
+
+		if old != nil {
+			if previous, ok := old.Properties.Recipes[resourceType]; ok && previous != nil && previous.Lock != nil &&
+				previous.Kind == recipe.Kind && previous.Source == recipe.Source && previous.PlainHTTP == recipe.PlainHTTP {
+				recipe.Lock = previous.Lock
+				recipe.LockError = ""
+				continue
+			}
+		}
+
+		// Each recipe is only modified by its own goroutine.
+		g.Go(func() error {
+			lock, err := resolveLock(ctx, resolver, recipe)
+			if errors.Is(err, sourcelock.ErrUnsupportedSource) {
+				logger.Info("Recipe source can't be locked", "resourceType", resourceType, "source", recipe.Source)
+			} else if err != nil {
+				logger.Error(err, "Failed to lock recipe source", "resourceType", resourceType, "source", recipe.Source)
+			}
+
+			recipe.Lock = lock
+			recipe.LockError = ""
+			if err != nil {
+				recipe.LockError = err.Error()
+			}
+
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+}
+
+// resolveLock resolves the source of the recipe to the immutable reference it currently points to.
+func resolveLock(ctx context.Context, resolver sourcelock.Resolver, recipe *datamodel.RecipeDefinition) (*datamodel.RecipeLock, error) {
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+
+	lock, err := resolver.Resolve(ctx, recipe.Kind, recipe.Source, recipe.PlainHTTP)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datamodel.RecipeLock{
+		Source:     lock.Source,
+		Version:    lock.Version,
+		Revision:   lock.Revision,
+		ResolvedAt: time.Now().UTC(),
+	}, nil
+}
+
+// lockVersion returns the version a recipe is locked to, for display: the module version, or the digest or commit.
+func lockVersion(lock *datamodel.RecipeLock) string {
+	if lock == nil {
+		return ""
+	}
+
+	if lock.Version != "" {
+		return lock.Version
+	}
+
+	return lock.Revision
+}
+
+// sameLock returns true if both locks pin the same immutable reference, regardless of when they were resolved.
+func sameLock(a *datamodel.RecipeLock, b *datamodel.RecipeLock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Source == b.Source && a.Version == b.Version && a.Revision == b.Revision
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/sync/errgroup"
+)
+
+var _ ctrl.Controller = (*UpdateRecipePackLocks)(nil)
+
+// UpdateRecipePackLocks is the controller implementation to update the locks of the recipes in a recipe pack to the
+// immutable references their sources currently resolve to.
+type UpdateRecipePackLocks struct {
+	ctrl.Operation[*datamodel.RecipePack, datamodel.RecipePack]
+	newResolver func(ctx context.Context, options *ctrl.Options, recipePack *datamodel.RecipePack) sourcelock.Resolver
+}
+
+// NewUpdateRecipePackLocks creates a new controller for updating the locks of the recipes in a recipe pack.
+func NewUpdateRecipePackLocks(opts ctrl.Options) (ctrl.Controller, error) {
+	return &UpdateRecipePackLocks{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.RecipePack]{
+				RequestConverter:  converter.RecipePackDataModelFromVersioned,
+				ResponseConverter: converter.RecipePackDataModelToVersioned,
+			},
+		),
+		newEnvironmentResolver,
+	}, nil
+}
+
+// Run resolves the sources of the requested recipes and updates their locks if they resolve to a different immutable
+// reference. A dry run only reports the available updates.
+func (r *UpdateRecipePackLocks) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updateRequest := recipes.RecipeLockUpdateRequest{}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &updateRequest); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("invalid recipe lock update request: %s", err.Error())), nil
+		}
+	}
+
+	resource, etag, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	resourceTypes, err := selectResourceTypes(resource, updateRequest.ResourceTypes)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// The sources are resolved concurrently, and the locks are updated once all of them are resolved.
+	resolver := r.newResolver(ctx, r.Options(), resource)
+	latest := make([]*datamodel.RecipeLock, len(resourceTypes))
+	resolveErrs := make([]error, len(resourceTypes))
+	g := errgroup.Group{}
+	g.SetLimit(maxConcurrentResolves)
+	for i, resourceType := range resourceTypes {
+		g.Go(func() error {
+			latest[i], resolveErrs[i] = resolveLock(ctx, resolver, resource.Properties.Recipes[resourceType])
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	result := recipes.RecipeLockUpdates{Recipes: []recipes.RecipeLockUpdate{}}
+	updated := false
+	for i, resourceType := range resourceTypes {
+		recipe := resource.Properties.Recipes[resourceType]
+		update := recipes.RecipeLockUpdate{
+			ResourceType: resourceType,
+			Source:       recipe.Source,
+			Locked:       lockVersion(recipe.Lock),
+		}
+
+		if resolveErrs[i] != nil {
+			logger.Error(resolveErrs[i], "Failed to resolve recipe source", "resourceType", resourceType, "source", recipe.Source)
+			update.Error = resolveErrs[i].Error()
+			result.Recipes = append(result.Recipes, update)
+			continue
+		}
+
+		update.Latest = lockVersion(latest[i])
+		update.UpdateAvailable = !sameLock(recipe.Lock, latest[i])
+		if update.UpdateAvailable && !updateRequest.DryRun {
+			logger.Info("Updating recipe lock", "resourceType", resourceType, "locked", update.Locked, "latest", update.Latest)
+			recipe.Lock = latest[i]
+			recipe.LockError = ""
+			update.Updated = true
+			updated = true
+		}
+
+		result.Recipes = append(result.Recipes, update)
+	}
+
+	if updated {
+		if _, err := r.SaveResource(ctx, serviceCtx.ResourceID.String(), resource, etag); err != nil {
+			return nil, err
+		}
+	}
+
+	return rest.NewOKResponse(result), nil
+}
+
+// selectResourceTypes returns the resource types of the recipes in the recipe pack matching the requested resource
+// types, in order. All resource types are returned if none are requested.
+func selectResourceTypes(recipePack *datamodel.RecipePack, requested []string) ([]string, error) {
+	resourceTypes := []string{}
+	for resourceType, recipe := range recipePack.Properties.Recipes {
+		if recipe == nil {
+			continue
+		}
+
+		if len(requested) == 0 || slices.ContainsFunc(requested, func(r string) bool { return strings.EqualFold(r, resourceType) }) {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	for _, r := range requested {
+		if !slices.ContainsFunc(resourceTypes, func(resourceType string) bool { return strings.EqualFold(r, resourceType) }) {
+			return nil, fmt.Errorf("recipe pack %q does not have a recipe for resource type %q", recipePack.Name, r)
+		}
+	}
+
+	slices.Sort(resourceTypes)
+	return resourceTypes, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/sourcelock"
+)
+
+const testRecipePackID = "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack"
+
+func newLockedRecipePack() *datamodel.RecipePack {
+	recipePack := &datamodel.RecipePack{
+		Properties: datamodel.RecipePackProperties{
+			Recipes: map[string]*datamodel.RecipeDefinition{
+				"Radius.Resources/redis": {
+					Kind:   "bicep",
+					Source: "example.com/recipes/redis:latest",
+					Lock: &datamodel.RecipeLock{
+						Source:     "example.com/recipes/redis@sha256:abc",
+						Revision:   "sha256:abc",
+						ResolvedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				"Radius.Resources/postgreSQL": {
+					Kind:   "terraform",
+					Source: "example/postgresql/kubernetes",
+					Lock: &datamodel.RecipeLock{
+						Source:     "example/postgresql/kubernetes",
+						Version:    "1.0.0",
+						ResolvedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				"Radius.Resources/mongoDatabases": {
+					Kind:   "helm",
+					Source: "oci://example.com/charts/mongo:1.0.0",
+				},
+			},
+		},
+	}
+	recipePack.ID = testRecipePackID
+	recipePack.Name = "testrecipepack"
+	return recipePack
+}
+
+func newUpdateLocksRequest(t *testing.T, body string) (*http.Request, context.Context) {
+	req, err := http.NewRequest(http.MethodPost, testRecipePackID+"/updatelocks?api-version=2025-08-01-preview", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req, rpctest.NewARMRequestContext(req)
+}
+
+func newTestLockResolver(mctrl *gomock.Controller) *sourcelock.MockResolver {
+	resolver := sourcelock.NewMockResolver(mctrl)
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "bicep", "example.com/recipes/redis:latest", false).
+		Return(&sourcelock.Lock{Source: "example.com/recipes/redis@sha256:def", Revision: "sha256:def"}, nil).
+		AnyTimes()
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "terraform", "example/postgresql/kubernetes", false).
+		Return(&sourcelock.Lock{Source: "example/postgresql/kubernetes", Version: "1.0.0"}, nil).
+		AnyTimes()
+	resolver.EXPECT().
+		Resolve(gomock.Any(), "helm", "oci://example.com/charts/mongo:1.0.0", false).
+		Return(nil, errors.New("source cannot be pinned")).
+		AnyTimes()
+	return resolver
+}
+
+func runUpdateLocks(t *testing.T, databaseClient database.Client, resolver sourcelock.Resolver, body string) *httptest.ResponseRecorder {
+	ctl, err := NewUpdateRecipePackLocks(ctrl.Options{DatabaseClient: databaseClient})
+	require.NoError(t, err)
+	ctl.(*UpdateRecipePackLocks).newResolver = withResolver(resolver)
+
+	req, ctx := newUpdateLocksRequest(t, body)
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	return w
+}
+
+func TestUpdateRecipePackLocksRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&database.Object{Metadata: database.Metadata{ETag: "etag"}, Data: newLockedRecipePack()}, nil)
+
+	var saved *datamodel.RecipePack
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*datamodel.RecipePack)
+			return nil
+		})
+
+	w := runUpdateLocks(t, databaseClient, newTestLockResolver(mctrl), `{}`)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	result := recipes.RecipeLockUpdates{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Equal(t, []recipes.RecipeLockUpdate{
+		{
+			ResourceType: "Radius.Resources/mongoDatabases",
+			Source:       "oci://example.com/charts/mongo:1.0.0",
+			Error:        "source cannot be pinned",
+		},
+		{
+			ResourceType: "Radius.Resources/postgreSQL",
+			Source:       "example/postgresql/kubernetes",
+			Locked:       "1.0.0",
+			Latest:       "1.0.0",
+		},
+		{
+			ResourceType:    "Radius.Resources/redis",
+			Source:          "example.com/recipes/redis:latest",
+			Locked:          "sha256:abc",
+			Latest:          "sha256:def",
+			UpdateAvailable: true,
+			Updated:         true,
+		},
+	}, result.Recipes)
+
+	require.Equal(t, "example.com/recipes/redis@sha256:def", saved.Properties.Recipes["Radius.Resources/redis"].Lock.Source)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), saved.Properties.Recipes["Radius.Resources/postgreSQL"].Lock.ResolvedAt)
+}
+
+func TestUpdateRecipePackLocksRun_DryRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	// A dry run doesn't save the recipe pack.
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&database.Object{Data: newLockedRecipePack()}, nil)
+
+	w := runUpdateLocks(t, databaseClient, newTestLockResolver(mctrl), `{"resourceTypes": ["radius.resources/redis"], "dryRun": true}`)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	result := recipes.RecipeLockUpdates{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Equal(t, []recipes.RecipeLockUpdate{
+		{
+			ResourceType:    "Radius.Resources/redis",
+			Source:          "example.com/recipes/redis:latest",
+			Locked:          "sha256:abc",
+			Latest:          "sha256:def",
+			UpdateAvailable: true,
+		},
+	}, result.Recipes)
+}
+
+func TestUpdateRecipePackLocksRun_UnknownResourceType(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&database.Object{Data: newLockedRecipePack()}, nil)
+
+	w := runUpdateLocks(t, databaseClient, newTestLockResolver(mctrl), `{"resourceTypes": ["Radius.Resources/unknown"]}`)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), `recipe pack \"testrecipepack\" does not have a recipe for resource type \"Radius.Resources/unknown\"`)
+}
+
+func TestUpdateRecipePackLocksRun_NotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &database.ErrNotFound{ID: testRecipePackID})
+
+	w := runUpdateLocks(t, databaseClient, sourcelock.NewMockResolver(mctrl), `{}`)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
@@ -64,7 +64,17 @@ func (l *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.
 		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	}
 
-	ksecret, err := getSecretFromOutputResources(resource.Properties.Status.OutputResources, l.Options())
+	resp, err := ListSecretValues(resource, l.Options())
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(resp), nil
+}
+
+// ListSecretValues reads the values of the secrets of the secret store from the Kubernetes secret it references.
+func ListSecretValues(resource *datamodel.SecretStore, options *ctrl.Options) (*datamodel.SecretStoreListSecrets, error) {
+	ksecret, err := getSecretFromOutputResources(resource.Properties.Status.OutputResources, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret from output resource: %w", err)
 	}
@@ -103,5 +113,5 @@ func (l *ListSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.
 		}
 	}
 
-	return rest.NewOKResponse(resp), nil
+	return resp, nil
 }
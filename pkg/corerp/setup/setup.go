@@ -67,6 +67,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"planrecipe": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe(opt, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -267,6 +272,11 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 		Patch: builder.Operation[datamodel.RecipePack]{
 			APIController: rp_ctrl.NewCreateOrUpdateRecipePack,
 		},
+		Custom: map[string]builder.Operation[datamodel.RecipePack]{
+			"updatelocks": {
+				APIController: rp_ctrl.NewUpdateRecipePackLocks,
+			},
+		},
 	})
 
 	_ = ns.AddResource("environments", &builder.ResourceOption[*datamodel.Environment_v20250801preview, datamodel.Environment_v20250801preview]{
@@ -279,6 +289,13 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 		Patch: builder.Operation[datamodel.Environment_v20250801preview]{
 			APIController: env_v20250801_ctrl.NewCreateOrUpdateEnvironmentv20250801preview,
 		},
+		Custom: map[string]builder.Operation[datamodel.Environment_v20250801preview]{
+			"planrecipe": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_v20250801_ctrl.NewPlanRecipev20250801preview(opt, recipeControllerConfig.Engine)
+				},
+			},
+		},
 	})
 
 	_ = ns.AddResource("applications", &builder.ResourceOption[*datamodel.Application_v20250801preview, datamodel.Application_v20250801preview]{
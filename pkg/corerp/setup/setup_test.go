@@ -119,6 +119,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONPLANRECIPE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
@@ -219,6 +223,10 @@ var radiusCoreHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Radius.Core/recipePacks", Method: v1.OperationPatch},
 		Path:          "/resourcegroups/testrg/providers/radius.core/recipepacks/recipe0",
 		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: "Radius.Core/recipePacks", Method: "ACTIONUPDATELOCKS"},
+		Path:          "/resourcegroups/testrg/providers/radius.core/recipepacks/recipe0/updatelocks",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Radius.Core/environments", Method: v1.OperationPut},
 		Path:          "/resourcegroups/testrg/providers/radius.core/environments/env0",
@@ -227,6 +235,10 @@ var radiusCoreHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Radius.Core/environments", Method: v1.OperationPatch},
 		Path:          "/resourcegroups/testrg/providers/radius.core/environments/env0",
 		Method:        http.MethodPatch,
+	}, {
+		OperationType: v1.OperationType{Type: "Radius.Core/environments", Method: "ACTIONPLANRECIPE"},
+		Path:          "/resourcegroups/testrg/providers/radius.core/environments/env0/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: "Radius.Core/applications", Method: v1.OperationPut},
 		Path:          "/resourcegroups/testrg/providers/radius.core/applications/app0",
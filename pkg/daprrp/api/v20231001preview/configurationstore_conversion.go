@@ -133,6 +133,7 @@ func (dst *DaprConfigurationStoreResource) ConvertFrom(src v1.DataModelInterface
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprConfigstore.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(daprConfigstore.Properties.Status.Recipe),
+			Drift:           fromDriftStatus(daprConfigstore.Properties.Status.Drift),
 		},
 		Auth: fromAuthDataModel(daprConfigstore.Properties.Auth),
 	}
@@ -115,6 +115,32 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 	return status
 }
 
+func fromDriftStatus(driftStatus *rpv1.DriftStatus) *DriftStatus {
+	if driftStatus == nil {
+		return nil
+	}
+
+	status := &DriftStatus{
+		State:           new(DriftState(driftStatus.State)),
+		LastCheckedTime: new(driftStatus.LastCheckedTime),
+	}
+
+	if driftStatus.Message != "" {
+		status.Message = new(driftStatus.Message)
+	}
+
+	for _, resource := range driftStatus.Resources {
+		status.Resources = append(status.Resources, &DriftedResource{
+			Action:       new(resource.Action),
+			ResourceType: new(resource.ResourceType),
+			Name:         new(resource.Name),
+			ID:           new(resource.ID),
+		})
+	}
+
+	return status
+}
+
 func fromSystemDataModel(s v1.SystemData) *SystemData {
 	return &SystemData{
 		CreatedBy:          new(s.CreatedBy),
@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -249,6 +250,52 @@ func Test_fromRecipeStatus(t *testing.T) {
 	}
 }
 
+func Test_fromDriftStatus(t *testing.T) {
+	checkedTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		driftStatus *rpv1.DriftStatus
+		expected    *DriftStatus
+	}{
+		{&rpv1.DriftStatus{
+			State:           rpv1.DriftStateDrifted,
+			LastCheckedTime: checkedTime,
+			Resources: []rpv1.DriftedResource{
+				{Action: "Update", ResourceType: "Microsoft.Cache/redis", ID: "/subscriptions/test/resourceGroups/test/providers/Microsoft.Cache/redis/redis0"},
+			},
+		}, &DriftStatus{
+			State:           to.Ptr(DriftStateDrifted),
+			LastCheckedTime: &checkedTime,
+			Resources: []*DriftedResource{
+				{
+					Action:       new("Update"),
+					ResourceType: new("Microsoft.Cache/redis"),
+					Name:         new(""),
+					ID:           new("/subscriptions/test/resourceGroups/test/providers/Microsoft.Cache/redis/redis0"),
+				},
+			},
+		}},
+		{&rpv1.DriftStatus{
+			State:           rpv1.DriftStateFailed,
+			LastCheckedTime: checkedTime,
+			Message:         "terraform plan failed",
+		}, &DriftStatus{
+			State:           to.Ptr(DriftStateFailed),
+			LastCheckedTime: &checkedTime,
+			Message:         new("terraform plan failed"),
+		}},
+		{nil, nil},
+	}
+
+	for _, tt := range testCases {
+		status := fromDriftStatus(tt.driftStatus)
+		if tt.expected == nil {
+			require.Nil(t, status)
+		} else {
+			require.Equal(t, *tt.expected, *status)
+		}
+	}
+}
+
 func TestToRecipeDataModel(t *testing.T) {
 	testset := []struct {
 		versioned *Recipe
@@ -102,6 +102,7 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(redis.Properties.Status.Recipe),
+			Drift:           fromDriftStatus(redis.Properties.Status.Drift),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
 		Environment:       new(redis.Properties.Environment),
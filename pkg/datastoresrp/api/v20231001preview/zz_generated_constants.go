@@ -46,6 +46,27 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// DriftState - The result of a drift check.
+type DriftState string
+
+const (
+	// DriftStateDrifted - The output resources no longer match what the recipe would deploy
+	DriftStateDrifted DriftState = "Drifted"
+	// DriftStateFailed - The drift check could not be completed
+	DriftStateFailed DriftState = "Failed"
+	// DriftStateInSync - The output resources match what the recipe would deploy
+	DriftStateInSync DriftState = "InSync"
+)
+
+// PossibleDriftStateValues returns the possible values for the DriftState const type.
+func PossibleDriftStateValues() []DriftState {
+	return []DriftState{
+		DriftStateDrifted,
+		DriftStateFailed,
+		DriftStateInSync,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
@@ -102,7 +102,7 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipeDeleteController(options, c.engine, c.configurationLoader)
 
-	case v1.OperationPut:
+	case v1.OperationPut, v1.OperationPatch:
 		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
 			return NewInertPutController(options)
 		}
@@ -120,7 +120,7 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return err
 	}
 
-	if operationContext.Method != v1.OperationPut {
+	if operationContext.Method != v1.OperationPut && operationContext.Method != v1.OperationPatch {
 		return nil
 	}
 
@@ -91,6 +91,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipePutController{}, selected)
 	})
 
+	t.Run("recipe PATCH", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPatch}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipePutController{}, selected)
+	})
+
 	t.Run("recipe DELETE", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
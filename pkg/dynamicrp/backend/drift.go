@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/recipes/drift"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// driftLeaseName is the name of the lease held by the dynamic-rp replica that checks for drift.
+	driftLeaseName = "dynamic-rp-drift-detector"
+)
+
+// NewDriftService creates the service that periodically checks the resources of user-defined types deployed by
+// recipes for drift.
+func NewDriftService(options *dynamicrp.Options) *drift.Service {
+	return &drift.Service{
+		ServiceName: "dynamic-rp drift detector",
+		Interval:    drift.IntervalFromSeconds(options.Config.DriftDetection.IntervalSeconds),
+		LeaseName:   driftLeaseName,
+		NewLeaseClient: func() (kubernetes.Interface, error) {
+			return options.KubernetesProvider.ClientGoClient()
+		},
+		NewDetector: func(ctx context.Context) (*drift.Detector, error) {
+			e, err := options.RecipeEngine()
+			if err != nil {
+				return nil, err
+			}
+
+			databaseClient, err := options.DatabaseProvider.GetClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(options.UCP))
+			if err != nil {
+				return nil, err
+			}
+
+			rootScopes, err := drift.RadiusPlanes(options.UCP)
+			if err != nil {
+				return nil, err
+			}
+
+			return &drift.Detector{
+				DatabaseClient: databaseClient,
+				Engine:         e,
+				RootScopes:     rootScopes,
+				ResourceTypes: func(ctx context.Context, rootScope string) ([]string, error) {
+					return recipeResourceTypes(ctx, ucp, rootScope)
+				},
+			}, nil
+		},
+	}
+}
+
+// recipeResourceTypes returns the user-defined resource types registered in the Radius plane of the root scope that are
+// deployed by recipes. The built-in Applications.* and Radius.Core types are served by applications-rp and are not
+// included.
+func recipeResourceTypes(ctx context.Context, ucp *v20231001preview.ClientFactory, rootScope string) ([]string, error) {
+	id, err := resources.ParseScope(rootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	planeName := id.FindScope(resources_radius.PlaneTypeRadius)
+	if planeName == "" {
+		return nil, fmt.Errorf("root scope %q is not a Radius plane", rootScope)
+	}
+
+	result := []string{}
+	pager := ucp.NewResourceProvidersClient().NewListProviderSummariesPager(planeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range page.Value {
+			if summary.Name == nil || isBuiltInNamespace(*summary.Name) {
+				continue
+			}
+
+			for typeName, resourceType := range summary.ResourceTypes {
+				if resourceType != nil && slices.ContainsFunc(resourceType.Capabilities, func(capability *string) bool {
+					return capability != nil && *capability == datamodel.CapabilityManualResourceProvisioning
+				}) {
+					continue
+				}
+
+				result = append(result, *summary.Name+"/"+typeName)
+			}
+		}
+	}
+
+	slices.Sort(result)
+	return result, nil
+}
+
+// isBuiltInNamespace returns true if the resource provider namespace is served by applications-rp.
+func isBuiltInNamespace(namespace string) bool {
+	return strings.HasPrefix(strings.ToLower(namespace), "applications.") || strings.EqualFold(namespace, "Radius.Core")
+}
@@ -42,6 +42,9 @@ type Config struct {
 	// Database is the configuration for the database.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
+	// DriftDetection is the configuration for detecting drift of the output resources deployed by recipes.
+	DriftDetection hostoptions.DriftDetectionOptions `yaml:"driftDetection"`
+
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
@@ -60,6 +63,12 @@ type Config struct {
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
+	// RecipeCache is the configuration for the cache of downloaded recipe artifacts.
+	RecipeCache hostoptions.RecipeCacheOptions `yaml:"recipeCache"`
+
+	// RecipeDrivers is the configuration for the out-of-process recipe driver plugins.
+	RecipeDrivers []hostoptions.RecipeDriverPluginOptions `yaml:"recipeDrivers"`
+
 	// Secrets is the configuration for the secret storage system.
 	Secrets secretprovider.SecretProviderOptions `yaml:"secretProvider"`
 
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// readOnlyProperties are the properties that are computed by Radius and are never part of
+// the document a JSON merge patch is applied to. This matches what a client would send on PUT.
+var readOnlyProperties = []string{"provisioningState", "status"}
+
+// PatchResource is a custom PATCH controller that applies a JSON merge patch (RFC 7396) to
+// an existing dynamic resource.
+type PatchResource struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient *v20231001preview.ClientFactory
+}
+
+// NewPatchResource creates a new PatchResource controller.
+func NewPatchResource(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+) (ctrl.Controller, error) {
+	return &PatchResource{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient: ucpClient,
+	}, nil
+}
+
+// Run merges the request body into the stored resource, validates the result against the
+// resource type's schema and queues an asynchronous update operation.
+//
+// The merge and the save use the ETag of the stored resource, so a concurrent write between
+// the two returns a conflict instead of silently discarding the other writer's changes.
+// Callers can additionally send If-Match to make the patch conditional on a version they've read.
+//
+// Resource types with sensitive properties do not support PATCH. Sensitive values are redacted
+// from the database once the resource is deployed, so they can't be carried over into the
+// merged resource. Those resources must be updated with PUT.
+func (c *PatchResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	patch, err := ctrl.ReadMergePatchBody(req)
+	if errors.Is(err, ctrl.ErrUnsupportedContentType) {
+		return newInvalidPatchResponse(fmt.Sprintf("PATCH requires Content-Type %q", ctrl.MergePatchContentType)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+
+	schemaData, err := schema.GetSchema(ctx, c.ucpClient, resourceID, resourceType, serviceCtx.APIVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema for PATCH",
+			"resourceType", resourceType, "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for resource validation",
+			},
+		}), nil
+	}
+
+	if schemaData != nil && len(schema.ExtractSensitiveFieldPaths(schemaData, "")) > 0 {
+		return newInvalidPatchResponse(fmt.Sprintf("resource type %q has sensitive properties and does not support PATCH, use PUT instead", resourceType)), nil
+	}
+
+	merged, err := applyMergePatch(old, patch)
+	if err != nil {
+		return newInvalidPatchResponse(err.Error()), nil
+	}
+
+	// A nil schema (as opposed to a typed nil) lets ValidateResourceAgainstSchema skip validation.
+	var validationSchema any
+	if schemaData != nil {
+		validationSchema = schemaData
+	}
+	if err := schema.ValidateResourceAgainstSchema(ctx, merged, validationSchema); err != nil {
+		return newInvalidPatchResponse(fmt.Sprintf("Schema validation failed: %v", err)), nil
+	}
+
+	content, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	newResource, err := c.RequestConverter()(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range c.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, c.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	if r, err := c.PrepareAsyncOperation(ctx, newResource, v1.ProvisioningStateAccepted, c.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	return c.ConstructAsyncResponse(ctx, req.Method, etag, newResource)
+}
+
+// applyMergePatch applies a JSON merge patch to the user-settable parts of the stored resource and returns the
+// merged document in its versioned (request body) shape.
+func applyMergePatch(old *datamodel.DynamicResource, patch []byte) (map[string]any, error) {
+	properties := maps.Clone(old.Properties)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	for _, key := range readOnlyProperties {
+		delete(properties, key)
+	}
+
+	original, err := json.Marshal(map[string]any{
+		"location":   old.Location,
+		"tags":       old.Tags,
+		"properties": properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := jsonpatch.MergePatch(original, patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON merge patch: %w", err)
+	}
+
+	merged := map[string]any{}
+	if err := json.Unmarshal(patched, &merged); err != nil {
+		return nil, fmt.Errorf("invalid JSON merge patch: %w", err)
+	}
+
+	// Removing "properties" entirely is never valid for a dynamic resource.
+	if _, ok := merged["properties"].(map[string]any); !ok {
+		return nil, errors.New("invalid JSON merge patch: 'properties' must be an object")
+	}
+
+	return merged, nil
+}
+
+func newInvalidPatchResponse(message string) rest.Response {
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPatchURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource?api-version=2023-10-01-preview"
+)
+
+func newTestPatchController(t *testing.T, databaseClient database.Client, statusManager statusmanager.StatusManager, ucpClient *v20231001preview.ClientFactory) controller.Controller {
+	t.Helper()
+
+	opts := controller.Options{
+		DatabaseClient: databaseClient,
+		StatusManager:  statusManager,
+	}
+	resourceOpts := controller.ResourceOptions[datamodel.DynamicResource]{
+		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+	}
+
+	c, err := NewPatchResource(opts, resourceOpts, ucpClient)
+	require.NoError(t, err)
+
+	return c
+}
+
+func newPatchRequest(t *testing.T, contentType string, body string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPatch, testPatchURL, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+func testUCPClientFactoryWithSizeSchema() (*v20231001preview.ClientFactory, error) {
+	return createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{
+				"type": "string",
+			},
+			"size": map[string]any{
+				"type": "string",
+				"enum": []any{"S", "M", "L"},
+			},
+			"replicas": map[string]any{
+				"type": "integer",
+			},
+		},
+		"required": []any{"environment"},
+	})
+}
+
+func TestPatchResource_MergesProperties(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"environment": "env",
+		"size":        "S",
+		"replicas":    float64(2),
+		"status":      map[string]any{"binding": "value"},
+	})
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	var saved *datamodel.DynamicResource
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			saved = obj.Data.(*datamodel.DynamicResource)
+			obj.ETag = "etag-2"
+			return nil
+		})
+
+	statusManager := statusmanager.NewMockStatusManager(mctrl)
+	statusManager.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	ucpClient, err := testUCPClientFactoryWithSizeSchema()
+	require.NoError(t, err)
+
+	c := newTestPatchController(t, databaseClient, statusManager, ucpClient)
+
+	req := newPatchRequest(t, controller.MergePatchContentType, `{"properties":{"size":"L","replicas":null}}`)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.IsType(t, &rest.AsyncOperationResponse{}, resp)
+
+	require.NotNil(t, saved)
+	require.Equal(t, map[string]any{
+		"environment": "env",
+		"size":        "L",
+	}, saved.Properties)
+	require.Equal(t, v1.ProvisioningStateAccepted, saved.ProvisioningState())
+}
+
+func TestPatchResource_SchemaValidationFails(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"environment": "env",
+		"size":        "S",
+	})
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithSizeSchema()
+	require.NoError(t, err)
+
+	c := newTestPatchController(t, databaseClient, nil, ucpClient)
+
+	req := newPatchRequest(t, controller.MergePatchContentType, `{"properties":{"size":"XXL"}}`)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	var body v1.ErrorResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	require.Equal(t, v1.CodeInvalidRequestContent, body.Error.Code)
+	require.Contains(t, body.Error.Message, "Schema validation failed")
+}
+
+func TestPatchResource_ETagMismatch(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"environment": "env",
+		"size":        "S",
+	})
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithSizeSchema()
+	require.NoError(t, err)
+
+	c := newTestPatchController(t, databaseClient, nil, ucpClient)
+
+	req := newPatchRequest(t, controller.MergePatchContentType, `{"properties":{"size":"L"}}`)
+	req.Header.Set("If-Match", "stale-etag")
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusPreconditionFailed, w.Result().StatusCode)
+}
+
+func TestPatchResource_NotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+	c := newTestPatchController(t, databaseClient, nil, nil)
+
+	req := newPatchRequest(t, controller.MergePatchContentType, `{"properties":{"size":"L"}}`)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestPatchResource_SensitiveFieldsRejected(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"name":     "test",
+		"password": nil,
+	})
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
+	require.NoError(t, err)
+
+	c := newTestPatchController(t, databaseClient, nil, ucpClient)
+
+	req := newPatchRequest(t, controller.MergePatchContentType, `{"properties":{"name":"updated"}}`)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	var body v1.ErrorResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	require.Contains(t, body.Error.Message, "use PUT instead")
+}
+
+func TestPatchResource_UnsupportedContentType(t *testing.T) {
+	c := newTestPatchController(t, nil, nil, nil)
+
+	req := newPatchRequest(t, "application/json-patch+json", `[{"op":"replace","path":"/properties/size","value":"L"}]`)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	old := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"environment":       "env",
+		"provisioningState": "Succeeded",
+		"nested": map[string]any{
+			"a": "1",
+			"b": "2",
+		},
+	})
+	old.Tags = map[string]string{"team": "a"}
+
+	t.Run("merges nested objects and removes nulls", func(t *testing.T) {
+		merged, err := applyMergePatch(old, []byte(`{"tags":{"owner":"b"},"properties":{"nested":{"a":null,"c":"3"}}}`))
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{"team": "a", "owner": "b"}, merged["tags"])
+		require.Equal(t, map[string]any{
+			"environment": "env",
+			"nested": map[string]any{
+				"b": "2",
+				"c": "3",
+			},
+		}, merged["properties"])
+	})
+
+	t.Run("does not modify the stored resource", func(t *testing.T) {
+		_, err := applyMergePatch(old, []byte(`{"properties":{"environment":"other"}}`))
+		require.NoError(t, err)
+		require.Equal(t, "env", old.Properties["environment"])
+	})
+
+	t.Run("properties cannot be removed", func(t *testing.T) {
+		_, err := applyMergePatch(old, []byte(`{"properties":null}`))
+		require.ErrorContains(t, err, "'properties' must be an object")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := applyMergePatch(old, []byte(`{"properties":`))
+		require.ErrorContains(t, err, "invalid JSON merge patch")
+	})
+}
@@ -53,7 +53,7 @@ func (s *Service) registerRoutes(
 	// Create encryption filter for sensitive fields
 	encryptionFilter := makeEncryptionFilter(ucpClient, handler)
 
-	// Resource options with encryption filter applied to PUT operations
+	// Resource options with encryption filter applied to PUT and PATCH operations
 	resourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
 		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
 		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
@@ -81,6 +81,8 @@ func (s *Service) registerRoutes(
 			r.Route("/locations/{locationName}", func(r chi.Router) {
 				r.Get("/{or:operation[Rr]esults}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationResultController))
 				r.Get("/{os:operation[Ss]tatuses}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationStatusController))
+				r.Post("/{os:operation[Ss]tatuses}/{operationID}/cancel", dynamicOperationHandler(v1.OperationPost, controllerOptions, makeCancelOperationController))
+				r.Get("/{os:operation[Ss]tatuses}/{operationID}/logs", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationLogsController))
 			})
 		})
 
@@ -98,6 +100,10 @@ func (s *Service) registerRoutes(
 				func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
 				}))
+			r.Patch("/{resourceName}", dynamicOperationHandler(v1.OperationPatch, controllerOptions,
+				func(opts controller.Options) (controller.Controller, error) {
+					return NewPatchResource(opts, resourceOptions, ucpClient)
+				}))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
@@ -115,3 +121,11 @@ func makeGetOperationResultController(opts controller.Options) (controller.Contr
 func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
+
+func makeCancelOperationController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewCancelOperation(opts)
+}
+
+func makeGetOperationLogsController(opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewGetOperationLogs(opts)
+}
@@ -31,9 +31,12 @@ import (
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/helm"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
@@ -74,6 +77,9 @@ type Options struct {
 
 // RecipeOptions holds the configuration options for the recipe engine subsystem.
 type RecipeOptions struct {
+	// Cache is the cache of downloaded recipe artifacts shared by the recipe drivers. It is nil if caching is disabled.
+	Cache *cache.Cache
+
 	// ConfigurationLoader is the loader for recipe configurations.
 	ConfigurationLoader configloader.ConfigurationLoader
 
@@ -118,6 +124,16 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
+	if config.RecipeCache.Path != "" {
+		options.Recipes.Cache, err = cache.New(cache.Options{
+			Path:    config.RecipeCache.Path,
+			MaxSize: int64(config.RecipeCache.MaxSizeMB) << 20,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	options.Recipes.ConfigurationLoader = configloader.NewEnvironmentLoader(sdk.NewClientOptions(options.UCP))
 	options.Recipes.SecretsLoader = configloader.NewSecretStoreLoader(sdk.NewClientOptions(options.UCP))
 
@@ -139,6 +155,7 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		o.Recipes.Drivers = map[string]func(options *Options) (driver.Driver, error){
 			recipes.TemplateKindBicep:     bicepDriver,
 			recipes.TemplateKindTerraform: terraformDriver,
+			recipes.TemplateKindHelm:      helmDriver,
 		}
 	}
 
@@ -152,6 +169,8 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		drivers[name] = driver
 	}
 
+	errs = errors.Join(errs, plugin.AddDrivers(drivers, o.Config.RecipeDrivers))
+
 	if errs != nil {
 		return nil, fmt.Errorf("failed to create recipe drivers: %w", errs)
 	}
@@ -172,6 +191,15 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 		return nil, err
 	}
 
+	deploymentOperationsClient, err := clients.NewResourceDeploymentOperationsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          options.UCP.Endpoint(),
+		ARMClientOptions: sdk.NewClientOptions(options.UCP),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	provider, err := sdk_cred.NewAzureCredentialProvider(options.SecretProvider, options.UCP, &aztoken.AnonymousCredential{})
 	if err != nil {
 		return nil, err
@@ -199,8 +227,10 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 		deploymentEngineClient,
 		resourceClient,
 		bicep.BicepOptions{
-			DeleteRetryCount:        bicepDeleteRetryCount,
-			DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
+			DeleteRetryCount:           bicepDeleteRetryCount,
+			DeleteRetryDelaySeconds:    bicepDeleteRetryDeleteSeconds,
+			Cache:                      options.Recipes.Cache,
+			DeploymentOperationsClient: deploymentOperationsClient,
 		}), nil
 }
 
@@ -211,5 +241,10 @@ func terraformDriver(options *Options) (driver.Driver, error) {
 		terraform.TerraformOptions{
 			Path:     options.Config.Terraform.Path,
 			LogLevel: options.Config.Terraform.LogLevel,
+			Cache:    options.Recipes.Cache,
 		}, *options.KubernetesProvider), nil
 }
+
+func helmDriver(options *Options) (driver.Driver, error) {
+	return helm.NewHelmDriver(options.KubernetesProvider, helm.HelmOptions{}), nil
+}
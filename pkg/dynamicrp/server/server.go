@@ -48,6 +48,11 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Drift detection is opt-in because planning recipes calls the cloud providers.
+	if options.Config.DriftDetection.Enabled {
+		services = append(services, backend.NewDriftService(options))
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -98,7 +99,7 @@ func (s *Store) Save(ctx context.Context, key persistence.Key, graph *corerpv202
 	if msg == "" {
 		msg = fmt.Sprintf("radius: update %s", path)
 	}
-	return wt.CommitAndPush(ctx, msg)
+	return wt.CommitAndPush(ctx, formatCommitMessage(msg, opts.Labels))
 }
 
 // Load returns the graph previously stored under key, or persistence.ErrNotFound.
@@ -117,23 +118,161 @@ func (s *Store) Load(ctx context.Context, key persistence.Key) (*corerpv20250801
 	}
 	defer wt.Remove(ctx)
 
-	data, err := wt.ReadFile(path)
-	if err != nil {
-		if errors.Is(err, fs.ErrNotExist) {
-			return nil, persistence.ErrNotFound
+	return readGraph(wt, path)
+}
+
+// List returns keys present on the branch under namespace. An empty namespace
+// lists every key on the branch.
+func (s *Store) List(ctx context.Context, namespace string) ([]persistence.Key, error) {
+	if namespace != "" {
+		if err := validateKeyPart("namespace", namespace); err != nil {
+			return nil, err
 		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wt, err := OpenOrCreate(ctx, s.branch)
+	if err != nil {
 		return nil, err
 	}
-	graph := &corerpv20250801preview.ApplicationGraphResponse{}
-	if err := json.Unmarshal(data, graph); err != nil {
-		return nil, fmt.Errorf("git: unmarshal graph at %s: %w", path, err)
+	defer wt.Remove(ctx)
+
+	return listKeys(wt, namespace)
+}
+
+// History returns the commits on the branch that changed the graph stored
+// under key, newest first.
+func (s *Store) History(ctx context.Context, key persistence.Key) ([]persistence.Revision, error) {
+	path, err := constructPathForKey(key)
+	if err != nil {
+		return nil, err
 	}
-	return graph, nil
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wt, err := OpenOrCreate(ctx, s.branch)
+	if err != nil {
+		return nil, err
+	}
+	defer wt.Remove(ctx)
+
+	commits, err := wt.Log(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 {
+		return nil, persistence.ErrNotFound
+	}
+
+	revisions := make([]persistence.Revision, 0, len(commits))
+	for _, c := range commits {
+		msg, labels := parseCommitMessage(c.Message)
+		revisions = append(revisions, persistence.Revision{
+			ID:        c.SHA,
+			Message:   msg,
+			Labels:    labels,
+			Timestamp: c.Timestamp,
+			Deleted:   c.Deleted,
+		})
+	}
+	return revisions, nil
 }
 
-// List returns keys present on the branch under namespace. An empty namespace
-// lists every key on the branch.
-func (s *Store) List(ctx context.Context, namespace string) ([]persistence.Key, error) {
+// LoadRevision returns the graph stored under key as of the commit named by
+// revision.
+func (s *Store) LoadRevision(ctx context.Context, key persistence.Key, revision string) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	path, err := constructPathForKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wt, err := OpenOrCreate(ctx, s.branch)
+	if err != nil {
+		return nil, err
+	}
+	defer wt.Remove(ctx)
+
+	return readGraphAt(ctx, wt, revision, path)
+}
+
+// Restore commits the graph stored under key as of revision as the latest
+// version of key. If opts.Message is empty a message naming the revision is
+// used.
+func (s *Store) Restore(ctx context.Context, key persistence.Key, revision string, opts persistence.SaveOptions) error {
+	path, err := constructPathForKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wt, err := OpenOrCreate(ctx, s.branch)
+	if err != nil {
+		return err
+	}
+	defer wt.Remove(ctx)
+
+	// Re-encode rather than copying the blob so that the restored file is
+	// validated and formatted like any other saved graph.
+	graph, err := readGraphAt(ctx, wt, revision, path)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return fmt.Errorf("git: marshal graph: %w", err)
+	}
+	if err := wt.WriteFile(path, data); err != nil {
+		return err
+	}
+
+	msg := opts.Message
+	if msg == "" {
+		msg = fmt.Sprintf("radius: restore %s to %s", path, revision)
+	}
+	return wt.CommitAndPush(ctx, formatCommitMessage(msg, opts.Labels))
+}
+
+// Neighbors returns the resources one edge away from resourceID across every
+// graph stored under namespace.
+func (s *Store) Neighbors(ctx context.Context, namespace string, resourceID string, direction persistence.TraversalDirection) ([]persistence.ResourceRef, error) {
+	index, err := s.loadIndex(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.Neighbors(resourceID, direction)
+}
+
+// Reachable returns every resource reachable from resourceID across every
+// graph stored under namespace.
+func (s *Store) Reachable(ctx context.Context, namespace string, resourceID string, direction persistence.TraversalDirection) ([]persistence.ResourceRef, error) {
+	index, err := s.loadIndex(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.Reachable(resourceID, direction)
+}
+
+// BlastRadius returns the resources affected by deleting resourceID across
+// every graph stored under namespace.
+func (s *Store) BlastRadius(ctx context.Context, namespace string, resourceID string) (*persistence.BlastRadius, error) {
+	index, err := s.loadIndex(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.BlastRadius(resourceID)
+}
+
+// loadIndex reads every graph stored under namespace into a
+// persistence.Index using a single worktree.
+func (s *Store) loadIndex(ctx context.Context, namespace string) (*persistence.Index, error) {
 	if namespace != "" {
 		if err := validateKeyPart("namespace", namespace); err != nil {
 			return nil, err
@@ -149,6 +288,77 @@ func (s *Store) List(ctx context.Context, namespace string) ([]persistence.Key,
 	}
 	defer wt.Remove(ctx)
 
+	keys, err := listKeys(wt, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	index := persistence.NewIndex()
+	for _, key := range keys {
+		path, err := constructPathForKey(key)
+		if err != nil {
+			// Files that do not map to a valid key were not written by this
+			// store.
+			continue
+		}
+		graph, err := readGraph(wt, path)
+		if err != nil {
+			return nil, err
+		}
+		index.AddGraph(key, graph)
+
+		// The labels of a graph are the labels of the commit that last
+		// saved it.
+		commits, err := wt.Log(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		if len(commits) > 0 {
+			_, labels := parseCommitMessage(commits[0].Message)
+			if environmentID := labels[persistence.EnvironmentLabel]; environmentID != "" {
+				index.AddEnvironment(key, environmentID)
+			}
+		}
+	}
+	return index, nil
+}
+
+// readGraph reads and decodes the graph stored at path in wt.
+func readGraph(wt *StateWorktree, path string) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	data, err := wt.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+	graph := &corerpv20250801preview.ApplicationGraphResponse{}
+	if err := json.Unmarshal(data, graph); err != nil {
+		return nil, fmt.Errorf("git: unmarshal graph at %s: %w", path, err)
+	}
+	return graph, nil
+}
+
+// readGraphAt reads and decodes the graph stored at path in wt as of
+// revision.
+func readGraphAt(ctx context.Context, wt *StateWorktree, revision string, path string) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	data, err := wt.ReadFileAt(ctx, revision, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, persistence.ErrNotFound
+		}
+		return nil, err
+	}
+	graph := &corerpv20250801preview.ApplicationGraphResponse{}
+	if err := json.Unmarshal(data, graph); err != nil {
+		return nil, fmt.Errorf("git: unmarshal graph at %s:%s: %w", revision, path, err)
+	}
+	return graph, nil
+}
+
+// listKeys returns the keys present in wt under namespace. An empty
+// namespace lists every key.
+func listKeys(wt *StateWorktree, namespace string) ([]persistence.Key, error) {
 	root := wt.Path
 	if namespace != "" {
 		root = filepath.Join(wt.Path, namespace)
@@ -212,6 +422,70 @@ func (s *Store) Delete(ctx context.Context, key persistence.Key) error {
 	return wt.CommitAndPush(ctx, msg)
 }
 
+// labelTrailer is the git trailer used to record SaveOptions.Labels in the
+// commit message, one trailer per label:
+//
+//	Radius-Label: env=prod
+const labelTrailer = "Radius-Label: "
+
+// formatCommitMessage appends labels to msg as git trailers, sorted by key so
+// that identical saves produce identical messages.
+func formatCommitMessage(msg string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return msg
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := &strings.Builder{}
+	b.WriteString(msg)
+	b.WriteString("\n\n")
+	for _, k := range keys {
+		fmt.Fprintf(b, "%s%s=%s\n", labelTrailer, k, labels[k])
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// parseCommitMessage is the inverse of formatCommitMessage. Labels are only
+// read from the trailer block, the last paragraph of the message, when every
+// line of it is a label trailer. Lines elsewhere in the message that look like
+// trailers are part of the message.
+func parseCommitMessage(full string) (string, map[string]string) {
+	msg := strings.TrimSpace(full)
+	body, block, ok := cutLastParagraph(msg)
+	if !ok {
+		return msg, nil
+	}
+
+	labels := map[string]string{}
+	for _, line := range strings.Split(block, "\n") {
+		rest, ok := strings.CutPrefix(line, labelTrailer)
+		if !ok {
+			return msg, nil
+		}
+		k, v, ok := strings.Cut(rest, "=")
+		if !ok {
+			return msg, nil
+		}
+		labels[k] = v
+	}
+	return strings.TrimSpace(body), labels
+}
+
+// cutLastParagraph splits msg before its last paragraph. It returns false if
+// msg has a single paragraph.
+func cutLastParagraph(msg string) (string, string, bool) {
+	i := strings.LastIndex(msg, "\n\n")
+	if i < 0 {
+		return "", "", false
+	}
+	return msg[:i], strings.TrimSpace(msg[i:]), true
+}
+
 // constructPathForKey returns the in-repo relative path used to store a
 // graph for key, after validating that Key.Namespace and Key.Name are safe
 // to embed in a path. The resulting path is always rooted under a single
@@ -259,5 +533,10 @@ func keyFromPath(rel string) persistence.Key {
 	return persistence.Key{Name: strings.TrimSuffix(rel, ".json")}
 }
 
-// Compile-time check that *Store satisfies persistence.Store.
-var _ persistence.Store = (*Store)(nil)
+// Compile-time checks that *Store satisfies persistence.Store,
+// persistence.Querier and persistence.Historian.
+var (
+	_ persistence.Store     = (*Store)(nil)
+	_ persistence.Querier   = (*Store)(nil)
+	_ persistence.Historian = (*Store)(nil)
+)
@@ -28,6 +28,7 @@ import (
 	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/graph/persistence"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/graph/graphstoretest"
 )
 
 func TestNewStore_DefaultsBranch(t *testing.T) {
@@ -222,6 +223,164 @@ func TestStore_ListRejectsInvalidNamespace(t *testing.T) {
 	}
 }
 
+func TestStore_HistoryLoadRevisionRestore(t *testing.T) {
+	repoDir := initTestRepo(t)
+	chdir(t, repoDir)
+
+	ctx := context.Background()
+	s, err := NewStore(Options{Branch: "store-" + t.Name()})
+	require.NoError(t, err)
+
+	key := persistence.Key{Namespace: "main", Name: "app"}
+	graphWithState := func(state string) *corerpv20250801preview.ApplicationGraphResponse {
+		return &corerpv20250801preview.ApplicationGraphResponse{
+			Resources: []*corerpv20250801preview.ApplicationGraphResource{
+				{
+					ID:                to.Ptr("resource-id"),
+					Name:              to.Ptr("frontend"),
+					Type:              to.Ptr("Applications.Core/containers"),
+					ProvisioningState: to.Ptr(state),
+				},
+			},
+		}
+	}
+
+	require.NoError(t, s.Save(ctx, key, graphWithState("Accepted"), persistence.SaveOptions{
+		Message: "first",
+		Labels:  map[string]string{"sha": "abc123", "env": "dev"},
+	}))
+	require.NoError(t, s.Save(ctx, key, graphWithState("Succeeded"), persistence.SaveOptions{}))
+
+	revisions, err := s.History(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "radius: update main/app.json", revisions[0].Message)
+	assert.Nil(t, revisions[0].Labels)
+	assert.Equal(t, "first", revisions[1].Message)
+	assert.Equal(t, map[string]string{"sha": "abc123", "env": "dev"}, revisions[1].Labels)
+	assert.False(t, revisions[0].Timestamp.IsZero())
+
+	old, err := s.LoadRevision(ctx, key, revisions[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Accepted", *old.Resources[0].ProvisioningState)
+
+	require.NoError(t, s.Restore(ctx, key, revisions[1].ID, persistence.SaveOptions{}))
+	got, err := s.Load(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "Accepted", *got.Resources[0].ProvisioningState)
+
+	require.NoError(t, s.Delete(ctx, key))
+	revisions, err = s.History(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+	assert.True(t, revisions[0].Deleted)
+	assert.Contains(t, revisions[1].Message, "radius: restore main/app.json to ")
+
+	_, err = s.LoadRevision(ctx, key, revisions[0].ID)
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+}
+
+func TestStore_HistoryMissingKeyReturnsErrNotFound(t *testing.T) {
+	repoDir := initTestRepo(t)
+	chdir(t, repoDir)
+
+	s, err := NewStore(Options{Branch: "store-" + t.Name()})
+	require.NoError(t, err)
+
+	_, err = s.History(context.Background(), persistence.Key{Namespace: "main", Name: "missing"})
+	assert.ErrorIs(t, err, persistence.ErrNotFound)
+}
+
+func TestStore_LoadRevisionRejectsInvalidRevision(t *testing.T) {
+	repoDir := initTestRepo(t)
+	chdir(t, repoDir)
+
+	ctx := context.Background()
+	s, err := NewStore(Options{Branch: "store-" + t.Name()})
+	require.NoError(t, err)
+
+	key := persistence.Key{Namespace: "main", Name: "app"}
+	require.NoError(t, s.Save(ctx, key, &corerpv20250801preview.ApplicationGraphResponse{}, persistence.SaveOptions{}))
+
+	for _, rev := range []string{"", "--output=/tmp/x", "HEAD:main/app.json", "HEAD..HEAD", "no such rev", "deadbeef"} {
+		_, err := s.LoadRevision(ctx, key, rev)
+		assert.Error(t, err, "revision %q", rev)
+	}
+}
+
+func TestCommitMessageLabelsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	msg := formatCommitMessage("save graph", map[string]string{"b": "2", "a": "x=y"})
+	assert.Equal(t, "save graph\n\nRadius-Label: a=x=y\nRadius-Label: b=2", msg)
+
+	got, labels := parseCommitMessage(msg + "\n")
+	assert.Equal(t, "save graph", got)
+	assert.Equal(t, map[string]string{"a": "x=y", "b": "2"}, labels)
+
+	got, labels = parseCommitMessage("plain\n")
+	assert.Equal(t, "plain", got)
+	assert.Nil(t, labels)
+
+	// Trailers are only read from the last paragraph.
+	body := "save graph\n\nRadius-Label: in=body\nmore text"
+	got, labels = parseCommitMessage(body)
+	assert.Equal(t, body, got)
+	assert.Nil(t, labels)
+
+	got, labels = parseCommitMessage("save graph\n\nRadius-Label: in=body\n\nRadius-Label: a=1")
+	assert.Equal(t, "save graph\n\nRadius-Label: in=body", got)
+	assert.Equal(t, map[string]string{"a": "1"}, labels)
+
+	// A last paragraph with other lines is not a trailer block.
+	mixed := "save graph\n\nRadius-Label: a=1\nSigned-off-by: someone"
+	got, labels = parseCommitMessage(mixed)
+	assert.Equal(t, mixed, got)
+	assert.Nil(t, labels)
+
+	// The subject is never a trailer.
+	got, labels = parseCommitMessage("Radius-Label: a=1")
+	assert.Equal(t, "Radius-Label: a=1", got)
+	assert.Nil(t, labels)
+}
+
+func TestStore_Conformance(t *testing.T) {
+	repoDir := initTestRepo(t)
+	chdir(t, repoDir)
+
+	s, err := NewStore(Options{Branch: "store-conformance"})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		keys, err := s.List(context.Background(), "")
+		require.NoError(t, err)
+		for _, key := range keys {
+			require.NoError(t, s.Delete(context.Background(), key))
+		}
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	graphstoretest.RunTest(t, s, clear)
+}
+
+func TestStore_QueryConformance(t *testing.T) {
+	repoDir := initTestRepo(t)
+	chdir(t, repoDir)
+
+	s, err := NewStore(Options{Branch: "store-query-conformance"})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		keys, err := s.List(context.Background(), "")
+		require.NoError(t, err)
+		for _, key := range keys {
+			require.NoError(t, s.Delete(context.Background(), key))
+		}
+	}
+
+	graphstoretest.RunQueryTest(t, s, clear)
+}
+
 func TestConstructPathForKey_RejectsEmptyNamespaceOrName(t *testing.T) {
 	t.Parallel()
 
@@ -31,12 +31,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/radius-project/radius/pkg/graph/persistence"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -139,6 +141,85 @@ func (w *StateWorktree) RemoveFile(relPath string) error {
 	return os.Remove(filepath.Join(w.Path, relPath))
 }
 
+// commitInfo describes a commit on the state branch that touched a file.
+type commitInfo struct {
+	SHA       string
+	Timestamp time.Time
+	Message   string
+	Deleted   bool
+}
+
+// Log returns the commits on the worktree's branch that touched relPath,
+// newest first. It returns persistence.ErrNotFound if the branch has no
+// commits yet.
+func (w *StateWorktree) Log(ctx context.Context, relPath string) ([]commitInfo, error) {
+	// git log fails on an orphan branch whose HEAD is unborn, which means
+	// that nothing was ever committed to the branch.
+	if err := gitExecIn(ctx, w.Path, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		return nil, persistence.ErrNotFound
+	}
+
+	// Fields are separated by US (0x1f) and records by RS (0x1e) because
+	// commit messages may contain any printable character.
+	out, err := gitOutputIn(ctx, w.Path, "log", "--format=%H%x1f%cI%x1f%B%x1e", "HEAD", "--", relPath)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := gitOutputIn(ctx, w.Path, "log", "--format=%H", "--diff-filter=D", "HEAD", "--", relPath)
+	if err != nil {
+		return nil, err
+	}
+	deletedSet := map[string]bool{}
+	for _, sha := range strings.Fields(string(deleted)) {
+		deletedSet[sha] = true
+	}
+
+	var commits []commitInfo
+	for _, record := range strings.Split(string(out), "\x1e") {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, "\x1f", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected git log record %q", record)
+		}
+		ts, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing commit time of %s: %w", fields[0], err)
+		}
+		commits = append(commits, commitInfo{
+			SHA:       fields[0],
+			Timestamp: ts,
+			Message:   strings.TrimRight(fields[2], "\n"),
+			Deleted:   deletedSet[fields[0]],
+		})
+	}
+	return commits, nil
+}
+
+// ReadFileAt returns the contents of relPath as of rev. A path that does not
+// exist at rev returns an error wrapping fs.ErrNotExist.
+func (w *StateWorktree) ReadFileAt(ctx context.Context, rev string, relPath string) ([]byte, error) {
+	// Reject anything git could interpret as an option or a revision range
+	// so that rev can only name a single commit.
+	if rev == "" || strings.HasPrefix(rev, "-") || strings.ContainsAny(rev, ": \t\n") || strings.Contains(rev, "..") {
+		return nil, fmt.Errorf("invalid revision %q", rev)
+	}
+
+	sha, err := gitOutputIn(ctx, w.Path, "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err != nil {
+		return nil, fmt.Errorf("unknown revision %q", rev)
+	}
+
+	spec := strings.TrimSpace(string(sha)) + ":" + relPath
+	if err := gitExecIn(ctx, w.Path, "cat-file", "-e", spec); err != nil {
+		return nil, fmt.Errorf("%s does not exist at %s: %w", relPath, rev, fs.ErrNotExist)
+	}
+	return gitOutputIn(ctx, w.Path, "show", spec)
+}
+
 // CommitAndPush stages all changes in the worktree, commits, and pushes.
 func (w *StateWorktree) CommitAndPush(ctx context.Context, msg string) error {
 	if err := w.commit(ctx, msg); err != nil {
@@ -297,6 +378,20 @@ func gitExecIn(ctx context.Context, dir string, args ...string) error {
 	return nil
 }
 
+// gitOutputIn runs a git command with its working directory set to dir and
+// returns its standard output.
+func gitOutputIn(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
 // hasGitIdentity reports whether both user.name and user.email are configured
 // (in any of: worktree, repo-local, global, system, or environment). When
 // either is missing, the caller should fall back to a bot identity so that
@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/graph/persistence"
 )
 
 // runGit executes a command (typically "git ...") in dir and asserts success.
@@ -201,6 +203,21 @@ func TestReadFileAndRemoveFile(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLog_UnbornBranchReturnsErrNotFound(t *testing.T) {
+	repoDir := initTestRepo(t)
+
+	// An orphan branch checked out without a commit has an unborn HEAD.
+	wtPath := filepath.Join(t.TempDir(), "wt")
+	runGit(t, repoDir, "git", "worktree", "add", "--detach", wtPath)
+	runGit(t, wtPath, "git", "checkout", "--orphan", "empty-"+t.Name())
+
+	wt := &StateWorktree{Path: wtPath, branchName: "empty-" + t.Name(), repoRoot: repoDir}
+	defer wt.Remove(context.Background())
+
+	_, err := wt.Log(context.Background(), "main/app.json")
+	require.ErrorIs(t, err, persistence.ErrNotFound)
+}
+
 func TestCommitAndPush_CommitsChanges(t *testing.T) {
 	repoDir := initTestRepo(t)
 	chdir(t, repoDir)
@@ -14,10 +14,28 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package graphdb is a placeholder for a future persistence.Store
-// implementation backed by a graph database (e.g. Neo4j, JanusGraph,
-// Azure Cosmos DB Gremlin).
+// Package graphdb provides a persistence.Store that keeps saved graphs as a
+// property graph in an embedded bbolt database.
 //
-// Implementations in this package must satisfy persistence.Store so that
-// callers can switch backends without code changes.
+// Unlike the git backend, which stores each ApplicationGraphResponse as an
+// opaque JSON document, this backend decomposes every saved graph into its
+// parts:
+//
+//   - each ApplicationGraphResource becomes a Node whose label is the
+//     resource type and whose properties carry the scalar fields,
+//   - each ApplicationGraphConnection becomes an Edge between two nodes,
+//   - SaveOptions.Labels are recorded on the graph and can be queried with
+//     FindGraphs and FindNodes.
+//
+// Nodes, edges and graph records are stored under keys prefixed by the graph
+// key, so Save and Delete only rewrite the records of the graph being
+// written. Graph labels and node labels are kept in index buckets that
+// FindGraphs and FindNodes read instead of scanning every graph.
+//
+// This package is composed of two layers:
+//
+//   - graph.go : the property graph (bucket layout, key encoding and the
+//     transactional operations on nodes, edges and indexes).
+//   - graphdb_store.go: Store implementation that maps persistence.Key values
+//     to sub-graphs of the property graph.
 package graphdb
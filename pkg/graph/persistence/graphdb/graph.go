@@ -0,0 +1,504 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/radius-project/radius/pkg/graph/persistence"
+)
+
+// schemaVersion is the version of the bucket layout. It is bumped whenever
+// the layout changes incompatibly.
+const schemaVersion = 1
+
+// openTimeout bounds how long opening a database waits for the file lock
+// held by another process.
+const openTimeout = 5 * time.Second
+
+// Well-known node property names.
+const (
+	// PropertyName is the node property holding the resource name.
+	PropertyName = "name"
+
+	// PropertyProvisioningState is the node property holding the
+	// provisioning state of the resource.
+	PropertyProvisioningState = "provisioningState"
+
+	// PropertyDiffHash is the node property holding the diff hash of the
+	// resource.
+	PropertyDiffHash = "diffHash"
+)
+
+// Bucket names. Keys of the graph, node and edge buckets start with the
+// encoded graph key (see graphKey), so the records of one graph, or of one
+// namespace, are contiguous and can be read or removed with a prefix scan.
+var (
+	// bucketMeta holds the schema version.
+	bucketMeta = []byte("meta")
+
+	// bucketGraphs maps graphKey to a graphRecord.
+	bucketGraphs = []byte("graphs")
+
+	// bucketNodes maps graphKey + NUL + resource ID to a Node.
+	bucketNodes = []byte("nodes")
+
+	// bucketEdges maps graphKey + NUL + 8-byte sequence number to an Edge.
+	bucketEdges = []byte("edges")
+
+	// bucketGraphLabels indexes graphs by label: label name + NUL + label
+	// value + NUL + graphKey.
+	bucketGraphLabels = []byte("graphLabels")
+
+	// bucketNodeLabels indexes nodes by lower-cased label: label + NUL +
+	// node key.
+	bucketNodeLabels = []byte("nodeLabels")
+
+	metaVersion = []byte("version")
+)
+
+// Node is a vertex of the property graph. Every ApplicationGraphResource
+// saved in the store is represented by exactly one Node.
+type Node struct {
+	// Graph is the key of the graph that owns the node.
+	Graph persistence.Key `json:"graph"`
+
+	// ID is the resource ID. It is unique within a graph.
+	ID string `json:"id"`
+
+	// Label is the node label. The store uses the resource type.
+	Label string `json:"label"`
+
+	// Properties holds the scalar properties of the node. A property that is
+	// absent from the map was nil on the saved resource.
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// OutputResources are the output resources that comprise the resource.
+	OutputResources []OutputResource `json:"outputResources,omitempty"`
+}
+
+// OutputResource is an output resource recorded on a Node.
+type OutputResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Edge is a directed edge of the property graph. Every
+// ApplicationGraphConnection saved in the store is represented by exactly
+// one Edge.
+type Edge struct {
+	// Graph is the key of the graph that owns the edge.
+	Graph persistence.Key `json:"graph"`
+
+	// From is the ID of the node the edge starts at. For an 'Outbound'
+	// connection this is the declaring resource; for an 'Inbound' connection
+	// it is the connected resource.
+	From string `json:"from"`
+
+	// To is the ID of the node the edge ends at.
+	To string `json:"to"`
+
+	// DeclaredBy is the ID of the resource whose connection list contains
+	// the connection.
+	DeclaredBy string `json:"declaredBy"`
+
+	// Direction is the connection direction as recorded on DeclaredBy.
+	Direction string `json:"direction"`
+}
+
+// graphRecord is the metadata stored for a single persistence.Key.
+type graphRecord struct {
+	Key     persistence.Key   `json:"key"`
+	Message string            `json:"message,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+
+	// Nodes holds the IDs of the graph's nodes in the order they were saved
+	// so that Load returns resources in their original order.
+	Nodes []string `json:"nodes"`
+}
+
+// propertyGraph is a property graph stored in a bbolt database. Nodes,
+// edges and graph metadata live in separate buckets, and secondary index
+// buckets map graph labels and node labels to the records that carry them.
+// Writing a graph only touches the records of that graph.
+//
+// bbolt serializes write transactions and gives read transactions a
+// consistent snapshot, so propertyGraph is safe for concurrent use.
+type propertyGraph struct {
+	db *bolt.DB
+
+	// tempDir is the directory created for a database without a path. It is
+	// removed by close.
+	tempDir string
+}
+
+// openPropertyGraph opens the property graph stored at path, creating it if
+// it does not exist. An empty path creates a database in a temporary
+// directory that is removed when the graph is closed.
+func openPropertyGraph(path string) (*propertyGraph, error) {
+	g := &propertyGraph{}
+	if path == "" {
+		dir, err := os.MkdirTemp("", "graphdb-")
+		if err != nil {
+			return nil, fmt.Errorf("graphdb: create temporary directory: %w", err)
+		}
+		g.tempDir = dir
+		path = filepath.Join(dir, "graph.db")
+	} else if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("graphdb: create %s: %w", filepath.Dir(path), err)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		g.removeTempDir()
+		return nil, fmt.Errorf("graphdb: open %s: %w", path, err)
+	}
+	g.db = db
+
+	if err := db.Update(initSchema); err != nil {
+		_ = g.close()
+		return nil, fmt.Errorf("graphdb: open %s: %w", path, err)
+	}
+	return g, nil
+}
+
+// initSchema creates the buckets of an empty database and checks the schema
+// version of an existing one.
+func initSchema(tx *bolt.Tx) error {
+	for _, name := range [][]byte{bucketMeta, bucketGraphs, bucketNodes, bucketEdges, bucketGraphLabels, bucketNodeLabels} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+
+	meta := tx.Bucket(bucketMeta)
+	current := []byte(strconv.Itoa(schemaVersion))
+	stored := meta.Get(metaVersion)
+	if stored == nil {
+		return meta.Put(metaVersion, current)
+	}
+	if !bytes.Equal(stored, current) {
+		return fmt.Errorf("unsupported schema version %s", stored)
+	}
+	return nil
+}
+
+// close closes the database and removes its temporary directory, if any.
+func (g *propertyGraph) close() error {
+	err := g.db.Close()
+	g.removeTempDir()
+	return err
+}
+
+func (g *propertyGraph) removeTempDir() {
+	if g.tempDir != "" {
+		_ = os.RemoveAll(g.tempDir)
+	}
+}
+
+// view runs fn in a read-only transaction.
+func (g *propertyGraph) view(fn func(t graphTx) error) error {
+	return g.db.View(func(tx *bolt.Tx) error {
+		return fn(graphTx{tx: tx})
+	})
+}
+
+// update runs fn in a read-write transaction. The transaction is rolled back
+// if fn returns an error.
+func (g *propertyGraph) update(fn func(t graphTx) error) error {
+	return g.db.Update(func(tx *bolt.Tx) error {
+		return fn(graphTx{tx: tx})
+	})
+}
+
+// graphTx exposes the property graph operations within a single
+// transaction.
+type graphTx struct {
+	tx *bolt.Tx
+}
+
+// replace swaps the sub-graph stored under record.Key for the supplied
+// nodes and edges.
+func (t graphTx) replace(record *graphRecord, nodes []*Node, edges []*Edge) error {
+	if _, err := t.drop(record.Key); err != nil {
+		return err
+	}
+
+	gk := graphKey(record.Key)
+	if err := putJSON(t.tx.Bucket(bucketGraphs), gk, record); err != nil {
+		return err
+	}
+	for name, value := range record.Labels {
+		if err := t.tx.Bucket(bucketGraphLabels).Put(graphLabelKey(name, value, gk), nil); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range nodes {
+		nk := nodeKey(gk, n.ID)
+		if err := putJSON(t.tx.Bucket(bucketNodes), nk, n); err != nil {
+			return err
+		}
+		if err := t.tx.Bucket(bucketNodeLabels).Put(nodeLabelKey(n.Label, nk), nil); err != nil {
+			return err
+		}
+	}
+
+	for i, e := range edges {
+		if err := putJSON(t.tx.Bucket(bucketEdges), edgeKey(gk, i), e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drop removes the sub-graph stored under key together with its index
+// entries. It reports whether the key existed.
+func (t graphTx) drop(key persistence.Key) (bool, error) {
+	record, err := t.record(key)
+	if err != nil || record == nil {
+		return false, err
+	}
+
+	gk := graphKey(key)
+	for name, value := range record.Labels {
+		if err := t.tx.Bucket(bucketGraphLabels).Delete(graphLabelKey(name, value, gk)); err != nil {
+			return false, err
+		}
+	}
+
+	nodes, err := t.nodes(record)
+	if err != nil {
+		return false, err
+	}
+	for _, n := range nodes {
+		nk := nodeKey(gk, n.ID)
+		if err := t.tx.Bucket(bucketNodeLabels).Delete(nodeLabelKey(n.Label, nk)); err != nil {
+			return false, err
+		}
+		if err := t.tx.Bucket(bucketNodes).Delete(nk); err != nil {
+			return false, err
+		}
+	}
+
+	if err := deletePrefix(t.tx.Bucket(bucketEdges), childPrefix(gk)); err != nil {
+		return false, err
+	}
+	return true, t.tx.Bucket(bucketGraphs).Delete(gk)
+}
+
+// record returns the metadata stored under key, or nil if the key does not
+// exist.
+func (t graphTx) record(key persistence.Key) (*graphRecord, error) {
+	return t.recordAt(graphKey(key))
+}
+
+func (t graphTx) recordAt(gk []byte) (*graphRecord, error) {
+	data := t.tx.Bucket(bucketGraphs).Get(gk)
+	if data == nil {
+		return nil, nil
+	}
+	record := &graphRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("graphdb: unmarshal graph %q: %w", gk, err)
+	}
+	return record, nil
+}
+
+// node returns the node with the given ID in graph key, or nil if there is
+// no such node.
+func (t graphTx) node(key persistence.Key, id string) (*Node, error) {
+	return t.nodeAt(nodeKey(graphKey(key), id))
+}
+
+func (t graphTx) nodeAt(nk []byte) (*Node, error) {
+	data := t.tx.Bucket(bucketNodes).Get(nk)
+	if data == nil {
+		return nil, nil
+	}
+	n := &Node{}
+	if err := json.Unmarshal(data, n); err != nil {
+		return nil, fmt.Errorf("graphdb: unmarshal node %q: %w", nk, err)
+	}
+	return n, nil
+}
+
+// nodes returns the nodes of record in the order they were saved.
+func (t graphTx) nodes(record *graphRecord) ([]*Node, error) {
+	nodes := make([]*Node, 0, len(record.Nodes))
+	for _, id := range record.Nodes {
+		n, err := t.node(record.Key, id)
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			return nil, fmt.Errorf("graphdb: graph %s/%s is missing node %q", record.Key.Namespace, record.Key.Name, id)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+// edges returns the edges of graph key in the order they were saved.
+func (t graphTx) edges(key persistence.Key) ([]*Edge, error) {
+	var edges []*Edge
+	err := scanPrefix(t.tx.Bucket(bucketEdges), childPrefix(graphKey(key)), func(_, v []byte) error {
+		e := &Edge{}
+		if err := json.Unmarshal(v, e); err != nil {
+			return fmt.Errorf("graphdb: unmarshal edge: %w", err)
+		}
+		edges = append(edges, e)
+		return nil
+	})
+	return edges, err
+}
+
+// records returns the metadata of every graph under namespace ordered by
+// key. An empty namespace returns every graph.
+func (t graphTx) records(namespace string) ([]*graphRecord, error) {
+	var prefix []byte
+	if namespace != "" {
+		prefix = childPrefix([]byte(namespace))
+	}
+
+	var records []*graphRecord
+	err := scanPrefix(t.tx.Bucket(bucketGraphs), prefix, func(_, v []byte) error {
+		record := &graphRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return fmt.Errorf("graphdb: unmarshal graph: %w", err)
+		}
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
+
+// recordsWithLabel returns the metadata of every graph saved with the label
+// name=value, ordered by key. It reads the graph label index rather than
+// every graph.
+func (t graphTx) recordsWithLabel(name, value string) ([]*graphRecord, error) {
+	prefix := graphLabelKey(name, value, nil)
+
+	var records []*graphRecord
+	err := scanPrefix(t.tx.Bucket(bucketGraphLabels), prefix, func(k, _ []byte) error {
+		record, err := t.recordAt(k[len(prefix):])
+		if err != nil {
+			return err
+		}
+		// Label names and values may contain NUL, so the prefix can match
+		// entries of a different label. Those are filtered by the caller,
+		// which checks the record's labels.
+		if record != nil {
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// nodesWithLabel returns the nodes whose label equals label, ignoring case,
+// grouped by graph key. It reads the node label index rather than every
+// node.
+func (t graphTx) nodesWithLabel(label string) (map[persistence.Key]map[string]*Node, error) {
+	prefix := nodeLabelKey(label, nil)
+
+	result := map[persistence.Key]map[string]*Node{}
+	err := scanPrefix(t.tx.Bucket(bucketNodeLabels), prefix, func(k, _ []byte) error {
+		n, err := t.nodeAt(k[len(prefix):])
+		if err != nil {
+			return err
+		}
+		if n == nil || !strings.EqualFold(n.Label, label) {
+			return nil
+		}
+		if result[n.Graph] == nil {
+			result[n.Graph] = map[string]*Node{}
+		}
+		result[n.Graph][n.ID] = n
+		return nil
+	})
+	return result, err
+}
+
+// graphKey encodes key as namespace + NUL + name. Key parts cannot contain
+// NUL, so the encoding is unambiguous and sorts by namespace, then name.
+func graphKey(key persistence.Key) []byte {
+	return []byte(key.Namespace + "\x00" + key.Name)
+}
+
+// childPrefix returns the prefix shared by every key nested under parent.
+func childPrefix(parent []byte) []byte {
+	return append(append([]byte{}, parent...), 0)
+}
+
+func nodeKey(gk []byte, id string) []byte {
+	return append(childPrefix(gk), id...)
+}
+
+func edgeKey(gk []byte, seq int) []byte {
+	return binary.BigEndian.AppendUint64(childPrefix(gk), uint64(seq))
+}
+
+func graphLabelKey(name, value string, gk []byte) []byte {
+	return append([]byte(name+"\x00"+value+"\x00"), gk...)
+}
+
+func nodeLabelKey(label string, nk []byte) []byte {
+	return append([]byte(strings.ToLower(label)+"\x00"), nk...)
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("graphdb: marshal %q: %w", key, err)
+	}
+	return b.Put(key, data)
+}
+
+// scanPrefix calls fn for every key/value pair of b whose key starts with
+// prefix, in key order. A nil prefix scans the whole bucket.
+func scanPrefix(b *bolt.Bucket, prefix []byte, fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletePrefix removes every key of b that starts with prefix.
+func deletePrefix(b *bolt.Bucket, prefix []byte) error {
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Seek(prefix) {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,502 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// Options configures a Store.
+type Options struct {
+	// Path is the bbolt database file the property graph is stored in. It
+	// is created if it does not exist. If empty, the Store uses a database
+	// in a temporary directory that is removed by Close.
+	Path string
+}
+
+// NodeQuery selects nodes for Store.FindNodes. Zero-valued fields match
+// every node.
+type NodeQuery struct {
+	// Namespace restricts the query to graphs in the namespace.
+	Namespace string
+
+	// Label restricts the query to nodes with the label (resource type).
+	// The comparison is case-insensitive.
+	Label string
+
+	// Properties restricts the query to nodes whose properties contain every
+	// key/value pair.
+	Properties map[string]string
+
+	// GraphLabels restricts the query to nodes of graphs that were saved with
+	// every key/value pair in SaveOptions.Labels.
+	GraphLabels map[string]string
+}
+
+// Store is a persistence.Store backed by an embedded property graph. Each
+// saved graph is decomposed into nodes and edges rather than stored as a
+// single document, so that resources can be queried across graphs.
+//
+// Concurrency: bbolt serializes writes and isolates reads, so Store is safe
+// for concurrent use. A database file can be opened by one Store at a time.
+type Store struct {
+	graph *propertyGraph
+}
+
+// NewStore opens the property graph at opts.Path and returns a Store backed
+// by it. Callers must Close the Store to release the database file.
+func NewStore(opts Options) (*Store, error) {
+	g, err := openPropertyGraph(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{graph: g}, nil
+}
+
+// Close releases the database file.
+func (s *Store) Close() error {
+	return s.graph.close()
+}
+
+// Save replaces the sub-graph stored under key with the nodes and edges of
+// graph. Only the records of key are rewritten.
+func (s *Store) Save(ctx context.Context, key persistence.Key, graph *corerpv20250801preview.ApplicationGraphResponse, opts persistence.SaveOptions) error {
+	if graph == nil {
+		return errors.New("graphdb: nil graph")
+	}
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	record, nodes, edges, err := decompose(key, graph, opts)
+	if err != nil {
+		return err
+	}
+
+	return s.graph.update(func(t graphTx) error {
+		return t.replace(record, nodes, edges)
+	})
+}
+
+// Load reassembles the graph previously stored under key, or returns
+// persistence.ErrNotFound.
+func (s *Store) Load(ctx context.Context, key persistence.Key) (*corerpv20250801preview.ApplicationGraphResponse, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	var response *corerpv20250801preview.ApplicationGraphResponse
+	err := s.graph.view(func(t graphTx) error {
+		record, err := t.record(key)
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			return persistence.ErrNotFound
+		}
+		nodes, err := t.nodes(record)
+		if err != nil {
+			return err
+		}
+		edges, err := t.edges(key)
+		if err != nil {
+			return err
+		}
+		response = assemble(nodes, edges)
+		return nil
+	})
+	return response, err
+}
+
+// List returns the keys stored under namespace. An empty namespace lists
+// every key.
+func (s *Store) List(ctx context.Context, namespace string) ([]persistence.Key, error) {
+	return s.FindGraphs(ctx, namespace, nil)
+}
+
+// Delete removes the nodes and edges stored under key.
+func (s *Store) Delete(ctx context.Context, key persistence.Key) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	return s.graph.update(func(t graphTx) error {
+		found, err := t.drop(key)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return persistence.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// FindGraphs returns the keys under namespace whose SaveOptions.Labels
+// contain every key/value pair in labels. An empty namespace searches every
+// namespace and a nil labels map matches every graph. When labels is not
+// empty, candidates are read from the graph label index.
+func (s *Store) FindGraphs(ctx context.Context, namespace string, labels map[string]string) ([]persistence.Key, error) {
+	if namespace != "" {
+		if err := validateKeyPart("namespace", namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys []persistence.Key
+	err := s.graph.view(func(t graphTx) error {
+		records, err := candidateRecords(t, namespace, labels)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			keys = append(keys, record.Key)
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// FindNodes returns the nodes that match q, ordered by graph key and then by
+// their position in the saved graph. When q.Label is set, candidates are
+// read from the node label index.
+func (s *Store) FindNodes(ctx context.Context, q NodeQuery) ([]Node, error) {
+	if q.Namespace != "" {
+		if err := validateKeyPart("namespace", q.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []Node
+	err := s.graph.view(func(t graphTx) error {
+		var labeled map[persistence.Key]map[string]*Node
+		if q.Label != "" {
+			var err error
+			if labeled, err = t.nodesWithLabel(q.Label); err != nil {
+				return err
+			}
+			if len(labeled) == 0 {
+				return nil
+			}
+		}
+
+		records, err := candidateRecords(t, q.Namespace, q.GraphLabels)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			for _, id := range record.Nodes {
+				var n *Node
+				if labeled != nil {
+					if n = labeled[record.Key][id]; n == nil {
+						continue
+					}
+				} else if n, err = t.node(record.Key, id); err != nil {
+					return err
+				}
+				if n == nil || !containsAll(n.Properties, q.Properties) {
+					continue
+				}
+				result = append(result, *n)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// Neighbors returns the resources one edge away from resourceID across every
+// graph stored under namespace.
+func (s *Store) Neighbors(ctx context.Context, namespace string, resourceID string, direction persistence.TraversalDirection) ([]persistence.ResourceRef, error) {
+	index, err := s.index(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.Neighbors(resourceID, direction)
+}
+
+// Reachable returns every resource reachable from resourceID across every
+// graph stored under namespace.
+func (s *Store) Reachable(ctx context.Context, namespace string, resourceID string, direction persistence.TraversalDirection) ([]persistence.ResourceRef, error) {
+	index, err := s.index(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.Reachable(resourceID, direction)
+}
+
+// BlastRadius returns the resources affected by deleting resourceID across
+// every graph stored under namespace.
+func (s *Store) BlastRadius(ctx context.Context, namespace string, resourceID string) (*persistence.BlastRadius, error) {
+	index, err := s.index(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return index.BlastRadius(resourceID)
+}
+
+// index builds a persistence.Index directly from the node and edge buckets
+// of every graph stored under namespace.
+func (s *Store) index(namespace string) (*persistence.Index, error) {
+	if namespace != "" {
+		if err := validateKeyPart("namespace", namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	index := persistence.NewIndex()
+	err := s.graph.view(func(t graphTx) error {
+		records, err := t.records(namespace)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			nodes, err := t.nodes(record)
+			if err != nil {
+				return err
+			}
+			for _, n := range nodes {
+				outputs := make([]string, 0, len(n.OutputResources))
+				for _, or := range n.OutputResources {
+					outputs = append(outputs, or.ID)
+				}
+				index.AddResource(record.Key, n.ID, n.Properties[PropertyName], n.Label, outputs)
+			}
+			edges, err := t.edges(record.Key)
+			if err != nil {
+				return err
+			}
+			for _, e := range edges {
+				index.AddEdge(e.From, e.To)
+			}
+			if environmentID := record.Labels[persistence.EnvironmentLabel]; environmentID != "" {
+				index.AddEnvironment(record.Key, environmentID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// candidateRecords returns the records of the graphs under namespace whose
+// labels contain every pair in labels, ordered by key. With no labels it
+// scans the namespace; otherwise it starts from the graph label index entry
+// of the smallest label name.
+func candidateRecords(t graphTx, namespace string, labels map[string]string) ([]*graphRecord, error) {
+	if len(labels) == 0 {
+		return t.records(namespace)
+	}
+
+	name := slices.Min(slices.Collect(maps.Keys(labels)))
+	indexed, err := t.recordsWithLabel(name, labels[name])
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*graphRecord
+	for _, record := range indexed {
+		if namespace != "" && record.Key.Namespace != namespace {
+			continue
+		}
+		if !containsAll(record.Labels, labels) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// decompose converts graph into the graph record, nodes and edges stored
+// under key.
+func decompose(key persistence.Key, graph *corerpv20250801preview.ApplicationGraphResponse, opts persistence.SaveOptions) (*graphRecord, []*Node, []*Edge, error) {
+	record := &graphRecord{
+		Key:     key,
+		Message: opts.Message,
+		Labels:  maps.Clone(opts.Labels),
+		Nodes:   make([]string, 0, len(graph.Resources)),
+	}
+
+	seen := map[string]bool{}
+	nodes := make([]*Node, 0, len(graph.Resources))
+	var edges []*Edge
+	for i, resource := range graph.Resources {
+		if resource == nil {
+			return nil, nil, nil, fmt.Errorf("graphdb: resource at index %d is nil", i)
+		}
+		id := to.String(resource.ID)
+		if id == "" {
+			return nil, nil, nil, fmt.Errorf("graphdb: resource at index %d has no ID", i)
+		}
+		if seen[id] {
+			return nil, nil, nil, fmt.Errorf("graphdb: duplicate resource ID %q", id)
+		}
+		seen[id] = true
+
+		n := &Node{
+			Graph:      key,
+			ID:         id,
+			Label:      to.String(resource.Type),
+			Properties: map[string]string{},
+		}
+		setProperty(n.Properties, PropertyName, resource.Name)
+		setProperty(n.Properties, PropertyProvisioningState, resource.ProvisioningState)
+		setProperty(n.Properties, PropertyDiffHash, resource.DiffHash)
+		for _, or := range resource.OutputResources {
+			if or == nil {
+				continue
+			}
+			n.OutputResources = append(n.OutputResources, OutputResource{
+				ID:   to.String(or.ID),
+				Name: to.String(or.Name),
+				Type: to.String(or.Type),
+			})
+		}
+		nodes = append(nodes, n)
+		record.Nodes = append(record.Nodes, id)
+
+		for _, conn := range resource.Connections {
+			if conn == nil {
+				continue
+			}
+			e := &Edge{
+				Graph:      key,
+				From:       id,
+				To:         to.String(conn.ID),
+				DeclaredBy: id,
+			}
+			if conn.Direction != nil {
+				e.Direction = string(*conn.Direction)
+			}
+			if e.Direction == string(corerpv20250801preview.DirectionInbound) {
+				e.From, e.To = e.To, e.From
+			}
+			edges = append(edges, e)
+		}
+	}
+	return record, nodes, edges, nil
+}
+
+// assemble is the inverse of decompose. Connections and OutputResources are
+// required by the API, so they are always returned as non-nil slices.
+func assemble(nodes []*Node, edges []*Edge) *corerpv20250801preview.ApplicationGraphResponse {
+	connections := map[string][]*corerpv20250801preview.ApplicationGraphConnection{}
+	for _, e := range edges {
+		target := e.To
+		if e.DeclaredBy == e.To {
+			target = e.From
+		}
+		conn := &corerpv20250801preview.ApplicationGraphConnection{ID: to.Ptr(target)}
+		if e.Direction != "" {
+			conn.Direction = to.Ptr(corerpv20250801preview.Direction(e.Direction))
+		}
+		connections[e.DeclaredBy] = append(connections[e.DeclaredBy], conn)
+	}
+
+	response := &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: make([]*corerpv20250801preview.ApplicationGraphResource, 0, len(nodes)),
+	}
+	for _, n := range nodes {
+		resource := &corerpv20250801preview.ApplicationGraphResource{
+			ID:                to.Ptr(n.ID),
+			Type:              to.Ptr(n.Label),
+			Name:              getProperty(n.Properties, PropertyName),
+			ProvisioningState: getProperty(n.Properties, PropertyProvisioningState),
+			DiffHash:          getProperty(n.Properties, PropertyDiffHash),
+			Connections:       connections[n.ID],
+			OutputResources:   []*corerpv20250801preview.ApplicationGraphOutputResource{},
+		}
+		if resource.Connections == nil {
+			resource.Connections = []*corerpv20250801preview.ApplicationGraphConnection{}
+		}
+		for _, or := range n.OutputResources {
+			resource.OutputResources = append(resource.OutputResources, &corerpv20250801preview.ApplicationGraphOutputResource{
+				ID:   to.Ptr(or.ID),
+				Name: to.Ptr(or.Name),
+				Type: to.Ptr(or.Type),
+			})
+		}
+		response.Resources = append(response.Resources, resource)
+	}
+	return response
+}
+
+func setProperty(properties map[string]string, name string, value *string) {
+	if value != nil {
+		properties[name] = *value
+	}
+}
+
+func getProperty(properties map[string]string, name string) *string {
+	if value, ok := properties[name]; ok {
+		return to.Ptr(value)
+	}
+	return nil
+}
+
+// containsAll reports whether have contains every key/value pair in want.
+func containsAll(have, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := have[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// validateKey validates both parts of key.
+func validateKey(key persistence.Key) error {
+	if err := validateKeyPart("namespace", key.Namespace); err != nil {
+		return err
+	}
+	return validateKeyPart("name", key.Name)
+}
+
+// validateKeyPart applies the same rules as the git backend so that keys are
+// portable between backends: values must be non-empty, must not be "." or
+// "..", and must not contain path separators or NUL bytes.
+func validateKeyPart(field, value string) error {
+	switch {
+	case value == "":
+		return fmt.Errorf("graphdb: key %s must not be empty", field)
+	case value == "." || value == "..":
+		return fmt.Errorf("graphdb: key %s must not be %q", field, value)
+	case strings.ContainsAny(value, `/\`):
+		return fmt.Errorf("graphdb: key %s must not contain path separators", field)
+	case strings.Contains(value, "\x00"):
+		return fmt.Errorf("graphdb: key %s must not contain NUL bytes", field)
+	}
+	return nil
+}
+
+// Compile-time checks that *Store satisfies persistence.Store and
+// persistence.Querier.
+var (
+	_ persistence.Store   = (*Store)(nil)
+	_ persistence.Querier = (*Store)(nil)
+)
@@ -0,0 +1,289 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/test/graph/graphstoretest"
+)
+
+func newClearFunc(s *Store) func(t *testing.T) {
+	return func(t *testing.T) {
+		keys, err := s.List(context.Background(), "")
+		require.NoError(t, err)
+		for _, key := range keys {
+			require.NoError(t, s.Delete(context.Background(), key))
+		}
+	}
+}
+
+func newTestStore(t *testing.T, opts Options) *Store {
+	s, err := NewStore(opts)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s
+}
+
+func TestStore_Conformance_TempDir(t *testing.T) {
+	s := newTestStore(t, Options{})
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	graphstoretest.RunTest(t, s, newClearFunc(s))
+}
+
+func TestStore_Conformance_File(t *testing.T) {
+	s := newTestStore(t, Options{Path: filepath.Join(t.TempDir(), "graph.db")})
+
+	graphstoretest.RunTest(t, s, newClearFunc(s))
+}
+
+func TestStore_QueryConformance(t *testing.T) {
+	s := newTestStore(t, Options{})
+
+	graphstoretest.RunQueryTest(t, s, newClearFunc(s))
+}
+
+func TestStore_PersistsAcrossReopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "nested", "graph.db")
+	key := persistence.Key{Namespace: "main", Name: "app"}
+
+	s, err := NewStore(Options{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, s.Save(ctx, key, graphstoretest.NewTestGraph(), persistence.SaveOptions{Labels: map[string]string{"env": "prod"}}))
+	require.NoError(t, s.Close())
+
+	reopened := newTestStore(t, Options{Path: path})
+
+	got, err := reopened.Load(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, graphstoretest.NewTestGraph(), got)
+
+	keys, err := reopened.FindGraphs(ctx, "", map[string]string{"env": "prod"})
+	require.NoError(t, err)
+	require.Equal(t, []persistence.Key{key}, keys)
+}
+
+func TestNewStore_RejectsCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.db")
+	require.NoError(t, os.WriteFile(path, []byte("not a database"), 0o644))
+
+	_, err := NewStore(Options{Path: path})
+	require.Error(t, err)
+}
+
+func TestNewStore_RejectsUnknownSchemaVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.db")
+	db, err := bolt.Open(path, 0o600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket(bucketMeta)
+		if err != nil {
+			return err
+		}
+		return b.Put(metaVersion, []byte("99"))
+	}))
+	require.NoError(t, db.Close())
+
+	_, err = NewStore(Options{Path: path})
+	require.ErrorContains(t, err, "unsupported schema version")
+}
+
+func TestStore_Close_RemovesTempDir(t *testing.T) {
+	s, err := NewStore(Options{})
+	require.NoError(t, err)
+	dir := s.graph.tempDir
+	require.DirExists(t, dir)
+
+	require.NoError(t, s.Close())
+	require.NoDirExists(t, dir)
+}
+
+func TestStore_Save_StoresConnectionsAsEdges(t *testing.T) {
+	s := newTestStore(t, Options{})
+
+	key := persistence.Key{Namespace: "main", Name: "app"}
+	require.NoError(t, s.Save(context.Background(), key, graphstoretest.NewTestGraph(), persistence.SaveOptions{}))
+
+	var edges []*Edge
+	require.NoError(t, s.graph.view(func(t graphTx) error {
+		var err error
+		edges, err = t.edges(key)
+		return err
+	}))
+
+	// Inbound connections are stored with the connected resource as the
+	// source so that every edge follows the data flow.
+	require.Equal(t, []*Edge{
+		{Graph: key, From: graphstoretest.FrontendID, To: graphstoretest.BackendID, DeclaredBy: graphstoretest.FrontendID, Direction: "Outbound"},
+		{Graph: key, From: graphstoretest.BackendID, To: graphstoretest.DatabaseID, DeclaredBy: graphstoretest.BackendID, Direction: "Outbound"},
+		{Graph: key, From: graphstoretest.FrontendID, To: graphstoretest.BackendID, DeclaredBy: graphstoretest.BackendID, Direction: "Inbound"},
+		{Graph: key, From: graphstoretest.BackendID, To: graphstoretest.DatabaseID, DeclaredBy: graphstoretest.DatabaseID, Direction: "Inbound"},
+	}, edges)
+}
+
+func TestStore_Save_ReplacesIndexEntries(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, Options{})
+
+	key := persistence.Key{Namespace: "main", Name: "app"}
+	require.NoError(t, s.Save(ctx, key, graphstoretest.NewTestGraph(), persistence.SaveOptions{Labels: map[string]string{"env": "prod"}}))
+	require.NoError(t, s.Save(ctx, key, &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: graphstoretest.NewTestGraph().Resources[:1],
+	}, persistence.SaveOptions{Labels: map[string]string{"env": "dev"}}))
+
+	keys, err := s.FindGraphs(ctx, "", map[string]string{"env": "prod"})
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	nodes, err := s.FindNodes(ctx, NodeQuery{Label: "applications.datastores/rediscaches"})
+	require.NoError(t, err)
+	require.Empty(t, nodes)
+
+	require.NoError(t, s.Delete(ctx, key))
+	require.NoError(t, s.graph.view(func(t graphTx) error {
+		for _, name := range [][]byte{bucketGraphs, bucketNodes, bucketEdges, bucketGraphLabels, bucketNodeLabels} {
+			if k, _ := t.tx.Bucket(name).Cursor().First(); k != nil {
+				return fmt.Errorf("bucket %s is not empty", name)
+			}
+		}
+		return nil
+	}))
+}
+
+func TestStore_Save_RejectsInvalidResources(t *testing.T) {
+	s := newTestStore(t, Options{})
+
+	key := persistence.Key{Namespace: "main", Name: "app"}
+
+	tests := []struct {
+		name  string
+		graph *corerpv20250801preview.ApplicationGraphResponse
+	}{
+		{
+			name: "nil resource",
+			graph: &corerpv20250801preview.ApplicationGraphResponse{
+				Resources: []*corerpv20250801preview.ApplicationGraphResource{nil},
+			},
+		},
+		{
+			name: "missing ID",
+			graph: &corerpv20250801preview.ApplicationGraphResponse{
+				Resources: []*corerpv20250801preview.ApplicationGraphResource{{}},
+			},
+		},
+		{
+			name: "duplicate ID",
+			graph: &corerpv20250801preview.ApplicationGraphResponse{
+				Resources: append(graphstoretest.NewTestGraph().Resources, graphstoretest.NewTestGraph().Resources[0]),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.Save(context.Background(), key, tc.graph, persistence.SaveOptions{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestStore_FindGraphs(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, Options{})
+
+	require.NoError(t, s.Save(ctx, persistence.Key{Namespace: "main", Name: "app"}, graphstoretest.NewTestGraph(), persistence.SaveOptions{
+		Labels: map[string]string{"env": "prod", "team": "a"},
+	}))
+	require.NoError(t, s.Save(ctx, persistence.Key{Namespace: "main", Name: "other"}, graphstoretest.NewTestGraph(), persistence.SaveOptions{
+		Labels: map[string]string{"env": "dev", "team": "a"},
+	}))
+	require.NoError(t, s.Save(ctx, persistence.Key{Namespace: "feature", Name: "app"}, graphstoretest.NewTestGraph(), persistence.SaveOptions{
+		Labels: map[string]string{"env": "prod", "team": "b"},
+	}))
+
+	keys, err := s.FindGraphs(ctx, "", map[string]string{"env": "prod"})
+	require.NoError(t, err)
+	require.Equal(t, []persistence.Key{{Namespace: "feature", Name: "app"}, {Namespace: "main", Name: "app"}}, keys)
+
+	keys, err = s.FindGraphs(ctx, "main", map[string]string{"team": "a"})
+	require.NoError(t, err)
+	require.Equal(t, []persistence.Key{{Namespace: "main", Name: "app"}, {Namespace: "main", Name: "other"}}, keys)
+
+	keys, err = s.FindGraphs(ctx, "", map[string]string{"env": "staging"})
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestStore_FindNodes(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, Options{})
+
+	require.NoError(t, s.Save(ctx, persistence.Key{Namespace: "main", Name: "app"}, graphstoretest.NewTestGraph(), persistence.SaveOptions{
+		Labels: map[string]string{"env": "prod"},
+	}))
+	require.NoError(t, s.Save(ctx, persistence.Key{Namespace: "feature", Name: "app"}, graphstoretest.NewTestGraph(), persistence.SaveOptions{
+		Labels: map[string]string{"env": "dev"},
+	}))
+
+	t.Run("by label", func(t *testing.T) {
+		nodes, err := s.FindNodes(ctx, NodeQuery{Label: "applications.datastores/rediscaches"})
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		for _, n := range nodes {
+			require.Equal(t, graphstoretest.DatabaseID, n.ID)
+		}
+	})
+
+	t.Run("by property and graph label", func(t *testing.T) {
+		nodes, err := s.FindNodes(ctx, NodeQuery{
+			Properties:  map[string]string{PropertyName: "frontend"},
+			GraphLabels: map[string]string{"env": "prod"},
+		})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		require.Equal(t, persistence.Key{Namespace: "main", Name: "app"}, nodes[0].Graph)
+		require.Equal(t, graphstoretest.FrontendID, nodes[0].ID)
+		require.Len(t, nodes[0].OutputResources, 1)
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		nodes, err := s.FindNodes(ctx, NodeQuery{Namespace: "main", Properties: map[string]string{PropertyName: "db"}})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		nodes[0].Properties[PropertyName] = "changed"
+
+		again, err := s.FindNodes(ctx, NodeQuery{Namespace: "main", Properties: map[string]string{PropertyName: "db"}})
+		require.NoError(t, err)
+		require.Len(t, again, 1)
+	})
+
+	t.Run("rejects invalid namespace", func(t *testing.T) {
+		_, err := s.FindNodes(ctx, NodeQuery{Namespace: "a/b"})
+		require.Error(t, err)
+	})
+}
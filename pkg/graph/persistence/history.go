@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+// Revision describes a single change to a persisted graph.
+type Revision struct {
+	// ID identifies the revision (e.g. a git commit SHA). It can be passed to
+	// Historian.LoadRevision and Historian.Restore.
+	ID string
+
+	// Message is the SaveOptions.Message the change was saved with.
+	Message string
+
+	// Labels are the SaveOptions.Labels the change was saved with.
+	Labels map[string]string
+
+	// Timestamp is the time the change was saved.
+	Timestamp time.Time
+
+	// Deleted is true when the revision removed the graph.
+	Deleted bool
+}
+
+// Historian is implemented by Stores that keep every saved version of a
+// graph.
+//
+//go:generate go tool mockgen -typed -destination=./mock_historian.go -package=persistence -self_package github.com/radius-project/radius/pkg/graph/persistence github.com/radius-project/radius/pkg/graph/persistence Historian
+type Historian interface {
+	// History returns the revisions of the graph stored under key, newest
+	// first, or ErrNotFound if the key was never saved.
+	History(ctx context.Context, key Key) ([]Revision, error)
+
+	// LoadRevision returns the graph stored under key as of revision, or
+	// ErrNotFound if the graph did not exist at that revision.
+	LoadRevision(ctx context.Context, key Key, revision string) (*corerpv20250801preview.ApplicationGraphResponse, error)
+
+	// Restore saves the graph stored under key as of revision as the latest
+	// version of key.
+	Restore(ctx context.Context, key Key, revision string, opts SaveOptions) error
+}
+
+// RevisionAt returns the newest revision in revisions, which must be ordered
+// newest first, that was saved at or before t. It returns false when every
+// revision is newer than t.
+func RevisionAt(revisions []Revision, t time.Time) (Revision, bool) {
+	for _, r := range revisions {
+		if !r.Timestamp.After(t) {
+			return r, true
+		}
+	}
+	return Revision{}, false
+}
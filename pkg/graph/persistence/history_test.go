@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisionAt(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	revisions := []Revision{
+		{ID: "c", Timestamp: base.Add(2 * time.Hour)},
+		{ID: "b", Timestamp: base.Add(time.Hour)},
+		{ID: "a", Timestamp: base},
+	}
+
+	got, ok := RevisionAt(revisions, base.Add(90*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, "b", got.ID)
+
+	got, ok = RevisionAt(revisions, base.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, "b", got.ID)
+
+	got, ok = RevisionAt(revisions, base.Add(24*time.Hour))
+	require.True(t, ok)
+	require.Equal(t, "c", got.ID)
+
+	_, ok = RevisionAt(revisions, base.Add(-time.Second))
+	require.False(t, ok)
+
+	_, ok = RevisionAt(nil, base)
+	require.False(t, ok)
+}
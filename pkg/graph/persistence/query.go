@@ -0,0 +1,354 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// EnvironmentLabel is the SaveOptions label holding the resource ID of the
+// environment a graph is deployed to. Queries treat the environment as a
+// resource of the graph that every other resource of the graph depends on, so
+// the blast radius of an environment covers the graphs deployed to it.
+// `rad app graph` sets it from the environment of the modeled application.
+const EnvironmentLabel = "environment"
+
+// TraversalDirection selects which edges a graph query follows.
+//
+// Edges follow the data flow of ApplicationGraphConnection: an 'Outbound'
+// connection from A to B is the edge A -> B, meaning A depends on B.
+type TraversalDirection string
+
+const (
+	// Downstream follows edges forward, from a resource to the resources it
+	// depends on.
+	Downstream TraversalDirection = "Downstream"
+
+	// Upstream follows edges backward, from a resource to the resources that
+	// depend on it.
+	Upstream TraversalDirection = "Upstream"
+)
+
+// ResourceRef describes a resource returned by a graph query.
+type ResourceRef struct {
+	// ID is the resource ID as it was first seen in the stored graphs.
+	ID string
+
+	// Name is the resource name.
+	Name string
+
+	// Type is the resource type.
+	Type string
+
+	// Depth is the number of edges between the queried resource and this
+	// resource. Neighbors always have a depth of 1.
+	Depth int
+
+	// Graphs holds the keys of every stored graph that contains the resource.
+	Graphs []Key
+}
+
+// BlastRadius describes the resources affected by deleting a resource.
+type BlastRadius struct {
+	// Root is the resource being deleted.
+	Root ResourceRef
+
+	// Affected holds every resource that depends on Root, directly or
+	// transitively, ordered by depth and then by ID.
+	Affected []ResourceRef
+
+	// OutputResources holds the IDs of the output resources of Root and of
+	// every affected resource.
+	OutputResources []string
+
+	// Graphs holds the keys of every stored graph that contains Root or an
+	// affected resource.
+	Graphs []Key
+}
+
+// Querier answers dependency queries across every graph stored in a
+// namespace. A resource that appears in several graphs is treated as a
+// single vertex; resource IDs are compared case-insensitively.
+//
+// Querying a resource that does not appear in any graph must return
+// ErrNotFound. A resource that is only the target of connections does not
+// appear in a graph.
+//
+//go:generate go tool mockgen -typed -destination=./mock_querier.go -package=persistence -self_package github.com/radius-project/radius/pkg/graph/persistence github.com/radius-project/radius/pkg/graph/persistence Querier
+type Querier interface {
+	// Neighbors returns the resources one edge away from resourceID in the
+	// given direction.
+	Neighbors(ctx context.Context, namespace string, resourceID string, direction TraversalDirection) ([]ResourceRef, error)
+
+	// Reachable returns every resource reachable from resourceID in the given
+	// direction, excluding resourceID itself. Downstream answers "what does X
+	// depend on"; Upstream answers "what depends on X" and "which resources
+	// reach X".
+	Reachable(ctx context.Context, namespace string, resourceID string, direction TraversalDirection) ([]ResourceRef, error)
+
+	// BlastRadius returns the resources affected by deleting resourceID.
+	BlastRadius(ctx context.Context, namespace string, resourceID string) (*BlastRadius, error)
+}
+
+// Index is an in-memory dependency index over a set of graphs. Store
+// implementations that cannot answer queries natively load the graphs of a
+// namespace into an Index and delegate to it.
+//
+// An Index is not safe for concurrent mutation.
+type Index struct {
+	vertices map[string]*indexVertex
+	forward  map[string]map[string]bool
+	backward map[string]map[string]bool
+}
+
+type indexVertex struct {
+	ref     ResourceRef
+	outputs []string
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{
+		vertices: map[string]*indexVertex{},
+		forward:  map[string]map[string]bool{},
+		backward: map[string]map[string]bool{},
+	}
+}
+
+// AddGraph adds the resources and connections of graph, saved under key, to
+// the index.
+func (x *Index) AddGraph(key Key, graph *corerpv20250801preview.ApplicationGraphResponse) {
+	if graph == nil {
+		return
+	}
+	for _, resource := range graph.Resources {
+		if resource == nil || resource.ID == nil {
+			continue
+		}
+		var outputs []string
+		for _, or := range resource.OutputResources {
+			if or != nil && or.ID != nil {
+				outputs = append(outputs, *or.ID)
+			}
+		}
+		x.AddResource(key, *resource.ID, to.String(resource.Name), to.String(resource.Type), outputs)
+
+		for _, conn := range resource.Connections {
+			if conn == nil || conn.ID == nil {
+				continue
+			}
+			if conn.Direction != nil && *conn.Direction == corerpv20250801preview.DirectionInbound {
+				x.AddEdge(*conn.ID, *resource.ID)
+			} else {
+				x.AddEdge(*resource.ID, *conn.ID)
+			}
+		}
+	}
+}
+
+// AddResource records that the resource id, with the given name, type and
+// output resources, appears in the graph saved under key.
+func (x *Index) AddResource(key Key, id string, name string, resourceType string, outputResources []string) {
+	v := x.vertex(id)
+	if v.ref.Name == "" {
+		v.ref.Name = name
+	}
+	if v.ref.Type == "" {
+		v.ref.Type = resourceType
+	}
+	if !slices.Contains(v.ref.Graphs, key) {
+		v.ref.Graphs = append(v.ref.Graphs, key)
+	}
+	for _, or := range outputResources {
+		if !containsFold(v.outputs, or) {
+			v.outputs = append(v.outputs, or)
+		}
+	}
+}
+
+// AddEdge records that source depends on target.
+func (x *Index) AddEdge(source string, target string) {
+	f, t := x.vertex(source), x.vertex(target)
+	fk, tk := normalizeID(f.ref.ID), normalizeID(t.ref.ID)
+	if x.forward[fk] == nil {
+		x.forward[fk] = map[string]bool{}
+	}
+	if x.backward[tk] == nil {
+		x.backward[tk] = map[string]bool{}
+	}
+	x.forward[fk][tk] = true
+	x.backward[tk][fk] = true
+}
+
+// AddEnvironment records that the graph saved under key is deployed to the
+// environment environmentID. It must be called after the resources of the
+// graph have been added.
+func (x *Index) AddEnvironment(key Key, environmentID string) {
+	var members []string
+	for _, v := range x.vertices {
+		if slices.Contains(v.ref.Graphs, key) && !strings.EqualFold(v.ref.ID, environmentID) {
+			members = append(members, v.ref.ID)
+		}
+	}
+
+	var name, resourceType string
+	if id, err := resources.ParseResource(environmentID); err == nil {
+		name, resourceType = id.Name(), id.Type()
+	}
+	x.AddResource(key, environmentID, name, resourceType, nil)
+	for _, id := range members {
+		x.AddEdge(id, environmentID)
+	}
+}
+
+// Neighbors implements Querier.Neighbors over the indexed graphs.
+func (x *Index) Neighbors(resourceID string, direction TraversalDirection) ([]ResourceRef, error) {
+	return x.traverse(resourceID, direction, 1)
+}
+
+// Reachable implements Querier.Reachable over the indexed graphs.
+func (x *Index) Reachable(resourceID string, direction TraversalDirection) ([]ResourceRef, error) {
+	return x.traverse(resourceID, direction, -1)
+}
+
+// BlastRadius implements Querier.BlastRadius over the indexed graphs.
+func (x *Index) BlastRadius(resourceID string) (*BlastRadius, error) {
+	affected, err := x.traverse(resourceID, Upstream, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	root := x.vertices[normalizeID(resourceID)]
+	result := &BlastRadius{
+		Root:     cloneRef(root.ref),
+		Affected: affected,
+	}
+
+	graphs := append([]Key(nil), root.ref.Graphs...)
+	outputs := append([]string(nil), root.outputs...)
+	for _, ref := range affected {
+		for _, key := range ref.Graphs {
+			if !slices.Contains(graphs, key) {
+				graphs = append(graphs, key)
+			}
+		}
+		for _, or := range x.vertices[normalizeID(ref.ID)].outputs {
+			if !containsFold(outputs, or) {
+				outputs = append(outputs, or)
+			}
+		}
+	}
+	sortKeys(graphs)
+	sort.Strings(outputs)
+	result.Graphs = graphs
+	result.OutputResources = outputs
+	return result, nil
+}
+
+// traverse walks the index breadth-first from resourceID. A negative
+// maxDepth walks the whole reachable sub-graph.
+func (x *Index) traverse(resourceID string, direction TraversalDirection, maxDepth int) ([]ResourceRef, error) {
+	var edges map[string]map[string]bool
+	switch direction {
+	case Downstream:
+		edges = x.forward
+	case Upstream:
+		edges = x.backward
+	default:
+		return nil, fmt.Errorf("persistence: unsupported traversal direction %q", direction)
+	}
+
+	start := normalizeID(resourceID)
+	if v, ok := x.vertices[start]; !ok || len(v.ref.Graphs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	depths := map[string]int{start: 0}
+	frontier := []string{start}
+	result := []ResourceRef{}
+	for depth := 1; len(frontier) > 0 && (maxDepth < 0 || depth <= maxDepth); depth++ {
+		var next []string
+		for _, id := range frontier {
+			for neighbor := range edges[id] {
+				if _, seen := depths[neighbor]; seen {
+					continue
+				}
+				depths[neighbor] = depth
+				next = append(next, neighbor)
+
+				ref := cloneRef(x.vertices[neighbor].ref)
+				ref.Depth = depth
+				result = append(result, ref)
+			}
+		}
+		frontier = next
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Depth != result[j].Depth {
+			return result[i].Depth < result[j].Depth
+		}
+		return normalizeID(result[i].ID) < normalizeID(result[j].ID)
+	})
+	return result, nil
+}
+
+func (x *Index) vertex(id string) *indexVertex {
+	k := normalizeID(id)
+	v, ok := x.vertices[k]
+	if !ok {
+		v = &indexVertex{ref: ResourceRef{ID: id}}
+		x.vertices[k] = v
+	}
+	return v
+}
+
+func cloneRef(ref ResourceRef) ResourceRef {
+	ref.Graphs = append([]Key(nil), ref.Graphs...)
+	sortKeys(ref.Graphs)
+	return ref
+}
+
+func sortKeys(keys []Key) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Namespace != keys[j].Namespace {
+			return keys[i].Namespace < keys[j].Namespace
+		}
+		return keys[i].Name < keys[j].Name
+	})
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeID(id string) string {
+	return strings.ToLower(id)
+}
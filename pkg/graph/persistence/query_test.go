@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func TestIndex_AddGraph_InboundConnectionsFollowDataFlow(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.AddGraph(Key{Namespace: "ns", Name: "g"}, &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{
+				ID:   to.Ptr("/app"),
+				Name: to.Ptr("app"),
+			},
+			{
+				ID:   to.Ptr("/db"),
+				Name: to.Ptr("db"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr("/app"), Direction: to.Ptr(corerpv20250801preview.DirectionInbound)},
+				},
+			},
+			nil,
+			{Name: to.Ptr("no-id")},
+		},
+	})
+
+	got, err := index.Neighbors("/app", Downstream)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "/db", got[0].ID)
+	require.Equal(t, "db", got[0].Name)
+}
+
+func TestIndex_Reachable_HandlesCycles(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.AddResource(Key{Namespace: "ns", Name: "g"}, "/a", "a", "T", nil)
+	index.AddEdge("/a", "/b")
+	index.AddEdge("/b", "/c")
+	index.AddEdge("/c", "/a")
+
+	got, err := index.Reachable("/a", Downstream)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "/b", got[0].ID)
+	require.Equal(t, 1, got[0].Depth)
+	require.Equal(t, "/c", got[1].ID)
+	require.Equal(t, 2, got[1].Depth)
+}
+
+func TestIndex_AddResource_MergesAcrossGraphs(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.AddResource(Key{Namespace: "ns", Name: "b"}, "/DB", "db", "T", []string{"/out"})
+	index.AddResource(Key{Namespace: "ns", Name: "a"}, "/db", "", "", []string{"/OUT"})
+	index.AddEdge("/app", "/db")
+
+	got, err := index.BlastRadius("/Db")
+	require.NoError(t, err)
+	require.Equal(t, "/DB", got.Root.ID)
+	require.Equal(t, []Key{{Namespace: "ns", Name: "a"}, {Namespace: "ns", Name: "b"}}, got.Root.Graphs)
+	require.Equal(t, []string{"/out"}, got.OutputResources)
+	require.Len(t, got.Affected, 1)
+	require.Equal(t, "/app", got.Affected[0].ID)
+}
+
+func TestIndex_UnknownResource(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewIndex().BlastRadius("/missing")
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestIndex_ConnectionTargetOnly(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex()
+	index.AddResource(Key{Namespace: "ns", Name: "g"}, "/app", "app", "T", nil)
+	index.AddEdge("/app", "/external")
+
+	_, err := index.BlastRadius("/external")
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	got, err := index.Neighbors("/app", Downstream)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "/external", got[0].ID)
+}
+
+func TestIndex_AddEnvironment(t *testing.T) {
+	t.Parallel()
+
+	const environmentID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/prod"
+	app := Key{Namespace: "ns", Name: "app"}
+	other := Key{Namespace: "ns", Name: "other"}
+
+	index := NewIndex()
+	index.AddResource(app, "/frontend", "frontend", "T", []string{"/out"})
+	index.AddResource(app, "/db", "db", "T", nil)
+	index.AddEdge("/frontend", "/db")
+	index.AddEnvironment(app, environmentID)
+	index.AddResource(other, "/worker", "worker", "T", nil)
+	index.AddEdge("/worker", "/db")
+
+	got, err := index.BlastRadius(environmentID)
+	require.NoError(t, err)
+	require.Equal(t, "prod", got.Root.Name)
+	require.Equal(t, "Applications.Core/environments", got.Root.Type)
+	require.Equal(t, []Key{app}, got.Root.Graphs)
+	require.Len(t, got.Affected, 3)
+	require.Equal(t, "/db", got.Affected[0].ID)
+	require.Equal(t, "/frontend", got.Affected[1].ID)
+	require.Equal(t, "/worker", got.Affected[2].ID)
+	require.Equal(t, 2, got.Affected[2].Depth)
+	require.Equal(t, []Key{app, other}, got.Graphs)
+	require.Equal(t, []string{"/out"}, got.OutputResources)
+}
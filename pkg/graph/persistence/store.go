@@ -50,7 +50,8 @@ type SaveOptions struct {
 	// message).
 	Message string
 
-	// Labels are free-form key/value pairs attached to the saved graph.
+	// Labels are free-form key/value pairs attached to the saved graph. See
+	// EnvironmentLabel for the label used by graph queries.
 	Labels map[string]string
 }
 
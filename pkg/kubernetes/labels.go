@@ -33,6 +33,9 @@ const (
 
 	LabelManagedByRadiusRP = "radius-rp"
 
+	// LabelRecipeOutput marks the ConfigMap and Secret of a Helm recipe that hold the output of the recipe.
+	LabelRecipeOutput = "radapp.io/recipe-output"
+
 	FieldManager = "radius-rp"
 
 	// ControlPlanePartOfLabelValue is the value we use for 'app.kubernetes.io/part-of' in Radius's control-plane components.
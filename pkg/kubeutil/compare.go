@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeutil
+
+// IsSubset returns true if every field set in the desired object has the same value in the live object. It is used to
+// compare a manifest with the object in the cluster, which has additional fields set by the API server and controllers.
+// Numbers are compared by value, since manifests decoded from YAML and objects read from the cluster may use different
+// numeric types.
+func IsSubset(desired any, live any) bool {
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range d {
+			if !IsSubset(value, l[key]) {
+				return false
+			}
+		}
+		return true
+
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !IsSubset(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+
+	case int64:
+		return numberEquals(float64(d), live)
+
+	case float64:
+		return numberEquals(d, live)
+
+	default:
+		return desired == live
+	}
+}
+
+func numberEquals(desired float64, live any) bool {
+	switch l := live.(type) {
+	case int64:
+		return desired == float64(l)
+	case float64:
+		return desired == l
+	default:
+		return false
+	}
+}
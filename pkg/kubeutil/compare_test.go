@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSubset(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{"name": "test", "resourceVersion": "1"},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"ports":    []any{map[string]any{"port": float64(80), "protocol": "TCP"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		desired  any
+		expected bool
+	}{
+		{
+			name:     "subset",
+			desired:  map[string]any{"metadata": map[string]any{"name": "test"}, "spec": map[string]any{"ports": []any{map[string]any{"port": int64(80)}}}},
+			expected: true,
+		},
+		{
+			name:     "numbers of different types",
+			desired:  map[string]any{"spec": map[string]any{"replicas": float64(3)}},
+			expected: true,
+		},
+		{
+			name:     "different value",
+			desired:  map[string]any{"spec": map[string]any{"replicas": int64(2)}},
+			expected: false,
+		},
+		{
+			name:     "missing field",
+			desired:  map[string]any{"spec": map[string]any{"selector": map[string]any{"app": "test"}}},
+			expected: false,
+		},
+		{
+			name:     "different list length",
+			desired:  map[string]any{"spec": map[string]any{"ports": []any{}}},
+			expected: false,
+		},
+		{
+			name:     "different type",
+			desired:  map[string]any{"spec": "test"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, IsSubset(tc.desired, live))
+		})
+	}
+}
@@ -97,6 +97,7 @@ func (dst *RabbitMQQueueResource) ConvertFrom(src v1.DataModelInterface) error {
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(rabbitmq.Properties.Status.OutputResources),
 			Recipe:          fromRecipeStatus(rabbitmq.Properties.Status.Recipe),
+			Drift:           fromDriftStatus(rabbitmq.Properties.Status.Drift),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(rabbitmq.InternalMetadata.AsyncProvisioningState),
 		Environment:          new(rabbitmq.Properties.Environment),
@@ -29,6 +29,36 @@ func (a *AzureContainerInstanceCompute) GetEnvironmentCompute() *EnvironmentComp
 	}
 }
 
+// DriftStatus - The result of a drift check of the output resources deployed by a recipe.
+type DriftStatus struct {
+	// REQUIRED; The time of the drift check that first found the current result.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The result of the drift check.
+	State *DriftState
+
+	// The reason the drift check failed.
+	Message *string
+
+	// The output resources that no longer match what the recipe would deploy.
+	Resources []*DriftedResource
+}
+
+// DriftedResource - An output resource that no longer matches what the recipe would deploy.
+type DriftedResource struct {
+	// REQUIRED; The change that executing the recipe again would make to the resource.
+	Action *string
+
+	// The resource ID of the resource.
+	ID *string
+
+	// The name of the resource within the recipe.
+	Name *string
+
+	// The type of the resource.
+	ResourceType *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -283,6 +313,9 @@ type ResourceStatus struct {
 	// Properties of an output resource
 	OutputResources []*OutputResource
 
+	// READ-ONLY; The result of the last drift check of the output resources deployed by the recipe
+	Drift *DriftStatus
+
 	// READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/radius-project/radius/pkg/components/metrics"
+)
+
+// GetDir extracts the directory last stored for the reference with PutDir into dir. It returns false if the directory
+// is not in the cache.
+func (c *Cache) GetDir(ctx context.Context, artifact string, ref string, dir string) (bool, error) {
+	d, ok := c.Resolve(ref)
+	if !ok {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+		return false, nil
+	}
+
+	data, ok := c.Get(ctx, artifact, d)
+	if !ok {
+		return false, nil
+	}
+
+	if err := extractArchive(data, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return false, fmt.Errorf("failed to extract cached %s artifact %q: %w", artifact, d, err)
+	}
+
+	return true, nil
+}
+
+// PutDir stores the content of dir for the reference. Identical directories are stored once, regardless of their
+// reference.
+func (c *Cache) PutDir(ctx context.Context, artifact string, ref string, dir string) error {
+	data, err := createArchive(dir)
+	if err != nil {
+		return fmt.Errorf("failed to archive %s artifact: %w", artifact, err)
+	}
+
+	d := digest.FromBytes(data)
+	if err := c.Put(ctx, artifact, d, data); err != nil {
+		return err
+	}
+
+	return c.Tag(ref, d)
+}
+
+// createArchive creates a gzipped tarball of the directories and regular files in dir. The archive does not include
+// timestamps or owners, so that archives of identical directories have the same digest.
+func createArchive(dir string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	// WalkDir visits entries in lexical order, which keeps the archive deterministic.
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{Name: filepath.ToSlash(rel), Mode: int64(info.Mode().Perm())}
+		switch {
+		case d.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+		case d.Type().IsRegular():
+			header.Typeflag = tar.TypeReg
+			header.Size = info.Size()
+		default:
+			return fmt.Errorf("unsupported file type of %q", rel)
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// extractArchive extracts an archive created by createArchive into dir.
+func extractArchive(data []byte, dir string) error {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if !filepath.IsLocal(header.Name) {
+			return fmt.Errorf("invalid path %q in archive", header.Name)
+		}
+
+		target := filepath.Join(dir, header.Name)
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+
+		case tar.TypeReg:
+			if err := writeArchiveFile(target, tr, fs.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unsupported file type of %q in archive", header.Name)
+		}
+	}
+}
+
+// writeArchiveFile writes a regular file of an archive.
+func writeArchiveFile(path string, r io.Reader, mode fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestModule(t *testing.T, dir string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "redis", "modules", "cluster"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modules.json"), []byte(`{"Modules":[]}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "redis", "main.tf"), []byte(`variable "context" {}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "redis", "modules", "cluster", "run.sh"), []byte("#!/bin/sh"), 0755))
+}
+
+func Test_PutDirGetDir(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	source := t.TempDir()
+	writeTestModule(t, source)
+
+	target := filepath.Join(t.TempDir(), "modules")
+	ok, err := c.GetDir(ctx, ArtifactTerraformModule, "redis@1.0.0", target)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoDirExists(t, target)
+
+	require.NoError(t, c.PutDir(ctx, ArtifactTerraformModule, "redis@1.0.0", source))
+
+	ok, err = c.GetDir(ctx, ArtifactTerraformModule, "redis@1.0.0", target)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	content, err := os.ReadFile(filepath.Join(target, "redis", "main.tf"))
+	require.NoError(t, err)
+	require.Equal(t, `variable "context" {}`, string(content))
+
+	info, err := os.Stat(filepath.Join(target, "redis", "modules", "cluster", "run.sh"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	require.FileExists(t, filepath.Join(target, "modules.json"))
+}
+
+func Test_PutDir_Deduplicates(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	first, second := t.TempDir(), t.TempDir()
+	writeTestModule(t, first)
+	writeTestModule(t, second)
+
+	require.NoError(t, c.PutDir(ctx, ArtifactTerraformModule, "first", first))
+	size := c.Size()
+
+	require.NoError(t, c.PutDir(ctx, ArtifactTerraformModule, "second", second))
+	require.Equal(t, size, c.Size())
+
+	d1, ok := c.Resolve("first")
+	require.True(t, ok)
+	d2, ok := c.Resolve("second")
+	require.True(t, ok)
+	require.Equal(t, d1, d2)
+}
+
+func Test_PutDir_Symlink(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	source := t.TempDir()
+	writeTestModule(t, source)
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(source, "link")))
+
+	err := c.PutDir(ctx, ArtifactTerraformModule, "redis@1.0.0", source)
+	require.ErrorContains(t, err, `unsupported file type of "link"`)
+}
+
+func Test_ExtractArchive_InvalidPath(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../escape", Typeflag: tar.TypeReg, Mode: 0644, Size: 1}))
+	_, err := tw.Write([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	dir := t.TempDir()
+	err = extractArchive(buf.Bytes(), filepath.Join(dir, "modules"))
+	require.EqualError(t, err, `invalid path "../escape" in archive`)
+	require.NoFileExists(t, filepath.Join(dir, "escape"))
+}
@@ -0,0 +1,559 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/opencontainers/go-digest"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// ArtifactOCILayer is the kind of the layers of recipes stored in OCI registries, such as Bicep recipes.
+	ArtifactOCILayer = "oci.layer"
+
+	// ArtifactTerraformModule is the kind of Terraform modules downloaded for Terraform recipes.
+	ArtifactTerraformModule = "terraform.module"
+
+	// ArtifactTerraformProvider is the kind of the Terraform provider plugins installed by Terraform in the provider
+	// plugin cache.
+	ArtifactTerraformProvider = "terraform.provider"
+
+	// DefaultMaxSize is the default maximum size of the cache in bytes.
+	DefaultMaxSize int64 = 2 << 30
+
+	// inUseWindow is the time during which an artifact is not evicted after its last use. Terraform links provider plugins
+	// from the cache into its working directories, so a plugin must not be removed while a recipe execution uses it.
+	inUseWindow = time.Hour
+
+	blobsDir     = "blobs"
+	refsDir      = "refs"
+	tempDir      = "tmp"
+	providersDir = "terraform/plugins"
+
+	// providersLockFile is the file locked while Terraform installs provider plugins into the provider plugin cache. It
+	// is kept outside of providersDir so that Terraform does not see it when it scans the cache.
+	providersLockFile = "terraform/plugins.lock"
+
+	// providersLockRetryDelay is the delay between attempts to acquire the lock of the provider plugin cache.
+	providersLockRetryDelay = 100 * time.Millisecond
+
+	// providerDepth is the depth of the provider plugins in the provider plugin cache of Terraform, which are stored as
+	// <hostname>/<namespace>/<type>/<version>/<os>_<arch>.
+	providerDepth = 5
+)
+
+// Options represents the options of the recipe artifact cache.
+type Options struct {
+	// Path is the directory of the cache. It is created if it does not exist.
+	Path string
+
+	// MaxSize is the maximum total size of the cached artifacts in bytes. Defaults to DefaultMaxSize.
+	MaxSize int64
+}
+
+// Cache is a content-addressed cache of recipe artifacts on the local disk. Artifacts are stored by the digest of their
+// content, and references such as a module source and version are resolved to the digest of the artifact they were
+// last stored as.
+//
+// The total size of the artifacts is bounded, least recently used artifacts are evicted first. The time of the last use
+// of each artifact is kept as its modification time, so that the order of eviction survives restarts.
+//
+// Cache is safe for concurrent use.
+type Cache struct {
+	root    string
+	maxSize int64
+	now     func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	size    int64
+}
+
+// entry is an artifact in the cache.
+type entry struct {
+	// path is the path of the artifact relative to the root of the cache.
+	path string
+
+	// artifact is the kind of the artifact.
+	artifact string
+
+	// size is the size of the artifact in bytes.
+	size int64
+
+	// lastAccess is the time the artifact was last used.
+	lastAccess time.Time
+}
+
+// New creates a cache in the directory of the options and loads the artifacts already stored in it.
+func New(options Options) (*Cache, error) {
+	if options.Path == "" {
+		return nil, errors.New("path is a required option for the recipe artifact cache")
+	}
+
+	root, err := filepath.Abs(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		root:    root,
+		maxSize: options.MaxSize,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+
+	if c.maxSize <= 0 {
+		c.maxSize = DefaultMaxSize
+	}
+
+	// Incomplete writes of a previous process are discarded.
+	if err := os.RemoveAll(filepath.Join(root, tempDir)); err != nil {
+		return nil, fmt.Errorf("failed to clean up the recipe artifact cache: %w", err)
+	}
+
+	for _, dir := range []string{blobsDir, refsDir, tempDir, providersDir} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create the recipe artifact cache: %w", err)
+		}
+	}
+
+	// Terraform links provider plugins by their real path, which is compared to the root in Track.
+	c.root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.load(); err != nil {
+		return nil, fmt.Errorf("failed to load the recipe artifact cache: %w", err)
+	}
+
+	return c, nil
+}
+
+// ProvidersDir returns the directory used as the provider plugin cache of Terraform. Provider plugins installed there
+// are added to the cache with Track.
+func (c *Cache) ProvidersDir() string {
+	return filepath.Join(c.root, providersDir)
+}
+
+// LockProviders acquires an exclusive lock on the provider plugin cache of Terraform, waiting until it is available or
+// the context is done. Terraform's provider plugin cache is not safe for concurrent use, so Terraform must hold the lock
+// while it installs provider plugins into ProvidersDir. The lock is a file lock, so it also serializes the installs of
+// other processes sharing the cache directory. The returned function releases the lock.
+func (c *Cache) LockProviders(ctx context.Context) (func(), error) {
+	fileLock := flock.New(filepath.Join(c.root, providersLockFile))
+	locked, err := fileLock.TryLockContext(ctx, providersLockRetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock the Terraform provider plugin cache: %w", err)
+	} else if !locked {
+		return nil, errors.New("failed to lock the Terraform provider plugin cache")
+	}
+
+	return func() {
+		if err := fileLock.Unlock(); err != nil {
+			ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Failed to unlock the Terraform provider plugin cache: %s", err.Error()))
+		}
+	}, nil
+}
+
+// Get returns the content of the artifact with the given digest. The second return value is false if the artifact is
+// not in the cache, or if its content no longer matches the digest.
+func (c *Cache) Get(ctx context.Context, artifact string, d digest.Digest) ([]byte, bool) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if d.Validate() != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+		return nil, false
+	}
+
+	path := blobPath(artifact, d)
+
+	c.mu.Lock()
+	_, ok := c.entries[path]
+	c.mu.Unlock()
+	if !ok {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+		return nil, false
+	}
+
+	// The artifact can be evicted while it is read, which is the same as a miss.
+	data, err := os.ReadFile(filepath.Join(c.root, path))
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+		return nil, false
+	}
+
+	if d.Algorithm().FromBytes(data) != d {
+		logger.Info("Removing corrupted artifact from the recipe artifact cache", "artifact", artifact, "digest", d.String())
+		c.mu.Lock()
+		c.remove(ctx, path, false)
+		c.mu.Unlock()
+
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+		return nil, false
+	}
+
+	c.mu.Lock()
+	c.touch(ctx, path)
+	c.mu.Unlock()
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheHit(ctx, artifact)
+	return data, true
+}
+
+// Put stores the content of the artifact with the given digest. It returns an error if the content does not match the
+// digest.
+func (c *Cache) Put(ctx context.Context, artifact string, d digest.Digest, data []byte) error {
+	if err := d.Validate(); err != nil {
+		return fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+
+	if d.Algorithm().FromBytes(data) != d {
+		return fmt.Errorf("content of %s artifact does not match digest %q", artifact, d)
+	}
+
+	path := blobPath(artifact, d)
+
+	c.mu.Lock()
+	_, ok := c.entries[path]
+	if ok {
+		c.touch(ctx, path)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		return nil
+	}
+
+	if err := c.writeFile(path, data); err != nil {
+		return fmt.Errorf("failed to store %s artifact %q: %w", artifact, d, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(ctx, &entry{path: path, artifact: artifact, size: int64(len(data)), lastAccess: c.now()})
+	return nil
+}
+
+// Resolve returns the digest of the artifact last stored for the reference. The artifact itself may have been evicted
+// since.
+func (c *Cache) Resolve(ref string) (digest.Digest, bool) {
+	data, err := os.ReadFile(filepath.Join(c.root, refPath(ref)))
+	if err != nil {
+		return "", false
+	}
+
+	d := digest.Digest(strings.TrimSpace(string(data)))
+	if d.Validate() != nil {
+		return "", false
+	}
+
+	return d, true
+}
+
+// Tag associates the reference with the digest of an artifact.
+func (c *Cache) Tag(ref string, d digest.Digest) error {
+	if err := d.Validate(); err != nil {
+		return fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+
+	if err := c.writeFile(refPath(ref), []byte(d.String())); err != nil {
+		return fmt.Errorf("failed to store reference to artifact %q: %w", d, err)
+	}
+
+	return nil
+}
+
+// Track adds a file or a directory written to the cache directory by another process, such as a provider plugin
+// installed by Terraform in ProvidersDir, to the cache or marks it as used if it is already in the cache. A lookup of
+// the artifact is recorded, which is a hit if the artifact was already in the cache.
+func (c *Cache) Track(ctx context.Context, artifact string, path string) error {
+	rel, err := filepath.Rel(c.root, path)
+	if err != nil || rel == "." || !filepath.IsLocal(rel) {
+		return fmt.Errorf("path %q is not in the recipe artifact cache", path)
+	}
+	rel = filepath.ToSlash(rel)
+
+	c.mu.Lock()
+	_, ok := c.entries[rel]
+	if ok {
+		c.touch(ctx, rel)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheHit(ctx, artifact)
+		return nil
+	}
+
+	size, err := diskUsage(path)
+	if err != nil {
+		return err
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheMiss(ctx, artifact)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(ctx, &entry{path: rel, artifact: artifact, size: size, lastAccess: c.now()})
+	return nil
+}
+
+// Size returns the total size of the artifacts in the cache in bytes.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// load adds the artifacts stored in the cache directory to the cache, in the order of their last use.
+func (c *Cache) load() error {
+	loaded := []*entry{}
+
+	// Blobs are stored as blobs/<artifact>/<algorithm>/<encoded digest>.
+	blobs := filepath.Join(c.root, blobsDir)
+	err := filepath.WalkDir(blobs, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(blobs, path)
+		if err != nil {
+			return err
+		}
+
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if len(parts) != 3 {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		loaded = append(loaded, &entry{
+			path:       blobsDir + "/" + filepath.ToSlash(rel),
+			artifact:   parts[0],
+			size:       info.Size(),
+			lastAccess: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	providers := filepath.Join(c.root, providersDir)
+	err = filepath.WalkDir(providers, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(providers, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		if strings.Count(filepath.ToSlash(rel), "/") < providerDepth-1 {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		size, err := diskUsage(path)
+		if err != nil {
+			return err
+		}
+
+		loaded = append(loaded, &entry{
+			path:       providersDir + "/" + filepath.ToSlash(rel),
+			artifact:   ArtifactTerraformProvider,
+			size:       size,
+			lastAccess: info.ModTime(),
+		})
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(loaded, func(a, b *entry) int {
+		return a.lastAccess.Compare(b.lastAccess)
+	})
+
+	for _, e := range loaded {
+		c.entries[e.path] = c.lru.PushFront(e)
+		c.size += e.size
+	}
+
+	c.evict(context.Background())
+	return nil
+}
+
+// add adds an artifact to the cache and evicts the least recently used artifacts if the cache is full. c.mu must be held.
+func (c *Cache) add(ctx context.Context, e *entry) {
+	if element, ok := c.entries[e.path]; ok {
+		c.size -= element.Value.(*entry).size
+		c.lru.Remove(element)
+	}
+
+	c.entries[e.path] = c.lru.PushFront(e)
+	c.size += e.size
+	c.evict(ctx)
+}
+
+// touch marks an artifact as used. c.mu must be held.
+func (c *Cache) touch(ctx context.Context, path string) {
+	element, ok := c.entries[path]
+	if !ok {
+		return
+	}
+
+	e := element.Value.(*entry)
+	e.lastAccess = c.now()
+	c.lru.MoveToFront(element)
+
+	if err := os.Chtimes(filepath.Join(c.root, path), time.Time{}, e.lastAccess); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Info("Failed to update the last use of a cached recipe artifact", "path", path, "error", err.Error())
+	}
+}
+
+// evict removes the least recently used artifacts until the size of the cache is within its maximum size. Artifacts
+// used within inUseWindow are kept, even if the cache exceeds its maximum size. c.mu must be held.
+func (c *Cache) evict(ctx context.Context) {
+	for c.size > c.maxSize {
+		element := c.lru.Back()
+		if element == nil {
+			return
+		}
+
+		e := element.Value.(*entry)
+		if c.now().Sub(e.lastAccess) < inUseWindow {
+			return
+		}
+
+		c.remove(ctx, e.path, true)
+	}
+}
+
+// remove removes an artifact from the cache and deletes it from the disk. c.mu must be held.
+func (c *Cache) remove(ctx context.Context, path string, evicted bool) {
+	element, ok := c.entries[path]
+	if !ok {
+		return
+	}
+
+	e := element.Value.(*entry)
+	c.lru.Remove(element)
+	delete(c.entries, path)
+	c.size -= e.size
+
+	if err := os.RemoveAll(filepath.Join(c.root, path)); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Info("Failed to delete a cached recipe artifact", "path", path, "error", err.Error())
+	}
+
+	if evicted {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeCacheEviction(ctx, e.artifact)
+	}
+}
+
+// writeFile atomically writes a file to the path relative to the root of the cache, so that concurrent readers never
+// observe partial content.
+func (c *Cache) writeFile(path string, data []byte) error {
+	target := filepath.Join(c.root, path)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Join(c.root, tempDir), "artifact-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), target)
+}
+
+// blobPath returns the path of an artifact relative to the root of the cache.
+func blobPath(artifact string, d digest.Digest) string {
+	return blobsDir + "/" + artifact + "/" + d.Algorithm().String() + "/" + d.Encoded()
+}
+
+// refPath returns the path of a reference relative to the root of the cache.
+func refPath(ref string) string {
+	sum := sha256.Sum256([]byte(ref))
+	return refsDir + "/" + hex.EncodeToString(sum[:])
+}
+
+// diskUsage returns the total size of the regular files in a file or directory.
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
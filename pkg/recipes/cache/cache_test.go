@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock that only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.now = f.now.Add(d)
+}
+
+func newTestCache(t *testing.T, dir string, maxSize int64) (*Cache, *fakeClock) {
+	c, err := New(Options{Path: dir, MaxSize: maxSize})
+	require.NoError(t, err)
+
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c.now = clock.Now
+	return c, clock
+}
+
+func Test_New_RequiresPath(t *testing.T) {
+	_, err := New(Options{})
+	require.EqualError(t, err, "path is a required option for the recipe artifact cache")
+}
+
+func Test_PutGet(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	data := []byte(`{"resources": {}}`)
+	d := digest.FromBytes(data)
+
+	_, ok := c.Get(ctx, ArtifactOCILayer, d)
+	require.False(t, ok)
+
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, d, data))
+	require.Equal(t, int64(len(data)), c.Size())
+
+	actual, ok := c.Get(ctx, ArtifactOCILayer, d)
+	require.True(t, ok)
+	require.Equal(t, data, actual)
+
+	// Storing the same artifact again does not change the cache.
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, d, data))
+	require.Equal(t, int64(len(data)), c.Size())
+
+	// Artifacts of different kinds are stored separately.
+	_, ok = c.Get(ctx, ArtifactTerraformModule, d)
+	require.False(t, ok)
+}
+
+func Test_Put_DigestMismatch(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	err := c.Put(ctx, ArtifactOCILayer, digest.FromString("other"), []byte("data"))
+	require.ErrorContains(t, err, "does not match digest")
+
+	err = c.Put(ctx, ArtifactOCILayer, digest.Digest("invalid"), []byte("data"))
+	require.ErrorContains(t, err, "invalid digest")
+
+	require.Equal(t, int64(0), c.Size())
+}
+
+func Test_Get_Corrupted(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	c, _ := newTestCache(t, dir, 0)
+
+	data := []byte("data")
+	d := digest.FromBytes(data)
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, d, data))
+
+	require.NoError(t, os.WriteFile(filepath.Join(c.root, blobPath(ArtifactOCILayer, d)), []byte("corrupted"), 0644))
+
+	_, ok := c.Get(ctx, ArtifactOCILayer, d)
+	require.False(t, ok)
+	require.Equal(t, int64(0), c.Size())
+	require.NoFileExists(t, filepath.Join(c.root, blobPath(ArtifactOCILayer, d)))
+}
+
+func Test_Evict_LeastRecentlyUsed(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, clock := newTestCache(t, t.TempDir(), 10)
+
+	a, b, d := []byte("aaaaa"), []byte("bbbbb"), []byte("ccccc")
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(a), a))
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(b), b))
+
+	clock.Advance(2 * time.Hour)
+	_, ok := c.Get(ctx, ArtifactOCILayer, digest.FromBytes(a))
+	require.True(t, ok)
+
+	clock.Advance(2 * time.Hour)
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(d), d))
+	require.Equal(t, int64(10), c.Size())
+
+	_, ok = c.Get(ctx, ArtifactOCILayer, digest.FromBytes(b))
+	require.False(t, ok)
+	require.NoFileExists(t, filepath.Join(c.root, blobPath(ArtifactOCILayer, digest.FromBytes(b))))
+
+	_, ok = c.Get(ctx, ArtifactOCILayer, digest.FromBytes(a))
+	require.True(t, ok)
+	_, ok = c.Get(ctx, ArtifactOCILayer, digest.FromBytes(d))
+	require.True(t, ok)
+}
+
+func Test_Evict_KeepsArtifactsInUse(t *testing.T) {
+	ctx := testcontext.New(t)
+	c, clock := newTestCache(t, t.TempDir(), 10)
+
+	a, b, d := []byte("aaaaa"), []byte("bbbbb"), []byte("ccccc")
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(a), a))
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(b), b))
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(d), d))
+
+	// All artifacts were used recently, so the cache exceeds its maximum size until they are no longer in use.
+	require.Equal(t, int64(15), c.Size())
+
+	clock.Advance(2 * time.Hour)
+	e := []byte("e")
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(e), e))
+	require.Equal(t, int64(6), c.Size())
+}
+
+func Test_ResolveTag(t *testing.T) {
+	c, _ := newTestCache(t, t.TempDir(), 0)
+
+	_, ok := c.Resolve("ref")
+	require.False(t, ok)
+
+	d := digest.FromString("data")
+	require.NoError(t, c.Tag("ref", d))
+
+	actual, ok := c.Resolve("ref")
+	require.True(t, ok)
+	require.Equal(t, d, actual)
+
+	require.Error(t, c.Tag("ref", digest.Digest("invalid")))
+}
+
+func Test_Track(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	c, _ := newTestCache(t, dir, 0)
+
+	provider := filepath.Join(c.ProvidersDir(), "registry.terraform.io", "hashicorp", "random", "3.6.0", "linux_amd64")
+	require.NoError(t, os.MkdirAll(provider, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(provider, "terraform-provider-random"), []byte("provider"), 0755))
+
+	require.NoError(t, c.Track(ctx, ArtifactTerraformProvider, provider))
+	require.Equal(t, int64(8), c.Size())
+
+	// Tracking an artifact again marks it as used.
+	require.NoError(t, c.Track(ctx, ArtifactTerraformProvider, provider))
+	require.Equal(t, int64(8), c.Size())
+
+	err := c.Track(ctx, ArtifactTerraformProvider, t.TempDir())
+	require.ErrorContains(t, err, "is not in the recipe artifact cache")
+}
+
+func Test_LockProviders(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	c, _ := newTestCache(t, dir, 0)
+
+	// A second cache on the same directory stands in for another process sharing the cache.
+	other, _ := newTestCache(t, dir, 0)
+
+	unlock, err := c.LockProviders(ctx)
+	require.NoError(t, err)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+	_, err = other.LockProviders(timeoutCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+
+	unlockOther, err := other.LockProviders(ctx)
+	require.NoError(t, err)
+	unlockOther()
+
+	// The lock file is not part of the provider plugin cache scanned by Terraform.
+	entries, err := os.ReadDir(c.ProvidersDir())
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func Test_New_LoadsArtifacts(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir := t.TempDir()
+	c, _ := newTestCache(t, dir, 0)
+
+	data := []byte("data")
+	require.NoError(t, c.Put(ctx, ArtifactOCILayer, digest.FromBytes(data), data))
+
+	provider := filepath.Join(c.ProvidersDir(), "registry.terraform.io", "hashicorp", "random", "3.6.0", "linux_amd64")
+	require.NoError(t, os.MkdirAll(provider, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(provider, "terraform-provider-random"), []byte("provider"), 0755))
+
+	// Leftovers of incomplete writes are removed.
+	require.NoError(t, os.WriteFile(filepath.Join(c.root, tempDir, "artifact-1"), []byte("partial"), 0644))
+
+	reloaded, _ := newTestCache(t, dir, 0)
+	require.Equal(t, int64(12), reloaded.Size())
+	require.NoFileExists(t, filepath.Join(c.root, tempDir, "artifact-1"))
+
+	actual, ok := reloaded.Get(ctx, ArtifactOCILayer, digest.FromBytes(data))
+	require.True(t, ok)
+	require.Equal(t, data, actual)
+}
@@ -31,6 +31,7 @@ import (
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/kube"
 	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -364,12 +365,14 @@ func getRecipeDefinitionFromEnvironmentV20250801(ctx context.Context, environmen
 		// TODO: For now, we can set "Name" to default as recipe packs don't have named recipes.
 		// We will remove this field from EnvironmentDefinition once we deprecate Applications.Core.
 		definition := &recipes.EnvironmentDefinition{
-			Name:         "default",
-			Driver:       recipeDefinition.Kind,
-			ResourceType: resource.Type(),
-			Parameters:   parameters,
-			TemplatePath: recipeDefinition.Source,
-			PlainHTTP:    recipeDefinition.PlainHTTP,
+			Name:            "default",
+			Driver:          recipeDefinition.Kind,
+			ResourceType:    resource.Type(),
+			Parameters:      parameters,
+			TemplatePath:    recipeDefinition.Source,
+			TemplateVersion: recipeDefinition.Version,
+			PlainHTTP:       recipeDefinition.PlainHTTP,
+			TrustedKeys:     recipeDefinition.TrustedKeys,
 		}
 		return definition, nil
 	}
@@ -412,11 +415,21 @@ func fetchRecipeDefinition(ctx context.Context, recipePackIDs []string, armOptio
 				if definition.PlainHTTP != nil {
 					plainHTTP = *definition.PlainHTTP
 				}
+
+				// Recipes are deployed from the immutable reference their source was locked to, so that a mutable tag
+				// or ref can't change what is deployed.
+				source, version := *definition.Source, ""
+				if definition.Lock != nil && definition.Lock.Source != nil {
+					source, version = *definition.Lock.Source, to.String(definition.Lock.Version)
+				}
+
 				return &recipes.RecipeDefinition{
-					Kind:       string(*definition.Kind),
-					Source:     string(*definition.Source),
-					Parameters: definition.Parameters,
-					PlainHTTP:  plainHTTP,
+					Kind:        string(*definition.Kind),
+					Source:      source,
+					Version:     version,
+					Parameters:  definition.Parameters,
+					PlainHTTP:   plainHTTP,
+					TrustedKeys: to.StringArray(recipePackResource.Properties.TrustedKeys),
 				}, nil
 			}
 		}
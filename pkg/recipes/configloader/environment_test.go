@@ -18,11 +18,16 @@ package configloader
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	model "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	modelv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
@@ -587,6 +592,71 @@ func TestGetRecipeDefinitionFromEnvironmentV20250801(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "'invalid-id' is not a valid resource id")
 	})
+
+	t.Run("locked recipe", func(t *testing.T) {
+		recipePack := modelv20250801.RecipePackResource{
+			Properties: &modelv20250801.RecipePackProperties{
+				Recipes: map[string]*modelv20250801.RecipeDefinition{
+					"Applications.Datastores/mongoDatabases": {
+						Kind:   to.Ptr(modelv20250801.RecipeKindTerraform),
+						Source: new("example/mongodb/kubernetes"),
+						Lock: &modelv20250801.RecipeLock{
+							Source:  new("example/mongodb/kubernetes"),
+							Version: new("1.2.3"),
+						},
+					},
+				},
+			},
+		}
+		options := &armpolicy.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+					RecipePacksServer: fake.RecipePacksServer{
+						Get: func(ctx context.Context, rootScope string, recipePackName string, options *modelv20250801.RecipePacksClientGetOptions) (resp azfake.Responder[modelv20250801.RecipePacksClientGetResponse], errResp azfake.ErrorResponder) {
+							resp.SetResponse(http.StatusOK, modelv20250801.RecipePacksClientGetResponse{RecipePackResource: recipePack}, nil)
+							return
+						},
+					},
+				}),
+			},
+		}
+
+		definition, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, envResource, &recipeMetadata, options)
+		require.NoError(t, err)
+		require.Equal(t, "example/mongodb/kubernetes", definition.TemplatePath)
+		require.Equal(t, "1.2.3", definition.TemplateVersion)
+		require.Nil(t, definition.TrustedKeys)
+	})
+
+	t.Run("recipe pack with trusted keys", func(t *testing.T) {
+		recipePack := modelv20250801.RecipePackResource{
+			Properties: &modelv20250801.RecipePackProperties{
+				Recipes: map[string]*modelv20250801.RecipeDefinition{
+					"Applications.Datastores/mongoDatabases": {
+						Kind:   to.Ptr(modelv20250801.RecipeKindBicep),
+						Source: new("ghcr.io/radius-project/recipes/mongodb:latest"),
+					},
+				},
+				TrustedKeys: []*string{new("trusted-key")},
+			},
+		}
+		options := &armpolicy.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+					RecipePacksServer: fake.RecipePacksServer{
+						Get: func(ctx context.Context, rootScope string, recipePackName string, options *modelv20250801.RecipePacksClientGetOptions) (resp azfake.Responder[modelv20250801.RecipePacksClientGetResponse], errResp azfake.ErrorResponder) {
+							resp.SetResponse(http.StatusOK, modelv20250801.RecipePacksClientGetResponse{RecipePackResource: recipePack}, nil)
+							return
+						},
+					},
+				}),
+			},
+		}
+
+		definition, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, envResource, &recipeMetadata, options)
+		require.NoError(t, err)
+		require.Equal(t, []string{"trusted-key"}, definition.TrustedKeys)
+	})
 }
 
 func Test_reconcileRecipeParameters(t *testing.T) {
@@ -26,9 +26,12 @@ import (
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
+	"github.com/radius-project/radius/pkg/recipes/driver/helm"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
@@ -78,6 +81,15 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	deploymentOperationsClient, err := clients.NewResourceDeploymentOperationsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          options.UCPConnection.Endpoint(),
+		ARMClientOptions: sdk.NewClientOptions(options.UCPConnection),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if options.Config.Bicep.DeleteRetryCount == "" {
 		options.Config.Bicep.DeleteRetryCount = "3"
 	}
@@ -96,26 +108,47 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	var artifactCache *cache.Cache
+	if options.Config.RecipeCache.Path != "" {
+		artifactCache, err = cache.New(cache.Options{
+			Path:    options.Config.RecipeCache.Path,
+			MaxSize: int64(options.Config.RecipeCache.MaxSizeMB) << 20,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
+	drivers := map[string]driver.Driver{
+		recipes.TemplateKindBicep: bicep.NewBicepDriver(
+			clientOptions,
+			cfg.DeploymentEngineClient,
+			processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes),
+			bicep.BicepOptions{
+				DeleteRetryCount:           bicepDeleteRetryCount,
+				DeleteRetryDelaySeconds:    bicepDeleteRetryDeleteSeconds,
+				Cache:                      artifactCache,
+				DeploymentOperationsClient: deploymentOperationsClient,
+			},
+		),
+		recipes.TemplateKindTerraform: terraform.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
+			terraform.TerraformOptions{
+				Path:     options.Config.Terraform.Path,
+				LogLevel: options.Config.Terraform.LogLevel,
+				Cache:    artifactCache,
+			}, *cfg.Kubernetes),
+		recipes.TemplateKindHelm: helm.NewHelmDriver(cfg.Kubernetes, helm.HelmOptions{}),
+	}
+
+	if err := plugin.AddDrivers(drivers, options.Config.RecipeDrivers); err != nil {
+		return nil, err
+	}
+
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
 		SecretsLoader:       configloader.NewSecretStoreLoader(clientOptions),
-		Drivers: map[string]driver.Driver{
-			recipes.TemplateKindBicep: bicep.NewBicepDriver(
-				clientOptions,
-				cfg.DeploymentEngineClient,
-				processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes),
-				bicep.BicepOptions{
-					DeleteRetryCount:        bicepDeleteRetryCount,
-					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
-				},
-			),
-			recipes.TemplateKindTerraform: terraform.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
-				terraform.TerraformOptions{
-					Path:     options.Config.Terraform.Path,
-					LogLevel: options.Config.Terraform.LogLevel,
-				}, *cfg.Kubernetes),
-		},
+		Drivers:             drivers,
 	})
 
 	return cfg, nil
@@ -0,0 +1,340 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/resourceutil"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// RootScopesFunc returns the scopes under which the resources are checked for drift, e.g. "/planes/radius/local".
+type RootScopesFunc func(ctx context.Context) ([]string, error)
+
+// ResourceTypesFunc returns the resource types whose resources are checked for drift under the given root scope.
+type ResourceTypesFunc func(ctx context.Context, rootScope string) ([]string, error)
+
+// Detector detects drift of the output resources deployed by the recipes of portable and dynamic resources.
+//
+// A resource has drifted when planning its recipe again, with the output resources recorded in its status as the previous
+// state, reports changes. The result of a check is stored in the `drift` field of the resource status when it differs
+// from the stored result.
+type Detector struct {
+	// DatabaseClient is the client used to read and update the resources.
+	DatabaseClient database.Client
+
+	// Engine is the recipe engine used to plan the recipes.
+	Engine engine.Engine
+
+	// RootScopes returns the scopes under which the resources are checked.
+	RootScopes RootScopesFunc
+
+	// ResourceTypes returns the resource types to check.
+	ResourceTypes ResourceTypesFunc
+}
+
+// CheckAll checks all resources of the detector's resource types under the detector's root scopes for drift. A failure
+// to check one resource does not prevent the other resources from being checked; the errors are joined and returned.
+func (d *Detector) CheckAll(ctx context.Context) error {
+	rootScopes, err := d.RootScopes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list root scopes: %w", err)
+	}
+
+	var errs error
+	for _, rootScope := range rootScopes {
+		if err := d.checkScope(ctx, rootScope); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// checkScope checks all resources of the detector's resource types under the root scope for drift.
+func (d *Detector) checkScope(ctx context.Context, rootScope string) error {
+	resourceTypes, err := d.ResourceTypes(ctx, rootScope)
+	if err != nil {
+		return fmt.Errorf("failed to list resource types of %q: %w", rootScope, err)
+	}
+
+	var errs error
+	for _, resourceType := range resourceTypes {
+		query := database.Query{
+			RootScope:      rootScope,
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}
+
+		paginationToken := ""
+		for {
+			result, err := d.DatabaseClient.Query(ctx, query, database.WithPaginationToken(paginationToken))
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to query resources of type %q: %w", resourceType, err))
+				break
+			}
+
+			for i := range result.Items {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				if err := d.check(ctx, &result.Items[i]); err != nil {
+					errs = errors.Join(errs, fmt.Errorf("failed to check resource %q for drift: %w", result.Items[i].ID, err))
+				}
+			}
+
+			if result.PaginationToken == "" {
+				break
+			}
+			paginationToken = result.PaginationToken
+		}
+	}
+
+	return errs
+}
+
+// check plans the recipe of the stored resource and records the resulting drift status. Resources that are not
+// successfully deployed by a recipe are skipped.
+func (d *Detector) check(ctx context.Context, obj *database.Object) error {
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	if !isRecipeDeployed(resource) {
+		return nil
+	}
+
+	startTime := time.Now()
+	status := d.plan(ctx, resource)
+	metrics.DefaultDriftMetrics.RecordDriftCheck(ctx, startTime, resource.Type, string(status.State), len(status.Resources))
+
+	if status.State == rpv1.DriftStateDrifted {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info("Detected drift of recipe output resources", "resourceID", obj.ID, "driftedResources", len(status.Resources))
+	}
+
+	// The stored data is updated as a map rather than through the decoded resource so that fields unknown to
+	// DynamicResource, such as the computed values of portable resources, are preserved.
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return err
+	}
+
+	// A check that finds the stored result is not saved. Saving changes the ETag of the resource, which would fail
+	// conditional updates made by users between checks, and would write every resource on every interval.
+	if sameDriftResult(getDriftStatus(data), status) {
+		return nil
+	}
+
+	if err := setDriftStatus(data, status); err != nil {
+		return err
+	}
+
+	err := d.DatabaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: obj.ID}, Data: data}, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated while it was being checked. The next check will use the updated resource.
+		return nil
+	}
+
+	return err
+}
+
+// plan plans the recipe of the resource and converts the planned changes to a drift status.
+func (d *Detector) plan(ctx context.Context, resource *datamodel.DynamicResource) *rpv1.DriftStatus {
+	status := &rpv1.DriftStatus{
+		State:           rpv1.DriftStateInSync,
+		LastCheckedTime: time.Now().UTC(),
+	}
+
+	metadata, err := d.recipeMetadata(ctx, resource)
+	if err != nil {
+		status.State = rpv1.DriftStateFailed
+		status.Message = err.Error()
+		return status
+	}
+
+	previousState := []string{}
+	for _, outputResource := range resource.OutputResources() {
+		previousState = append(previousState, outputResource.ID.String())
+	}
+
+	plan, err := d.Engine.Plan(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: previousState,
+	})
+	if err != nil {
+		status.State = rpv1.DriftStateFailed
+		status.Message = err.Error()
+		return status
+	}
+
+	for _, change := range plan.Changes {
+		status.Resources = append(status.Resources, rpv1.DriftedResource{
+			Action:       string(change.Action),
+			ResourceType: change.ResourceType,
+			Name:         change.Name,
+			ID:           change.ID,
+		})
+	}
+
+	if len(status.Resources) > 0 {
+		status.State = rpv1.DriftStateDrifted
+	}
+
+	return status
+}
+
+// recipeMetadata builds the recipe metadata of the resource the same way the recipe was executed when the resource
+// was deployed, including the properties of its connected resources.
+func (d *Detector) recipeMetadata(ctx context.Context, resource *datamodel.DynamicResource) (recipes.ResourceMetadata, error) {
+	recipe := resource.GetRecipe()
+
+	properties, err := resourceutil.GetPropertiesFromResource(resource)
+	if err != nil {
+		return recipes.ResourceMetadata{}, err
+	}
+
+	connectionsAndSourceIDs, err := resourceutil.GetConnectionNameandSourceIDs(resource)
+	if err != nil {
+		return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource IDs: %w", err)
+	}
+
+	connectedResources := map[string]recipes.ConnectedResource{}
+	for connectionName, connectedResourceID := range connectionsAndSourceIDs {
+		connectedResource, err := d.DatabaseClient.Get(ctx, connectedResourceID)
+		if err != nil {
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get connected resource %s: %w", connectedResourceID, err)
+		}
+
+		connectedResourceMetadata, err := resourceutil.GetAllPropertiesFromResource(connectedResource.Data)
+		if err != nil {
+			return recipes.ResourceMetadata{}, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
+		}
+
+		connectedResources[connectionName] = recipes.ConnectedResource{
+			ID:         connectedResourceMetadata.ID,
+			Name:       connectedResourceMetadata.Name,
+			Type:       connectedResourceMetadata.Type,
+			Properties: connectedResourceMetadata.Properties,
+		}
+	}
+
+	return recipes.ResourceMetadata{
+		Name:                         recipe.Name,
+		Parameters:                   recipe.Parameters,
+		EnvironmentID:                resource.ResourceMetadata().EnvironmentID(),
+		ApplicationID:                resource.ResourceMetadata().ApplicationID(),
+		ResourceID:                   resource.ID,
+		Properties:                   properties,
+		ConnectedResourcesProperties: connectedResources,
+	}, nil
+}
+
+// isRecipeDeployed returns true if the resource was successfully deployed by a recipe.
+func isRecipeDeployed(resource *datamodel.DynamicResource) bool {
+	if resource.InternalMetadata.AsyncProvisioningState != v1.ProvisioningStateSucceeded {
+		return false
+	}
+
+	if provisioning, _ := resource.Properties["resourceProvisioning"].(string); strings.EqualFold(provisioning, string(portableresources.ResourceProvisioningManual)) {
+		return false
+	}
+
+	return resource.GetRecipe().DeploymentStatus == util.Success
+}
+
+// getDriftStatus returns the drift status stored in the resource data, or nil if the resource has none.
+func getDriftStatus(data map[string]any) *rpv1.DriftStatus {
+	properties, _ := data["properties"].(map[string]any)
+	resourceStatus, _ := properties["status"].(map[string]any)
+	drift, ok := resourceStatus["drift"]
+	if !ok {
+		return nil
+	}
+
+	bs, err := json.Marshal(drift)
+	if err != nil {
+		return nil
+	}
+
+	status := &rpv1.DriftStatus{}
+	if err := json.Unmarshal(bs, status); err != nil {
+		return nil
+	}
+	return status
+}
+
+// sameDriftResult reports whether the stored drift status has the same state, message and drifted resources as the
+// status of a new check. The time of the check is ignored.
+func sameDriftResult(stored *rpv1.DriftStatus, status *rpv1.DriftStatus) bool {
+	return stored != nil &&
+		stored.State == status.State &&
+		stored.Message == status.Message &&
+		slices.Equal(stored.Resources, status.Resources)
+}
+
+// setDriftStatus sets the drift status in the status of the stored resource data.
+func setDriftStatus(data map[string]any, status *rpv1.DriftStatus) error {
+	bs, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	drift := map[string]any{}
+	if err := json.Unmarshal(bs, &drift); err != nil {
+		return err
+	}
+
+	properties, ok := data["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		data["properties"] = properties
+	}
+
+	resourceStatus, ok := properties["status"].(map[string]any)
+	if !ok {
+		resourceStatus = map[string]any{}
+		properties["status"] = resourceStatus
+	}
+
+	resourceStatus["drift"] = drift
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+const (
+	testResourceType   = "Applications.Datastores/redisCaches"
+	testResourceID     = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache"
+	testEnvironmentID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+	testOutputResource = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache"
+)
+
+func testResourceData(provisioningState string, recipeStatus string) map[string]any {
+	return map[string]any{
+		"id":                testResourceID,
+		"name":              "cache",
+		"type":              testResourceType,
+		"provisioningState": provisioningState,
+		"computedValues":    map[string]any{"host": "cache.redis.cache.windows.net"},
+		"properties": map[string]any{
+			"environment": testEnvironmentID,
+			"recipe": map[string]any{
+				"name":         "default",
+				"parameters":   map[string]any{"sku": "Basic"},
+				"recipeStatus": recipeStatus,
+			},
+			"status": map[string]any{
+				"outputResources": []any{
+					map[string]any{"id": testOutputResource},
+				},
+			},
+		},
+	}
+}
+
+func setupDetector(t *testing.T, data map[string]any) (*Detector, *database.MockClient, *engine.MockEngine) {
+	ctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(ctrl)
+	mEngine := engine.NewMockEngine(ctrl)
+
+	databaseClient.EXPECT().
+		Query(gomock.Any(), database.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: testResourceType}, gomock.Any()).
+		Return(&database.ObjectQueryResult{
+			Items: []database.Object{
+				{Metadata: database.Metadata{ID: testResourceID, ETag: "etag"}, Data: data},
+			},
+		}, nil)
+
+	detector := &Detector{
+		DatabaseClient: databaseClient,
+		Engine:         mEngine,
+		RootScopes: func(ctx context.Context) ([]string, error) {
+			return []string{"/planes/radius/local"}, nil
+		},
+		ResourceTypes: func(ctx context.Context, rootScope string) ([]string, error) {
+			require.Equal(t, "/planes/radius/local", rootScope)
+			return []string{testResourceType}, nil
+		},
+	}
+
+	return detector, databaseClient, mEngine
+}
+
+// savedDrift captures the drift status of the saved resource.
+func savedDrift(t *testing.T, databaseClient *database.MockClient, err error) *map[string]any {
+	drift := &map[string]any{}
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			require.Equal(t, testResourceID, obj.ID)
+
+			data := obj.Data.(map[string]any)
+			require.Equal(t, map[string]any{"host": "cache.redis.cache.windows.net"}, data["computedValues"])
+
+			status := data["properties"].(map[string]any)["status"].(map[string]any)
+			require.NotEmpty(t, status["outputResources"])
+			*drift = status["drift"].(map[string]any)
+			return err
+		})
+
+	return drift
+}
+
+func TestDetector_CheckAll(t *testing.T) {
+	t.Run("drifted", func(t *testing.T) {
+		detector, databaseClient, mEngine := setupDetector(t, testResourceData("Succeeded", "success"))
+
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipePlan, error) {
+				require.Equal(t, "default", opts.Recipe.Name)
+				require.Equal(t, testResourceID, opts.Recipe.ResourceID)
+				require.Equal(t, testEnvironmentID, opts.Recipe.EnvironmentID)
+				require.Equal(t, map[string]any{"sku": "Basic"}, opts.Recipe.Parameters)
+				require.Equal(t, []string{testOutputResource}, opts.PreviousState)
+
+				return &recipes.RecipePlan{
+					Changes: []recipes.PlannedResourceChange{
+						{Action: recipes.PlanActionUpdate, ResourceType: "Microsoft.Cache/redis", ID: testOutputResource},
+					},
+				}, nil
+			})
+		drift := savedDrift(t, databaseClient, nil)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, "Drifted", (*drift)["state"])
+		require.NotEmpty(t, (*drift)["lastCheckedTime"])
+		require.Equal(t, []any{
+			map[string]any{"action": "Update", "resourceType": "Microsoft.Cache/redis", "id": testOutputResource},
+		}, (*drift)["resources"])
+	})
+
+	t.Run("in sync", func(t *testing.T) {
+		detector, databaseClient, mEngine := setupDetector(t, testResourceData("Succeeded", "success"))
+
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}, nil)
+		drift := savedDrift(t, databaseClient, nil)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, "InSync", (*drift)["state"])
+		require.Nil(t, (*drift)["resources"])
+	})
+
+	t.Run("plan failure", func(t *testing.T) {
+		detector, databaseClient, mEngine := setupDetector(t, testResourceData("Succeeded", "success"))
+
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("terraform plan failed"))
+		drift := savedDrift(t, databaseClient, nil)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, "Failed", (*drift)["state"])
+		require.Equal(t, "terraform plan failed", (*drift)["message"])
+	})
+
+	t.Run("unchanged result is not saved", func(t *testing.T) {
+		data := testResourceData("Succeeded", "success")
+		status := data["properties"].(map[string]any)["status"].(map[string]any)
+		status["drift"] = map[string]any{"state": "InSync", "lastCheckedTime": "2025-01-01T00:00:00Z"}
+		detector, _, mEngine := setupDetector(t, data)
+
+		// Save is not mocked, so saving the resource fails the test.
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}, nil)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("changed result is saved", func(t *testing.T) {
+		data := testResourceData("Succeeded", "success")
+		status := data["properties"].(map[string]any)["status"].(map[string]any)
+		status["drift"] = map[string]any{"state": "Failed", "lastCheckedTime": "2025-01-01T00:00:00Z", "message": "terraform plan failed"}
+		detector, databaseClient, mEngine := setupDetector(t, data)
+
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("terraform init failed"))
+		drift := savedDrift(t, databaseClient, nil)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, "Failed", (*drift)["state"])
+		require.Equal(t, "terraform init failed", (*drift)["message"])
+		require.NotEqual(t, "2025-01-01T00:00:00Z", (*drift)["lastCheckedTime"])
+	})
+
+	t.Run("concurrent update is ignored", func(t *testing.T) {
+		detector, databaseClient, mEngine := setupDetector(t, testResourceData("Succeeded", "success"))
+
+		mEngine.EXPECT().
+			Plan(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}, nil)
+		_ = savedDrift(t, databaseClient, &database.ErrConcurrency{})
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("recipe deployment failed", func(t *testing.T) {
+		detector, _, _ := setupDetector(t, testResourceData("Succeeded", "executionError"))
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("provisioning in progress", func(t *testing.T) {
+		detector, _, _ := setupDetector(t, testResourceData("Updating", "success"))
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("manual provisioning", func(t *testing.T) {
+		data := testResourceData("Succeeded", "success")
+		data["properties"].(map[string]any)["resourceProvisioning"] = "manual"
+		detector, _, _ := setupDetector(t, data)
+
+		err := detector.CheckAll(context.Background())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"slices"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// RadiusPlanes returns a RootScopesFunc that lists the Radius planes registered in UCP, so that the resources of
+// every plane are checked for drift.
+func RadiusPlanes(connection sdk.Connection) (RootScopesFunc, error) {
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(connection))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) ([]string, error) {
+		result := []string{}
+		pager := clientFactory.NewRadiusPlanesClient().NewListPager(nil)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, plane := range page.Value {
+				if plane.ID != nil {
+					result = append(result, *plane.ID)
+				}
+			}
+		}
+
+		slices.Sort(result)
+		return result, nil
+	}, nil
+}
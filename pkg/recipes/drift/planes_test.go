@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func TestRadiusPlanes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/planes/radius", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"value": []any{
+				map[string]any{"id": "/planes/radius/local", "name": "local", "type": "System.Radius/planes"},
+				map[string]any{"id": "/planes/radius/edge", "name": "edge", "type": "System.Radius/planes"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	rootScopes, err := RadiusPlanes(connection)
+	require.NoError(t, err)
+
+	scopes, err := rootScopes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"/planes/radius/edge", "/planes/radius/local"}, scopes)
+}
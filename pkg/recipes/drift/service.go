@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default time between two drift checks.
+	DefaultInterval = time.Hour
+
+	// LeaseNamespace is the namespace of the lease used to elect the replica that checks for drift.
+	LeaseNamespace = "radius-system"
+)
+
+var (
+	// leaseDuration, renewDeadline and retryPeriod configure the leader election. They are the defaults of
+	// controller-runtime and are variables so that tests can shorten them.
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// Service is the hosting service that periodically checks the resources deployed by recipes for drift.
+type Service struct {
+	// ServiceName is the name of the service used for logging.
+	ServiceName string
+
+	// Interval is the time between two drift checks. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// NewDetector creates the drift detector when the service starts.
+	NewDetector func(ctx context.Context) (*Detector, error)
+
+	// LeaseName is the name of the Kubernetes lease used to elect the replica that checks for drift, so that the
+	// replicas of the service don't repeat the same checks. Every replica checks for drift when LeaseName is empty.
+	LeaseName string
+
+	// NewLeaseClient creates the Kubernetes client used to hold the lease. Required if LeaseName is set.
+	NewLeaseClient func() (kubernetes.Interface, error)
+}
+
+// IntervalFromSeconds converts the configured drift detection interval to a duration, falling back to DefaultInterval
+// if the interval is not set.
+func IntervalFromSeconds(seconds int) time.Duration {
+	if seconds <= 0 {
+		return DefaultInterval
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// Name returns the name of the service used for logging.
+func (s *Service) Name() string {
+	return s.ServiceName
+}
+
+// Run checks all resources for drift every interval until the context is cancelled. The first check runs one interval
+// after the service starts so that it does not compete with the deployments made at startup. If LeaseName is set, only
+// the replica holding the lease checks for drift, and the other replicas wait to take over the lease.
+func (s *Service) Run(ctx context.Context) error {
+	detector, err := s.NewDetector(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.LeaseName == "" {
+		s.checkPeriodically(ctx, detector)
+		return nil
+	}
+
+	lock, err := s.newLeaseLock()
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for ctx.Err() == nil {
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Name:            s.LeaseName,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					logger.Info("Acquired the drift detection lease", "lease", s.LeaseName, "identity", lock.Identity())
+					s.checkPeriodically(ctx, detector)
+				},
+				OnStoppedLeading: func() {
+					logger.Info("Released the drift detection lease", "lease", s.LeaseName, "identity", lock.Identity())
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Run returns when the context is cancelled or when the lease is lost, in which case the replica competes
+		// for the lease again.
+		elector.Run(ctx)
+	}
+
+	return nil
+}
+
+// newLeaseLock creates the lock of the lease held by the replica that checks for drift.
+func (s *Service) newLeaseLock() (*resourcelock.LeaseLock, error) {
+	client, err := s.NewLeaseClient()
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      s.LeaseName,
+			Namespace: LeaseNamespace,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: hostname + "_" + uuid.NewString(),
+		},
+	}, nil
+}
+
+// checkPeriodically checks all resources for drift every interval until the context is cancelled.
+func (s *Service) checkPeriodically(ctx context.Context, detector *Detector) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			logger.Info("Checking recipe resources for drift")
+			if err := detector.CheckAll(ctx); err != nil {
+				logger.Error(err, "Failed to check recipe resources for drift")
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestService creates a service whose checks are reported on the returned channel.
+func newTestService(client kubernetes.Interface) (*Service, chan struct{}) {
+	checks := make(chan struct{}, 100)
+	return &Service{
+		Interval:  10 * time.Millisecond,
+		LeaseName: "test-drift-detector",
+		NewLeaseClient: func() (kubernetes.Interface, error) {
+			return client, nil
+		},
+		NewDetector: func(ctx context.Context) (*Detector, error) {
+			return &Detector{
+				RootScopes: func(ctx context.Context) ([]string, error) {
+					checks <- struct{}{}
+					return []string{}, nil
+				},
+			}, nil
+		},
+	}, checks
+}
+
+func TestService_Run_LeaderElection(t *testing.T) {
+	leaseDuration, renewDeadline, retryPeriod = 1*time.Second, 500*time.Millisecond, 100*time.Millisecond
+	t.Cleanup(func() {
+		leaseDuration, renewDeadline, retryPeriod = 15*time.Second, 10*time.Second, 2*time.Second
+	})
+
+	client := fake.NewClientset()
+	leader, leaderChecks := newTestService(client)
+	follower, followerChecks := newTestService(client)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() { leaderDone <- leader.Run(leaderCtx) }()
+
+	select {
+	case <-leaderChecks:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the leader didn't check for drift")
+	}
+
+	followerCtx, cancelFollower := context.WithCancel(context.Background())
+	defer cancelFollower()
+	followerDone := make(chan error)
+	go func() { followerDone <- follower.Run(followerCtx) }()
+
+	// The follower doesn't check for drift while the leader holds the lease.
+	select {
+	case <-followerChecks:
+		require.Fail(t, "the follower checked for drift while the leader holds the lease")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// The follower takes over the lease once the leader releases it.
+	cancelLeader()
+	require.NoError(t, <-leaderDone)
+
+	select {
+	case <-followerChecks:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the follower didn't take over the lease")
+	}
+
+	cancelFollower()
+	require.NoError(t, <-followerDone)
+}
@@ -18,36 +18,47 @@ package bicep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	reflect "reflect"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armdeployments"
 	"github.com/go-logr/logr"
+	"github.com/opencontainers/go-digest"
 	"golang.org/x/sync/errgroup"
 	"oras.land/oras-go/v2/registry/remote"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/signature"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/util"
 	"github.com/radius-project/radius/pkg/rp/util/authclient"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 const (
+	// logSource is the source of the Bicep entries in the logs of the operation.
+	logSource = "bicep"
+
 	deploymentPrefix = "recipe"
 	pollFrequency    = time.Second * 5
 	recipeParameters = "parameters"
@@ -68,6 +79,19 @@ func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.Reso
 type BicepOptions struct {
 	DeleteRetryCount        int
 	DeleteRetryDelaySeconds int
+
+	// Cache is the optional cache of the recipe layers downloaded from container registries.
+	Cache *cache.Cache
+
+	// DeploymentOperationsClient is the optional client used to list the operations of recipe deployments. The
+	// operations are recorded in the logs of the async operation executing the recipe.
+	DeploymentOperationsClient DeploymentOperationsClient
+}
+
+// DeploymentOperationsClient lists the operations of a deployment.
+type DeploymentOperationsClient interface {
+	// List lists the operations of the deployment with the given resource ID.
+	List(ctx context.Context, resourceGroupName string, deploymentName string, resourceID string, apiVersion string, top *int32) (*armdeployments.DeploymentOperationsListResult, error)
 }
 
 type bicepDriver struct {
@@ -87,74 +111,15 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts.BaseOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	registryClient := d.RegistryClient
-	// Get ORAS authentication client if secrets are found for the registry.
-	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
-		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
-		if err != nil {
-			return nil, err
-		}
-
-		registryClient = authClient
-	}
-
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	//update the recipe context with connected resources properties
-	recipeContext.Resource.Connections = opts.Recipe.ConnectedResourcesProperties
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
 	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
-	}
-
 	poller, err := d.DeploymentClient.CreateOrUpdate(
 		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armdeployments.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
+		deployment,
 		deploymentID.String(),
 		clients.DeploymentsClientAPIVersion,
 	)
@@ -164,6 +129,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	}
 
 	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	d.recordDeploymentOperations(ctx, deploymentID)
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
@@ -197,6 +163,35 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	return recipeResponse, nil
 }
 
+// Plan fetches recipe contents from container registry and runs a what-if for the recipe deployment using the UCP
+// deployment client. The predicted changes are returned along with the deletion of previously deployed output resources
+// that are no longer part of the recipe, mirroring the garbage collection done by Execute. When the deployment engine
+// does not serve what-if requests the returned error has the RecipePlanNotSupported code.
+func (d *bicepDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts.BaseOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("running what-if of bicep template for recipe", "deploymentID", deploymentID)
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if errors.Is(err, clients.ErrWhatIfNotSupported) {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanNotSupported, fmt.Sprintf("recipe %s of type %s cannot be planned: %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType, clients.ErrWhatIfNotSupported.Error()), recipes_util.ExecutionError, nil)
+	} else if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return d.preparePlanResponse(resp.WhatIfOperationResult, opts.PrevState)
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -292,7 +287,7 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOpt
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient, d.layerCache())
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +404,145 @@ func (d *bicepDriver) prepareRecipeResponse(templatePath string, outputs any, re
 	return recipeResponse, nil
 }
 
+// prepareDeployment fetches the recipe contents from the container registry and builds the deployment for the recipe,
+// including the recipe context, the recipe parameters and the provider config. It returns the ID to deploy it to.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts driver.BaseOptions) (resources.ID, clients.Deployment, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, err
+	}
+
+	registryClient := d.RegistryClient
+	// Get ORAS authentication client if secrets are found for the registry.
+	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
+		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
+		if err != nil {
+			return resources.ID{}, clients.Deployment{}, err
+		}
+
+		registryClient = authClient
+	}
+
+	// Recipes with trusted keys are deployed from the digest of the artifact whose signature was verified.
+	definition := opts.Definition
+	if len(definition.TrustedKeys) > 0 {
+		definition.TemplatePath, err = verifySignature(ctx, definition, registryClient)
+		if err != nil {
+			return resources.ID{}, clients.Deployment{}, err
+		}
+	}
+
+	err = util.ReadFromRegistry(ctx, definition, &recipeData, registryClient, d.layerCache())
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	//update the recipe context with connected resources properties
+	recipeContext.Resource.Connections = opts.Recipe.ConnectedResourcesProperties
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	return deploymentID, clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armdeployments.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}, nil
+}
+
+// preparePlanResponse converts the result of a what-if operation into a RecipePlan. Resources without changes are not
+// included. The output resources in previous that are not part of the what-if result are planned for deletion because
+// Execute garbage collects them.
+func (d *bicepDriver) preparePlanResponse(result armdeployments.WhatIfOperationResult, previous []string) (*recipes.RecipePlan, error) {
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+
+	current := []string{}
+	if result.Properties != nil {
+		for _, change := range result.Properties.Changes {
+			if change == nil || change.ChangeType == nil || change.ResourceID == nil {
+				continue
+			}
+
+			var action recipes.PlanAction
+			switch *change.ChangeType {
+			case armdeployments.ChangeTypeCreate:
+				action = recipes.PlanActionCreate
+			case armdeployments.ChangeTypeModify:
+				action = recipes.PlanActionUpdate
+			case armdeployments.ChangeTypeDelete:
+				action = recipes.PlanActionDelete
+			}
+
+			if action != recipes.PlanActionDelete {
+				current = append(current, *change.ResourceID)
+			}
+			if action == "" {
+				// NoChange, Ignore and Unsupported do not change the output resources. Deploy is reported when the
+				// provider cannot predict the changes of a redeployment, so it is not evidence of a change either.
+				continue
+			}
+
+			plan.Changes = append(plan.Changes, newPlannedResourceChange(action, *change.ResourceID))
+		}
+	}
+
+	gc, err := d.getGCOutputResources(current, previous)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range gc {
+		plan.Changes = append(plan.Changes, newPlannedResourceChange(recipes.PlanActionDelete, resource.ID.String()))
+	}
+
+	return plan, nil
+}
+
+// newPlannedResourceChange creates a PlannedResourceChange for the resource with the given ID.
+func newPlannedResourceChange(action recipes.PlanAction, id string) recipes.PlannedResourceChange {
+	change := recipes.PlannedResourceChange{Action: action, ID: id}
+	if parsed, err := resources.Parse(id); err == nil {
+		change.ResourceType = parsed.Type()
+		change.Name = parsed.Name()
+	}
+
+	return change
+}
+
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
 func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
@@ -445,6 +579,88 @@ func (d *bicepDriver) FindSecretIDs(ctx context.Context, envConfig recipes.Confi
 	return secretStoreIDResourceKeys, err
 }
 
+// recordDeploymentOperations records the operations of the recipe deployment in the logs of the async operation
+// executing the recipe, so that the failure of a recipe can be investigated. Failing to list the operations does
+// not fail the recipe.
+func (d *bicepDriver) recordDeploymentOperations(ctx context.Context, deploymentID resources.ID) {
+	recorder := operationlog.FromContext(ctx)
+	if recorder == nil || d.options.DeploymentOperationsClient == nil {
+		return
+	}
+
+	ops, err := d.options.DeploymentOperationsClient.List(ctx, deploymentID.FindScope(resources_radius.ScopeResourceGroups), deploymentID.Name(), deploymentID.String(), clients.DeploymentOperationsClientAPIVersion, nil)
+	if err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "failed to list the operations of the recipe deployment", "deploymentID", deploymentID.String())
+		return
+	}
+
+	operations := slices.Clone(ops.Value)
+	slices.SortStableFunc(operations, func(a, b *armdeployments.DeploymentOperation) int {
+		return operationTimestamp(a).Compare(operationTimestamp(b))
+	})
+
+	for _, op := range operations {
+		level, message := formatDeploymentOperation(op)
+		if message != "" {
+			recorder.Record(logSource, level, message)
+		}
+	}
+}
+
+// operationTimestamp returns the time of a deployment operation, or the zero time if it is unknown.
+func operationTimestamp(op *armdeployments.DeploymentOperation) time.Time {
+	if op == nil || op.Properties == nil || op.Properties.Timestamp == nil {
+		return time.Time{}
+	}
+	return *op.Properties.Timestamp
+}
+
+// formatDeploymentOperation returns the level and the log message of a deployment operation, or an empty message if
+// the operation has no target resource.
+func formatDeploymentOperation(op *armdeployments.DeploymentOperation) (string, string) {
+	if op == nil || op.Properties == nil || op.Properties.TargetResource == nil {
+		return "", ""
+	}
+
+	props := op.Properties
+	target := to.String(props.TargetResource.ID)
+	if props.TargetResource.ResourceType != nil && props.TargetResource.ResourceName != nil {
+		target = *props.TargetResource.ResourceType + "/" + *props.TargetResource.ResourceName
+	}
+
+	operation := ""
+	if props.ProvisioningOperation != nil {
+		operation = string(*props.ProvisioningOperation) + " "
+	}
+
+	level := v1.LogLevelInfo
+	message := fmt.Sprintf("%s%s: %s", operation, target, to.String(props.ProvisioningState))
+	if props.StatusMessage != nil && props.StatusMessage.Error != nil {
+		level = v1.LogLevelError
+		message += fmt.Sprintf(" (%s: %s)", to.String(props.StatusMessage.Error.Code), to.String(props.StatusMessage.Error.Message))
+	} else if strings.EqualFold(to.String(props.ProvisioningState), string(v1.ProvisioningStateFailed)) {
+		level = v1.LogLevelError
+	}
+
+	return level, message
+}
+
+// verifySignature verifies that the recipe artifact is signed by one of the trusted keys of the recipe. It returns the
+// template path pinned to the digest of the verified artifact.
+func verifySignature(ctx context.Context, definition recipes.EnvironmentDefinition, client remote.Client) (string, error) {
+	verifier, err := signature.NewVerifier(definition.TrustedKeys)
+	if err != nil {
+		return "", recipes.NewRecipeError(recipes.RecipeSignatureVerificationFailed, fmt.Sprintf("failed to verify the signature of recipe %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+	}
+
+	pinned, err := verifier.VerifyArtifact(ctx, definition.TemplatePath, definition.PlainHTTP, client)
+	if err != nil {
+		return "", recipes.NewRecipeError(recipes.RecipeSignatureVerificationFailed, fmt.Sprintf("failed to verify the signature of recipe %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+	}
+
+	return pinned, nil
+}
+
 func getRegistryAuthClient(ctx context.Context, secrets recipes.SecretData, templatePath string) (remote.Client, error) {
 	newRegistryClient, err := authclient.GetNewRegistryAuthClient(secrets)
 	if err != nil {
@@ -453,3 +669,29 @@ func getRegistryAuthClient(ctx context.Context, secrets recipes.SecretData, temp
 
 	return newRegistryClient.GetAuthClient(ctx, templatePath)
 }
+
+// layerCache returns the cache of the recipe layers, or nil if caching is disabled.
+func (d *bicepDriver) layerCache() util.LayerCache {
+	if d.options.Cache == nil {
+		return nil
+	}
+
+	return &ociLayerCache{cache: d.options.Cache}
+}
+
+var _ util.LayerCache = (*ociLayerCache)(nil)
+
+// ociLayerCache stores the recipe layers read from registries in the recipe artifact cache.
+type ociLayerCache struct {
+	cache *cache.Cache
+}
+
+// Get returns the content of the layer with the given digest if it is in the cache.
+func (c *ociLayerCache) Get(ctx context.Context, d digest.Digest) ([]byte, bool) {
+	return c.cache.Get(ctx, cache.ArtifactOCILayer, d)
+}
+
+// Put adds the content of the layer with the given digest to the cache.
+func (c *ociLayerCache) Put(ctx context.Context, d digest.Digest, data []byte) error {
+	return c.cache.Put(ctx, cache.ArtifactOCILayer, d, data)
+}
@@ -17,20 +17,32 @@ limitations under the License.
 package bicep
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armdeployments"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
 	corerp_datamodel "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/util/registrytest"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/test/testcontext"
@@ -486,6 +498,41 @@ func Test_Bicep_GetRecipeMetadata_Success(t *testing.T) {
 	require.Equal(t, expectedOutput, recipeData["parameters"])
 }
 
+func Test_Bicep_GetRecipeMetadata_Cache(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	artifactCache, err := cache.New(cache.Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	ctx := testcontext.New(t)
+	driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client(), options: BicepOptions{Cache: artifactCache}}
+	recipeDefinition := recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: ts.TestImageURL,
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+
+	expectedOutput := map[string]any{
+		"documentdbName": map[string]any{"type": "string"},
+		"location":       map[string]any{"defaultValue": "[resourceGroup().location]", "type": "string"},
+	}
+
+	recipeData, err := driverBicep.GetRecipeMetadata(ctx, driver.BaseOptions{Definition: recipeDefinition})
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput, recipeData["parameters"])
+
+	size := artifactCache.Size()
+	require.NotZero(t, size)
+
+	// The layer is read from the cache.
+	recipeData, err = driverBicep.GetRecipeMetadata(ctx, driver.BaseOptions{Definition: recipeDefinition})
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput, recipeData["parameters"])
+	require.Equal(t, size, artifactCache.Size())
+}
+
 func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	ts := registrytest.NewFakeRegistryServer(t)
 	t.Cleanup(ts.CloseServer)
@@ -514,6 +561,91 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	require.Equal(t, actualErr, &expErr)
 }
 
+func Test_Bicep_Execute_UnsignedRecipe(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	// The recipe isn't deployed, so the deployment client isn't called.
+	ctx := testcontext.New(t)
+	driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+	_, err = driverBicep.Execute(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "mongo-azure",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: ts.TestImageURL,
+				ResourceType: "Applications.Datastores/mongoDatabases",
+				TrustedKeys:  []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+			},
+		},
+	})
+
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeSignatureVerificationFailed, recipeError.ErrorDetails.Code)
+	require.Equal(t, fmt.Sprintf("failed to verify the signature of recipe %q: recipe is not signed", ts.TestImageURL), recipeError.ErrorDetails.Message)
+	require.Equal(t, recipes_util.RecipeSetupError, recipeError.DeploymentStatus)
+}
+
+func Test_Bicep_PreparePlanResponse(t *testing.T) {
+	d := &bicepDriver{}
+	created := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/created"
+	modified := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/modified"
+	unchanged := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/unchanged"
+	obsolete := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/obsolete"
+
+	result := armdeployments.WhatIfOperationResult{
+		Properties: &armdeployments.WhatIfOperationProperties{
+			Changes: []*armdeployments.WhatIfChange{
+				{ChangeType: to.Ptr(armdeployments.ChangeTypeCreate), ResourceID: to.Ptr(created)},
+				{ChangeType: to.Ptr(armdeployments.ChangeTypeModify), ResourceID: to.Ptr(modified)},
+				{ChangeType: to.Ptr(armdeployments.ChangeTypeNoChange), ResourceID: to.Ptr(unchanged)},
+			},
+		},
+	}
+
+	plan, err := d.preparePlanResponse(result, []string{modified, unchanged, obsolete})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.PlannedResourceChange{
+			{Action: recipes.PlanActionCreate, ResourceType: "Microsoft.Cache/redis", Name: "created", ID: created},
+			{Action: recipes.PlanActionUpdate, ResourceType: "Microsoft.Cache/redis", Name: "modified", ID: modified},
+			{Action: recipes.PlanActionDelete, ResourceType: "Microsoft.Storage/storageAccounts", Name: "obsolete", ID: obsolete},
+		},
+	}, plan)
+}
+
+func Test_Bicep_PreparePlanResponse_NoChanges(t *testing.T) {
+	d := &bicepDriver{}
+
+	plan, err := d.preparePlanResponse(armdeployments.WhatIfOperationResult{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+}
+
+func Test_Bicep_PreparePlanResponse_DeployOnly(t *testing.T) {
+	d := &bicepDriver{}
+	redeployed := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redeployed"
+
+	result := armdeployments.WhatIfOperationResult{
+		Properties: &armdeployments.WhatIfOperationProperties{
+			Changes: []*armdeployments.WhatIfChange{
+				{ChangeType: to.Ptr(armdeployments.ChangeTypeDeploy), ResourceID: to.Ptr(redeployed)},
+			},
+		},
+	}
+
+	// An empty plan is reported as InSync by drift detection.
+	plan, err := d.preparePlanResponse(result, []string{redeployed})
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+}
+
 func Test_GetGCOutputResources(t *testing.T) {
 	d := &bicepDriver{}
 	before := []string{
@@ -586,3 +718,86 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+type fakeDeploymentOperationsClient struct {
+	resourceID string
+	result     *armdeployments.DeploymentOperationsListResult
+	err        error
+}
+
+func (c *fakeDeploymentOperationsClient) List(ctx context.Context, resourceGroupName string, deploymentName string, resourceID string, apiVersion string, top *int32) (*armdeployments.DeploymentOperationsListResult, error) {
+	c.resourceID = resourceID
+	return c.result, c.err
+}
+
+func Test_Bicep_RecordDeploymentOperations(t *testing.T) {
+	deploymentID := resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe123")
+	now := time.Now()
+
+	client := &fakeDeploymentOperationsClient{
+		result: &armdeployments.DeploymentOperationsListResult{
+			Value: []*armdeployments.DeploymentOperation{
+				{
+					Properties: &armdeployments.DeploymentOperationProperties{
+						ProvisioningOperation: to.Ptr(armdeployments.ProvisioningOperationCreate),
+						ProvisioningState:     new("Failed"),
+						Timestamp:             new(now),
+						TargetResource: &armdeployments.TargetResource{
+							ResourceType: new("apps/Deployment"),
+							ResourceName: new("redis"),
+						},
+						StatusMessage: &armdeployments.StatusMessage{
+							Error: &armdeployments.ErrorResponse{Code: new("Conflict"), Message: new("deployment already exists")},
+						},
+					},
+				},
+				{
+					Properties: &armdeployments.DeploymentOperationProperties{
+						ProvisioningOperation: to.Ptr(armdeployments.ProvisioningOperationCreate),
+						ProvisioningState:     new("Succeeded"),
+						Timestamp:             new(now.Add(-time.Minute)),
+						TargetResource: &armdeployments.TargetResource{
+							ID: new("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"),
+						},
+					},
+				},
+				{
+					// Operations without target resource, such as the end of the deployment, are not recorded.
+					Properties: &armdeployments.DeploymentOperationProperties{
+						ProvisioningOperation: to.Ptr(armdeployments.ProvisioningOperationEvaluateDeploymentOutput),
+						ProvisioningState:     new("Succeeded"),
+					},
+				},
+			},
+		},
+	}
+
+	d := &bicepDriver{options: BicepOptions{DeploymentOperationsClient: client}}
+
+	t.Run("without recorder", func(t *testing.T) {
+		client.resourceID = ""
+		d.recordDeploymentOperations(testcontext.New(t), deploymentID)
+		require.Empty(t, client.resourceID)
+	})
+
+	t.Run("with recorder", func(t *testing.T) {
+		recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+		d.recordDeploymentOperations(operationlog.WithRecorder(testcontext.New(t), recorder), deploymentID)
+		require.Equal(t, deploymentID.String(), client.resourceID)
+
+		logs := recorder.Logs()
+		require.Len(t, logs.Value, 2)
+		require.Equal(t, "bicep", logs.Value[0].Source)
+		require.Equal(t, v1.LogLevelInfo, logs.Value[0].Level)
+		require.Equal(t, "Create /planes/kubernetes/local/namespaces/default/providers/core/Service/redis: Succeeded", logs.Value[0].Message)
+		require.Equal(t, v1.LogLevelError, logs.Value[1].Level)
+		require.Equal(t, "Create apps/Deployment/redis: Failed (Conflict: deployment already exists)", logs.Value[1].Message)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		d := &bicepDriver{options: BicepOptions{DeploymentOperationsClient: &fakeDeploymentOperationsClient{err: errors.New("list error")}}}
+		recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+		d.recordDeploymentOperations(operationlog.WithRecorder(testcontext.New(t), recorder), deploymentID)
+		require.Nil(t, recorder.Logs())
+	})
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"helm.sh/helm/v4/pkg/action"
+	chart "helm.sh/helm/v4/pkg/chart/v2"
+	"helm.sh/helm/v4/pkg/chart/v2/loader"
+	"helm.sh/helm/v4/pkg/cli"
+	"helm.sh/helm/v4/pkg/registry"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/rp/util/authclient"
+)
+
+// chartReference is a reference to a chart in an OCI registry or an HTTP chart repository.
+type chartReference struct {
+	// RepoURL is the URL of the HTTP chart repository. It is empty for charts in OCI registries.
+	RepoURL string
+
+	// Chart is the name of the chart in an HTTP chart repository, or the OCI reference of the chart without its tag.
+	Chart string
+
+	// Version is the version of the chart. The latest version is used if it is empty.
+	Version string
+}
+
+// parseChartReference parses the template path of a Helm recipe. Charts are referenced as
+// `oci://<registry>/<repository>/<chart>[:<version>]` for OCI registries and as
+// `https://<repository URL>/<chart>[:<version>]` for HTTP chart repositories. The template version, if set, overrides
+// the version in the template path.
+func parseChartReference(templatePath string, templateVersion string) (chartReference, error) {
+	scheme, _, ok := strings.Cut(templatePath, "://")
+	if !ok || (scheme != "oci" && scheme != "http" && scheme != "https") {
+		return chartReference{}, fmt.Errorf("invalid Helm chart reference %q: must start with oci://, http:// or https://", templatePath)
+	}
+
+	index := strings.LastIndex(templatePath, "/")
+	base, name := templatePath[:index], templatePath[index+1:]
+	if strings.HasSuffix(base, ":/") {
+		return chartReference{}, fmt.Errorf("invalid Helm chart reference %q: must include a chart name", templatePath)
+	}
+
+	name, version, _ := strings.Cut(name, ":")
+	if name == "" {
+		return chartReference{}, fmt.Errorf("invalid Helm chart reference %q: must include a chart name", templatePath)
+	}
+
+	if templateVersion != "" {
+		version = templateVersion
+	}
+
+	if scheme == "oci" {
+		return chartReference{Chart: base + "/" + name, Version: version}, nil
+	}
+
+	return chartReference{RepoURL: base, Chart: name, Version: version}, nil
+}
+
+// String returns the chart reference in the format of a template path.
+func (r chartReference) String() string {
+	reference := r.Chart
+	if r.RepoURL != "" {
+		reference = r.RepoURL + "/" + r.Chart
+	}
+
+	if r.Version != "" {
+		reference += ":" + r.Version
+	}
+
+	return reference
+}
+
+// pullOptions configures the download of a chart.
+type pullOptions struct {
+	// PlainHTTP is true if the OCI registry of the chart is accessed over HTTP.
+	PlainHTTP bool
+
+	// Credential returns the credential of the registry or repository of the chart. Charts are downloaded anonymously
+	// when it is nil.
+	Credential auth.CredentialFunc
+}
+
+// chartCredential returns the credential of the registry or repository of the chart of the recipe, from the registry
+// authentication of the environment keyed by the hostname of the template path, or nil if there is none. The secrets
+// of the registry authentication are loaded by the engine through FindSecretIDs, as for Bicep recipes.
+func chartCredential(ctx context.Context, opts driver.BaseOptions) (auth.CredentialFunc, error) {
+	path := registryPath(opts.Definition.TemplatePath)
+	secrets, err := util.GetRegistrySecrets(opts.Configuration, path, opts.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(secrets, recipes.SecretData{}) {
+		return nil, nil
+	}
+
+	authClient, err := authclient.GetNewRegistryAuthClient(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := authClient.GetAuthClient(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	orasClient, ok := client.(*auth.Client)
+	if !ok {
+		return nil, fmt.Errorf("unsupported registry authentication client %T", client)
+	}
+
+	return orasClient.Credential, nil
+}
+
+// registryPath returns the template path of a Helm recipe without its scheme, so that its hostname can be looked up in
+// the registry authentication of the environment.
+func registryPath(templatePath string) string {
+	if _, path, ok := strings.Cut(templatePath, "://"); ok {
+		return path
+	}
+
+	return templatePath
+}
+
+// pullChart downloads the chart from its registry or repository and loads it. The download is abandoned when ctx is
+// done.
+func pullChart(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error) {
+	type result struct {
+		chart *chart.Chart
+		err   error
+	}
+
+	// Helm does not accept a context for downloads from HTTP chart repositories, so the download runs in the
+	// background and is abandoned when ctx is done. Requests to OCI registries are cancelled with ctx.
+	done := make(chan result, 1)
+	go func() {
+		c, err := downloadChart(ctx, reference, options)
+		done <- result{chart: c, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.chart, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to download Helm chart %q: %w", reference.String(), ctx.Err())
+	}
+}
+
+// downloadChart downloads the chart to a temporary directory and loads it.
+func downloadChart(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error) {
+	dir, err := os.MkdirTemp("", "helm-recipe-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a directory for the chart: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Keep the repository index and cache out of the home directory, which may not be writable.
+	settings := cli.New()
+	settings.RepositoryConfig = filepath.Join(dir, "repositories.yaml")
+	settings.RepositoryCache = filepath.Join(dir, "repository")
+	settings.ContentCache = filepath.Join(dir, "content")
+
+	httpClient := &http.Client{Transport: &contextTransport{ctx: ctx, base: registry.NewTransport(false)}}
+	registryOptions := []registry.ClientOption{registry.ClientOptHTTPClient(httpClient)}
+	if options.PlainHTTP {
+		registryOptions = append(registryOptions, registry.ClientOptPlainHTTP())
+	}
+	if options.Credential != nil && reference.RepoURL == "" {
+		registryOptions = append(registryOptions, registry.ClientOptAuthorizer(auth.Client{
+			Client:     httpClient,
+			Credential: options.Credential,
+			Cache:      auth.NewCache(),
+		}))
+	}
+	registryClient, err := registry.NewClient(registryOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the registry client: %w", err)
+	}
+
+	configuration := action.NewConfiguration()
+	configuration.RegistryClient = registryClient
+
+	chartsDir := filepath.Join(dir, "charts")
+	if err := os.Mkdir(chartsDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create a directory for the chart: %w", err)
+	}
+
+	pull := action.NewPull(action.WithConfig(configuration))
+	pull.Settings = settings
+	pull.DestDir = chartsDir
+	pull.RepoURL = reference.RepoURL
+	pull.Version = reference.Version
+	pull.PlainHTTP = options.PlainHTTP
+
+	// HTTP chart repositories only support basic authentication.
+	if options.Credential != nil && reference.RepoURL != "" {
+		repoURL, err := url.Parse(reference.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Helm chart repository URL %q: %w", reference.RepoURL, err)
+		}
+
+		credential, err := options.Credential(ctx, repoURL.Host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the credential of Helm chart repository %q: %w", reference.RepoURL, err)
+		}
+		if credential.Username == "" || credential.Password == "" {
+			return nil, fmt.Errorf("Helm chart repository %q only supports basic authentication", reference.RepoURL)
+		}
+
+		pull.Username = credential.Username
+		pull.Password = credential.Password
+	}
+
+	if _, err := pull.Run(reference.Chart); err != nil {
+		return nil, fmt.Errorf("failed to download Helm chart %q: %w", reference.String(), err)
+	}
+
+	archives, err := filepath.Glob(filepath.Join(chartsDir, "*.tgz"))
+	if err != nil {
+		return nil, err
+	} else if len(archives) != 1 {
+		return nil, errors.New("failed to find the downloaded Helm chart")
+	}
+
+	return loader.Load(archives[0])
+}
+
+// contextTransport sends requests with a context, so that they are cancelled when it is done.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
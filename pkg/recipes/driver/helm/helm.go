@@ -0,0 +1,534 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v4/pkg/action"
+	chart "helm.sh/helm/v4/pkg/chart/v2"
+	"helm.sh/helm/v4/pkg/kube"
+	ri "helm.sh/helm/v4/pkg/release"
+	rcommon "helm.sh/helm/v4/pkg/release/common"
+	releasev1 "helm.sh/helm/v4/pkg/release/v1"
+	helmdriver "helm.sh/helm/v4/pkg/storage/driver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultTimeout is the default time to wait for the resources of a release to become ready or to be deleted.
+	DefaultTimeout = 10 * time.Minute
+
+	// contextValuesKey is the key of the recipe context in the values of the chart.
+	contextValuesKey = "context"
+
+	// maxReleaseNameLength is the maximum length of a Helm release name.
+	maxReleaseNameLength = 53
+
+	// helmStorageDriver is the Helm storage driver used to store releases, in Secrets of the release namespace.
+	helmStorageDriver = "secret"
+)
+
+var (
+	_ driver.DriverWithSecrets = (*helmDriver)(nil)
+
+	invalidReleaseNameChars = regexp.MustCompile("[^a-z0-9-]+")
+)
+
+// HelmOptions is the configuration of the Helm driver.
+type HelmOptions struct {
+	// Timeout is the time to wait for the resources of a release to become ready or to be deleted. Defaults to
+	// DefaultTimeout.
+	Timeout time.Duration
+}
+
+// NewHelmDriver creates a recipe driver that deploys Helm charts. Charts are installed as a release in the Kubernetes
+// namespace of the environment, with the recipe parameters and the recipe context, under the "context" key, as values.
+func NewHelmDriver(kubernetesProvider *kubernetesclientprovider.KubernetesClientProvider, options HelmOptions) driver.Driver {
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	return &helmDriver{
+		kubernetesProvider: kubernetesProvider,
+		options:            options,
+		loadChart:          pullChart,
+		newConfiguration: func(namespace string) (*action.Configuration, error) {
+			configuration := action.NewConfiguration()
+			getter := &restClientGetter{config: kubernetesProvider.Config(), namespace: namespace}
+			if err := configuration.Init(getter, namespace, helmStorageDriver); err != nil {
+				return nil, err
+			}
+
+			return configuration, nil
+		},
+	}
+}
+
+type helmDriver struct {
+	kubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
+	options            HelmOptions
+
+	// loadChart downloads and loads a chart. It can be overridden for testing.
+	loadChart func(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error)
+
+	// newConfiguration creates the Helm configuration for the releases of a namespace. It can be overridden for testing.
+	newConfiguration func(namespace string) (*action.Configuration, error)
+}
+
+// Execute installs or upgrades the release of the recipe and returns the objects of the release and the output of the
+// chart. Objects that are no longer part of the chart are deleted by the upgrade. A release left pending by an
+// interrupted deployment is marked as failed so that it can be upgraded.
+func (d *helmDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	release, err := d.newRelease(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.pullChart(ctx, opts.BaseOptions, release.Chart)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	configuration, err := d.newConfiguration(release.Namespace)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	last, err := lastRelease(configuration, release.Name)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	if err := recoverPendingRelease(ctx, configuration, last); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	var deployed ri.Releaser
+	if last != nil {
+		logger.Info(fmt.Sprintf("Upgrading Helm release %q in namespace %q", release.Name, release.Namespace))
+		upgrade := action.NewUpgrade(configuration)
+		upgrade.Namespace = release.Namespace
+		upgrade.Timeout = d.options.Timeout
+		upgrade.WaitStrategy = kube.StatusWatcherStrategy
+		upgrade.ResetValues = true
+		deployed, err = upgrade.RunWithContext(ctx, release.Name, helmChart, release.Values)
+	} else {
+		logger.Info(fmt.Sprintf("Installing Helm release %q in namespace %q", release.Name, release.Namespace))
+		install := action.NewInstall(configuration)
+		install.ReleaseName = release.Name
+		install.Namespace = release.Namespace
+		install.CreateNamespace = true
+		install.Timeout = d.options.Timeout
+		install.WaitStrategy = kube.StatusWatcherStrategy
+		deployed, err = install.RunWithContext(ctx, helmChart, release.Values)
+	}
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy Helm release %q: %s", release.Name, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := d.releaseObjects(deployed)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	output, err := prepareRecipeResponse(objects)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	output.Status = &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    opts.Definition.TemplatePath,
+		TemplateVersion: opts.Definition.TemplateVersion,
+	}
+
+	return output, nil
+}
+
+// FindSecretIDs returns the ID of the secret store of the registry authentication of the environment for the
+// hostname of the chart, if there is one. Its secrets are used to download the chart.
+func (d *helmDriver) FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (map[string][]string, error) {
+	secretIDs := map[string][]string{}
+
+	parsed, err := url.Parse("https://" + registryPath(definition.TemplatePath))
+	if err != nil {
+		return nil, err
+	}
+
+	if auth, ok := config.RecipeConfig.Bicep.Authentication[parsed.Host]; ok && auth.Secret != "" {
+		secretIDs[auth.Secret] = []string{}
+	}
+
+	return secretIDs, nil
+}
+
+// pullChart downloads and loads the chart of the recipe with the credential of its registry or repository.
+func (d *helmDriver) pullChart(ctx context.Context, opts driver.BaseOptions, reference chartReference) (*chart.Chart, error) {
+	credential, err := chartCredential(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to the registry of Helm chart %q: %w", reference.String(), err)
+	}
+
+	return d.loadChart(ctx, reference, pullOptions{PlainHTTP: opts.Definition.PlainHTTP, Credential: credential})
+}
+
+// Delete uninstalls the release of the recipe. A release that does not exist is ignored.
+func (d *helmDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	namespace, err := releaseNamespace(recipeContext)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	configuration, err := d.newConfiguration(namespace)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	name := releaseName(opts.Recipe.ResourceID)
+	logger.Info(fmt.Sprintf("Uninstalling Helm release %q in namespace %q", name, namespace))
+
+	uninstall := action.NewUninstall(configuration)
+	uninstall.IgnoreNotFound = true
+	uninstall.Timeout = d.options.Timeout
+	uninstall.WaitStrategy = kube.StatusWatcherStrategy
+	if _, err := uninstall.Run(name); err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, fmt.Sprintf("failed to uninstall Helm release %q: %s", name, err.Error()), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the top-level values of the chart as the parameters of the recipe.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	reference, err := parseChartReference(opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.pullChart(ctx, opts, reference)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters := map[string]any{}
+	for name, value := range helmChart.Values {
+		if name == contextValuesKey {
+			continue
+		}
+
+		parameters[name] = map[string]any{
+			"type":         valueType(value),
+			"defaultValue": value,
+		}
+	}
+
+	return map[string]any{"parameters": parameters}, nil
+}
+
+// Plan renders the chart and compares its objects with the objects in the cluster. An object is updated if one of
+// the fields set by the chart has a different value in the cluster. Objects of the previous deployment that are no
+// longer part of the chart are deleted.
+func (d *helmDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	release, err := d.newRelease(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	helmChart, err := d.pullChart(ctx, opts.BaseOptions, release.Chart)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	configuration, err := d.newConfiguration(release.Namespace)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Render the chart without contacting the cluster.
+	install := action.NewInstall(configuration)
+	install.ReleaseName = release.Name
+	install.Namespace = release.Namespace
+	install.DryRunStrategy = action.DryRunClient
+	rendered, err := install.RunWithContext(ctx, helmChart, release.Values)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to render Helm chart %q: %s", release.Chart.String(), err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := d.releaseObjects(rendered)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	plan, err := d.preparePlanResponse(ctx, objects, opts.PrevState)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return plan, nil
+}
+
+// preparePlanResponse compares the rendered objects with the objects in the cluster.
+func (d *helmDriver) preparePlanResponse(ctx context.Context, objects []*unstructured.Unstructured, prevState []string) (*recipes.RecipePlan, error) {
+	kubeClient, err := d.kubernetesProvider.RuntimeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+	rendered := map[string]bool{}
+	for _, obj := range objects {
+		id := objectID(obj)
+		rendered[strings.ToLower(id)] = true
+		resourceType := resources_kubernetes.ResourceTypeFromGVK(obj.GroupVersionKind())
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		err := kubeClient.Get(ctx, runtimeclient.ObjectKeyFromObject(obj), live)
+		if apierrors.IsNotFound(err) {
+			plan.Changes = append(plan.Changes, recipes.PlannedResourceChange{Action: recipes.PlanActionCreate, ResourceType: resourceType, ID: id})
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		if !kubeutil.IsSubset(obj.Object, live.Object) {
+			plan.Changes = append(plan.Changes, recipes.PlannedResourceChange{Action: recipes.PlanActionUpdate, ResourceType: resourceType, ID: id})
+		}
+	}
+
+	for _, id := range prevState {
+		if rendered[strings.ToLower(id)] {
+			continue
+		}
+
+		parsed, err := resources.ParseResource(id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only Kubernetes objects are part of the release. Other output resources are listed by the output ConfigMap
+		// of the chart and are not managed by Helm.
+		if !strings.EqualFold(parsed.PlaneNamespace(), resources_kubernetes.PlaneTypeKubernetes+"/"+resources_kubernetes.PlaneNameTODO) {
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, recipes.PlannedResourceChange{Action: recipes.PlanActionDelete, ResourceType: parsed.Type(), ID: id})
+	}
+
+	return plan, nil
+}
+
+// helmRelease describes the release of a recipe.
+type helmRelease struct {
+	// Name is the name of the release.
+	Name string
+
+	// Namespace is the namespace of the release.
+	Namespace string
+
+	// Chart is the reference to the chart of the release.
+	Chart chartReference
+
+	// Values are the values of the release.
+	Values map[string]any
+}
+
+// newRelease describes the release of the recipe, which is installed in the Kubernetes namespace of the resource.
+func (d *helmDriver) newRelease(opts driver.BaseOptions) (*helmRelease, error) {
+	reference, err := parseChartReference(opts.Definition.TemplatePath, opts.Definition.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := releaseNamespace(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := releaseValues(opts, recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &helmRelease{
+		Name:      releaseName(opts.Recipe.ResourceID),
+		Namespace: namespace,
+		Chart:     reference,
+		Values:    values,
+	}, nil
+}
+
+// releaseObjects returns the objects of a release, excluding hooks.
+func (d *helmDriver) releaseObjects(r ri.Releaser) ([]*unstructured.Unstructured, error) {
+	release, ok := r.(*releasev1.Release)
+	if !ok {
+		return nil, fmt.Errorf("unexpected release type %T returned by Helm", r)
+	}
+
+	kubeClient, err := d.kubernetesProvider.RuntimeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseManifest(kubeClient, release.Manifest, release.Namespace)
+}
+
+// lastRelease returns the last revision of the release, or nil if the release has not been installed.
+func lastRelease(configuration *action.Configuration, name string) (*releasev1.Release, error) {
+	last, err := configuration.Releases.Last(name)
+	if errors.Is(err, helmdriver.ErrReleaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the last revision of Helm release %q: %w", name, err)
+	}
+
+	release, ok := last.(*releasev1.Release)
+	if !ok {
+		return nil, fmt.Errorf("unexpected release type %T returned by Helm", last)
+	}
+
+	return release, nil
+}
+
+// recoverPendingRelease marks the revision of the release as failed if it is still pending. Helm refuses to upgrade a
+// release with a pending revision. Deployments of a resource don't run concurrently, so a pending revision was left by
+// a deployment that was interrupted, for example by a restart, and would otherwise block the release forever. Helm
+// upgrades failed revisions, from the last deployed revision if there is one.
+func recoverPendingRelease(ctx context.Context, configuration *action.Configuration, release *releasev1.Release) error {
+	if release == nil || release.Info == nil || !release.Info.Status.IsPending() {
+		return nil
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Marking interrupted revision %d of Helm release %q in namespace %q as failed", release.Version, release.Name, release.Namespace), "status", release.Info.Status.String())
+	release.SetStatus(rcommon.StatusFailed, fmt.Sprintf("Interrupted while %s", release.Info.Status.String()))
+	if err := configuration.Releases.Update(release); err != nil {
+		return fmt.Errorf("failed to mark interrupted revision %d of Helm release %q as failed: %w", release.Version, release.Name, err)
+	}
+
+	return nil
+}
+
+// releaseName returns the name of the release of a resource. Release names are limited to 53 characters, so the name
+// of the resource is truncated and followed by a hash of the resource ID to keep release names unique.
+func releaseName(resourceID string) string {
+	name := resourceID
+	if parsed, err := resources.ParseResource(resourceID); err == nil {
+		name = parsed.Name()
+	}
+
+	name = strings.Trim(invalidReleaseNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(strings.ToLower(resourceID)))
+	suffix := fmt.Sprintf("%08x", hash.Sum32())
+
+	if maxLength := maxReleaseNameLength - len(suffix) - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+
+	if name == "" {
+		return "recipe-" + suffix
+	}
+
+	return name + "-" + suffix
+}
+
+// releaseNamespace returns the Kubernetes namespace of the resource.
+func releaseNamespace(recipeContext *recipecontext.Context) (string, error) {
+	if recipeContext.Runtime.Kubernetes == nil || recipeContext.Runtime.Kubernetes.Namespace == "" {
+		return "", errors.New("Helm recipes can only be deployed to environments with a Kubernetes namespace")
+	}
+
+	return recipeContext.Runtime.Kubernetes.Namespace, nil
+}
+
+// releaseValues returns the values of the release: the recipe parameters, with the parameters of the resource
+// overriding the parameters of the environment, and the recipe context.
+func releaseValues(opts driver.BaseOptions, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	maps.Copy(values, opts.Definition.Parameters)
+	maps.Copy(values, opts.Recipe.Parameters)
+
+	// Values must be plain JSON types, so the recipe context is converted using its JSON representation.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	contextValues := map[string]any{}
+	if err := json.Unmarshal(b, &contextValues); err != nil {
+		return nil, err
+	}
+	values[contextValuesKey] = contextValues
+
+	return values, nil
+}
+
+// valueType returns the JSON type of a chart value.
+func valueType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int, int64, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return "any"
+	}
+}
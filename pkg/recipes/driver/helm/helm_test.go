@@ -0,0 +1,456 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v4/pkg/action"
+	"helm.sh/helm/v4/pkg/chart/common"
+	chart "helm.sh/helm/v4/pkg/chart/v2"
+	kubefake "helm.sh/helm/v4/pkg/kube/fake"
+	rcommon "helm.sh/helm/v4/pkg/release/common"
+	releasev1 "helm.sh/helm/v4/pkg/release/v1"
+	"helm.sh/helm/v4/pkg/storage"
+	helmdriver "helm.sh/helm/v4/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/k8sutil"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+	testNamespace     = "test-namespace"
+	testTemplatePath  = "oci://registry.example.com/charts/redis:1.0.0"
+
+	testConfigMapID = "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/cache-config"
+	testOutputID    = "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/cache-output"
+	testSecretID    = "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/cache-secret"
+)
+
+var testTemplates = map[string]string{
+	"templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Values.context.resource.name }}-config
+data:
+  size: {{ .Values.size | quote }}
+`,
+	"templates/output.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Values.context.resource.name }}-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  host: {{ .Values.context.resource.name }}.{{ .Release.Namespace }}.svc.cluster.local
+  port: "6379"
+  resources: '["/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket"]'
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Values.context.resource.name }}-secret
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  password: {{ "secret" | b64enc }}
+stringData:
+  username: admin
+`,
+}
+
+// fakeKubeClient is a fake Kubernetes client with a REST mapper for the built-in Kubernetes types.
+type fakeKubeClient struct {
+	client.WithWatch
+	mapper meta.RESTMapper
+}
+
+func (c *fakeKubeClient) RESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
+func (c *fakeKubeClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	return apiutil.IsObjectNamespaced(obj, clientgoscheme.Scheme, c.mapper)
+}
+
+func newTestChart() *chart.Chart {
+	helmChart := &chart.Chart{
+		Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "redis", Version: "1.0.0"},
+		Values:   map[string]any{"size": "small", "replicas": float64(1)},
+	}
+
+	for name, data := range testTemplates {
+		helmChart.Templates = append(helmChart.Templates, &common.File{Name: name, Data: []byte(data)})
+	}
+
+	return helmChart
+}
+
+func setupDriver(t *testing.T, objs ...client.Object) (*helmDriver, *action.Configuration) {
+	configuration := action.NewConfiguration()
+	configuration.Releases = storage.Init(helmdriver.NewMemory())
+	configuration.KubeClient = &kubefake.PrintingKubeClient{Out: io.Discard}
+	configuration.Capabilities = common.DefaultCapabilities
+
+	kubernetesProvider := kubernetesclientprovider.FromConfig(nil)
+	kubernetesProvider.SetRuntimeClient(&fakeKubeClient{
+		WithWatch: k8sutil.NewFakeKubeClient(clientgoscheme.Scheme, objs...),
+		mapper:    testrestmapper.TestOnlyStaticRESTMapper(clientgoscheme.Scheme),
+	})
+
+	d := &helmDriver{
+		kubernetesProvider: kubernetesProvider,
+		options:            HelmOptions{Timeout: DefaultTimeout},
+		loadChart: func(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error) {
+			require.Equal(t, chartReference{Chart: "oci://registry.example.com/charts/redis", Version: "1.0.0"}, reference)
+			require.Nil(t, options.Credential)
+			return newTestChart(), nil
+		},
+		newConfiguration: func(namespace string) (*action.Configuration, error) {
+			require.Equal(t, testNamespace, namespace)
+			return configuration, nil
+		},
+	}
+
+	return d, configuration
+}
+
+func testBaseOptions() driver.BaseOptions {
+	return driver.BaseOptions{
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: testNamespace}},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			ResourceID:    testResourceID,
+			EnvironmentID: testEnvironmentID,
+			Parameters:    map[string]any{"size": "large"},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:         "default",
+			Driver:       recipes.TemplateKindHelm,
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplatePath: testTemplatePath,
+			Parameters:   map[string]any{"size": "small"},
+		},
+	}
+}
+
+func TestHelmDriver_Execute(t *testing.T) {
+	t.Run("install", func(t *testing.T) {
+		d, configuration := setupDriver(t)
+
+		output, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{
+			testConfigMapID,
+			testOutputID,
+			testSecretID,
+			"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket",
+		}, output.Resources)
+		require.Equal(t, map[string]any{"host": "cache.test-namespace.svc.cluster.local", "port": float64(6379)}, output.Values)
+		require.Equal(t, map[string]any{"password": "secret", "username": "admin"}, output.Secrets)
+		require.Equal(t, &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindHelm, TemplatePath: testTemplatePath}, output.Status)
+
+		release, err := configuration.Releases.Last(releaseName(testResourceID))
+		require.NoError(t, err)
+		require.Contains(t, release.(*releasev1.Release).Manifest, `size: "large"`)
+	})
+
+	t.Run("upgrade", func(t *testing.T) {
+		d, configuration := setupDriver(t)
+
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+
+		_, err = d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+
+		history, err := configuration.Releases.History(releaseName(testResourceID))
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+	})
+
+	t.Run("interrupted install", func(t *testing.T) {
+		d, configuration := setupDriver(t)
+
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+		setLastReleaseStatus(t, configuration, rcommon.StatusPendingInstall)
+
+		_, err = d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+
+		history := releaseHistory(t, configuration)
+		require.Len(t, history, 2)
+		require.Equal(t, "Interrupted while pending-install", history[0].Info.Description)
+		require.Equal(t, rcommon.StatusDeployed, history[1].Info.Status)
+	})
+
+	t.Run("interrupted upgrade", func(t *testing.T) {
+		d, configuration := setupDriver(t)
+
+		for range 2 {
+			_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+			require.NoError(t, err)
+		}
+		setLastReleaseStatus(t, configuration, rcommon.StatusPendingUpgrade)
+
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+
+		history := releaseHistory(t, configuration)
+		require.Len(t, history, 3)
+		require.Equal(t, "Interrupted while pending-upgrade", history[1].Info.Description)
+		require.Equal(t, rcommon.StatusDeployed, history[2].Info.Status)
+	})
+
+	t.Run("no Kubernetes namespace", func(t *testing.T) {
+		d, _ := setupDriver(t)
+		opts := testBaseOptions()
+		opts.Configuration.Runtime.Kubernetes = nil
+
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: opts})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Contains(t, recipeError.ErrorDetails.Message, "Helm recipes can only be deployed to environments with a Kubernetes namespace")
+	})
+
+	t.Run("chart download failure", func(t *testing.T) {
+		d, _ := setupDriver(t)
+		d.loadChart = func(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error) {
+			return nil, errors.New("not found")
+		}
+
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func TestHelmDriver_RegistryAuthentication(t *testing.T) {
+	const secretStoreID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/registry"
+
+	opts := testBaseOptions()
+	opts.Configuration.RecipeConfig.Bicep.Authentication = map[string]datamodel.RegistrySecretConfig{
+		"registry.example.com": {Secret: secretStoreID},
+	}
+
+	d, _ := setupDriver(t)
+	secretIDs, err := d.FindSecretIDs(context.Background(), opts.Configuration, opts.Definition)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{secretStoreID: {}}, secretIDs)
+
+	opts.Secrets = map[string]recipes.SecretData{
+		secretStoreID: {Type: "basicAuthentication", Data: map[string]string{"username": "user", "password": "secret"}},
+	}
+	d.loadChart = func(ctx context.Context, reference chartReference, options pullOptions) (*chart.Chart, error) {
+		require.NotNil(t, options.Credential)
+		credential, err := options.Credential(ctx, "registry.example.com")
+		require.NoError(t, err)
+		require.Equal(t, "user", credential.Username)
+		require.Equal(t, "secret", credential.Password)
+		return newTestChart(), nil
+	}
+
+	_, err = d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+}
+
+func TestPullChart_Cancelled(t *testing.T) {
+	// The repository never answers, so the download only ends when the context is done.
+	blocked := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blocked
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(blocked) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := pullChart(ctx, chartReference{RepoURL: server.URL, Chart: "redis", Version: "1.0.0"}, pullOptions{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// setLastReleaseStatus sets the status of the last revision of the release of the test resource, like an interrupted
+// deployment would leave it.
+func setLastReleaseStatus(t *testing.T, configuration *action.Configuration, status rcommon.Status) {
+	last, err := configuration.Releases.Last(releaseName(testResourceID))
+	require.NoError(t, err)
+
+	release := last.(*releasev1.Release)
+	release.SetStatus(status, "")
+	require.NoError(t, configuration.Releases.Update(release))
+}
+
+// releaseHistory returns the revisions of the release of the test resource, in order.
+func releaseHistory(t *testing.T, configuration *action.Configuration) []*releasev1.Release {
+	history, err := configuration.Releases.History(releaseName(testResourceID))
+	require.NoError(t, err)
+
+	releases := []*releasev1.Release{}
+	for _, r := range history {
+		releases = append(releases, r.(*releasev1.Release))
+	}
+	slices.SortFunc(releases, func(a, b *releasev1.Release) int { return a.Version - b.Version })
+
+	return releases
+}
+
+func TestHelmDriver_Delete(t *testing.T) {
+	d, configuration := setupDriver(t)
+
+	_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+	require.NoError(t, err)
+
+	err = d.Delete(context.Background(), driver.DeleteOptions{BaseOptions: testBaseOptions()})
+	require.NoError(t, err)
+
+	_, err = configuration.Releases.Last(releaseName(testResourceID))
+	require.ErrorIs(t, err, helmdriver.ErrReleaseNotFound)
+
+	// Deleting a release that does not exist succeeds.
+	err = d.Delete(context.Background(), driver.DeleteOptions{BaseOptions: testBaseOptions()})
+	require.NoError(t, err)
+}
+
+func TestHelmDriver_GetRecipeMetadata(t *testing.T) {
+	d, _ := setupDriver(t)
+
+	metadata, err := d.GetRecipeMetadata(context.Background(), testBaseOptions())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"size":     map[string]any{"type": "string", "defaultValue": "small"},
+			"replicas": map[string]any{"type": "number", "defaultValue": float64(1)},
+		},
+	}, metadata)
+}
+
+func TestHelmDriver_Plan(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "cache-config"},
+		Data:       map[string]string{"size": "small"},
+	}
+	d, _ := setupDriver(t, configMap)
+
+	plan, err := d.Plan(context.Background(), driver.ExecuteOptions{
+		BaseOptions: testBaseOptions(),
+		PrevState: []string{
+			testConfigMapID,
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/stale",
+			"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket",
+		},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []recipes.PlannedResourceChange{
+		{Action: recipes.PlanActionUpdate, ResourceType: "core/ConfigMap", ID: testConfigMapID},
+		{Action: recipes.PlanActionCreate, ResourceType: "core/ConfigMap", ID: testOutputID},
+		{Action: recipes.PlanActionCreate, ResourceType: "core/Secret", ID: testSecretID},
+		{Action: recipes.PlanActionDelete, ResourceType: "apps/Deployment", ID: "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/stale"},
+	}, plan.Changes)
+}
+
+func TestParseChartReference(t *testing.T) {
+	tests := []struct {
+		templatePath    string
+		templateVersion string
+		expected        chartReference
+		err             string
+	}{
+		{
+			templatePath: "oci://registry.example.com/charts/redis:1.0.0",
+			expected:     chartReference{Chart: "oci://registry.example.com/charts/redis", Version: "1.0.0"},
+		},
+		{
+			templatePath:    "oci://registry.example.com:5000/redis",
+			templateVersion: "2.0.0",
+			expected:        chartReference{Chart: "oci://registry.example.com:5000/redis", Version: "2.0.0"},
+		},
+		{
+			templatePath: "https://charts.example.com/stable/redis:1.0.0",
+			expected:     chartReference{RepoURL: "https://charts.example.com/stable", Chart: "redis", Version: "1.0.0"},
+		},
+		{
+			templatePath: "https://charts.example.com/redis",
+			expected:     chartReference{RepoURL: "https://charts.example.com", Chart: "redis"},
+		},
+		{
+			templatePath: "registry.example.com/charts/redis",
+			err:          `invalid Helm chart reference "registry.example.com/charts/redis": must start with oci://, http:// or https://`,
+		},
+		{
+			templatePath: "oci://redis",
+			err:          `invalid Helm chart reference "oci://redis": must include a chart name`,
+		},
+		{
+			templatePath: "https://charts.example.com/",
+			err:          `invalid Helm chart reference "https://charts.example.com/": must include a chart name`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.templatePath, func(t *testing.T) {
+			reference, err := parseChartReference(tc.templatePath, tc.templateVersion)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, reference)
+		})
+	}
+}
+
+func TestReleaseName(t *testing.T) {
+	name := releaseName(testResourceID)
+	require.True(t, strings.HasPrefix(name, "cache-"))
+	require.Len(t, name, len("cache-")+8)
+	require.Equal(t, name, releaseName(strings.Replace(testResourceID, "redisCaches/cache", "redisCaches/Cache", 1)))
+	require.NotEqual(t, name, releaseName(strings.Replace(testResourceID, "test-rg", "other-rg", 1)))
+
+	long := releaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("Cache_", 20))
+	require.LessOrEqual(t, len(long), maxReleaseNameLength)
+	require.Regexp(t, "^cache-cache-[a-z0-9-]*[a-z0-9]-[0-9a-f]{8}$", long)
+}
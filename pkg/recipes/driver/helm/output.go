@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/recipes"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// outputResourcesKey is the key of the output ConfigMap listing additional output resources of the recipe as a
+	// JSON array of resource IDs, like the "resources" property of the result of Bicep and Terraform recipes.
+	outputResourcesKey = "resources"
+)
+
+// parseManifest decodes the manifest of a release. Namespaced objects without a namespace are set to the namespace of
+// the release, where Helm deploys them.
+func parseManifest(kubeClient runtimeclient.Client, manifest string, namespace string) ([]*unstructured.Unstructured, error) {
+	objects := []*unstructured.Unstructured{}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode the release manifest: %w", err)
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.GetNamespace() == "" {
+			namespaced, err := kubeClient.IsObjectNamespaced(obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine the scope of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			if namespaced {
+				obj.SetNamespace(namespace)
+			}
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// prepareRecipeResponse builds the recipe output from the objects of a release. Every object of the release is an
+// output resource. The data of the ConfigMap labeled with kubernetes.LabelRecipeOutput become the values of the
+// recipe, and the data of the Secret with the same label become its secrets.
+//
+// Values that are valid JSON are decoded, so that charts can output numbers, booleans, lists and objects. The
+// "resources" key of the ConfigMap can list additional output resources as a JSON array of resource IDs.
+func prepareRecipeResponse(objects []*unstructured.Unstructured) (*recipes.RecipeOutput, error) {
+	output := &recipes.RecipeOutput{
+		Resources: []string{},
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+	}
+
+	for _, obj := range objects {
+		output.Resources = append(output.Resources, objectID(obj))
+
+		if obj.GetLabels()[kubernetes.LabelRecipeOutput] != "true" || obj.GroupVersionKind().Group != "" {
+			continue
+		}
+
+		switch obj.GetKind() {
+		case "ConfigMap":
+			data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+			if err != nil {
+				return nil, fmt.Errorf("invalid data in ConfigMap %q: %w", obj.GetName(), err)
+			}
+
+			for key, value := range data {
+				if key == outputResourcesKey {
+					resources := []string{}
+					if err := json.Unmarshal([]byte(value), &resources); err != nil {
+						return nil, fmt.Errorf("invalid %q in ConfigMap %q: must be a JSON array of resource IDs", outputResourcesKey, obj.GetName())
+					}
+					output.Resources = append(output.Resources, resources...)
+					continue
+				}
+
+				output.Values[key] = decodeValue(value)
+			}
+
+		case "Secret":
+			data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+			if err != nil {
+				return nil, fmt.Errorf("invalid data in Secret %q: %w", obj.GetName(), err)
+			}
+
+			for key, value := range data {
+				decoded, err := base64.StdEncoding.DecodeString(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value of %q in Secret %q: %w", key, obj.GetName(), err)
+				}
+				output.Secrets[key] = string(decoded)
+			}
+
+			stringData, _, err := unstructured.NestedStringMap(obj.Object, "stringData")
+			if err != nil {
+				return nil, fmt.Errorf("invalid stringData in Secret %q: %w", obj.GetName(), err)
+			}
+
+			for key, value := range stringData {
+				output.Secrets[key] = value
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// decodeValue decodes an output value that is valid JSON, and returns other values as strings.
+func decodeValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+// objectID returns the resource ID of the Kubernetes object.
+func objectID(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()).String()
+}
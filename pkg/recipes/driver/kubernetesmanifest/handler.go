@@ -0,0 +1,369 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesmanifest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// Kind is the recipe kind handled by the driver.
+	Kind = "kubernetes"
+
+	// fieldManager is the field manager used to apply the manifests.
+	fieldManager = "radius-kubernetes-recipe-driver"
+)
+
+var _ plugin.Handler = (*Handler)(nil)
+
+// Handler is a recipe driver plugin that deploys raw Kubernetes manifests. It is the reference implementation of the
+// recipe driver plugin protocol.
+//
+// The template path of the recipe is a local path or an HTTP(S) URL of a YAML file containing one or more manifests.
+// The file is rendered as a Go template before it is applied, with the recipe parameters available as `.Parameters`
+// and the recipe context as `.Context`. Namespaced objects without a namespace are deployed to the namespace of the
+// recipe context. The recipe has no output values.
+type Handler struct {
+	// Client is the client for the Kubernetes cluster.
+	Client client.Client
+
+	// HTTPClient is the client used to download manifests from HTTP(S) URLs.
+	HTTPClient *http.Client
+}
+
+// Execute applies the manifests of the recipe and deletes the objects of the previous state that are no longer part of
+// the recipe.
+func (h *Handler) Execute(ctx context.Context, request *plugin.Request) (*plugin.Output, error) {
+	objects, err := h.render(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &plugin.Output{Resources: []string{}}
+	for _, obj := range objects {
+		// Using client.Apply patch type for server-side apply with unstructured types.
+		//nolint:staticcheck // SA1019: client.Apply will be replaced when ApplyConfiguration support is available
+		err := h.Client.Patch(ctx, obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		output.Resources = append(output.Resources, objectID(obj))
+	}
+
+	obsolete := []string{}
+	for _, id := range request.PreviousState {
+		if !containsID(output.Resources, id) {
+			obsolete = append(obsolete, id)
+		}
+	}
+
+	if err := h.delete(ctx, obsolete); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), "")
+	}
+
+	return output, nil
+}
+
+// Delete deletes the objects listed in the request.
+func (h *Handler) Delete(ctx context.Context, request *plugin.Request) error {
+	return h.delete(ctx, request.OutputResources)
+}
+
+// GetRecipeMetadata returns the parameters referenced by the manifests of the recipe.
+func (h *Handler) GetRecipeMetadata(ctx context.Context, request *plugin.Request) (map[string]any, error) {
+	tmpl, err := h.parse(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]any{}
+	for _, tree := range tmpl.Templates() {
+		if tree.Tree == nil {
+			continue
+		}
+		for _, name := range referencedParameters(tree.Tree.Root) {
+			parameters[name] = map[string]any{"type": "any"}
+		}
+	}
+
+	return map[string]any{"parameters": parameters}, nil
+}
+
+// Plan compares the manifests of the recipe with the live objects. An object is updated if one of the fields set by
+// its manifest has a different value in the cluster.
+func (h *Handler) Plan(ctx context.Context, request *plugin.Request) (*recipes.RecipePlan, error) {
+	objects, err := h.render(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+	ids := []string{}
+	for _, obj := range objects {
+		id := objectID(obj)
+		ids = append(ids, id)
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		err := h.Client.Get(ctx, client.ObjectKeyFromObject(obj), live)
+		if apierrors.IsNotFound(err) {
+			plan.Changes = append(plan.Changes, newChange(recipes.PlanActionCreate, obj.GroupVersionKind(), id))
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		if !kubeutil.IsSubset(obj.Object, live.Object) {
+			plan.Changes = append(plan.Changes, newChange(recipes.PlanActionUpdate, obj.GroupVersionKind(), id))
+		}
+	}
+
+	for _, id := range request.PreviousState {
+		if containsID(ids, id) {
+			continue
+		}
+
+		parsed, err := resources.ParseResource(id)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, recipes.PlannedResourceChange{Action: recipes.PlanActionDelete, ResourceType: parsed.Type(), ID: id})
+	}
+
+	return plan, nil
+}
+
+// render downloads and renders the manifests of the recipe and returns the objects to apply.
+func (h *Handler) render(ctx context.Context, request *plugin.Request) ([]*unstructured.Unstructured, error) {
+	tmpl, err := h.parse(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// The recipe context uses the same field names as in Bicep and Terraform recipes, e.g. `.Context.resource.name`.
+	recipeContext := map[string]any{}
+	if request.Context != nil {
+		b, err := json.Marshal(request.Context)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &recipeContext); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered := &bytes.Buffer{}
+	if err := tmpl.Execute(rendered, map[string]any{"Parameters": request.Parameters, "Context": recipeContext}); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to render recipe %q: %s", request.Recipe.TemplatePath, err.Error()), "")
+	}
+
+	namespace := ""
+	if request.Context != nil && request.Context.Runtime.Kubernetes != nil {
+		namespace = request.Context.Runtime.Kubernetes.Namespace
+	}
+
+	objects := []*unstructured.Unstructured{}
+	decoder := yaml.NewYAMLOrJSONDecoder(rendered, 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to decode recipe %q: %s", request.Recipe.TemplatePath, err.Error()), "")
+		}
+
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.GetNamespace() == "" {
+			namespaced, err := h.Client.IsObjectNamespaced(obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine the scope of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			if namespaced {
+				obj.SetNamespace(namespace)
+			}
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// parse downloads and parses the template of the recipe.
+func (h *Handler) parse(ctx context.Context, request *plugin.Request) (*template.Template, error) {
+	content, err := h.download(ctx, request.Recipe.TemplatePath)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, fmt.Sprintf("failed to download recipe %q: %s", request.Recipe.TemplatePath, err.Error()), "")
+	}
+
+	tmpl, err := template.New(request.Recipe.TemplatePath).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to parse recipe %q: %s", request.Recipe.TemplatePath, err.Error()), "")
+	}
+
+	return tmpl, nil
+}
+
+// download reads the manifests from a local path or an HTTP(S) URL.
+func (h *Handler) download(ctx context.Context, templatePath string) ([]byte, error) {
+	if !strings.HasPrefix(templatePath, "http://") && !strings.HasPrefix(templatePath, "https://") {
+		return os.ReadFile(strings.TrimPrefix(templatePath, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, templatePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := h.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// delete deletes the Kubernetes objects with the given resource IDs. Objects that no longer exist are ignored.
+func (h *Handler) delete(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		parsed, err := resources.ParseResource(id)
+		if err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(parsed.PlaneNamespace(), resources_kubernetes.PlaneTypeKubernetes+"/"+resources_kubernetes.PlaneNameTODO) {
+			return fmt.Errorf("resource %q is not a Kubernetes object", id)
+		}
+
+		group, kind, namespace, name := resources_kubernetes.ToParts(parsed)
+		mapping, err := h.Client.RESTMapper().RESTMapping(schema.GroupKind{Group: group, Kind: kind})
+		if err != nil {
+			return fmt.Errorf("failed to find the API version of %s %q: %w", kind, name, err)
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(mapping.GroupVersionKind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		if err := h.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %s %q: %w", kind, name, err)
+		}
+	}
+
+	return nil
+}
+
+// objectID returns the resource ID of the Kubernetes object.
+func objectID(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName()).String()
+}
+
+func newChange(action recipes.PlanAction, gvk schema.GroupVersionKind, id string) recipes.PlannedResourceChange {
+	return recipes.PlannedResourceChange{Action: action, ResourceType: resources_kubernetes.ResourceTypeFromGVK(gvk), ID: id}
+}
+
+func containsID(ids []string, id string) bool {
+	return slices.ContainsFunc(ids, func(candidate string) bool {
+		return strings.EqualFold(candidate, id)
+	})
+}
+
+// referencedParameters returns the names of the parameters referenced as `.Parameters.<name>` in the template.
+func referencedParameters(node parse.Node) []string {
+	names := []string{}
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return names
+		}
+		for _, child := range n.Nodes {
+			names = append(names, referencedParameters(child)...)
+		}
+	case *parse.ActionNode:
+		names = append(names, referencedParameters(n.Pipe)...)
+	case *parse.PipeNode:
+		if n == nil {
+			return names
+		}
+		for _, command := range n.Cmds {
+			names = append(names, referencedParameters(command)...)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			names = append(names, referencedParameters(arg)...)
+		}
+	case *parse.IfNode:
+		names = append(names, referencedBranchParameters(&n.BranchNode)...)
+	case *parse.RangeNode:
+		names = append(names, referencedBranchParameters(&n.BranchNode)...)
+	case *parse.WithNode:
+		names = append(names, referencedBranchParameters(&n.BranchNode)...)
+	case *parse.TemplateNode:
+		names = append(names, referencedParameters(n.Pipe)...)
+	case *parse.FieldNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "Parameters" {
+			names = append(names, n.Ident[1])
+		}
+	}
+
+	return names
+}
+
+func referencedBranchParameters(n *parse.BranchNode) []string {
+	names := referencedParameters(n.Pipe)
+	names = append(names, referencedParameters(n.List)...)
+	return append(names, referencedParameters(n.ElseList)...)
+}
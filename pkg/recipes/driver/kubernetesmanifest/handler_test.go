@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesmanifest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin"
+	"github.com/radius-project/radius/pkg/recipes/driver/plugin/plugintest"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/test/k8sutil"
+)
+
+const (
+	testResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+	testNamespace     = "test-namespace"
+
+	testManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Context.resource.name }}-config
+data:
+  size: {{ .Parameters.size | printf "%q" }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Context.resource.name }}
+spec:
+  ports:
+  - port: {{ .Parameters.port }}
+`
+)
+
+// fakeKubeClient is a fake Kubernetes client with a REST mapper for the built-in Kubernetes types.
+type fakeKubeClient struct {
+	client.WithWatch
+	mapper meta.RESTMapper
+}
+
+func newFakeKubeClient(objs ...client.Object) *fakeKubeClient {
+	return &fakeKubeClient{
+		WithWatch: k8sutil.NewFakeKubeClient(clientgoscheme.Scheme, objs...),
+		mapper:    testrestmapper.TestOnlyStaticRESTMapper(clientgoscheme.Scheme),
+	}
+}
+
+func (c *fakeKubeClient) RESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
+func (c *fakeKubeClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	return apiutil.IsObjectNamespaced(obj, clientgoscheme.Scheme, c.mapper)
+}
+
+func writeManifest(t *testing.T, content string) string {
+	templatePath := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(templatePath, []byte(content), 0600))
+	return templatePath
+}
+
+func newRequest(templatePath string) *plugin.Request {
+	return &plugin.Request{
+		ProtocolVersion: plugin.ProtocolVersion,
+		Recipe:          plugin.RecipeDefinition{Name: "default", Kind: Kind, TemplatePath: templatePath},
+		Parameters:      map[string]any{"size": "small", "port": 6379},
+		Context: &recipecontext.Context{
+			Resource: recipecontext.Resource{ResourceInfo: recipecontext.ResourceInfo{Name: "cache", ID: testResourceID}},
+			Runtime:  recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: testNamespace}},
+		},
+	}
+}
+
+func TestHandler_Conformance(t *testing.T) {
+	handler := &Handler{Client: newFakeKubeClient()}
+
+	plugintest.Run(t, plugintest.Options{
+		Driver: plugin.NewInProcessDriver(Kind, handler),
+		Kind:   Kind,
+		Definition: recipes.EnvironmentDefinition{
+			Name:         "default",
+			Driver:       Kind,
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplatePath: writeManifest(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Context.resource.name }}
+data:
+  size: {{ .Parameters.size }}
+`),
+			Parameters: map[string]any{"size": "small"},
+		},
+		Metadata: recipes.ResourceMetadata{
+			Name:          "default",
+			ResourceID:    testResourceID,
+			EnvironmentID: testEnvironmentID,
+		},
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: testNamespace}},
+		},
+	})
+}
+
+func TestHandler_Execute(t *testing.T) {
+	t.Run("applies manifests", func(t *testing.T) {
+		kubeClient := newFakeKubeClient()
+		handler := &Handler{Client: kubeClient}
+
+		output, err := handler.Execute(context.Background(), newRequest(writeManifest(t, testManifest)))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/cache-config",
+			"/planes/kubernetes/local/namespaces/test-namespace/providers/core/Service/cache",
+		}, output.Resources)
+
+		configMap := &corev1.ConfigMap{}
+		err = kubeClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: "cache-config"}, configMap)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"size": "small"}, configMap.Data)
+
+		service := &corev1.Service{}
+		err = kubeClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: "cache"}, service)
+		require.NoError(t, err)
+		require.Equal(t, int32(6379), service.Spec.Ports[0].Port)
+	})
+
+	t.Run("deletes resources no longer in the recipe", func(t *testing.T) {
+		stale := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "stale"}}
+		kubeClient := newFakeKubeClient(stale)
+		handler := &Handler{Client: kubeClient}
+
+		request := newRequest(writeManifest(t, testManifest))
+		request.PreviousState = []string{"/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/stale"}
+
+		_, err := handler.Execute(context.Background(), request)
+		require.NoError(t, err)
+
+		err = kubeClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: "stale"}, &corev1.ConfigMap{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("missing parameter", func(t *testing.T) {
+		handler := &Handler{Client: newFakeKubeClient()}
+
+		request := newRequest(writeManifest(t, testManifest))
+		request.Parameters = map[string]any{"size": "small"}
+
+		_, err := handler.Execute(context.Background(), request)
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeLanguageFailure, recipeError.ErrorDetails.Code)
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		handler := &Handler{Client: newFakeKubeClient()}
+
+		_, err := handler.Execute(context.Background(), newRequest(filepath.Join(t.TempDir(), "missing.yaml")))
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func TestHandler_Plan(t *testing.T) {
+	kubeClient := newFakeKubeClient()
+	handler := &Handler{Client: kubeClient}
+	request := newRequest(writeManifest(t, testManifest))
+
+	output, err := handler.Execute(context.Background(), request)
+	require.NoError(t, err)
+
+	request.Parameters["size"] = "large"
+	request.PreviousState = append(output.Resources, "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/stale")
+
+	plan, err := handler.Plan(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, []recipes.PlannedResourceChange{
+		{
+			Action:       recipes.PlanActionUpdate,
+			ResourceType: "core/ConfigMap",
+			ID:           "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/cache-config",
+		},
+		{
+			Action:       recipes.PlanActionDelete,
+			ResourceType: "core/ConfigMap",
+			ID:           "/planes/kubernetes/local/namespaces/test-namespace/providers/core/ConfigMap/stale",
+		},
+	}, plan.Changes)
+}
+
+func TestHandler_GetRecipeMetadata(t *testing.T) {
+	handler := &Handler{}
+
+	metadata, err := handler.GetRecipeMetadata(context.Background(), newRequest(writeManifest(t, `{{ if .Parameters.enabled }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Context.resource.name }}
+data:
+  size: {{ with .Parameters.size }}{{ . }}{{ else }}small{{ end }}
+{{ end }}`)))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"enabled": map[string]any{"type": "any"},
+			"size":    map[string]any{"type": "any"},
+		},
+	}, metadata)
+}
@@ -156,3 +156,42 @@ func (c *MockDriverGetRecipeMetadataCall) DoAndReturn(f func(context.Context, Ba
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// Plan mocks base method.
+func (m *MockDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, opts)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverMockRecorder) Plan(ctx, opts any) *MockDriverPlanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriver)(nil).Plan), ctx, opts)
+	return &MockDriverPlanCall{Call: call}
+}
+
+// MockDriverPlanCall wrap *gomock.Call
+type MockDriverPlanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverPlanCall) Return(arg0 *recipes.RecipePlan, arg1 error) *MockDriverPlanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverPlanCall) Do(f func(context.Context, ExecuteOptions) (*recipes.RecipePlan, error)) *MockDriverPlanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverPlanCall) DoAndReturn(f func(context.Context, ExecuteOptions) (*recipes.RecipePlan, error)) *MockDriverPlanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
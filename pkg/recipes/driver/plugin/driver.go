@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os/exec"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultTimeout is the default time a plugin is given to complete an operation.
+	DefaultTimeout = 30 * time.Minute
+
+	// maxStderrLength is the maximum length of the plugin's standard error included in errors.
+	maxStderrLength = 4096
+)
+
+var _ driver.Driver = (*pluginDriver)(nil)
+
+// Options is the configuration of a recipe driver plugin.
+type Options struct {
+	// Kind is the recipe kind handled by the plugin.
+	Kind string
+
+	// Command is the path to the plugin executable.
+	Command string
+
+	// Args are the arguments passed to the plugin executable.
+	Args []string
+
+	// Timeout is the time the plugin is given to complete an operation. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// NewDriver creates a recipe driver that delegates recipe operations to an out-of-process plugin.
+//
+// The plugin is responsible for deleting the output resources listed in the previous state of an execution that are
+// no longer deployed by the recipe.
+func NewDriver(options Options) driver.Driver {
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	return &pluginDriver{options: options, run: options.runCommand}
+}
+
+// NewInProcessDriver creates a recipe driver that serves recipe operations with the handler in the current process.
+// Requests and responses are serialized exactly as they are for out-of-process plugins, which makes it suitable for
+// testing plugins written in Go.
+func NewInProcessDriver(kind string, handler Handler) driver.Driver {
+	return &pluginDriver{
+		options: Options{Kind: kind, Timeout: DefaultTimeout},
+		run: func(ctx context.Context, input []byte, stdout io.Writer, stderr io.Writer) error {
+			return Serve(ctx, handler, bytes.NewReader(input), stdout)
+		},
+	}
+}
+
+// runFunc runs a plugin with the serialized request as input.
+type runFunc func(ctx context.Context, input []byte, stdout io.Writer, stderr io.Writer) error
+
+type pluginDriver struct {
+	options Options
+	run     runFunc
+}
+
+// Execute runs the plugin to deploy the recipe and returns the output resources, values and secrets.
+func (d *pluginDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	request, err := d.newRequest(MethodExecute, opts.BaseOptions, true)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	request.PreviousState = opts.PrevState
+
+	response, err := d.invoke(ctx, request)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	output := response.Output
+	if output == nil {
+		output = &Output{}
+	}
+
+	return &recipes.RecipeOutput{
+		Resources: output.Resources,
+		Values:    output.Values,
+		Secrets:   output.Secrets,
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    d.options.Kind,
+			TemplatePath:    opts.Definition.TemplatePath,
+			TemplateVersion: opts.Definition.TemplateVersion,
+		},
+	}, nil
+}
+
+// Delete runs the plugin to delete the output resources of the recipe.
+func (d *pluginDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	request, err := d.newRequest(MethodDelete, opts.BaseOptions, true)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	for _, outputResource := range opts.OutputResources {
+		request.OutputResources = append(request.OutputResources, outputResource.ID.String())
+	}
+
+	if _, err := d.invoke(ctx, request); err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata runs the plugin to read the parameters of the recipe.
+func (d *pluginDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	request, err := d.newRequest(MethodGetRecipeMetadata, opts, false)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := d.invoke(ctx, request)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	if response.Metadata == nil {
+		return map[string]any{}, nil
+	}
+
+	return response.Metadata, nil
+}
+
+// Plan runs the plugin to return the changes deploying the recipe would make.
+func (d *pluginDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	request, err := d.newRequest(MethodPlan, opts.BaseOptions, true)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	request.PreviousState = opts.PrevState
+
+	response, err := d.invoke(ctx, request)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	if response.Plan == nil {
+		return &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}, nil
+	}
+
+	return response.Plan, nil
+}
+
+// newRequest creates the plugin request for the operation. The recipe context is only built for operations on a
+// resource, since the metadata of a recipe can be read without one.
+func (d *pluginDriver) newRequest(method Method, opts driver.BaseOptions, withContext bool) (*Request, error) {
+	parameters := map[string]any{}
+	maps.Copy(parameters, opts.Definition.Parameters)
+	maps.Copy(parameters, opts.Recipe.Parameters)
+
+	request := &Request{
+		ProtocolVersion: ProtocolVersion,
+		Method:          method,
+		Recipe: RecipeDefinition{
+			Name:            opts.Definition.Name,
+			Kind:            d.options.Kind,
+			ResourceType:    opts.Definition.ResourceType,
+			TemplatePath:    opts.Definition.TemplatePath,
+			TemplateVersion: opts.Definition.TemplateVersion,
+			PlainHTTP:       opts.Definition.PlainHTTP,
+		},
+		Parameters: parameters,
+		Secrets:    opts.Secrets,
+	}
+
+	if withContext {
+		recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+		if err != nil {
+			return nil, err
+		}
+		request.Context = recipeContext
+	}
+
+	return request, nil
+}
+
+// invoke runs the plugin with the request and returns its response. Errors are returned as a RecipeError, carrying the
+// error details reported by the plugin if it wrote a response.
+func (d *pluginDriver) invoke(ctx context.Context, request *Request) (*Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the plugin request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.options.Timeout)
+	defer cancel()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	logger.Info(fmt.Sprintf("Running recipe driver plugin %q", d.options.Kind), "method", request.Method)
+	runErr := d.run(ctx, input, stdout, stderr)
+	if stderr.Len() > 0 {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf("Recipe driver plugin %q output", d.options.Kind), "stderr", stderr.String())
+	}
+
+	response := &Response{}
+	if err := json.Unmarshal(stdout.Bytes(), response); err != nil {
+		if runErr != nil {
+			return nil, d.newRunError(runErr, stderr.String())
+		}
+		return nil, recipes.NewRecipeError(v1.CodeInternal, fmt.Sprintf("recipe driver plugin %q returned an invalid response: %s", d.options.Kind, err.Error()), "")
+	}
+
+	if response.Error != nil {
+		return nil, newPluginError(response.Error)
+	}
+
+	if runErr != nil {
+		return nil, d.newRunError(runErr, stderr.String())
+	}
+
+	return response, nil
+}
+
+// newRunError creates the error for a plugin that could not be run or exited with an error, including the end of its
+// standard error.
+func (d *pluginDriver) newRunError(runErr error, stderr string) error {
+	message := fmt.Sprintf("recipe driver plugin %q failed: %s", d.options.Kind, runErr.Error())
+	if stderr = truncate(stderr); stderr != "" {
+		message += ": " + stderr
+	}
+
+	return recipes.NewRecipeError(v1.CodeInternal, message, "")
+}
+
+// runCommand runs the plugin executable with the serialized request as its standard input.
+func (o Options) runCommand(ctx context.Context, input []byte, stdout io.Writer, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, o.Command, o.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
+
+// newPluginError converts the error details reported by a plugin to a RecipeError.
+func newPluginError(details *v1.ErrorDetails) error {
+	if details.Message == "" {
+		return errors.New("recipe driver plugin failed without an error message")
+	}
+
+	code := details.Code
+	if code == "" {
+		code = recipes.RecipeDeploymentFailed
+	}
+
+	return recipes.NewRecipeError(code, details.Message, "", details.Details...)
+}
+
+// truncate shortens the standard error of a plugin so that it can be included in an error message.
+func truncate(stderr string) string {
+	stderr = strings.TrimSpace(stderr)
+	if len(stderr) > maxStderrLength {
+		return stderr[len(stderr)-maxStderrLength:]
+	}
+
+	return stderr
+}
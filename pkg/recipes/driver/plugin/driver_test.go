@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	testKind = "test"
+
+	// helperModeEnv selects the behavior of the test binary when it is run as a plugin by TestHelperPlugin.
+	helperModeEnv = "RADIUS_TEST_RECIPE_DRIVER_PLUGIN"
+)
+
+// TestHelperPlugin is not a real test. It serves plugin requests when the test binary is run as a plugin.
+func TestHelperPlugin(t *testing.T) {
+	switch os.Getenv(helperModeEnv) {
+	case "":
+		return
+	case "serve":
+		Main(&fakeHandler{})
+	case "fail":
+		Main(&fakeHandler{err: recipes.NewRecipeError(recipes.RecipeLanguageFailure, "invalid template", "")})
+	case "crash":
+		fmt.Fprintln(os.Stderr, "panic: something went wrong")
+		os.Exit(2)
+	}
+
+	// Exit before the test framework writes its results to the standard output.
+	os.Exit(0)
+}
+
+func newHelperDriver(t *testing.T, mode string) driver.Driver {
+	t.Setenv(helperModeEnv, mode)
+	return NewDriver(Options{Kind: testKind, Command: os.Args[0], Args: []string{"-test.run=^TestHelperPlugin$"}})
+}
+
+func testBaseOptions() driver.BaseOptions {
+	return driver.BaseOptions{
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			Parameters:    map[string]any{"size": "large"},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:         "default",
+			Driver:       testKind,
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplatePath: "https://example.com/recipe.yaml",
+			Parameters:   map[string]any{"size": "small"},
+		},
+	}
+}
+
+func TestPluginDriver_Execute(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		output, err := newHelperDriver(t, "serve").Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.NoError(t, err)
+		require.Equal(t, &recipes.RecipeOutput{
+			Resources: []string{testOutputResource},
+			Values:    map[string]any{"host": "cache", "size": "large"},
+			Secrets:   map[string]any{"password": "secret"},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind: testKind,
+				TemplatePath: "https://example.com/recipe.yaml",
+			},
+		}, output)
+	})
+
+	t.Run("plugin error", func(t *testing.T) {
+		_, err := newHelperDriver(t, "fail").Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Equal(t, recipes.RecipeLanguageFailure, recipeError.ErrorDetails.Details[0].Code)
+		require.Equal(t, "invalid template", recipeError.ErrorDetails.Details[0].Message)
+	})
+
+	t.Run("plugin crash", func(t *testing.T) {
+		_, err := newHelperDriver(t, "crash").Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Contains(t, recipeError.ErrorDetails.Details[0].Message, "panic: something went wrong")
+	})
+
+	t.Run("missing executable", func(t *testing.T) {
+		d := NewDriver(Options{Kind: testKind, Command: "/does/not/exist"})
+		_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+		require.Error(t, err)
+	})
+}
+
+func TestPluginDriver_Delete(t *testing.T) {
+	id, err := resources.ParseResource(testOutputResource)
+	require.NoError(t, err)
+	opts := driver.DeleteOptions{BaseOptions: testBaseOptions(), OutputResources: []rpv1.OutputResource{{ID: id}}}
+
+	t.Run("success", func(t *testing.T) {
+		err := newHelperDriver(t, "serve").Delete(context.Background(), opts)
+		require.NoError(t, err)
+	})
+
+	t.Run("plugin error", func(t *testing.T) {
+		err := newHelperDriver(t, "fail").Delete(context.Background(), opts)
+
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func TestPluginDriver_GetRecipeMetadata(t *testing.T) {
+	metadata, err := newHelperDriver(t, "serve").GetRecipeMetadata(context.Background(), testBaseOptions())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{"size": map[string]any{"type": "string"}}}, metadata)
+}
+
+func TestPluginDriver_Plan(t *testing.T) {
+	plan, err := newHelperDriver(t, "serve").Plan(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions(), PrevState: []string{testOutputResource}})
+	require.NoError(t, err)
+	require.Empty(t, plan.Changes)
+}
+
+func TestInProcessDriver(t *testing.T) {
+	d := NewInProcessDriver(testKind, &fakeHandler{err: errors.New("failed to deploy")})
+
+	_, err := d.Execute(context.Background(), driver.ExecuteOptions{BaseOptions: testBaseOptions()})
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Equal(t, "failed to deploy", recipeError.ErrorDetails.Details[0].Message)
+}
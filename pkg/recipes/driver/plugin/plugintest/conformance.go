@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugintest provides a conformance test harness for recipe driver plugins.
+package plugintest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Options configures the conformance tests.
+type Options struct {
+	// Driver is the driver of the plugin under test, created with plugin.NewDriver or plugin.NewInProcessDriver.
+	Driver driver.Driver
+
+	// Kind is the recipe kind handled by the plugin.
+	Kind string
+
+	// Definition is the definition of the recipe deployed by the tests. The recipe must deploy at least one resource.
+	Definition recipes.EnvironmentDefinition
+
+	// Metadata is the metadata of the resource the recipe is deployed for.
+	Metadata recipes.ResourceMetadata
+
+	// Configuration is the configuration of the environment the recipe is deployed to.
+	Configuration recipes.Configuration
+}
+
+// Run runs the conformance tests against a plugin. The tests deploy the recipe, deploy it again, plan it and delete
+// it, and check that the plugin behaves as Radius expects at each step. Later steps are skipped if a step fails.
+func Run(t *testing.T, options Options) {
+	ctx := context.Background()
+	base := driver.BaseOptions{
+		Configuration: options.Configuration,
+		Recipe:        options.Metadata,
+		Definition:    options.Definition,
+	}
+
+	var deployed []string
+	steps := []struct {
+		name string
+		fn   func(t *testing.T)
+	}{
+		{
+			name: "GetRecipeMetadata returns the recipe parameters",
+			fn: func(t *testing.T) {
+				metadata, err := options.Driver.GetRecipeMetadata(ctx, base)
+				require.NoError(t, err)
+				require.IsType(t, map[string]any{}, metadata["parameters"])
+			},
+		},
+		{
+			name: "Plan before deployment only creates resources",
+			fn: func(t *testing.T) {
+				plan, err := options.Driver.Plan(ctx, driver.ExecuteOptions{BaseOptions: base})
+				require.NoError(t, err)
+				require.NotNil(t, plan)
+				for _, change := range plan.Changes {
+					require.Equal(t, recipes.PlanActionCreate, change.Action, "unexpected change for %s", change.ID)
+				}
+			},
+		},
+		{
+			name: "Execute deploys the recipe",
+			fn: func(t *testing.T) {
+				output, err := options.Driver.Execute(ctx, driver.ExecuteOptions{BaseOptions: base})
+				require.NoError(t, err)
+				require.NotNil(t, output)
+				require.NotEmpty(t, output.Resources)
+				for _, id := range output.Resources {
+					_, err := resources.ParseResource(id)
+					require.NoError(t, err, "output resource %q is not a valid resource ID", id)
+				}
+				require.Equal(t, options.Kind, output.Status.TemplateKind)
+				require.Equal(t, options.Definition.TemplatePath, output.Status.TemplatePath)
+
+				deployed = output.Resources
+			},
+		},
+		{
+			name: "Execute is idempotent",
+			fn: func(t *testing.T) {
+				output, err := options.Driver.Execute(ctx, driver.ExecuteOptions{BaseOptions: base, PrevState: deployed})
+				require.NoError(t, err)
+				require.ElementsMatch(t, deployed, output.Resources)
+			},
+		},
+		{
+			name: "Plan after deployment has no changes",
+			fn: func(t *testing.T) {
+				plan, err := options.Driver.Plan(ctx, driver.ExecuteOptions{BaseOptions: base, PrevState: deployed})
+				require.NoError(t, err)
+				require.Empty(t, plan.Changes)
+			},
+		},
+		{
+			name: "Delete deletes the output resources",
+			fn: func(t *testing.T) {
+				err := options.Driver.Delete(ctx, driver.DeleteOptions{BaseOptions: base, OutputResources: outputResources(t, deployed)})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Delete ignores deleted resources",
+			fn: func(t *testing.T) {
+				err := options.Driver.Delete(ctx, driver.DeleteOptions{BaseOptions: base, OutputResources: outputResources(t, deployed)})
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, step := range steps {
+		if !t.Run(step.name, step.fn) {
+			return
+		}
+	}
+}
+
+func outputResources(t *testing.T, ids []string) []rpv1.OutputResource {
+	outputResources := []rpv1.OutputResource{}
+	for _, id := range ids {
+		parsed, err := resources.ParseResource(id)
+		require.NoError(t, err)
+		outputResources = append(outputResources, rpv1.OutputResource{ID: parsed, RadiusManaged: new(true)})
+	}
+
+	return outputResources
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+)
+
+// ProtocolVersion is the version of the recipe driver plugin protocol.
+//
+// The protocol is JSON over stdio. Each operation starts the plugin executable, writes a single Request as JSON to its
+// standard input and closes it. The plugin writes a single Response as JSON to its standard output and exits. Anything
+// the plugin writes to its standard error is treated as diagnostic output and included in errors when the plugin fails
+// without writing a response.
+const ProtocolVersion = "v1"
+
+// Method is an operation of a recipe driver plugin.
+type Method string
+
+const (
+	// MethodExecute deploys the recipe. The response contains the Output of the recipe.
+	MethodExecute Method = "execute"
+
+	// MethodDelete deletes the output resources of the recipe listed in the request.
+	MethodDelete Method = "delete"
+
+	// MethodGetRecipeMetadata reads the parameters of the recipe. The response contains the Metadata of the recipe.
+	MethodGetRecipeMetadata Method = "getRecipeMetadata"
+
+	// MethodPlan returns the changes deploying the recipe would make, without making them. The response contains the Plan.
+	MethodPlan Method = "plan"
+)
+
+// Request is the request written by Radius to the standard input of a recipe driver plugin.
+type Request struct {
+	// ProtocolVersion is the version of the protocol used by Radius. Plugins should fail requests with an unknown version.
+	ProtocolVersion string `json:"protocolVersion"`
+
+	// Method is the operation to perform.
+	Method Method `json:"method"`
+
+	// Recipe is the definition of the recipe in the environment.
+	Recipe RecipeDefinition `json:"recipe"`
+
+	// Parameters are the recipe parameters. Parameters set on the resource override the parameters set in the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+
+	// Context is the recipe context, describing the resource, its environment and application, and the cloud providers.
+	// It is not set for MethodGetRecipeMetadata.
+	Context *recipecontext.Context `json:"context,omitempty"`
+
+	// Secrets are the secrets referenced by the recipe configuration of the environment, keyed by secret store ID.
+	Secrets map[string]recipes.SecretData `json:"secrets,omitempty"`
+
+	// PreviousState is the list of output resource IDs deployed by the previous execution of the recipe. It is set for
+	// MethodExecute and MethodPlan.
+	PreviousState []string `json:"previousState,omitempty"`
+
+	// OutputResources is the list of output resource IDs to delete. It is set for MethodDelete.
+	OutputResources []string `json:"outputResources,omitempty"`
+}
+
+// RecipeDefinition is the definition of a recipe in the environment.
+type RecipeDefinition struct {
+	// Name is the name of the recipe.
+	Name string `json:"name"`
+
+	// Kind is the kind of the recipe, which selects the plugin.
+	Kind string `json:"kind"`
+
+	// ResourceType is the resource type the recipe deploys.
+	ResourceType string `json:"resourceType"`
+
+	// TemplatePath is the location of the recipe, such as a registry reference, a URL or a module source.
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the recipe, if the recipe source is versioned separately.
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// PlainHTTP is true if the recipe source should be accessed over HTTP rather than HTTPS.
+	PlainHTTP bool `json:"plainHttp,omitempty"`
+}
+
+// Response is the response written by a recipe driver plugin to its standard output.
+type Response struct {
+	// Output is the result of MethodExecute.
+	Output *Output `json:"output,omitempty"`
+
+	// Metadata is the result of MethodGetRecipeMetadata. Parameters are listed under the "parameters" key, using the
+	// same shape as Bicep and Terraform recipes.
+	Metadata map[string]any `json:"metadata,omitempty"`
+
+	// Plan is the result of MethodPlan.
+	Plan *recipes.RecipePlan `json:"plan,omitempty"`
+
+	// Error is set if the operation failed. The other fields are ignored.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+}
+
+// Output is the output of a recipe deployment.
+type Output struct {
+	// Resources is the list of IDs of the resources deployed by the recipe.
+	Resources []string `json:"resources,omitempty"`
+
+	// Values are the values output by the recipe, which become the computed values of the resource.
+	Values map[string]any `json:"values,omitempty"`
+
+	// Secrets are the secret values output by the recipe.
+	Secrets map[string]any `json:"secrets,omitempty"`
+}
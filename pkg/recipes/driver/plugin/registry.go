@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+)
+
+// AddDrivers adds a driver for each configured plugin to the drivers, keyed by the recipe kind of the plugin. It
+// returns an error if a plugin is misconfigured or if its kind is already registered, e.g. by a built-in driver.
+func AddDrivers(drivers map[string]driver.Driver, plugins []hostoptions.RecipeDriverPluginOptions) error {
+	var errs error
+	for _, plugin := range plugins {
+		if plugin.Kind == "" {
+			errs = errors.Join(errs, fmt.Errorf("recipe driver plugin %q must specify a kind", plugin.Command))
+			continue
+		}
+
+		if plugin.Command == "" {
+			errs = errors.Join(errs, fmt.Errorf("recipe driver plugin %q must specify a command", plugin.Kind))
+			continue
+		}
+
+		if _, ok := drivers[plugin.Kind]; ok {
+			errs = errors.Join(errs, fmt.Errorf("recipe driver plugin %q conflicts with an existing driver of the same kind", plugin.Kind))
+			continue
+		}
+
+		drivers[plugin.Kind] = NewDriver(Options{
+			Kind:    plugin.Kind,
+			Command: plugin.Command,
+			Args:    plugin.Args,
+			Timeout: time.Duration(plugin.TimeoutSeconds) * time.Second,
+		})
+	}
+
+	return errs
+}
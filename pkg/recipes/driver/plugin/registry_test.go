@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+)
+
+func TestAddDrivers(t *testing.T) {
+	t.Run("adds plugins", func(t *testing.T) {
+		drivers := map[string]driver.Driver{recipes.TemplateKindBicep: nil}
+		err := AddDrivers(drivers, []hostoptions.RecipeDriverPluginOptions{
+			{Kind: "kubernetes", Command: "/usr/local/bin/kubernetes-recipe-driver"},
+			{Kind: "pulumi", Command: "/usr/local/bin/pulumi-recipe-driver", Args: []string{"--verbose"}, TimeoutSeconds: 60},
+		})
+		require.NoError(t, err)
+		require.Len(t, drivers, 3)
+
+		kubernetes := drivers["kubernetes"].(*pluginDriver)
+		require.Equal(t, Options{Kind: "kubernetes", Command: "/usr/local/bin/kubernetes-recipe-driver", Timeout: DefaultTimeout}, kubernetes.options)
+
+		pulumi := drivers["pulumi"].(*pluginDriver)
+		require.Equal(t, Options{Kind: "pulumi", Command: "/usr/local/bin/pulumi-recipe-driver", Args: []string{"--verbose"}, Timeout: time.Minute}, pulumi.options)
+	})
+
+	t.Run("invalid plugins", func(t *testing.T) {
+		drivers := map[string]driver.Driver{recipes.TemplateKindBicep: nil}
+		err := AddDrivers(drivers, []hostoptions.RecipeDriverPluginOptions{
+			{Command: "/usr/local/bin/kubernetes-recipe-driver"},
+			{Kind: "pulumi"},
+			{Kind: recipes.TemplateKindBicep, Command: "/usr/local/bin/bicep-recipe-driver"},
+		})
+		require.ErrorContains(t, err, `recipe driver plugin "/usr/local/bin/kubernetes-recipe-driver" must specify a kind`)
+		require.ErrorContains(t, err, `recipe driver plugin "pulumi" must specify a command`)
+		require.ErrorContains(t, err, `recipe driver plugin "bicep" conflicts with an existing driver of the same kind`)
+		require.Len(t, drivers, 1)
+	})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+// Handler implements the operations of a recipe driver plugin written in Go. Handlers are served with Serve or Main.
+//
+// Errors of type *recipes.RecipeError are reported to Radius with their error details. Other errors are reported with
+// a generic error code for the operation.
+type Handler interface {
+	// Execute deploys the recipe and returns its output. Output resources in the previous state of the request that are
+	// no longer deployed by the recipe must be deleted.
+	Execute(ctx context.Context, request *Request) (*Output, error)
+
+	// Delete deletes the output resources listed in the request.
+	Delete(ctx context.Context, request *Request) error
+
+	// GetRecipeMetadata returns the metadata of the recipe, including its parameters under the "parameters" key.
+	GetRecipeMetadata(ctx context.Context, request *Request) (map[string]any, error)
+
+	// Plan returns the changes deploying the recipe would make, without making them.
+	Plan(ctx context.Context, request *Request) (*recipes.RecipePlan, error)
+}
+
+// Main serves a single request read from the standard input with the handler and exits. It is meant to be called from
+// the main function of a plugin.
+func Main(handler Handler) {
+	if err := Serve(context.Background(), handler, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+// Serve reads a single request from the reader, handles it with the handler and writes the response to the writer.
+// Failures of the handler are written as error responses; an error is only returned if the request cannot be read or
+// the response cannot be written.
+func Serve(ctx context.Context, handler Handler, in io.Reader, out io.Writer) error {
+	request := &Request{}
+	if err := json.NewDecoder(in).Decode(request); err != nil {
+		return writeResponse(out, &Response{Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("failed to read the plugin request: %s", err.Error()),
+		}})
+	}
+
+	return writeResponse(out, handle(ctx, handler, request))
+}
+
+// handle dispatches the request to the handler.
+func handle(ctx context.Context, handler Handler, request *Request) *Response {
+	if request.ProtocolVersion != ProtocolVersion {
+		return &Response{Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("unsupported protocol version %q, expected %q", request.ProtocolVersion, ProtocolVersion),
+		}}
+	}
+
+	switch request.Method {
+	case MethodExecute:
+		output, err := handler.Execute(ctx, request)
+		if err != nil {
+			return errorResponse(recipes.RecipeDeploymentFailed, err)
+		}
+		if output == nil {
+			output = &Output{}
+		}
+		return &Response{Output: output}
+
+	case MethodDelete:
+		if err := handler.Delete(ctx, request); err != nil {
+			return errorResponse(recipes.RecipeDeletionFailed, err)
+		}
+		return &Response{}
+
+	case MethodGetRecipeMetadata:
+		metadata, err := handler.GetRecipeMetadata(ctx, request)
+		if err != nil {
+			return errorResponse(recipes.RecipeGetMetadataFailed, err)
+		}
+		if metadata == nil {
+			metadata = map[string]any{}
+		}
+		return &Response{Metadata: metadata}
+
+	case MethodPlan:
+		plan, err := handler.Plan(ctx, request)
+		if err != nil {
+			return errorResponse(recipes.RecipePlanFailed, err)
+		}
+		if plan == nil {
+			plan = &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+		}
+		return &Response{Plan: plan}
+
+	default:
+		return &Response{Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("unsupported method %q", request.Method),
+		}}
+	}
+}
+
+// errorResponse creates the response for a failed operation.
+func errorResponse(code string, err error) *Response {
+	recipeError := &recipes.RecipeError{}
+	if errors.As(err, &recipeError) {
+		return &Response{Error: &recipeError.ErrorDetails}
+	}
+
+	return &Response{Error: &v1.ErrorDetails{Code: code, Message: err.Error()}}
+}
+
+func writeResponse(out io.Writer, response *Response) error {
+	if err := json.NewEncoder(out).Encode(response); err != nil {
+		return fmt.Errorf("failed to write the plugin response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const testOutputResource = "/planes/kubernetes/local/namespaces/default/providers/core/ConfigMap/test"
+
+// fakeHandler is a Handler that deploys a single ConfigMap, or fails with err if set.
+type fakeHandler struct {
+	err error
+}
+
+func (h *fakeHandler) Execute(ctx context.Context, request *Request) (*Output, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return &Output{
+		Resources: []string{testOutputResource},
+		Values:    map[string]any{"host": request.Context.Resource.Name, "size": request.Parameters["size"]},
+		Secrets:   map[string]any{"password": "secret"},
+	}, nil
+}
+
+func (h *fakeHandler) Delete(ctx context.Context, request *Request) error {
+	return h.err
+}
+
+func (h *fakeHandler) GetRecipeMetadata(ctx context.Context, request *Request) (map[string]any, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return map[string]any{"parameters": map[string]any{"size": map[string]any{"type": "string"}}}, nil
+}
+
+func (h *fakeHandler) Plan(ctx context.Context, request *Request) (*recipes.RecipePlan, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+	if len(request.PreviousState) == 0 {
+		plan.Changes = append(plan.Changes, recipes.PlannedResourceChange{Action: recipes.PlanActionCreate, ResourceType: "core/ConfigMap", ID: testOutputResource})
+	}
+	return plan, nil
+}
+
+func serve(t *testing.T, handler Handler, input string) *Response {
+	out := &bytes.Buffer{}
+	err := Serve(context.Background(), handler, strings.NewReader(input), out)
+	require.NoError(t, err)
+
+	response := &Response{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), response))
+	return response
+}
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  Handler
+		input    string
+		expected *Response
+	}{
+		{
+			name:    "get recipe metadata",
+			handler: &fakeHandler{},
+			input:   `{"protocolVersion": "v1", "method": "getRecipeMetadata"}`,
+			expected: &Response{
+				Metadata: map[string]any{"parameters": map[string]any{"size": map[string]any{"type": "string"}}},
+			},
+		},
+		{
+			name:     "delete",
+			handler:  &fakeHandler{},
+			input:    `{"protocolVersion": "v1", "method": "delete", "outputResources": ["` + testOutputResource + `"]}`,
+			expected: &Response{},
+		},
+		{
+			name:    "plan",
+			handler: &fakeHandler{},
+			input:   `{"protocolVersion": "v1", "method": "plan"}`,
+			expected: &Response{
+				Plan: &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{
+					{Action: recipes.PlanActionCreate, ResourceType: "core/ConfigMap", ID: testOutputResource},
+				}},
+			},
+		},
+		{
+			name:    "recipe error",
+			handler: &fakeHandler{err: recipes.NewRecipeError(recipes.RecipeDownloadFailed, "failed to download", "")},
+			input:   `{"protocolVersion": "v1", "method": "plan"}`,
+			expected: &Response{
+				Error: &v1.ErrorDetails{Code: recipes.RecipeDownloadFailed, Message: "failed to download"},
+			},
+		},
+		{
+			name:    "other error",
+			handler: &fakeHandler{err: errors.New("failed to delete")},
+			input:   `{"protocolVersion": "v1", "method": "delete"}`,
+			expected: &Response{
+				Error: &v1.ErrorDetails{Code: recipes.RecipeDeletionFailed, Message: "failed to delete"},
+			},
+		},
+		{
+			name:    "unsupported protocol version",
+			handler: &fakeHandler{},
+			input:   `{"protocolVersion": "v0", "method": "delete"}`,
+			expected: &Response{
+				Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: `unsupported protocol version "v0", expected "v1"`},
+			},
+		},
+		{
+			name:    "unsupported method",
+			handler: &fakeHandler{},
+			input:   `{"protocolVersion": "v1", "method": "import"}`,
+			expected: &Response{
+				Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: `unsupported method "import"`},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, serve(t, tc.handler, tc.input))
+		})
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		response := serve(t, &fakeHandler{}, `not json`)
+		require.Equal(t, v1.CodeInvalid, response.Error.Code)
+		require.Contains(t, response.Error.Message, "failed to read the plugin request")
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/terraform"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
@@ -63,6 +64,9 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution. Valid values: TRACE, DEBUG, INFO, WARN, ERROR, OFF. Default: ERROR.
 	LogLevel string
+
+	// Cache is the optional cache of downloaded modules and provider plugins, shared by all executions.
+	Cache *cache.Cache
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -109,6 +113,7 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -163,6 +168,7 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -177,6 +183,103 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 	return nil
 }
 
+// Plan creates a unique directory for each execution of terraform and runs terraform plan for the recipe
+// using the Terraform CLI through terraform-exec. It returns the planned resource changes or an error if planning fails.
+func (d *terraformDriver) Plan(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Get the secret store ID associated with the git private terraform repository source.
+	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add credential information to .gitconfig for module source of type git if applicable.
+	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:          requestDirPath,
+		EnvConfig:        &opts.Configuration,
+		ResourceRecipe:   &opts.Recipe,
+		EnvRecipe:        &opts.Definition,
+		Secrets:          opts.Secrets,
+		StateLockTimeout: terraform.DefaultStateLockTimeout,
+		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
+	})
+
+	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return preparePlanResponse(tfPlan), nil
+}
+
+// preparePlanResponse converts the resource changes of a Terraform plan into a RecipePlan.
+// Data sources and resources without changes are not included.
+func preparePlanResponse(tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}
+	if tfPlan == nil {
+		return plan
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+
+		var action recipes.PlanAction
+		switch {
+		case rc.Change.Actions.Replace():
+			action = recipes.PlanActionReplace
+		case rc.Change.Actions.Create():
+			action = recipes.PlanActionCreate
+		case rc.Change.Actions.Update():
+			action = recipes.PlanActionUpdate
+		case rc.Change.Actions.Delete():
+			action = recipes.PlanActionDelete
+		default:
+			// No-op and read actions do not change the output resources.
+			continue
+		}
+
+		change := recipes.PlannedResourceChange{
+			Action:       action,
+			ResourceType: rc.Type,
+			Name:         rc.Address,
+		}
+
+		// The provider-assigned ID is only known for resources that already exist.
+		if before, ok := rc.Change.Before.(map[string]any); ok {
+			if id, ok := before["id"].(string); ok {
+				change.ID = id
+			}
+		}
+
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	return plan
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -289,6 +392,7 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts driver.Bas
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 		LogLevel:       d.options.LogLevel,
+		Cache:          d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -462,6 +462,101 @@ func Test_Terraform_Delete_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.azurerm_redis_cache.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_resource_group.rg",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_resource_group",
+				Change: &tfjson.Change{
+					Actions: tfjson.Actions{tfjson.ActionUpdate},
+					Before:  map[string]any{"id": "/subscriptions/test-sub/resourceGroups/test-rg"},
+				},
+			},
+			{
+				Address: "module.redis-azure.azurerm_storage_account.old",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_storage_account",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.azurerm_key_vault.unchanged",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_key_vault",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.redis-azure.data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	plan, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.PlannedResourceChange{
+			{Action: recipes.PlanActionCreate, ResourceType: "azurerm_redis_cache", Name: "module.redis-azure.azurerm_redis_cache.redis"},
+			{Action: recipes.PlanActionUpdate, ResourceType: "azurerm_resource_group", Name: "module.redis-azure.azurerm_resource_group.rg", ID: "/subscriptions/test-sub/resourceGroups/test-rg"},
+			{Action: recipes.PlanActionReplace, ResourceType: "azurerm_storage_account", Name: "module.redis-azure.azurerm_storage_account.old"},
+		},
+	}, plan)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).
+		Return(nil, errors.New("Failed to plan terraform module"))
+
+	_, err := tfDriver.Plan(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipePlanFailed, recipeError.ErrorDetails.Code)
+	require.Equal(t, "Failed to plan terraform module", recipeError.ErrorDetails.Message)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 	d := &terraformDriver{}
 	tests := []struct {
@@ -35,6 +35,9 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan fetches the recipe contents and returns the changes the recipe deployment would make, without making them.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // DriverWithSecrets is an optional interface and used when the driver needs to load secrets for recipe deployment.
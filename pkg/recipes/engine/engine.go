@@ -112,6 +112,70 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 	return res, definition, nil
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then asks the driver to plan the recipe deployment. It returns a
+// RecipePlan describing the changes to the output resources and an error if one occurs.
+func (e *engine) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	plan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return plan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Nothing is ever deployed in a simulated environment, so the plan is always empty.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, returning an empty plan")
+		return &recipes.RecipePlan{Changes: []recipes.PlannedResourceChange{}}, nil, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return plan, definition, nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
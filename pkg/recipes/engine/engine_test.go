@@ -835,6 +835,116 @@ func Test_Delete_Lookup_Error(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	plan := &recipes.RecipePlan{
+		Changes: []recipes.PlannedResourceChange{
+			{Action: recipes.PlanActionCreate, ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test2"},
+			{Action: recipes.PlanActionDelete, ID: prevState[0]},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(plan, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, plan, result)
+}
+
+func Test_Engine_Plan_SimulatedEnv_Success(t *testing.T) {
+	recipeMetadata, _, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Simulated: true,
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+
+	result, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Changes)
+}
+
+func Test_Engine_Plan_Error(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeErr := recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan recipe", "", nil)
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, recipeErr)
+
+	_, err := engine.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Equal(t, recipeErr, err)
+}
+
 func Test_Engine_GetRecipeMetadata_Success(t *testing.T) {
 	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
 	envConfig := &recipes.Configuration{
@@ -35,6 +35,10 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to plan the recipe deployment.
+	// It returns the changes the recipe would make to its output resources without deploying anything.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
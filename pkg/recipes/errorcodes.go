@@ -17,9 +17,19 @@ const (
 	// Used for recipe download failures.
 	RecipeDownloadFailed = "RecipeDownloadFailed"
 
+	// Used for recipes that are not signed, or not signed by a trusted key.
+	RecipeSignatureVerificationFailed = "RecipeSignatureVerificationFailed"
+
 	// Used for recipe deployment failures.
 	RecipeDeploymentFailed = "RecipeDeploymentFailed"
 
+	// Used for recipe plan (what-if) failures.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used for recipes whose driver cannot plan them, for example when the deployment engine does not serve
+	// what-if requests.
+	RecipePlanNotSupported = "RecipePlanNotSupported"
+
 	// Used for recipe validation failures.
 	RecipeValidationFailed = "RecipeValidationFailed"
 
@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+// RecipeLockUpdateRequest represents a request to update the locks of the recipes in a recipe pack to the immutable
+// references their sources currently resolve to.
+type RecipeLockUpdateRequest struct {
+	// ResourceTypes represents the resource types of the recipes to update. All recipes are updated if it is empty.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	// DryRun reports the available updates without updating the locks.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// RecipeLockUpdates represents the result of a recipe lock update request.
+type RecipeLockUpdates struct {
+	// Recipes represents the result for each of the requested recipes, ordered by resource type.
+	Recipes []RecipeLockUpdate `json:"recipes"`
+}
+
+// RecipeLockUpdate represents the result of updating the lock of a single recipe.
+type RecipeLockUpdate struct {
+	// ResourceType represents the resource type of the recipe.
+	ResourceType string `json:"resourceType"`
+	// Source represents the source of the recipe.
+	Source string `json:"source"`
+	// Locked represents the version or revision the recipe was locked to before the request. It is empty if the
+	// recipe was not locked.
+	Locked string `json:"locked,omitempty"`
+	// Latest represents the version or revision the source currently resolves to. It is empty if the source could not
+	// be resolved.
+	Latest string `json:"latest,omitempty"`
+	// UpdateAvailable is true if the source resolves to a different immutable reference than the lock.
+	UpdateAvailable bool `json:"updateAvailable"`
+	// Updated is true if the lock was updated to the latest reference.
+	Updated bool `json:"updated"`
+	// Error represents the reason the source could not be resolved.
+	Error string `json:"error,omitempty"`
+}
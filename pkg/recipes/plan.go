@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+// PlanAction represents the kind of change a recipe execution would make to an output resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate indicates that the output resource would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionUpdate indicates that the output resource would be updated in place.
+	PlanActionUpdate PlanAction = "Update"
+	// PlanActionReplace indicates that the output resource would be deleted and created again.
+	PlanActionReplace PlanAction = "Replace"
+	// PlanActionDelete indicates that the output resource would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// RecipePlan represents the changes that executing a recipe would make, without making them.
+type RecipePlan struct {
+	// Changes represents the planned changes to the output resources of the recipe.
+	Changes []PlannedResourceChange `json:"changes"`
+}
+
+// PlannedResourceChange represents a planned change to a single output resource of a recipe.
+type PlannedResourceChange struct {
+	// Action represents the kind of change.
+	Action PlanAction `json:"action"`
+	// ResourceType represents the type of the output resource, e.g. "Microsoft.Cache/redis" or "aws_s3_bucket".
+	ResourceType string `json:"resourceType,omitempty"`
+	// Name represents the name of the output resource within the recipe, e.g. the Terraform resource address.
+	Name string `json:"name,omitempty"`
+	// ID represents the resource ID of the output resource when it is known ahead of the deployment.
+	ID string `json:"id,omitempty"`
+}
+
+// RecipePlanRequest represents a request to plan the recipe for a resource that has not necessarily been deployed yet.
+type RecipePlanRequest struct {
+	// ResourceID represents the fully qualified resource ID of the resource the recipe would be deploying.
+	ResourceID string `json:"resourceId"`
+	// RecipeName represents the name of the recipe within the environment. Defaults to "default".
+	RecipeName string `json:"recipeName,omitempty"`
+	// ApplicationID represents the fully qualified resource ID of the application the resource belongs to.
+	ApplicationID string `json:"applicationId,omitempty"`
+	// Parameters represents the developer-provided recipe parameters.
+	Parameters map[string]any `json:"parameters,omitempty"`
+	// Properties represents the properties of the resource, which are passed to the recipe context.
+	Properties map[string]any `json:"properties,omitempty"`
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const (
+	// ChecksumsFile is the file listing the SHA-256 checksums of the files of a signed directory, in the format of
+	// the output of sha256sum.
+	ChecksumsFile = "SHA256SUMS"
+
+	// ChecksumsSignatureFile is the file holding the base64 encoded signature of ChecksumsFile, as written by
+	// `cosign sign-blob --key`.
+	ChecksumsSignatureFile = ChecksumsFile + ".sig"
+)
+
+// VerifyDir verifies the signature of the content of dir, such as a downloaded Terraform module. A signed directory
+// contains a SHA256SUMS file listing the checksums of all its files and a SHA256SUMS.sig file with the signature of
+// SHA256SUMS. It can be created with:
+//
+//	find . -type f -not -path './.git/*' | sort | xargs sha256sum > SHA256SUMS
+//	cosign sign-blob --key cosign.key SHA256SUMS > SHA256SUMS.sig
+//
+// VerifyDir fails if a file is missing, modified or not listed. The .git directory is not part of the signed content.
+func (v *Verifier) VerifyDir(dir string) error {
+	checksums, err := os.ReadFile(filepath.Join(dir, ChecksumsFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotSigned
+	} else if err != nil {
+		return err
+	}
+
+	encoded, err := os.ReadFile(filepath.Join(dir, ChecksumsSignatureFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotSigned
+	} else if err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return fmt.Errorf("invalid signature in %s: %w", ChecksumsSignatureFile, err)
+	}
+
+	if err := v.VerifyBlob(checksums, signature); err != nil {
+		return err
+	}
+
+	expected, err := parseChecksums(checksums)
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		switch {
+		case d.IsDir() && rel == ".git":
+			return fs.SkipDir
+		case d.IsDir(), rel == ChecksumsFile, rel == ChecksumsSignatureFile:
+			return nil
+		}
+
+		checksum, ok := expected[rel]
+		if !ok {
+			return fmt.Errorf("file %q is not listed in %s", rel, ChecksumsFile)
+		}
+		delete(expected, rel)
+
+		actual, err := fileChecksum(path)
+		if err != nil {
+			return err
+		}
+		if actual != checksum {
+			return fmt.Errorf("checksum of file %q does not match %s", rel, ChecksumsFile)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(expected) > 0 {
+		missing := slices.Sorted(maps.Keys(expected))
+		return fmt.Errorf("file %q listed in %s is missing", missing[0], ChecksumsFile)
+	}
+
+	return nil
+}
+
+// parseChecksums parses the lines of the output of sha256sum, "<checksum>  <path>", into a map of the paths to their
+// checksums.
+func parseChecksums(data []byte) (map[string]string, error) {
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		checksum, path, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid line in %s: %q", ChecksumsFile, line)
+		}
+
+		// sha256sum marks files read in binary mode with a '*'.
+		path = strings.TrimPrefix(strings.TrimLeft(path, " "), "*")
+		path = strings.TrimPrefix(path, "./")
+		checksums[path] = strings.ToLower(checksum)
+	}
+
+	return checksums, scanner.Err()
+}
+
+// fileChecksum returns the hex encoded SHA-256 checksum of the file at path.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
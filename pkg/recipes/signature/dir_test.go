@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newSignedDir creates a directory with a Terraform module signed by signer.
+func newSignedDir(t *testing.T, signer *testSigner) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.tf":               `variable "context" {}`,
+		"modules/redis/main.tf": `output "result" {}`,
+	}
+
+	checksums := ""
+	for _, path := range []string{"main.tf", "modules/redis/main.tf"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(files[path]), 0644))
+
+		sum := sha256.Sum256([]byte(files[path]))
+		checksums += hex.EncodeToString(sum[:]) + "  ./" + path + "\n"
+	}
+
+	// The .git directory of modules downloaded from Git repositories is not signed.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0644))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ChecksumsFile), []byte(checksums), 0644))
+	signature := base64.StdEncoding.EncodeToString(signer.sign(t, []byte(checksums)))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ChecksumsSignatureFile), []byte(signature+"\n"), 0644))
+
+	return dir
+}
+
+func Test_VerifyDir(t *testing.T) {
+	signer := newECDSASigner(t)
+	verifier, err := NewVerifier([]string{signer.publicKey})
+	require.NoError(t, err)
+
+	t.Run("signed", func(t *testing.T) {
+		require.NoError(t, verifier.VerifyDir(newSignedDir(t, signer)))
+	})
+
+	t.Run("not signed", func(t *testing.T) {
+		dir := newSignedDir(t, signer)
+		require.NoError(t, os.Remove(filepath.Join(dir, ChecksumsSignatureFile)))
+		require.ErrorIs(t, verifier.VerifyDir(dir), ErrNotSigned)
+
+		require.ErrorIs(t, verifier.VerifyDir(t.TempDir()), ErrNotSigned)
+	})
+
+	t.Run("untrusted", func(t *testing.T) {
+		require.ErrorIs(t, verifier.VerifyDir(newSignedDir(t, newECDSASigner(t))), ErrUntrusted)
+	})
+
+	t.Run("modified file", func(t *testing.T) {
+		dir := newSignedDir(t, signer)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`resource "null_resource" "x" {}`), 0644))
+		require.EqualError(t, verifier.VerifyDir(dir), `checksum of file "main.tf" does not match SHA256SUMS`)
+	})
+
+	t.Run("added file", func(t *testing.T) {
+		dir := newSignedDir(t, signer)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "extra.tf"), []byte(`resource "null_resource" "x" {}`), 0644))
+		require.EqualError(t, verifier.VerifyDir(dir), `file "extra.tf" is not listed in SHA256SUMS`)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		dir := newSignedDir(t, signer)
+		require.NoError(t, os.Remove(filepath.Join(dir, "modules", "redis", "main.tf")))
+		require.EqualError(t, verifier.VerifyDir(dir), `file "modules/redis/main.tf" listed in SHA256SUMS is missing`)
+	})
+}
+
+func Test_ParseChecksums(t *testing.T) {
+	checksums, err := parseChecksums([]byte("ABC  ./main.tf\ndef *modules/main.tf\n\n"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"main.tf": "abc", "modules/main.tf": "def"}, checksums)
+
+	_, err = parseChecksums([]byte("abc"))
+	require.EqualError(t, err, `invalid line in SHA256SUMS: "abc"`)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/radius-project/radius/pkg/rp/util"
+)
+
+const (
+	// SignatureAnnotation is the annotation of the layers of a signature manifest holding the base64 encoded
+	// signature of the layer.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// SimpleSigningMediaType is the media type of the layers of a signature manifest.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+)
+
+// simpleSigningPayload is the signed payload of a signature, in the simple signing format used by cosign.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifyArtifact verifies the signature of the OCI artifact referenced by path, in the form registry/repository:tag
+// or registry/repository@digest. Signatures are stored the way `cosign sign --key` stores them: in a manifest tagged
+// sha256-<digest>.sig in the repository of the artifact, with a layer for each signature.
+//
+// VerifyArtifact returns the reference pinned to the digest of the verified manifest, so that the artifact that is
+// used is the one that was verified, even if the tag is moved in the meantime.
+func (v *Verifier) VerifyArtifact(ctx context.Context, path string, plainHTTP bool, client remote.Client) (string, error) {
+	pinned, digest, err := util.ResolveRegistryReference(ctx, path, plainHTTP, client)
+	if err != nil {
+		return "", err
+	}
+
+	repo, err := remote.NewRepository(pinned)
+	if err != nil {
+		return "", fmt.Errorf("failed to create client to registry %s", err.Error())
+	}
+
+	repo.Client = client
+	repo.PlainHTTP = plainHTTP
+
+	signatureTag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	descriptor, err := repo.Resolve(ctx, signatureTag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return "", ErrNotSigned
+	} else if err != nil {
+		return "", fmt.Errorf("failed to resolve the signature of %q: %w", pinned, err)
+	}
+
+	manifestBlob, err := content.FetchAll(ctx, repo, descriptor)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the signature of %q: %w", pinned, err)
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(manifestBlob, &manifest); err != nil {
+		return "", fmt.Errorf("failed to parse the signature of %q: %w", pinned, err)
+	}
+
+	signed := false
+	for _, layer := range manifest.Layers {
+		encoded, ok := layer.Annotations[SignatureAnnotation]
+		if !ok || layer.MediaType != SimpleSigningMediaType {
+			continue
+		}
+		signed = true
+
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		payload, err := content.FetchAll(ctx, repo.Blobs(), layer)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch the signature of %q: %w", pinned, err)
+		}
+
+		if v.VerifyBlob(payload, signature) != nil {
+			continue
+		}
+
+		// The signature is only valid for the artifact whose digest was signed.
+		signedPayload := simpleSigningPayload{}
+		if err := json.Unmarshal(payload, &signedPayload); err != nil || signedPayload.Critical.Image.DockerManifestDigest != digest {
+			continue
+		}
+
+		return pinned, nil
+	}
+
+	if !signed {
+		return "", ErrNotSigned
+	}
+
+	return "", ErrUntrusted
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+// testRegistry is a minimal OCI registry serving the manifests and blobs of a single repository.
+type testRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var data []byte
+	var ok bool
+	if ref, found := strings.CutPrefix(req.URL.Path, "/v2/recipes/redis/manifests/"); found {
+		data, ok = r.manifests[ref]
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+	} else if ref, found := strings.CutPrefix(req.URL.Path, "/v2/recipes/redis/blobs/"); found {
+		data, ok = r.blobs[ref]
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Docker-Content-Digest", digest.FromBytes(data).String())
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	if req.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+// addManifest adds a manifest with the given layers to the registry under the given tag and its digest.
+func (r *testRegistry) addManifest(t *testing.T, tag string, layers ...ocispec.Descriptor) digest.Digest {
+	manifest, err := json.Marshal(ocispec.Manifest{MediaType: ocispec.MediaTypeImageManifest, Layers: layers})
+	require.NoError(t, err)
+
+	d := digest.FromBytes(manifest)
+	r.manifests[tag] = manifest
+	r.manifests[d.String()] = manifest
+	return d
+}
+
+// sign adds a cosign signature of the manifest with the given digest to the registry.
+func (r *testRegistry) sign(t *testing.T, signer *testSigner, manifestDigest digest.Digest, signedDigest digest.Digest) {
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"recipes/redis"},"image":{"docker-manifest-digest":"` + signedDigest.String() + `"},"type":"cosign container image signature"},"optional":null}`)
+	r.blobs[digest.FromBytes(payload).String()] = payload
+
+	r.addManifest(t, strings.Replace(manifestDigest.String(), ":", "-", 1)+".sig", ocispec.Descriptor{
+		MediaType:   SimpleSigningMediaType,
+		Digest:      digest.FromBytes(payload),
+		Size:        int64(len(payload)),
+		Annotations: map[string]string{SignatureAnnotation: base64.StdEncoding.EncodeToString(signer.sign(t, payload))},
+	})
+}
+
+func Test_VerifyArtifact(t *testing.T) {
+	signer := newECDSASigner(t)
+	verifier, err := NewVerifier([]string{signer.publicKey})
+	require.NoError(t, err)
+
+	setup := func(t *testing.T) (*testRegistry, string, digest.Digest) {
+		registry := &testRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+		layer := []byte(`{"resources": {}}`)
+		registry.blobs[digest.FromBytes(layer).String()] = layer
+		manifestDigest := registry.addManifest(t, "latest", ocispec.Descriptor{MediaType: "application/vnd.ms.bicep.layer.v1+json", Digest: digest.FromBytes(layer), Size: int64(len(layer))})
+
+		server := httptest.NewServer(registry)
+		t.Cleanup(server.Close)
+		return registry, strings.TrimPrefix(server.URL, "http://") + "/recipes/redis", manifestDigest
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		registry, repository, manifestDigest := setup(t)
+		registry.sign(t, signer, manifestDigest, manifestDigest)
+
+		pinned, err := verifier.VerifyArtifact(testcontext.New(t), repository+":latest", true, nil)
+		require.NoError(t, err)
+		require.Equal(t, repository+"@"+manifestDigest.String(), pinned)
+	})
+
+	t.Run("not signed", func(t *testing.T) {
+		_, repository, _ := setup(t)
+
+		_, err := verifier.VerifyArtifact(testcontext.New(t), repository+":latest", true, nil)
+		require.ErrorIs(t, err, ErrNotSigned)
+	})
+
+	t.Run("untrusted", func(t *testing.T) {
+		registry, repository, manifestDigest := setup(t)
+		registry.sign(t, newECDSASigner(t), manifestDigest, manifestDigest)
+
+		_, err := verifier.VerifyArtifact(testcontext.New(t), repository+":latest", true, nil)
+		require.ErrorIs(t, err, ErrUntrusted)
+	})
+
+	t.Run("signature of another artifact", func(t *testing.T) {
+		registry, repository, manifestDigest := setup(t)
+		registry.sign(t, signer, manifestDigest, digest.FromString("other"))
+
+		_, err := verifier.VerifyArtifact(testcontext.New(t), repository+":latest", true, nil)
+		require.ErrorIs(t, err, ErrUntrusted)
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotSigned is returned when a recipe has no signature.
+	ErrNotSigned = errors.New("recipe is not signed")
+
+	// ErrUntrusted is returned when none of the signatures of a recipe were made with a trusted key.
+	ErrUntrusted = errors.New("recipe is not signed by a trusted key")
+)
+
+// Verifier verifies the signatures of recipes against a set of trusted public keys. Verification is offline: the
+// signatures are verified with the keys alone, without a transparency log or certificate authority.
+type Verifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewVerifier creates a verifier trusting the given PEM encoded public keys. ECDSA, Ed25519 and RSA keys are supported,
+// which covers the keys generated by `cosign generate-key-pair`.
+func NewVerifier(trustedKeys []string) (*Verifier, error) {
+	if len(trustedKeys) == 0 {
+		return nil, errors.New("at least one trusted key is required")
+	}
+
+	keys := []crypto.PublicKey{}
+	for i, trustedKey := range trustedKeys {
+		key, err := parsePublicKey(trustedKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key at index %d: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return &Verifier{keys: keys}, nil
+}
+
+// VerifyBlob verifies that signature is a signature of payload made with one of the trusted keys. It returns
+// ErrUntrusted otherwise.
+func (v *Verifier) VerifyBlob(payload []byte, signature []byte) error {
+	hash := sha256.Sum256(payload)
+	for _, key := range v.keys {
+		if verify(key, payload, hash[:], signature) {
+			return nil
+		}
+	}
+
+	return ErrUntrusted
+}
+
+// verify returns true if signature is a signature of payload, or of its SHA-256 hash for ECDSA and RSA keys, made
+// with key.
+func verify(key crypto.PublicKey, payload []byte, hash []byte, signature []byte) bool {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hash, signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash, signature) == nil ||
+			rsa.VerifyPSS(k, crypto.SHA256, hash, signature, nil) == nil
+	default:
+		return false
+	}
+}
+
+// parsePublicKey parses a PEM encoded PKIX public key.
+func parsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("key is not PEM encoded")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testSigner signs payloads the way cosign does for its key type.
+type testSigner struct {
+	key       crypto.Signer
+	publicKey string
+}
+
+func newTestSigner(t *testing.T, key crypto.Signer) *testSigner {
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	return &testSigner{
+		key:       key,
+		publicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+func newECDSASigner(t *testing.T) *testSigner {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return newTestSigner(t, key)
+}
+
+func (s *testSigner) sign(t *testing.T, payload []byte) []byte {
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		signature, err := s.key.Sign(rand.Reader, payload, crypto.Hash(0))
+		require.NoError(t, err)
+		return signature
+	}
+
+	hash := sha256.Sum256(payload)
+	signature, err := s.key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	require.NoError(t, err)
+	return signature
+}
+
+func Test_NewVerifier(t *testing.T) {
+	_, err := NewVerifier(nil)
+	require.ErrorContains(t, err, "at least one trusted key is required")
+
+	_, err = NewVerifier([]string{newECDSASigner(t).publicKey, "not a key"})
+	require.ErrorContains(t, err, "invalid trusted key at index 1: key is not PEM encoded")
+
+	_, err = NewVerifier([]string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("invalid")}))})
+	require.ErrorContains(t, err, "invalid trusted key at index 0")
+}
+
+func Test_VerifyBlob(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signers := map[string]*testSigner{
+		"ecdsa":   newECDSASigner(t),
+		"ed25519": newTestSigner(t, ed25519Key),
+		"rsa":     newTestSigner(t, rsaKey),
+	}
+
+	payload := []byte("recipe")
+	for name, signer := range signers {
+		t.Run(name, func(t *testing.T) {
+			verifier, err := NewVerifier([]string{newECDSASigner(t).publicKey, signer.publicKey})
+			require.NoError(t, err)
+
+			signature := signer.sign(t, payload)
+			require.NoError(t, verifier.VerifyBlob(payload, signature))
+			require.ErrorIs(t, verifier.VerifyBlob([]byte("modified"), signature), ErrUntrusted)
+		})
+	}
+
+	t.Run("untrusted key", func(t *testing.T) {
+		verifier, err := NewVerifier([]string{newECDSASigner(t).publicKey})
+		require.NoError(t, err)
+		require.ErrorIs(t, verifier.VerifyBlob(payload, newECDSASigner(t).sign(t, payload)), ErrUntrusted)
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sourcelock
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+const (
+	// gitForcedPrefix is the prefix of Terraform module sources that are forced to be read from a Git repository.
+	gitForcedPrefix = "git::"
+
+	// githubPrefix is the prefix of Terraform module sources in GitHub repositories.
+	githubPrefix = "github.com/"
+)
+
+// commitHash matches full SHA-1 and SHA-256 commit hashes.
+var commitHash = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// gitSource is a Terraform module source in a Git repository.
+// https://developer.hashicorp.com/terraform/language/modules/sources#generic-git-repository
+type gitSource struct {
+	// path is the source without its query, including the forced prefix and subdirectory.
+	path string
+
+	// repository is the URL of the repository.
+	repository string
+
+	// query is the query of the source.
+	query url.Values
+}
+
+// parseGitSource parses a Terraform module source that is read from a Git repository, either forced with the git::
+// prefix or a GitHub repository. It returns false for other sources.
+func parseGitSource(source string) (*gitSource, bool) {
+	path, rawQuery, _ := strings.Cut(source, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, false
+	}
+
+	var repository string
+	switch {
+	case strings.HasPrefix(path, gitForcedPrefix):
+		repository = strings.TrimPrefix(path, gitForcedPrefix)
+
+		// The subdirectory of the module follows a double slash, which must be told apart from the one of the scheme.
+		start := 0
+		if i := strings.Index(repository, "://"); i >= 0 {
+			start = i + len("://")
+		}
+		if i := strings.Index(repository[start:], "//"); i >= 0 {
+			repository = repository[:start+i]
+		}
+
+	case strings.HasPrefix(path, githubPrefix):
+		parts := strings.SplitN(strings.TrimPrefix(path, githubPrefix), "/", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, false
+		}
+		repository = "https://" + githubPrefix + parts[0] + "/" + strings.TrimSuffix(parts[1], ".git") + ".git"
+
+	default:
+		return nil, false
+	}
+
+	if repository == "" {
+		return nil, false
+	}
+
+	return &gitSource{path: path, repository: repository, query: query}, true
+}
+
+// ref returns the branch, tag or commit the source refers to. An empty ref refers to the default branch.
+func (s *gitSource) ref() string {
+	return s.query.Get("ref")
+}
+
+// pin returns the source with its ref replaced by the commit. Shallow clones are not supported for commits, so the
+// depth is removed.
+func (s *gitSource) pin(commit string) string {
+	query := url.Values{}
+	for k, v := range s.query {
+		query[k] = v
+	}
+	query.Set("ref", commit)
+	query.Del("depth")
+
+	return s.path + "?" + query.Encode()
+}
+
+// resolveGitRef returns the commit the branch or tag of the repository points to. An empty ref resolves the default
+// branch, and a commit resolves to itself.
+func resolveGitRef(ctx context.Context, repository string, ref string) (string, error) {
+	if commitHash.MatchString(ref) {
+		return ref, nil
+	}
+
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{repository}})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the refs of %q: %w", repository, err)
+	}
+
+	hashes := map[plumbing.ReferenceName]*plumbing.Reference{}
+	for _, r := range refs {
+		hashes[r.Name()] = r
+	}
+
+	var candidates []plumbing.ReferenceName
+	if ref == "" {
+		candidates = []plumbing.ReferenceName{plumbing.HEAD}
+	} else {
+		// Annotated tags are peeled to the commit they point to.
+		candidates = []plumbing.ReferenceName{
+			plumbing.ReferenceName(plumbing.NewTagReferenceName(ref).String() + "^{}"),
+			plumbing.NewTagReferenceName(ref),
+			plumbing.NewBranchReferenceName(ref),
+		}
+	}
+
+	for _, name := range candidates {
+		r, ok := hashes[name]
+		if ok && r.Type() == plumbing.SymbolicReference {
+			r, ok = hashes[r.Target()]
+		}
+		if ok {
+			return r.Hash().String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("ref %q was not found in %q", ref, repository)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sourcelock
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepository creates a Git repository with a commit on the default branch, a lightweight tag and an annotated
+// tag. It returns the URL of the repository and the hash of the commit.
+func newTestRepository(t *testing.T) (string, string) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`variable "context" {}`), 0644))
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add("main.tf")
+	require.NoError(t, err)
+
+	signature := &object.Signature{Name: "test", Email: "test@example.com", When: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	commit, err := worktree.Commit("initial commit", &git.CommitOptions{Author: signature})
+	require.NoError(t, err)
+
+	_, err = repo.CreateTag("v1.0.0", commit, nil)
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.1.0", commit, &git.CreateTagOptions{Tagger: signature, Message: "v1.1.0"})
+	require.NoError(t, err)
+
+	return "file://" + dir, commit.String()
+}
+
+func Test_ParseGitSource(t *testing.T) {
+	tests := []struct {
+		source     string
+		repository string
+		ref        string
+		pinned     string
+		ok         bool
+	}{
+		{
+			source:     "git::https://example.com/recipes.git//redis?ref=v1.0.0&depth=1",
+			repository: "https://example.com/recipes.git",
+			ref:        "v1.0.0",
+			pinned:     "git::https://example.com/recipes.git//redis?ref=abc",
+			ok:         true,
+		},
+		{
+			source:     "git::ssh://git@example.com/recipes.git",
+			repository: "ssh://git@example.com/recipes.git",
+			pinned:     "git::ssh://git@example.com/recipes.git?ref=abc",
+			ok:         true,
+		},
+		{
+			source:     "github.com/example/recipes//redis?ref=main",
+			repository: "https://github.com/example/recipes.git",
+			ref:        "main",
+			pinned:     "github.com/example/recipes//redis?ref=abc",
+			ok:         true,
+		},
+		{source: "github.com/example"},
+		{source: "Azure/redis/azurerm"},
+		{source: "https://example.com/redis.zip"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.source, func(t *testing.T) {
+			source, ok := parseGitSource(tc.source)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+
+			require.Equal(t, tc.repository, source.repository)
+			require.Equal(t, tc.ref, source.ref())
+			require.Equal(t, tc.pinned, source.pin("abc"))
+		})
+	}
+}
+
+func Test_ResolveGitRef(t *testing.T) {
+	ctx := testcontext.New(t)
+	repository, commit := newTestRepository(t)
+
+	for _, ref := range []string{"", "master", "v1.0.0", "v1.1.0", commit} {
+		t.Run(ref, func(t *testing.T) {
+			actual, err := resolveGitRef(ctx, repository, ref)
+			require.NoError(t, err)
+			require.Equal(t, commit, actual)
+		})
+	}
+
+	_, err := resolveGitRef(ctx, repository, "v2.0.0")
+	require.EqualError(t, err, `ref "v2.0.0" was not found in "`+repository+`"`)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sourcelock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-version"
+)
+
+const (
+	// discoveryPath is the path of the service discovery document of Terraform registries.
+	// https://developer.hashicorp.com/terraform/internals/remote-service-discovery
+	discoveryPath = "/.well-known/terraform.json"
+
+	// modulesService is the name of the module registry protocol in the service discovery document.
+	modulesService = "modules.v1"
+)
+
+// moduleVersions is the response of the list available versions endpoint of the module registry protocol.
+// https://developer.hashicorp.com/terraform/internals/module-registry-protocol#list-available-versions-for-a-specific-module
+type moduleVersions struct {
+	Modules []struct {
+		Versions []struct {
+			Version string `json:"version"`
+		} `json:"versions"`
+	} `json:"modules"`
+}
+
+// latestModuleVersion returns the latest version of the module with the address <namespace>/<name>/<system> in the
+// registry. Pre-releases are ignored, like Terraform does for modules without a version constraint.
+func (r *resolver) latestModuleVersion(ctx context.Context, host string, address string) (string, error) {
+	discovery := map[string]any{}
+	base := &url.URL{Scheme: "https", Host: host}
+	if err := r.getJSON(ctx, base.ResolveReference(&url.URL{Path: discoveryPath}), &discovery); err != nil {
+		return "", err
+	}
+
+	service, ok := discovery[modulesService].(string)
+	if !ok {
+		return "", fmt.Errorf("registry %q does not support the %s protocol", host, modulesService)
+	}
+
+	modules, err := base.Parse(service)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s endpoint %q of registry %q: %w", modulesService, service, host, err)
+	}
+
+	response := moduleVersions{}
+	if err := r.getJSON(ctx, modules.JoinPath(address, "versions"), &response); err != nil {
+		return "", err
+	}
+
+	var latest *version.Version
+	for _, module := range response.Modules {
+		for _, v := range module.Versions {
+			parsed, err := version.NewVersion(v.Version)
+			if err != nil || parsed.Prerelease() != "" {
+				continue
+			}
+
+			if latest == nil || parsed.GreaterThan(latest) {
+				latest = parsed
+			}
+		}
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("no versions of module %q were found in registry %q", address, host)
+	}
+
+	return latest.Original(), nil
+}
+
+// getJSON sends a GET request to the URL and decodes the JSON response into v.
+func (r *resolver) getJSON(ctx context.Context, u *url.URL, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if token, ok := r.options.TerraformTokens[u.Host]; ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := r.options.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %q failed with status code %d", u.String(), resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode the response of %q: %w", u.String(), err)
+	}
+
+	return nil
+}
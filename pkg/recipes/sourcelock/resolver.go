@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sourcelock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/pkg/rp/util"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+// ErrUnsupportedSource is returned when the source of a recipe cannot be pinned to an immutable reference.
+var ErrUnsupportedSource = errors.New("source cannot be pinned to an immutable reference")
+
+// Lock is the immutable reference the source of a recipe resolves to.
+type Lock struct {
+	// Source is the source of the recipe pinned to the immutable reference. It is used in place of the source of the
+	// recipe.
+	Source string
+
+	// Version is the version of the module the recipe is pinned to, for Terraform registry modules, which are versioned
+	// separately from their source.
+	Version string
+
+	// Revision is the digest of the OCI artifact or the commit of the Git repository the source resolves to.
+	Revision string
+}
+
+//go:generate go tool mockgen -typed -destination=./mock_resolver.go -package=sourcelock -self_package github.com/radius-project/radius/pkg/recipes/sourcelock github.com/radius-project/radius/pkg/recipes/sourcelock Resolver
+
+// Resolver resolves the sources of recipes to immutable references.
+type Resolver interface {
+	// Resolve resolves the source of a recipe of the given kind to the immutable reference it currently points to.
+	// It returns ErrUnsupportedSource if the kind or form of the source cannot be pinned.
+	Resolve(ctx context.Context, kind string, source string, plainHTTP bool) (*Lock, error)
+}
+
+// ResolverOptions represents the options of the recipe source resolver.
+type ResolverOptions struct {
+	// RegistryClient is the client used to resolve OCI references. A nil client uses RegistryCredentials.
+	RegistryClient remote.Client
+
+	// RegistryCredentials maps the hostnames of OCI registries to the credentials used to resolve their references.
+	// Other registries are accessed anonymously. It is ignored if RegistryClient is set.
+	RegistryCredentials map[string]auth.Credential
+
+	// HTTPClient is the client used to query Terraform registries. A nil client uses http.DefaultClient.
+	HTTPClient *http.Client
+
+	// TerraformTokens maps the hostnames of Terraform registries to the tokens used to query them, like the credentials
+	// blocks of the Terraform CLI configuration.
+	TerraformTokens map[string]string
+}
+
+// NewResolver creates a new resolver of recipe sources.
+func NewResolver(options ResolverOptions) Resolver {
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+
+	if options.RegistryClient == nil && len(options.RegistryCredentials) > 0 {
+		credentials := options.RegistryCredentials
+		options.RegistryClient = &auth.Client{
+			Client: retry.DefaultClient,
+			Credential: func(ctx context.Context, hostport string) (auth.Credential, error) {
+				return credentials[hostport], nil
+			},
+		}
+	}
+
+	return &resolver{options: options}
+}
+
+type resolver struct {
+	options ResolverOptions
+}
+
+// Resolve resolves the source of a recipe to an immutable reference:
+//
+//   - Bicep recipes are pinned to the digest of the manifest of their OCI artifact.
+//   - Terraform recipes from a Git repository are pinned to the commit of their ref.
+//   - Terraform recipes from a Terraform registry are pinned to the latest version of the module.
+//
+// Other sources, such as Helm charts and archives served over HTTP, are not pinned.
+func (r *resolver) Resolve(ctx context.Context, kind string, source string, plainHTTP bool) (*Lock, error) {
+	switch kind {
+	case recipes.TemplateKindBicep:
+		pinned, digest, err := util.ResolveRegistryReference(ctx, source, plainHTTP, r.options.RegistryClient)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Lock{Source: pinned, Revision: digest}, nil
+
+	case recipes.TemplateKindTerraform:
+		if host, address, ok := terraform.ParseRegistryModuleSource(source); ok {
+			version, err := r.latestModuleVersion(ctx, host, address)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Lock{Source: source, Version: version}, nil
+		}
+
+		if git, ok := parseGitSource(source); ok {
+			commit, err := resolveGitRef(ctx, git.repository, git.ref())
+			if err != nil {
+				return nil, err
+			}
+
+			return &Lock{Source: git.pin(commit), Revision: commit}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s recipe source %q", ErrUnsupportedSource, kind, source)
+}
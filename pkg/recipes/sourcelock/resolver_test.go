@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sourcelock
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+func Test_Resolve_Bicep(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)
+	manifestDigest := digest.FromBytes(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/recipes/redis/manifests/latest" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	lock, err := NewResolver(ResolverOptions{}).Resolve(testcontext.New(t), recipes.TemplateKindBicep, host+"/recipes/redis:latest", true)
+	require.NoError(t, err)
+	require.Equal(t, &Lock{Source: host + "/recipes/redis@" + manifestDigest.String(), Revision: manifestDigest.String()}, lock)
+}
+
+func Test_Resolve_TerraformRegistry(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/terraform.json":
+			_, _ = w.Write([]byte(`{"modules.v1": "/api/registry/v1/modules/"}`))
+		case "/api/registry/v1/modules/example/redis/kubernetes/versions":
+			_, _ = w.Write([]byte(`{"modules": [{"versions": [{"version": "1.2.0"}, {"version": "1.10.0"}, {"version": "2.0.0-beta1"}, {"version": "1.9.0"}]}]}`))
+		case "/api/registry/v1/modules/example/empty/kubernetes/versions":
+			_, _ = w.Write([]byte(`{"modules": [{"versions": []}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := testcontext.New(t)
+	host := strings.TrimPrefix(server.URL, "https://")
+	resolver := NewResolver(ResolverOptions{HTTPClient: server.Client()})
+
+	source := host + "/example/redis/kubernetes//modules/cluster"
+	lock, err := resolver.Resolve(ctx, recipes.TemplateKindTerraform, source, false)
+	require.NoError(t, err)
+	require.Equal(t, &Lock{Source: source, Version: "1.10.0"}, lock)
+
+	_, err = resolver.Resolve(ctx, recipes.TemplateKindTerraform, host+"/example/empty/kubernetes", false)
+	require.ErrorContains(t, err, `no versions of module "example/empty/kubernetes" were found`)
+
+	_, err = resolver.Resolve(ctx, recipes.TemplateKindTerraform, host+"/example/missing/kubernetes", false)
+	require.ErrorContains(t, err, "failed with status code 404")
+}
+
+func Test_Resolve_Credentials(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)
+	manifestDigest := digest.FromBytes(manifest)
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "password" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+	}))
+	defer registry.Close()
+
+	terraformRegistry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/.well-known/terraform.json":
+			_, _ = w.Write([]byte(`{"modules.v1": "/v1/modules/"}`))
+		case "/v1/modules/example/redis/kubernetes/versions":
+			_, _ = w.Write([]byte(`{"modules": [{"versions": [{"version": "1.0.0"}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer terraformRegistry.Close()
+
+	ctx := testcontext.New(t)
+	registryHost := strings.TrimPrefix(registry.URL, "http://")
+	terraformHost := strings.TrimPrefix(terraformRegistry.URL, "https://")
+
+	anonymous := NewResolver(ResolverOptions{HTTPClient: terraformRegistry.Client()})
+	_, err := anonymous.Resolve(ctx, recipes.TemplateKindBicep, registryHost+"/recipes/redis:latest", true)
+	require.Error(t, err)
+	_, err = anonymous.Resolve(ctx, recipes.TemplateKindTerraform, terraformHost+"/example/redis/kubernetes", false)
+	require.ErrorContains(t, err, "failed with status code 401")
+
+	resolver := NewResolver(ResolverOptions{
+		RegistryCredentials: map[string]auth.Credential{registryHost: {Username: "user", Password: "password"}},
+		HTTPClient:          terraformRegistry.Client(),
+		TerraformTokens:     map[string]string{terraformHost: "token"},
+	})
+
+	lock, err := resolver.Resolve(ctx, recipes.TemplateKindBicep, registryHost+"/recipes/redis:latest", true)
+	require.NoError(t, err)
+	require.Equal(t, manifestDigest.String(), lock.Revision)
+
+	lock, err = resolver.Resolve(ctx, recipes.TemplateKindTerraform, terraformHost+"/example/redis/kubernetes", false)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", lock.Version)
+}
+
+func Test_Resolve_TerraformGit(t *testing.T) {
+	repository, commit := newTestRepository(t)
+
+	lock, err := NewResolver(ResolverOptions{}).Resolve(testcontext.New(t), recipes.TemplateKindTerraform, "git::"+repository+"//redis?ref=v1.1.0", false)
+	require.NoError(t, err)
+	require.Equal(t, &Lock{Source: "git::" + repository + "//redis?ref=" + commit, Revision: commit}, lock)
+}
+
+func Test_Resolve_Unsupported(t *testing.T) {
+	resolver := NewResolver(ResolverOptions{})
+
+	_, err := resolver.Resolve(testcontext.New(t), recipes.TemplateKindHelm, "oci://example.com/charts/redis:1.0.0", false)
+	require.ErrorIs(t, err, ErrUnsupportedSource)
+
+	_, err = resolver.Resolve(testcontext.New(t), recipes.TemplateKindTerraform, "https://example.com/redis.zip", false)
+	require.ErrorIs(t, err, ErrUnsupportedSource)
+}
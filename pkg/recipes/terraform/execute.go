@@ -23,6 +23,7 @@ import (
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
@@ -42,6 +44,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// planFileName is the name of the file terraform plan writes the planned changes to.
+	planFileName = "tfplan"
+
+	// providersRootDir is the directory terraform init installs the provider plugins to.
+	providersRootDir = ".terraform/providers"
+
+	// envTFPluginCacheDir is the Terraform CLI environment variable that sets the provider plugin cache directory.
+	envTFPluginCacheDir = "TF_PLUGIN_CACHE_DIR"
+
+	// envTFPluginCacheMayBreakDependencyLockFile is the Terraform CLI environment variable that allows Terraform to use
+	// the provider plugin cache without a dependency lock file.
+	envTFPluginCacheMayBreakDependencyLockFile = "TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"
+)
+
 var (
 	// ErrRecipeNameEmpty is the error when the recipe name is empty.
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
@@ -70,7 +87,7 @@ type executor struct {
 func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, SensitiveValues: secretValues(options.Secrets)})
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +111,8 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Run TF Init and Apply in the working directory
 	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	state, err := initAndApply(ctx, tf, stateLockTimeout)
+	state, err := initAndApply(ctx, tf, options.Cache, stateLockTimeout)
+	trackCachedProviders(ctx, options.Cache, tf.WorkingDir())
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +141,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, SensitiveValues: secretValues(options.Secrets)})
 	// Note: We use a global shared binary approach, so we should NOT call i.Remove()
 	// as it would remove the shared global binary that other operations might be using.
 	// The global binary will persist across operations to eliminate race conditions.
@@ -164,7 +182,8 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
 	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	err = initAndDestroy(ctx, tf, stateLockTimeout)
+	err = initAndDestroy(ctx, tf, options.Cache, stateLockTimeout)
+	trackCachedProviders(ctx, options.Cache, tf.WorkingDir())
 	if err != nil {
 		return err
 	}
@@ -180,10 +199,41 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	return nil
 }
 
+// Plan ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init and
+// plan in the working directory, returning the planned changes. Nothing is applied.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, SensitiveValues: secretValues(options.Secrets)})
+	if err != nil {
+		return nil, err
+	}
+
+	// Set environment variables before generateConfig for the same reasons as Deploy.
+	if options.EnvConfig != nil {
+		if err = e.setEnvironmentVariables(tf, options); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run TF Init and Plan in the working directory. The state backend is read (and locked) so that
+	// the plan reflects the resources deployed by previous executions of the recipe.
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
+	plan, err := initAndPlan(ctx, tf, options.Cache, stateLockTimeout)
+	trackCachedProviders(ctx, options.Cache, tf.WorkingDir())
+	return plan, err
+}
+
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel, SensitiveValues: secretValues(options.Secrets)})
 	if err != nil {
 		return nil, err
 	}
@@ -255,6 +305,12 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 		}
 	}
 
+	// Install provider plugins in the shared provider plugin cache.
+	if options.Cache != nil {
+		envVarUpdate = true
+		maps.Copy(envVars, providerCacheEnvVars(options.Cache))
+	}
+
 	// Set the environment variables for the Terraform process
 	if envVarUpdate {
 		if err := tf.SetEnv(envVars); err != nil {
@@ -267,39 +323,107 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 
 // applyTerraformCLIConfig writes a .terraformrc file derived from the
 // provider_installation rules and credentials in options.EnvConfig (if any) and
-// configures the Terraform CLI to use it via TF_CLI_CONFIG_FILE.
+// configures the Terraform CLI to use it via TF_CLI_CONFIG_FILE, and points the
+// Terraform CLI at the provider plugin cache of options.Cache (if any).
 //
 // This path is used by Delete (and indirectly by Deploy via setEnvironmentVariables)
 // to ensure terraform init can resolve providers from a network mirror and
-// authenticate to private registries. When neither input is populated, this is
-// a no-op.
+// authenticate to private registries. When none of the inputs is populated, this
+// is a no-op.
 func (e executor) applyTerraformCLIConfig(tf *tfexec.Terraform, options Options) error {
-	if options.EnvConfig == nil {
-		return nil
-	}
-	pi := options.EnvConfig.RecipeConfig.Terraform.ProviderInstallation
-	creds := options.EnvConfig.RecipeConfig.Terraform.Credentials
-	if pi == nil && len(creds) == 0 {
-		return nil
+	// tf.SetEnv replaces the entire env, so seed from the current process env first.
+	envVars := splitEnvVar(os.Environ())
+	var envVarUpdate bool
+
+	if options.EnvConfig != nil {
+		pi := options.EnvConfig.RecipeConfig.Terraform.ProviderInstallation
+		creds := options.EnvConfig.RecipeConfig.Terraform.Credentials
+		if pi != nil || len(creds) > 0 {
+			rcPath, err := writeTerraformCLIConfig(tf.WorkingDir(), pi, creds, options.Secrets)
+			if err != nil {
+				return err
+			}
+			if rcPath != "" {
+				envVarUpdate = true
+				envVars[envTFCLIConfigFile] = rcPath
+			}
+		}
 	}
 
-	rcPath, err := writeTerraformCLIConfig(tf.WorkingDir(), pi, creds, options.Secrets)
-	if err != nil {
-		return err
+	if options.Cache != nil {
+		envVarUpdate = true
+		maps.Copy(envVars, providerCacheEnvVars(options.Cache))
 	}
-	if rcPath == "" {
+
+	if !envVarUpdate {
 		return nil
 	}
 
-	// tf.SetEnv replaces the entire env, so seed from the current process env first.
-	envVars := splitEnvVar(os.Environ())
-	envVars[envTFCLIConfigFile] = rcPath
 	if err := tf.SetEnv(envVars); err != nil {
 		return fmt.Errorf("failed to set environment variables: %w", err)
 	}
 	return nil
 }
 
+// providerCacheEnvVars returns the environment variables that make Terraform install provider plugins in the provider
+// plugin cache of the artifact cache, and link them from there into the working directory.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#provider-plugin-cache
+func providerCacheEnvVars(artifactCache *cache.Cache) map[string]string {
+	return map[string]string{
+		envTFPluginCacheDir: artifactCache.ProvidersDir(),
+
+		// Recipe executions have no dependency lock file, without which Terraform does not use the plugin cache.
+		envTFPluginCacheMayBreakDependencyLockFile: "true",
+	}
+}
+
+// terraformInitializer is the subset of *tfexec.Terraform used to run Terraform init.
+type terraformInitializer interface {
+	Init(ctx context.Context, opts ...tfexec.InitOption) error
+}
+
+// initTerraform runs Terraform init. When the provider plugin cache of the artifact cache is used, init holds the lock
+// of the provider plugin cache, because Terraform installs provider plugins into it and it is not safe for concurrent use.
+func initTerraform(ctx context.Context, tf terraformInitializer, artifactCache *cache.Cache) error {
+	if artifactCache != nil {
+		unlock, err := artifactCache.LockProviders(ctx)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	return tf.Init(ctx)
+}
+
+// trackCachedProviders adds the provider plugins linked into the working directory from the provider plugin cache to
+// the artifact cache, or marks them as used, so that the least recently used plugins are evicted first.
+func trackCachedProviders(ctx context.Context, artifactCache *cache.Cache, workingDir string) {
+	if artifactCache == nil {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Providers are installed as .terraform/providers/<hostname>/<namespace>/<type>/<version>/<os>_<arch>, which links to
+	// the same path in the provider plugin cache.
+	installed, err := filepath.Glob(filepath.Join(workingDir, providersRootDir, "*", "*", "*", "*", "*"))
+	if err != nil {
+		return
+	}
+
+	for _, path := range installed {
+		target, err := filepath.EvalSymlinks(path)
+		if err != nil || !strings.HasPrefix(target, artifactCache.ProvidersDir()+string(filepath.Separator)) {
+			continue
+		}
+
+		if err := artifactCache.Track(ctx, cache.ArtifactTerraformProvider, target); err != nil {
+			logger.Info(fmt.Sprintf("Failed to track cached Terraform provider %q: %s", target, err.Error()))
+		}
+	}
+}
+
 // splitEnvVar splits a slice of environment variables into a map of keys and values.
 func splitEnvVar(envVars []string) map[string]string {
 	parsedEnvVars := make(map[string]string)
@@ -427,13 +551,13 @@ func getStateLockTimeout(timeout string) string {
 }
 
 // initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) (*tfjson.State, error) {
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, artifactCache *cache.Cache, stateLockTimeout string) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := initTerraform(ctx, tf, artifactCache); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -467,14 +591,44 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the saved plan.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform, artifactCache *cache.Cache, stateLockTimeout string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := initTerraform(ctx, tf, artifactCache); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Plan Terraform configuration with state lock timeout
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	logger.Info("Running Terraform plan with state lock timeout: " + stateLockTimeout)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	// Suppress stdout while reading the plan, it contains the planned values which may be sensitive.
+	tf.SetStdout(io.Discard)
+	defer tf.SetStdout(&tfLogWrapper{logger: logger})
+
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) error {
+func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, artifactCache *cache.Cache, stateLockTimeout string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := initTerraform(ctx, tf, artifactCache); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
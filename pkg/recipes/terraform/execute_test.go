@@ -17,14 +17,19 @@ limitations under the License.
 package terraform
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	reflect "reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -355,6 +360,125 @@ func TestApplyTerraformCLIConfig(t *testing.T) {
 	}
 }
 
+func TestApplyTerraformCLIConfig_ProviderCache(t *testing.T) {
+	artifactCache, err := cache.New(cache.Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	env := providerCacheEnvVars(artifactCache)
+	require.Equal(t, map[string]string{
+		"TF_PLUGIN_CACHE_DIR":                            artifactCache.ProvidersDir(),
+		"TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE": "true",
+	}, env)
+
+	workingDir := t.TempDir()
+	tf, err := tfexec.NewTerraform(workingDir, filepath.Join(workingDir, "terraform"))
+	require.NoError(t, err)
+
+	e := executor{}
+	require.NoError(t, e.applyTerraformCLIConfig(tf, Options{Cache: artifactCache}))
+
+	// The provider plugin cache does not need a .terraformrc.
+	require.NoFileExists(t, filepath.Join(workingDir, terraformCLIConfigFileName))
+}
+
+func TestTrackCachedProviders(t *testing.T) {
+	ctx := testcontext.New(t)
+	artifactCache, err := cache.New(cache.Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	workingDir := t.TempDir()
+	providerPath := filepath.Join("registry.terraform.io", "hashicorp", "random", "3.6.0", "linux_amd64")
+
+	// Terraform links the providers installed from the plugin cache into the working directory.
+	cached := filepath.Join(artifactCache.ProvidersDir(), providerPath)
+	require.NoError(t, os.MkdirAll(cached, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(cached, "terraform-provider-random"), []byte("provider"), 0755))
+
+	linked := filepath.Join(workingDir, providersRootDir, providerPath)
+	require.NoError(t, os.MkdirAll(filepath.Dir(linked), 0755))
+	require.NoError(t, os.Symlink(cached, linked))
+
+	// Providers that are not linked from the plugin cache are ignored.
+	copied := filepath.Join(workingDir, providersRootDir, "registry.terraform.io", "hashicorp", "null", "3.2.0", "linux_amd64")
+	require.NoError(t, os.MkdirAll(copied, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(copied, "terraform-provider-null"), []byte("provider-null"), 0755))
+
+	trackCachedProviders(ctx, artifactCache, workingDir)
+	require.Equal(t, int64(8), artifactCache.Size())
+
+	// A nil cache is a no-op.
+	trackCachedProviders(ctx, nil, workingDir)
+}
+
+// fakeProviderInstaller is a terraformInitializer that installs a provider plugin into the provider plugin cache, and
+// records how many inits install into the cache at the same time.
+type fakeProviderInstaller struct {
+	providersDir string
+	active       *atomic.Int32
+	maxActive    *atomic.Int32
+}
+
+func (f *fakeProviderInstaller) Init(ctx context.Context, opts ...tfexec.InitOption) error {
+	active := f.active.Add(1)
+	defer f.active.Add(-1)
+	for {
+		current := f.maxActive.Load()
+		if active <= current || f.maxActive.CompareAndSwap(current, active) {
+			break
+		}
+	}
+
+	provider := filepath.Join(f.providersDir, "registry.terraform.io", "hashicorp", "random", "3.6.0", "linux_amd64")
+	if err := os.MkdirAll(provider, 0755); err != nil {
+		return err
+	}
+
+	// Give other inits the chance to run concurrently if they are not serialized.
+	time.Sleep(10 * time.Millisecond)
+	return os.WriteFile(filepath.Join(provider, "terraform-provider-random"), []byte("provider"), 0755)
+}
+
+func TestInitTerraform_SerializesProviderCacheInstalls(t *testing.T) {
+	ctx := testcontext.New(t)
+	artifactCache, err := cache.New(cache.Options{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	installer := &fakeProviderInstaller{
+		providersDir: artifactCache.ProvidersDir(),
+		active:       &atomic.Int32{},
+		maxActive:    &atomic.Int32{},
+	}
+
+	const executions = 8
+	errs := make(chan error, executions)
+	var wg sync.WaitGroup
+	for range executions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- initTerraform(ctx, installer, artifactCache)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), installer.maxActive.Load())
+}
+
+func TestInitTerraform_NoCache(t *testing.T) {
+	installer := &fakeProviderInstaller{
+		providersDir: t.TempDir(),
+		active:       &atomic.Int32{},
+		maxActive:    &atomic.Int32{},
+	}
+
+	require.NoError(t, initTerraform(testcontext.New(t), installer, nil))
+	require.Equal(t, int32(1), installer.maxActive.Load())
+}
+
 func TestSplitEnvVar(t *testing.T) {
 	tests := []struct {
 		name    string
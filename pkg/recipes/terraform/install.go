@@ -54,6 +54,9 @@ type InstallOptions struct {
 
 	// LogLevel controls the verbosity of Terraform execution logs.
 	LogLevel string
+
+	// SensitiveValues are the values redacted from the Terraform logs, such as the values of the secrets used by the recipe.
+	SensitiveValues []string
 }
 
 // getGlobalTerraformPaths returns the terraform paths, allowing override for testing
@@ -90,7 +93,7 @@ func Install(ctx context.Context, installer *install.Installer, opts InstallOpti
 	}
 
 	// Configure Terraform logs
-	configureTerraformLogs(ctx, tf, opts.LogLevel)
+	configureTerraformLogs(ctx, tf, opts.LogLevel, opts.SensitiveValues)
 
 	return tf, nil
 }
@@ -17,32 +17,135 @@ limitations under the License.
 package terraform
 
 import (
+	"cmp"
 	"context"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger.
+const (
+	// logSource is the source of the Terraform entries in the logs of the operation.
+	logSource = "terraform"
+
+	// redacted replaces the sensitive values in the Terraform logs.
+	redacted = "***"
+)
+
+var (
+	// tfLogEntryPattern matches the first line of an entry of the Terraform logs enabled by TF_LOG, capturing its level.
+	// For example: "2024-01-02T03:04:05.678Z [DEBUG] provider: starting plugin".
+	tfLogEntryPattern = regexp.MustCompile(`^\S+ \[(TRACE|DEBUG|INFO|WARN|ERROR)\] `)
+
+	// sensitiveAssignmentPattern matches the assignment of a value to a key whose name suggests the value is
+	// sensitive, such as `password = "value"`, `"client_secret": "value"` or `Authorization: Bearer value`.
+	sensitiveAssignmentPattern = regexp.MustCompile(`(?i)([\w.-]*(?:password|passwd|secret|token|api[_-]?key|access[_-]?key|private[_-]?key|authorization|credentials?)[\w.-]*"?\s*[:=]\s*)(?:(?:bearer|basic)\s+)?("[^"]*"|[^\s,;]+)`)
+)
+
+// tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger and to the logs of
+// the operation executing the recipe. Sensitive values are redacted from both, and the TRACE and DEBUG entries of
+// the Terraform logs are not recorded in the logs of the operation.
 type tfLogWrapper struct {
 	logger   logr.Logger
+	recorder *operationlog.Recorder
+	redactor *strings.Replacer
 	isStdErr bool
+
+	// inDebugEntry is true while the lines written continue a TRACE or DEBUG entry of the Terraform logs.
+	inDebugEntry bool
+}
+
+// newTFLogWrapper creates a tfLogWrapper redacting the given sensitive values, such as the values of the secrets
+// used by the recipe.
+func newTFLogWrapper(logger logr.Logger, recorder *operationlog.Recorder, sensitiveValues []string, isStdErr bool) *tfLogWrapper {
+	// Replace the longest values first so that a value containing another one is redacted entirely.
+	values := slices.DeleteFunc(slices.Clone(sensitiveValues), func(value string) bool { return value == "" })
+	slices.SortFunc(values, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, redacted)
+	}
+
+	return &tfLogWrapper{
+		logger:   logger,
+		recorder: recorder,
+		redactor: strings.NewReplacer(oldnew...),
+		isStdErr: isStdErr,
+	}
 }
 
 // Write implements the io.Writer interface to stream the Terraform logs to the Radius logger.
 func (w *tfLogWrapper) Write(p []byte) (n int, err error) {
+	message := w.redact(string(p))
 	if w.isStdErr {
-		w.logger.Error(nil, string(p))
+		w.logger.Error(nil, message)
 	} else {
-		w.logger.Info(string(p))
+		w.logger.Info(message)
+	}
+
+	level := v1.LogLevelInfo
+	if w.isStdErr {
+		level = v1.LogLevelError
+	}
+
+	for line := range strings.Lines(message) {
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) != "" && !w.isDebugLine(line) {
+			w.recorder.Record(logSource, level, line)
+		}
 	}
 
 	return len(p), nil
 }
 
-// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs.
-func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, logLevel string) {
+// isDebugLine reports whether the line belongs to a TRACE or DEBUG entry of the Terraform logs. The values of an
+// entry spanning multiple lines are written on the following indented lines.
+func (w *tfLogWrapper) isDebugLine(line string) bool {
+	if match := tfLogEntryPattern.FindStringSubmatch(line); match != nil {
+		w.inDebugEntry = match[1] == "TRACE" || match[1] == "DEBUG"
+		return w.inDebugEntry
+	}
+
+	if w.inDebugEntry && strings.HasPrefix(line, " ") {
+		return true
+	}
+
+	w.inDebugEntry = false
+	return false
+}
+
+// redact replaces the sensitive values of the message.
+func (w *tfLogWrapper) redact(message string) string {
+	if w.redactor != nil {
+		message = w.redactor.Replace(message)
+	}
+
+	return sensitiveAssignmentPattern.ReplaceAllString(message, "${1}"+redacted)
+}
+
+// secretValues returns the values of the given secrets, to be redacted from the Terraform logs.
+func secretValues(secrets map[string]recipes.SecretData) []string {
+	values := []string{}
+	for _, secret := range secrets {
+		for _, value := range secret.Data {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs, redacting the given
+// sensitive values.
+func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, logLevel string, sensitiveValues []string) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Default to ERROR if no log level is provided
@@ -56,6 +159,7 @@ func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, logLevel
 		return
 	}
 
-	tf.SetStdout(&tfLogWrapper{logger: logger})
-	tf.SetStderr(&tfLogWrapper{logger: logger, isStdErr: true})
+	recorder := operationlog.FromContext(ctx)
+	tf.SetStdout(newTFLogWrapper(logger, recorder, sensitiveValues, false))
+	tf.SetStderr(newTFLogWrapper(logger, recorder, sensitiveValues, true))
 }
@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/operationlog"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -40,7 +43,7 @@ func TestConfigureTerraformLogs_DefaultLevel(t *testing.T) {
 
 	// This should not panic and should handle the default level when empty string is passed
 	// The function will fail to set the log level due to no binary, but should handle the error gracefully
-	configureTerraformLogs(ctx, tf, "")
+	configureTerraformLogs(ctx, tf, "", nil)
 
 	// Verify that the log wrappers are set (these can be set regardless of binary availability)
 	// This is the main testable behavior in a unit test
@@ -79,7 +82,7 @@ func TestConfigureTerraformLogs_ParameterHandling(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// This should not panic and should process the log level parameter
 			// Even though SetLog will fail, the function should handle it gracefully
-			configureTerraformLogs(ctx, tf, tc.logLevel)
+			configureTerraformLogs(ctx, tf, tc.logLevel, nil)
 		})
 	}
 }
@@ -98,7 +101,7 @@ func TestConfigureTerraformLogs_ErrorHandling(t *testing.T) {
 
 	// Test that the function handles errors gracefully when SetLog fails
 	// This tests the error handling path in our function
-	configureTerraformLogs(ctx, tf, "DEBUG")
+	configureTerraformLogs(ctx, tf, "DEBUG", nil)
 
 	// The function should not panic even when tf.SetLog fails
 	// This validates our error handling logic
@@ -139,7 +142,7 @@ func TestConfigureTerraformLogs_LoggerSetup(t *testing.T) {
 
 	// Test that the function sets up log wrappers correctly
 	// This is the main unit-testable behavior - the stdout/stderr redirection
-	configureTerraformLogs(ctx, tf, "DEBUG")
+	configureTerraformLogs(ctx, tf, "DEBUG", nil)
 
 	// The test validates that:
 	// 1. configureTerraformLogs doesn't panic
@@ -147,3 +150,98 @@ func TestConfigureTerraformLogs_LoggerSetup(t *testing.T) {
 	// 3. Error handling works when SetLog fails
 	// The actual log level setting requires a functional test with real terraform binary
 }
+
+func TestTFLogWrapper_RecordsOperationLogs(t *testing.T) {
+	ctx := testcontext.New(t)
+	recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+
+	stdout := &tfLogWrapper{logger: ucplog.FromContextOrDiscard(ctx), recorder: recorder}
+	stderr := &tfLogWrapper{logger: ucplog.FromContextOrDiscard(ctx), recorder: recorder, isStdErr: true}
+
+	output := []byte("Initializing the backend...\n\nInitializing provider plugins...\n")
+	n, err := stdout.Write(output)
+	require.NoError(t, err)
+	require.Equal(t, len(output), n)
+
+	_, err = stderr.Write([]byte("Error: Invalid provider configuration\r\n"))
+	require.NoError(t, err)
+
+	logs := recorder.Logs()
+	require.Len(t, logs.Value, 3)
+	require.Equal(t, "terraform", logs.Value[0].Source)
+	require.Equal(t, v1.LogLevelInfo, logs.Value[0].Level)
+	require.Equal(t, "Initializing the backend...", logs.Value[0].Message)
+	require.Equal(t, "Initializing provider plugins...", logs.Value[1].Message)
+	require.Equal(t, v1.LogLevelError, logs.Value[2].Level)
+	require.Equal(t, "Error: Invalid provider configuration", logs.Value[2].Message)
+}
+
+func TestTFLogWrapper_WithoutRecorder(t *testing.T) {
+	ctx := testcontext.New(t)
+	stdout := &tfLogWrapper{logger: ucplog.FromContextOrDiscard(ctx)}
+
+	_, err := stdout.Write([]byte("Apply complete!\n"))
+	require.NoError(t, err)
+}
+
+func TestTFLogWrapper_SkipsDebugEntries(t *testing.T) {
+	ctx := testcontext.New(t)
+	recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+	stderr := newTFLogWrapper(ucplog.FromContextOrDiscard(ctx), recorder, nil, true)
+
+	output := "2024-01-02T03:04:05.678Z [INFO]  Terraform version: 1.9.0\n" +
+		"2024-01-02T03:04:05.679Z [DEBUG] provider: starting plugin: path=.terraform/providers/aws\n" +
+		"2024-01-02T03:04:05.680Z [TRACE] provider.terraform-provider-aws: HTTP Request Sent:\n" +
+		"  | GET / HTTP/1.1\n" +
+		"  | Host: example.com\n" +
+		"2024-01-02T03:04:05.681Z [WARN]  Provider produced an unexpected value\n" +
+		"Error: Invalid provider configuration\n"
+	_, err := stderr.Write([]byte(output))
+	require.NoError(t, err)
+
+	messages := []string{}
+	for _, entry := range recorder.Logs().Value {
+		messages = append(messages, entry.Message)
+	}
+	require.Equal(t, []string{
+		"2024-01-02T03:04:05.678Z [INFO]  Terraform version: 1.9.0",
+		"2024-01-02T03:04:05.681Z [WARN]  Provider produced an unexpected value",
+		"Error: Invalid provider configuration",
+	}, messages)
+}
+
+func TestTFLogWrapper_RedactsSensitiveValues(t *testing.T) {
+	ctx := testcontext.New(t)
+	recorder := operationlog.NewRecorder(operationlog.DefaultMaxSize)
+	stdout := newTFLogWrapper(ucplog.FromContextOrDiscard(ctx), recorder, []string{"", "s3cr3t", "s3cr3t-and-more"}, false)
+
+	tests := []struct {
+		output   string
+		expected string
+	}{
+		{"connecting with s3cr3t-and-more", "connecting with ***"},
+		{"token s3cr3t is invalid", "token *** is invalid"},
+		{`  + admin_password = "hunter2"`, `  + admin_password = ***`},
+		{`{"client_secret": "abc", "name": "db"}`, `{"client_secret": ***, "name": "db"}`},
+		{"Authorization: Bearer eyJhbGciOi", "Authorization: ***"},
+		{"Apply complete! Resources: 1 added, 0 changed, 0 destroyed.", "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."},
+	}
+	for _, tc := range tests {
+		_, err := stdout.Write([]byte(tc.output + "\n"))
+		require.NoError(t, err)
+	}
+
+	logs := recorder.Logs()
+	require.Len(t, logs.Value, len(tests))
+	for i, tc := range tests {
+		require.Equal(t, tc.expected, logs.Value[i].Message)
+	}
+}
+
+func TestSecretValues(t *testing.T) {
+	values := secretValues(map[string]recipes.SecretData{
+		"store1": {Data: map[string]string{"username": "admin", "password": "hunter2"}},
+		"store2": {Data: map[string]string{"token": "abc"}},
+	})
+	require.ElementsMatch(t, []string{"admin", "hunter2", "abc"}, values)
+}
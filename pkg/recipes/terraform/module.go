@@ -18,17 +18,24 @@ package terraform
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	getter "github.com/hashicorp/go-getter/v2"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/signature"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -38,6 +45,14 @@ const (
 	moduleRootDir = ".terraform/modules"
 )
 
+// registryModuleSource matches the sources of modules in Terraform registries, [<hostname>/]<namespace>/<name>/<system>,
+// optionally followed by a subdirectory.
+// https://developer.hashicorp.com/terraform/language/modules/sources#terraform-registry
+var registryModuleSource = regexp.MustCompile(`^([0-9A-Za-z-]+(\.[0-9A-Za-z-]+)+(:[0-9]+)?/)?[0-9A-Za-z_-]+/[0-9A-Za-z_-]+/[0-9A-Za-z_-]+(//.+)?$`)
+
+// defaultRegistryHost is the hostname of the registry of module sources that don't include one.
+const defaultRegistryHost = "registry.terraform.io"
+
 // moduleInspectResult contains the result of inspecting a Terraform module config.
 type moduleInspectResult struct {
 	// ContextVarExists is true if the module has a variable defined for recipe context.
@@ -60,8 +75,57 @@ type moduleInspectResult struct {
 func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Options) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Run Terraform Get command to download the module from the source specified in the config.
-	// The downloaded module is stored in the working directory.
+	// Modules that can't change for the same source and version are restored from the cache if they were downloaded before.
+	// Terraform init reuses the restored modules rather than downloading them again.
+	modulesDir := filepath.Join(tf.WorkingDir(), moduleRootDir)
+	ref, cacheable := moduleCacheReference(options.EnvRecipe)
+	cacheable = cacheable && options.Cache != nil
+
+	restored := false
+	if cacheable {
+		var err error
+		restored, err = options.Cache.GetDir(ctx, cache.ArtifactTerraformModule, ref, modulesDir)
+		if err != nil {
+			logger.Info(fmt.Sprintf("Failed to restore Terraform module %q from the cache: %s", options.EnvRecipe.TemplatePath, err.Error()))
+		}
+	}
+
+	if restored {
+		logger.Info(fmt.Sprintf("Restored Terraform module from the cache: %s", options.EnvRecipe.TemplatePath))
+	} else if err := downloadModule(ctx, tf, options); err != nil {
+		return nil, err
+	}
+
+	// The module is verified before it is inspected or cached, whether it was downloaded or restored from the cache.
+	if len(options.EnvRecipe.TrustedKeys) > 0 {
+		if err := verifyModuleSignature(tf.WorkingDir(), options.EnvRecipe); err != nil {
+			return nil, err
+		}
+	}
+
+	// A failure to cache the module does not fail the recipe, it is downloaded again next time.
+	if cacheable && !restored {
+		if err := options.Cache.PutDir(ctx, cache.ArtifactTerraformModule, ref, modulesDir); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cache Terraform module %q: %s", options.EnvRecipe.TemplatePath, err.Error()))
+		}
+	}
+
+	// Load the downloaded module to retrieve providers and variables required by the module.
+	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
+	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
+	loadedModule, err := inspectModule(tf.WorkingDir(), options.EnvRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadedModule, nil
+}
+
+// downloadModule runs Terraform Get command to download the module from the source specified in the config.
+// The downloaded module is stored in the working directory.
+func downloadModule(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
 	downloadStartTime := time.Now()
 	if err := tf.Get(ctx); err != nil {
@@ -70,22 +134,146 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 				options.EnvRecipe, recipes.RecipeDownloadFailed))
 
 		errMsg := fmt.Sprintf("failed to download Terraform module from source %q, version %q: %s", options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion, err.Error())
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, errMsg, util.RecipeSetupError, recipes.GetErrorDetails(err))
+		return recipes.NewRecipeError(recipes.RecipeDownloadFailed, errMsg, util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 			options.EnvRecipe, metrics.SuccessfulOperationState))
 
-	// Load the downloaded module to retrieve providers and variables required by the module.
-	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
-	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
-	loadedModule, err := inspectModule(tf.WorkingDir(), options.EnvRecipe)
+	return nil
+}
+
+// modulesManifest is the manifest written by Terraform to .terraform/modules/modules.json with the modules it
+// installed.
+type modulesManifest struct {
+	Modules []struct {
+		// Key is the path of the module in the configuration, for example "redis.child". It is empty for the root module.
+		Key string `json:"Key"`
+		// Source is the source of the module in the configuration.
+		Source string `json:"Source"`
+		// Dir is the directory of the module, relative to the working directory.
+		Dir string `json:"Dir"`
+	} `json:"Modules"`
+}
+
+// verifyModuleSignature verifies that the downloaded modules are signed by one of the trusted keys of the recipe.
+//
+// Terraform downloads the module of the recipe and each remote child module it calls into separate packages under
+// .terraform/modules, so every package listed in modules.json is verified, not only the one of the recipe. Local child
+// modules are part of the package of their parent, and must not point outside of a verified package.
+func verifyModuleSignature(workingDir string, recipe *recipes.EnvironmentDefinition) error {
+	verificationError := func(err error) error {
+		errMsg := fmt.Sprintf("failed to verify the signature of Terraform module from source %q, version %q: %s", recipe.TemplatePath, recipe.TemplateVersion, err.Error())
+		return recipes.NewRecipeError(recipes.RecipeSignatureVerificationFailed, errMsg, util.RecipeSetupError, nil)
+	}
+
+	verifier, err := signature.NewVerifier(recipe.TrustedKeys)
 	if err != nil {
-		return nil, err
+		return verificationError(err)
 	}
 
-	return loadedModule, nil
+	modulesDir := filepath.Join(workingDir, moduleRootDir)
+	b, err := os.ReadFile(filepath.Join(modulesDir, "modules.json"))
+	if err != nil {
+		return verificationError(fmt.Errorf("failed to read the installed modules: %w", err))
+	}
+
+	manifest := modulesManifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return verificationError(fmt.Errorf("failed to read the installed modules: %w", err))
+	}
+
+	packages := []string{}
+	for _, module := range manifest.Modules {
+		if module.Key == "" || isLocalModuleSource(module.Source) {
+			continue
+		}
+
+		dir := filepath.Join(modulesDir, module.Key)
+		if err := verifier.VerifyDir(dir); err != nil {
+			if module.Key == recipe.Name {
+				return verificationError(err)
+			}
+			return verificationError(fmt.Errorf("child module %q from source %q: %w", module.Key, module.Source, err))
+		}
+		packages = append(packages, dir)
+	}
+
+	// The package of the recipe itself must have been verified, even if the manifest does not list it.
+	if !slices.Contains(packages, filepath.Join(modulesDir, recipe.Name)) {
+		if err := verifier.VerifyDir(filepath.Join(modulesDir, recipe.Name)); err != nil {
+			return verificationError(err)
+		}
+		packages = append(packages, filepath.Join(modulesDir, recipe.Name))
+	}
+
+	for _, module := range manifest.Modules {
+		if module.Key == "" || !isLocalModuleSource(module.Source) {
+			continue
+		}
+
+		dir := filepath.Join(workingDir, module.Dir)
+		if !slices.ContainsFunc(packages, func(p string) bool { return isWithinDir(dir, p) }) {
+			return verificationError(fmt.Errorf("child module %q from source %q is outside of the verified modules", module.Key, module.Source))
+		}
+	}
+
+	return nil
+}
+
+// isLocalModuleSource returns true if source is the path of a module in the same package as the module calling it.
+// https://developer.hashicorp.com/terraform/language/modules/sources#local-paths
+func isLocalModuleSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// isWithinDir returns true if path is dir or one of its descendants.
+func isWithinDir(path string, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// moduleCacheReference returns the reference of the module of the recipe in the cache. Only modules from Terraform
+// registries with an exact version are cached, because registry versions are immutable while other sources, like Git
+// branches or version constraints, can resolve to different content over time.
+func moduleCacheReference(recipe *recipes.EnvironmentDefinition) (string, bool) {
+	if recipe == nil || recipe.TemplateVersion == "" {
+		return "", false
+	}
+
+	if _, _, ok := ParseRegistryModuleSource(recipe.TemplatePath); !ok {
+		return "", false
+	}
+
+	if _, err := version.NewVersion(recipe.TemplateVersion); err != nil {
+		return "", false
+	}
+
+	// The module is downloaded to a directory named after the recipe, so the name is part of the reference.
+	return fmt.Sprintf("%s=%s@%s", recipe.Name, recipe.TemplatePath, recipe.TemplateVersion), true
+}
+
+// ParseRegistryModuleSource parses the source of a module in a Terraform registry. It returns the hostname of the registry
+// and the address of the module, <namespace>/<name>/<system>, without the subdirectory. It returns false if the source
+// is not a registry source.
+func ParseRegistryModuleSource(source string) (host string, address string, ok bool) {
+	if !registryModuleSource.MatchString(source) {
+		return "", "", false
+	}
+
+	address, _, _ = strings.Cut(source, "//")
+	host = defaultRegistryHost
+	if parts := strings.Split(address, "/"); len(parts) == 4 {
+		host, address = parts[0], strings.Join(parts[1:], "/")
+	}
+
+	// Terraform treats GitHub and Bitbucket addresses as Git repositories rather than registry addresses.
+	if host == "github.com" || host == "bitbucket.org" {
+		return "", "", false
+	}
+
+	return host, address, true
 }
 
 // inspectModule inspects the module present at workingDir/.terraform/modules/<localModuleName> directory
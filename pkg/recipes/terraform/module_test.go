@@ -17,10 +17,21 @@ limitations under the License.
 package terraform
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/signature"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/stretchr/testify/require"
 )
@@ -148,3 +159,179 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_ModuleCacheReference(t *testing.T) {
+	tests := []struct {
+		name      string
+		recipe    *recipes.EnvironmentDefinition
+		reference string
+		cacheable bool
+	}{
+		{
+			name:      "registry module with exact version",
+			recipe:    &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "Azure/redis/azurerm", TemplateVersion: "1.2.3"},
+			reference: "redis=Azure/redis/azurerm@1.2.3",
+			cacheable: true,
+		},
+		{
+			name:      "private registry submodule with exact version",
+			recipe:    &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "app.terraform.io/example/redis/aws//modules/cluster", TemplateVersion: "v0.1.0"},
+			reference: "redis=app.terraform.io/example/redis/aws//modules/cluster@v0.1.0",
+			cacheable: true,
+		},
+		{
+			name:   "registry module with version constraint",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "Azure/redis/azurerm", TemplateVersion: "~> 1.2"},
+		},
+		{
+			name:   "registry module without version",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "Azure/redis/azurerm"},
+		},
+		{
+			name:   "git module",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "git::https://github.com/example/redis.git?ref=v1.0.0", TemplateVersion: "1.0.0"},
+		},
+		{
+			name:   "github module",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "github.com/example/redis/aws", TemplateVersion: "1.0.0"},
+		},
+		{
+			name:   "http module",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "https://example.com/redis.zip", TemplateVersion: "1.0.0"},
+		},
+		{
+			name:   "local module",
+			recipe: &recipes.EnvironmentDefinition{Name: "redis", TemplatePath: "./modules/redis", TemplateVersion: "1.0.0"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reference, cacheable := moduleCacheReference(tc.recipe)
+			require.Equal(t, tc.cacheable, cacheable)
+			require.Equal(t, tc.reference, reference)
+		})
+	}
+}
+
+func Test_ParseRegistryModuleSource(t *testing.T) {
+	tests := []struct {
+		source  string
+		host    string
+		address string
+		ok      bool
+	}{
+		{source: "Azure/redis/azurerm", host: "registry.terraform.io", address: "Azure/redis/azurerm", ok: true},
+		{source: "app.terraform.io/example/redis/aws//modules/cluster", host: "app.terraform.io", address: "example/redis/aws", ok: true},
+		{source: "localhost.localdomain:8080/example/redis/aws", host: "localhost.localdomain:8080", address: "example/redis/aws", ok: true},
+		{source: "github.com/example/redis/aws"},
+		{source: "git::https://github.com/example/redis.git?ref=v1.0.0"},
+		{source: "./modules/redis"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.source, func(t *testing.T) {
+			host, address, ok := ParseRegistryModuleSource(tc.source)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.host, host)
+			require.Equal(t, tc.address, address)
+		})
+	}
+}
+
+// writeSignedModule writes a module with a main.tf file to dir and signs it with key when key is not nil.
+func writeSignedModule(t *testing.T, dir string, key *ecdsa.PrivateKey) {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	content := []byte(`variable "context" {}`)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), content, 0644))
+	if key == nil {
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	checksums := []byte(hex.EncodeToString(sum[:]) + "  ./main.tf\n")
+	hash := sha256.Sum256(checksums)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, signature.ChecksumsFile), checksums, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, signature.ChecksumsSignatureFile), []byte(base64.StdEncoding.EncodeToString(sig)), 0644))
+}
+
+func Test_VerifyModuleSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	trustedKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	recipe := &recipes.EnvironmentDefinition{
+		Name:            "redis",
+		TemplatePath:    "example/redis/kubernetes",
+		TemplateVersion: "1.0.0",
+		TrustedKeys:     []string{trustedKey},
+	}
+
+	tests := []struct {
+		name     string
+		manifest string
+		signed   []string
+		unsigned []string
+		err      string
+	}{
+		{
+			name:     "unsigned",
+			manifest: `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"example/redis/kubernetes","Dir":".terraform/modules/redis"}]}`,
+			unsigned: []string{"redis"},
+			err:      `failed to verify the signature of Terraform module from source "example/redis/kubernetes", version "1.0.0": recipe is not signed`,
+		},
+		{
+			name:     "signed",
+			manifest: `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"example/redis/kubernetes","Dir":".terraform/modules/redis"}]}`,
+			signed:   []string{"redis"},
+		},
+		{
+			name:     "unsigned remote child module",
+			manifest: `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"example/redis/kubernetes","Dir":".terraform/modules/redis"},{"Key":"redis.network","Source":"git::https://example.com/network.git","Dir":".terraform/modules/redis.network"}]}`,
+			signed:   []string{"redis"},
+			unsigned: []string{"redis.network"},
+			err:      `failed to verify the signature of Terraform module from source "example/redis/kubernetes", version "1.0.0": child module "redis.network" from source "git::https://example.com/network.git": recipe is not signed`,
+		},
+		{
+			name:     "signed remote and local child modules",
+			manifest: `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"example/redis/kubernetes","Dir":".terraform/modules/redis"},{"Key":"redis.network","Source":"git::https://example.com/network.git","Dir":".terraform/modules/redis.network"},{"Key":"redis.local","Source":"./local","Dir":".terraform/modules/redis/local"}]}`,
+			signed:   []string{"redis", "redis.network"},
+		},
+		{
+			name:     "local child module outside of the package",
+			manifest: `{"Modules":[{"Key":"","Source":"","Dir":"."},{"Key":"redis","Source":"example/redis/kubernetes","Dir":".terraform/modules/redis"},{"Key":"redis.local","Source":"../../outside","Dir":"outside"}]}`,
+			signed:   []string{"redis"},
+			err:      `failed to verify the signature of Terraform module from source "example/redis/kubernetes", version "1.0.0": child module "redis.local" from source "../../outside" is outside of the verified modules`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workingDir := t.TempDir()
+			modulesDir := filepath.Join(workingDir, moduleRootDir)
+			for _, name := range tc.signed {
+				writeSignedModule(t, filepath.Join(modulesDir, name), key)
+			}
+			for _, name := range tc.unsigned {
+				writeSignedModule(t, filepath.Join(modulesDir, name), nil)
+			}
+			require.NoError(t, os.WriteFile(filepath.Join(modulesDir, "modules.json"), []byte(tc.manifest), 0644))
+
+			err := verifyModuleSignature(workingDir, recipe)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			recipeError, ok := err.(*recipes.RecipeError)
+			require.True(t, ok)
+			require.Equal(t, recipes.RecipeSignatureVerificationFailed, recipeError.ErrorDetails.Code)
+			require.Equal(t, tc.err, recipeError.ErrorDetails.Message)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -48,6 +49,10 @@ type TerraformExecutor interface {
 	// and deletes the Kubernetes secret created for terraform state store.
 	Delete(ctx context.Context, options Options) error
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// and returns the planned changes without applying them.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
 }
@@ -76,6 +81,9 @@ type Options struct {
 
 	// LogLevel is the log level for Terraform execution (e.g., TRACE, DEBUG, INFO, WARN, ERROR).
 	LogLevel string
+
+	// Cache is the optional cache of downloaded modules and provider plugins, shared by all executions.
+	Cache *cache.Cache
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -83,6 +83,8 @@ type EnvironmentDefinition struct {
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// TrustedKeys represents the PEM encoded public keys the recipe must be signed with. The signature of the recipe is not verified if empty.
+	TrustedKeys []string
 }
 
 // ResourceMetadata represents recipe details provided while deploying a portable or a user-defined resource.
@@ -108,6 +110,7 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
@@ -156,10 +159,14 @@ type RecipeDefinition struct {
 	Kind string
 	// Source represents URL or path to the recipe source
 	Source string
+	// Version represents the version of the module the recipe is locked to, for Terraform registry modules
+	Version string
 	// Parameters represents parameters to pass to the recipe
 	Parameters map[string]any
 	// PlainHTTP connects to the source using HTTP (not-HTTPS)
 	PlainHTTP bool
+	// TrustedKeys represents the PEM encoded public keys the recipe must be signed with
+	TrustedKeys []string
 }
 
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
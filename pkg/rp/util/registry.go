@@ -24,18 +24,30 @@ import (
 	"strings"
 
 	"github.com/distribution/reference"
+	godigest "github.com/opencontainers/go-digest"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/recipes"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
 )
 
+// LayerCache is a cache of the layers of recipes read from registries, keyed by their digest.
+type LayerCache interface {
+	// Get returns the content of the layer with the given digest if it is in the cache.
+	Get(ctx context.Context, d godigest.Digest) ([]byte, bool)
+
+	// Put adds the content of the layer with the given digest to the cache.
+	Put(ctx context.Context, d godigest.Digest, data []byte) error
+}
+
 // ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns an error if the path is invalid,
 // if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
-// the data fails to be unmarshalled.
-func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client) error {
-	registryRepo, tag, err := parsePath(definition.TemplatePath)
+// the data fails to be unmarshalled. The layer is read from the layer cache if it was already downloaded; a nil cache disables
+// caching.
+func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client, layerCache LayerCache) error {
+	registryRepo, ref, err := parsePath(definition.TemplatePath)
 	if err != nil {
 		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
 	}
@@ -51,12 +63,12 @@ func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinit
 		repo.PlainHTTP = true
 	}
 
-	digest, err := getDigestFromManifest(ctx, repo, tag)
+	digest, err := getDigestFromManifest(ctx, repo, ref)
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
 
-	bytes, err := getBytes(ctx, repo, digest)
+	bytes, err := getLayer(ctx, repo, digest, layerCache)
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
@@ -70,9 +82,9 @@ func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinit
 }
 
 // getDigestFromManifest gets the layers digest from the manifest
-func getDigestFromManifest(ctx context.Context, repo *remote.Repository, tag string) (string, error) {
-	// resolves a manifest descriptor with a Tag reference
-	descriptor, err := repo.Resolve(ctx, tag)
+func getDigestFromManifest(ctx context.Context, repo *remote.Repository, ref string) (string, error) {
+	// resolves a manifest descriptor with a tag or digest reference
+	descriptor, err := repo.Resolve(ctx, ref)
 	if err != nil {
 		return "", err
 	}
@@ -105,6 +117,30 @@ func getDigestFromManifest(ctx context.Context, repo *remote.Repository, tag str
 	return layerDigest, nil
 }
 
+// getLayer returns the layer with the given digest from the cache, or fetches it from the registry and adds it to the
+// cache.
+func getLayer(ctx context.Context, repo *remote.Repository, layerDigest string, layerCache LayerCache) ([]byte, error) {
+	if layerCache == nil {
+		return getBytes(ctx, repo, layerDigest)
+	}
+
+	if data, ok := layerCache.Get(ctx, godigest.Digest(layerDigest)); ok {
+		return data, nil
+	}
+
+	data, err := getBytes(ctx, repo, layerDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	// A failure to cache the layer does not fail the recipe, it is downloaded again next time.
+	if err := layerCache.Put(ctx, godigest.Digest(layerDigest), data); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Failed to cache recipe layer %q: %s", layerDigest, err.Error()))
+	}
+
+	return data, nil
+}
+
 // getBytes fetches the recipe ARM JSON using the layers digest
 func getBytes(ctx context.Context, repo *remote.Repository, layerDigest string) ([]byte, error) {
 	// resolves a layer blob descriptor with a digest reference
@@ -125,14 +161,41 @@ func getBytes(ctx context.Context, repo *remote.Repository, layerDigest string)
 	return pulledBlob, nil
 }
 
-// parsePath parses a path in the form of registry/repository:tag. Recipe template
-// paths may include an http(s):// scheme even though OCI references do not, so a
-// leading scheme is stripped before normalizing (matching the previous parser).
-// A tag is required: TagNameOnly defaults a name-only reference to ":latest", but
-// a digest reference such as repo@sha256:... carries no tag, which the registry is
-// resolved by, so it is rejected here with a descriptive error rather than failing
-// later at resolution time.
-func parsePath(path string) (repository string, tag string, err error) {
+// ResolveRegistryReference resolves an OCI reference in the form of registry/repository:tag to the digest of its manifest.
+// It returns the reference pinned to the digest, in the form registry/repository@digest, and the digest. A reference
+// that already includes a digest is returned as is.
+func ResolveRegistryReference(ctx context.Context, path string, plainHTTP bool, client remote.Client) (string, string, error) {
+	registryRepo, ref, err := parsePath(path)
+	if err != nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
+	}
+
+	if _, err := godigest.Parse(ref); err == nil {
+		return registryRepo + "@" + ref, ref, nil
+	}
+
+	repo, err := remote.NewRepository(registryRepo)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create client to registry %s", err.Error())
+	}
+
+	repo.Client = client
+	repo.PlainHTTP = plainHTTP
+
+	descriptor, err := repo.Resolve(ctx, ref)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve %q: %w", path, err)
+	}
+
+	return registryRepo + "@" + descriptor.Digest.String(), descriptor.Digest.String(), nil
+}
+
+// parsePath parses a path in the form of registry/repository:tag or registry/repository@digest and returns the
+// repository and the tag or digest the manifest is resolved by. Recipe template paths may include an http(s)://
+// scheme even though OCI references do not, so a leading scheme is stripped before normalizing (matching the
+// previous parser). TagNameOnly defaults a name-only reference to ":latest". A digest takes precedence over a tag,
+// since recipe packs pin their sources to digests.
+func parsePath(path string) (repository string, ref string, err error) {
 	path = strings.TrimPrefix(path, "https://")
 	path = strings.TrimPrefix(path, "http://")
 
@@ -141,14 +204,17 @@ func parsePath(path string) (repository string, tag string, err error) {
 		return "", "", err
 	}
 
+	if digested, ok := named.(reference.Digested); ok {
+		return named.Name(), digested.Digest().String(), nil
+	}
+
 	named = reference.TagNameOnly(named)
 	tagged, ok := named.(reference.Tagged)
 	if !ok {
-		return "", "", fmt.Errorf("%q does not include a tag; a tagged reference such as repository:tag is required (digest references are not supported)", path)
+		return "", "", fmt.Errorf("%q does not include a tag; a reference such as repository:tag or repository@digest is required", path)
 	}
-	repository = named.Name()
-	tag = tagged.Tag()
-	return repository, tag, nil
+
+	return named.Name(), tagged.Tag(), nil
 }
 
 // GetRegistrySecrets retrieves secret data based on the recipe configuration and template path.
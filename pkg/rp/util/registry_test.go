@@ -17,10 +17,16 @@ limitations under the License.
 package util
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
+	godigest "github.com/opencontainers/go-digest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,14 +44,59 @@ func Test_PathParserErr(t *testing.T) {
 	require.Equal(t, "", tag)
 }
 
-func Test_PathParserDigestErr(t *testing.T) {
-	// A digest reference carries no tag, so it must be rejected at parse time
-	// rather than failing later when the registry is resolved by tag.
-	repository, tag, err := parsePath("ghcr.io/radius-project/recipes/test@sha256:1234567890123456789012345678901234567890123456789012345678901234")
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "a tagged reference such as repository:tag is required")
-	require.Equal(t, "", repository)
-	require.Equal(t, "", tag)
+func Test_PathParserDigest(t *testing.T) {
+	repository, ref, err := parsePath("ghcr.io/radius-project/recipes/test@sha256:1234567890123456789012345678901234567890123456789012345678901234")
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io/radius-project/recipes/test", repository)
+	require.Equal(t, "sha256:1234567890123456789012345678901234567890123456789012345678901234", ref)
+
+	// The digest takes precedence over the tag.
+	repository, ref, err = parsePath("ghcr.io/radius-project/recipes/test:1.0@sha256:1234567890123456789012345678901234567890123456789012345678901234")
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io/radius-project/recipes/test", repository)
+	require.Equal(t, "sha256:1234567890123456789012345678901234567890123456789012345678901234", ref)
+}
+
+func Test_ResolveRegistryReference(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)
+	manifestDigest := godigest.FromBytes(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/recipes/redis/manifests/1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(manifest)
+		}
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	t.Run("tag", func(t *testing.T) {
+		source, d, err := ResolveRegistryReference(testcontext.New(t), host+"/recipes/redis:1.0", true, nil)
+		require.NoError(t, err)
+		require.Equal(t, host+"/recipes/redis@"+manifestDigest.String(), source)
+		require.Equal(t, manifestDigest.String(), d)
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		pinned := "example.com/recipes/redis@" + manifestDigest.String()
+		source, d, err := ResolveRegistryReference(testcontext.New(t), pinned, false, nil)
+		require.NoError(t, err)
+		require.Equal(t, pinned, source)
+		require.Equal(t, manifestDigest.String(), d)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, _, err := ResolveRegistryReference(testcontext.New(t), host+"/recipes/redis:2.0", true, nil)
+		require.ErrorContains(t, err, "failed to resolve")
+	})
 }
 
 func Test_GetRegistrySecrets(t *testing.T) {
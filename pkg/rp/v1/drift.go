@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// DriftState represents the result of a drift check of the output resources of a recipe.
+type DriftState string
+
+const (
+	// DriftStateInSync indicates that the output resources match what the recipe would deploy.
+	DriftStateInSync DriftState = "InSync"
+
+	// DriftStateDrifted indicates that the output resources no longer match what the recipe would deploy.
+	DriftStateDrifted DriftState = "Drifted"
+
+	// DriftStateFailed indicates that the drift check could not be completed.
+	DriftStateFailed DriftState = "Failed"
+)
+
+// DriftStatus defines the result of the last drift check of the output resources of a recipe.
+type DriftStatus struct {
+	// State specifies the result of the drift check.
+	State DriftState `json:"state"`
+
+	// LastCheckedTime specifies the time of the drift check that first found the current result. Later checks that
+	// find the same result do not update the status.
+	LastCheckedTime time.Time `json:"lastCheckedTime"`
+
+	// Message specifies the reason the drift check failed.
+	Message string `json:"message,omitempty"`
+
+	// Resources specifies the output resources that drifted.
+	Resources []DriftedResource `json:"resources,omitempty"`
+}
+
+// DriftedResource defines an output resource that no longer matches what the recipe would deploy.
+type DriftedResource struct {
+	// Action specifies the change that executing the recipe again would make to the resource.
+	Action string `json:"action"`
+
+	// ResourceType specifies the type of the resource.
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// Name specifies the name of the resource within the recipe.
+	Name string `json:"name,omitempty"`
+
+	// ID specifies the resource ID of the resource.
+	ID string `json:"id,omitempty"`
+}
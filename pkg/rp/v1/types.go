@@ -103,11 +103,14 @@ type ResourceStatus struct {
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
 	Recipe          *RecipeStatus    `json:"recipe,omitempty"`
+
+	// Drift represents the result of the last drift check of the output resources deployed by the recipe.
+	Drift *DriftStatus `json:"drift,omitempty"`
 }
 
 // DeepCopyRecipeStatus creates a copy of ResourceStatus.
 // It only deep-copies the Recipe field (if not nil).
-// Other fields (Compute and OutputResources) will reference
+// Other fields (Compute, OutputResources and Drift) will reference
 // the same underlying data as the original.
 func (original ResourceStatus) DeepCopyRecipeStatus() ResourceStatus {
 	copy := original
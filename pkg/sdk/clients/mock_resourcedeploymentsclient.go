@@ -146,6 +146,30 @@ func (rdc *MockResourceDeploymentsClient) ContinueDeleteOperation(ctx context.Co
 	}, nil
 }
 
+func (rdc *MockResourceDeploymentsClient) WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (Poller[ClientWhatIfResponse], error) {
+	rdc.lock.Lock()
+	defer rdc.lock.Unlock()
+
+	state := &OperationState{
+		Kind:       http.MethodPost,
+		ResourceID: resourceID,
+		Value: ClientWhatIfResponse{
+			WhatIfOperationResult: armdeployments.WhatIfOperationResult{
+				Properties: &armdeployments.WhatIfOperationProperties{},
+			},
+		},
+	}
+
+	operationID := uuid.New().String()
+	rdc.operations[operationID] = state
+
+	return &MockResourceDeploymentsClientPoller[ClientWhatIfResponse]{
+		mock:        rdc,
+		operationID: operationID,
+		state:       state,
+	}, nil
+}
+
 func (rdc *MockResourceDeploymentsClient) GetResource(resourceID string) (*ClientCreateOrUpdateResponse, bool) {
 	resource, ok := rdc.resourceDeployments[resourceID]
 
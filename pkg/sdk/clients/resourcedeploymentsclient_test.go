@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func newTestDeploymentsClient(t *testing.T, handler http.HandlerFunc) ResourceDeploymentsClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewResourceDeploymentsClient(&Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          server.URL,
+		ARMClientOptions: sdk.NewClientOptions(connection),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func Test_WhatIf_NotSupported(t *testing.T) {
+	const deploymentID = "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe"
+
+	client := newTestDeploymentsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, deploymentID+"/whatIf", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.WhatIf(context.Background(), Deployment{}, deploymentID, DeploymentsClientAPIVersion)
+	require.ErrorIs(t, err, ErrWhatIfNotSupported)
+}
+
+func Test_WhatIf_Error(t *testing.T) {
+	const deploymentID = "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe"
+
+	client := newTestDeploymentsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.WhatIf(context.Background(), Deployment{}, deploymentID, DeploymentsClientAPIVersion)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrWhatIfNotSupported)
+}
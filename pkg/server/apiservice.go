@@ -26,6 +26,8 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/audit/auditprovider"
 )
 
 // APIService is the restful API server for Radius Resource Provider.
@@ -62,6 +64,21 @@ func (s *APIService) Run(ctx context.Context) error {
 		return err
 	}
 
+	auditSink, err := auditprovider.NewSink(ctx, s.Options.Config.AuditProvider, databaseClient)
+	if err != nil {
+		return err
+	}
+
+	var auditOptions *audit.MiddlewareOptions
+	if auditSink != nil {
+		auditOptions = &audit.MiddlewareOptions{
+			Sink:           auditSink,
+			Source:         s.Name(),
+			DatabaseClient: databaseClient,
+			PathBase:       s.Options.Config.Server.PathBase,
+		}
+	}
+
 	address := fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port)
 	return s.Start(ctx, server.Options{
 		Location: s.Options.Config.Env.RoleLocation,
@@ -92,5 +109,6 @@ func (s *APIService) Run(ctx context.Context) error {
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+		Audit:         auditOptions,
 	})
 }
@@ -32,6 +32,9 @@ type ServerFactory struct {
 	// LocationsServer contains the fakes for client LocationsClient
 	LocationsServer LocationsServer
 
+	// LocksServer contains the fakes for client LocksClient
+	LocksServer LocksServer
+
 	// PlanesServer contains the fakes for client PlanesClient
 	PlanesServer PlanesServer
 
@@ -49,6 +52,12 @@ type ServerFactory struct {
 
 	// ResourcesServer contains the fakes for client ResourcesClient
 	ResourcesServer ResourcesServer
+
+	// RoleAssignmentsServer contains the fakes for client RoleAssignmentsClient
+	RoleAssignmentsServer RoleAssignmentsServer
+
+	// RoleDefinitionsServer contains the fakes for client RoleDefinitionsClient
+	RoleDefinitionsServer RoleDefinitionsServer
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -71,12 +80,15 @@ type ServerFactoryTransport struct {
 	trAzureCredentialsServer  *AzureCredentialsServerTransport
 	trAzurePlanesServer       *AzurePlanesServerTransport
 	trLocationsServer         *LocationsServerTransport
+	trLocksServer             *LocksServerTransport
 	trPlanesServer            *PlanesServerTransport
 	trRadiusPlanesServer      *RadiusPlanesServerTransport
 	trResourceGroupsServer    *ResourceGroupsServerTransport
 	trResourceProvidersServer *ResourceProvidersServerTransport
 	trResourceTypesServer     *ResourceTypesServerTransport
 	trResourcesServer         *ResourcesServerTransport
+	trRoleAssignmentsServer   *RoleAssignmentsServerTransport
+	trRoleDefinitionsServer   *RoleDefinitionsServerTransport
 }
 
 // Do implements the policy.Transporter interface for ServerFactoryTransport.
@@ -114,6 +126,9 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "LocationsClient":
 		initServer(&s.trMu, &s.trLocationsServer, func() *LocationsServerTransport { return NewLocationsServerTransport(&s.srv.LocationsServer) })
 		resp, err = s.trLocationsServer.Do(req)
+	case "LocksClient":
+		initServer(&s.trMu, &s.trLocksServer, func() *LocksServerTransport { return NewLocksServerTransport(&s.srv.LocksServer) })
+		resp, err = s.trLocksServer.Do(req)
 	case "PlanesClient":
 		initServer(&s.trMu, &s.trPlanesServer, func() *PlanesServerTransport { return NewPlanesServerTransport(&s.srv.PlanesServer) })
 		resp, err = s.trPlanesServer.Do(req)
@@ -138,6 +153,16 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "ResourcesClient":
 		initServer(&s.trMu, &s.trResourcesServer, func() *ResourcesServerTransport { return NewResourcesServerTransport(&s.srv.ResourcesServer) })
 		resp, err = s.trResourcesServer.Do(req)
+	case "RoleAssignmentsClient":
+		initServer(&s.trMu, &s.trRoleAssignmentsServer, func() *RoleAssignmentsServerTransport {
+			return NewRoleAssignmentsServerTransport(&s.srv.RoleAssignmentsServer)
+		})
+		resp, err = s.trRoleAssignmentsServer.Do(req)
+	case "RoleDefinitionsClient":
+		initServer(&s.trMu, &s.trRoleDefinitionsServer, func() *RoleDefinitionsServerTransport {
+			return NewRoleDefinitionsServerTransport(&s.srv.RoleDefinitionsServer)
+		})
+		resp, err = s.trRoleDefinitionsServer.Do(req)
 	default:
 		err = fmt.Errorf("unhandled client %s", client)
 	}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ConvertTo converts from the versioned LockResource resource to version-agnostic datamodel.
+func (src *LockResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("$.properties is required")
+	}
+
+	level := LockLevel(to.String((*string)(src.Properties.Level)))
+	if level != LockLevelCanNotDelete && level != LockLevelReadOnly {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("$.properties.level must be one of %q or %q", LockLevelCanNotDelete, LockLevelReadOnly))
+	}
+
+	scope, err := resources.Parse(to.String(src.Properties.Scope))
+	if err != nil || !scope.IsUCPQualified() || len(scope.ScopeSegments()) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("$.properties.scope must be the ID of a plane, resource group or resource, for example '/planes/radius/local/resourceGroups/default'")
+	}
+
+	dst := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     datamodel.LockResourceType,
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: string(level),
+			Scope: scope.String(),
+			Notes: to.String(src.Properties.Notes),
+		},
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned LockResource resource.
+func (dst *LockResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.Lock)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = new(dm.Type)
+	dst.Location = new(dm.Location)
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	dst.Properties = &LockProperties{
+		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Level:             new(LockLevel(dm.Properties.Level)),
+		Scope:             new(dm.Properties.Scope),
+	}
+
+	if dm.Properties.Notes != "" {
+		dst.Properties.Notes = new(dm.Properties.Notes)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lock_resource.json")
+	versioned := &LockResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       "/planes/radius/local/providers/System.Authorization/locks/production",
+				Name:     "production",
+				Type:     datamodel.LockResourceType,
+				Location: v1.LocationGlobal,
+				Tags:     map[string]string{},
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: datamodel.LockLevelCanNotDelete,
+			Scope: "/planes/radius/local/resourceGroups/production",
+			Notes: "Protects the production environment.",
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_Lock_VersionedToDataModel_ResourceScope(t *testing.T) {
+	versioned := &LockResource{
+		Properties: &LockProperties{
+			Level: new(LockLevelReadOnly),
+			Scope: new("/planes/radius/local/resourceGroups/production/providers/Applications.Core/environments/prod"),
+		},
+	}
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+	require.Equal(t, datamodel.LockLevelReadOnly, dm.(*datamodel.Lock).Properties.Level)
+	require.Equal(t, "/planes/radius/local/resourceGroups/production/providers/Applications.Core/environments/prod", dm.(*datamodel.Lock).Properties.Scope)
+}
+
+func Test_Lock_VersionedToDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties *LockProperties
+		err        string
+	}{
+		{
+			name:       "missing properties",
+			properties: nil,
+			err:        "$.properties is required",
+		},
+		{
+			name:       "missing level",
+			properties: &LockProperties{Scope: new("/planes/radius/local")},
+			err:        `$.properties.level must be one of "CanNotDelete" or "ReadOnly"`,
+		},
+		{
+			name:       "invalid level",
+			properties: &LockProperties{Level: new(LockLevel("NoWrite")), Scope: new("/planes/radius/local")},
+			err:        `$.properties.level must be one of "CanNotDelete" or "ReadOnly"`,
+		},
+		{
+			name:       "missing scope",
+			properties: &LockProperties{Level: new(LockLevelCanNotDelete)},
+			err:        "$.properties.scope must be the ID of a plane, resource group or resource, for example '/planes/radius/local/resourceGroups/default'",
+		},
+		{
+			name:       "scope is not UCP qualified",
+			properties: &LockProperties{Level: new(LockLevelCanNotDelete), Scope: new("/subscriptions/sub")},
+			err:        "$.properties.scope must be the ID of a plane, resource group or resource, for example '/planes/radius/local/resourceGroups/default'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &LockResource{Properties: tt.properties}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
+
+func Test_Lock_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lock_datamodel.json")
+	dm := &datamodel.Lock{}
+	err := json.Unmarshal(rawPayload, dm)
+	require.NoError(t, err)
+
+	versioned := &LockResource{}
+	err = versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	expected := &LockResource{
+		ID:       new("/planes/radius/local/providers/System.Authorization/locks/production"),
+		Name:     new("production"),
+		Type:     new(datamodel.LockResourceType),
+		Location: new(v1.LocationGlobal),
+		Tags:     map[string]*string{},
+		Properties: &LockProperties{
+			ProvisioningState: new(ProvisioningStateSucceeded),
+			Level:             new(LockLevelCanNotDelete),
+			Scope:             new("/planes/radius/local/resourceGroups/production"),
+			Notes:             new("Protects the production environment."),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_Lock_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &LockResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ConvertTo converts from the versioned RoleAssignmentResource resource to version-agnostic datamodel.
+func (src *RoleAssignmentResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("$.properties is required")
+	}
+
+	if to.String(src.Properties.PrincipalName) == "" {
+		return nil, v1.NewClientErrInvalidRequest("$.properties.principalName is required")
+	}
+
+	principalType := PrincipalType(to.String((*string)(src.Properties.PrincipalType)))
+	if principalType != PrincipalTypeUser && principalType != PrincipalTypeGroup {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("$.properties.principalType must be one of %q or %q", PrincipalTypeUser, PrincipalTypeGroup))
+	}
+
+	roleDefinitionID, err := resources.ParseResource(to.String(src.Properties.RoleDefinitionID))
+	if err != nil || !strings.EqualFold(roleDefinitionID.Type(), datamodel.RoleDefinitionResourceType) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("$.properties.roleDefinitionId must be the resource ID of a %s resource", datamodel.RoleDefinitionResourceType))
+	}
+
+	scope, err := resources.ParseScope(to.String(src.Properties.Scope))
+	if err != nil || !scope.IsUCPQualified() || len(scope.ScopeSegments()) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("$.properties.scope must be the ID of a plane or resource group, for example '/planes/radius/local/resourceGroups/default'")
+	}
+
+	dst := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     datamodel.RoleAssignmentResourceType,
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalName:    to.String(src.Properties.PrincipalName),
+			PrincipalType:    string(principalType),
+			RoleDefinitionID: roleDefinitionID.String(),
+			Scope:            scope.String(),
+		},
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleAssignmentResource resource.
+func (dst *RoleAssignmentResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleAssignment)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = new(dm.Type)
+	dst.Location = new(dm.Location)
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	dst.Properties = &RoleAssignmentProperties{
+		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		PrincipalName:     new(dm.Properties.PrincipalName),
+		PrincipalType:     new(PrincipalType(dm.Properties.PrincipalType)),
+		RoleDefinitionID:  new(dm.Properties.RoleDefinitionID),
+		Scope:             new(dm.Properties.Scope),
+	}
+
+	return nil
+}
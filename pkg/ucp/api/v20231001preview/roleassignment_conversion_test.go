@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAssignment_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_resource.json")
+	versioned := &RoleAssignmentResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       "/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-readers",
+				Name:     "team-a-readers",
+				Type:     datamodel.RoleAssignmentResourceType,
+				Location: v1.LocationGlobal,
+				Tags:     map[string]string{},
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalName:    "team-a",
+			PrincipalType:    datamodel.PrincipalTypeGroup,
+			RoleDefinitionID: "/planes/radius/local/providers/System.Authorization/roleDefinitions/reader",
+			Scope:            "/planes/radius/local/resourceGroups/team-a",
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleAssignment_VersionedToDataModel_Invalid(t *testing.T) {
+	valid := func() *RoleAssignmentProperties {
+		return &RoleAssignmentProperties{
+			PrincipalName:    new("team-a"),
+			PrincipalType:    new(PrincipalTypeGroup),
+			RoleDefinitionID: new("/planes/radius/local/providers/System.Authorization/roleDefinitions/reader"),
+			Scope:            new("/planes/radius/local"),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *RoleAssignmentProperties)
+		err    string
+	}{
+		{
+			name:   "missing principal name",
+			modify: func(p *RoleAssignmentProperties) { p.PrincipalName = nil },
+			err:    "$.properties.principalName is required",
+		},
+		{
+			name:   "invalid principal type",
+			modify: func(p *RoleAssignmentProperties) { p.PrincipalType = new(PrincipalType("ServiceAccount")) },
+			err:    `$.properties.principalType must be one of "User" or "Group"`,
+		},
+		{
+			name: "role definition of the wrong type",
+			modify: func(p *RoleAssignmentProperties) {
+				p.RoleDefinitionID = new("/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/reader")
+			},
+			err: "$.properties.roleDefinitionId must be the resource ID of a System.Authorization/roleDefinitions resource",
+		},
+		{
+			name:   "scope is a resource",
+			modify: func(p *RoleAssignmentProperties) { p.Scope = p.RoleDefinitionID },
+			err:    "$.properties.scope must be the ID of a plane or resource group, for example '/planes/radius/local/resourceGroups/default'",
+		},
+		{
+			name:   "scope is not UCP qualified",
+			modify: func(p *RoleAssignmentProperties) { p.Scope = new("/subscriptions/sub") },
+			err:    "$.properties.scope must be the ID of a plane or resource group, for example '/planes/radius/local/resourceGroups/default'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := valid()
+			tt.modify(properties)
+			versioned := &RoleAssignmentResource{Properties: properties}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
+
+func Test_RoleAssignment_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_datamodel.json")
+	dm := &datamodel.RoleAssignment{}
+	err := json.Unmarshal(rawPayload, dm)
+	require.NoError(t, err)
+
+	versioned := &RoleAssignmentResource{}
+	err = versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	expected := &RoleAssignmentResource{
+		ID:       new("/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-readers"),
+		Name:     new("team-a-readers"),
+		Type:     new(datamodel.RoleAssignmentResourceType),
+		Location: new(v1.LocationGlobal),
+		Tags:     map[string]*string{},
+		Properties: &RoleAssignmentProperties{
+			ProvisioningState: new(ProvisioningStateSucceeded),
+			PrincipalName:     new("team-a"),
+			PrincipalType:     new(PrincipalTypeGroup),
+			RoleDefinitionID:  new("/planes/radius/local/providers/System.Authorization/roleDefinitions/reader"),
+			Scope:             new("/planes/radius/local/resourceGroups/team-a"),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_RoleAssignment_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &RoleAssignmentResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleDefinitionResource resource to version-agnostic datamodel.
+func (src *RoleDefinitionResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil || len(src.Properties.Actions) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("$.properties.actions must contain at least one action")
+	}
+
+	actions, err := toActions("$.properties.actions", src.Properties.Actions)
+	if err != nil {
+		return nil, err
+	}
+
+	notActions, err := toActions("$.properties.notActions", src.Properties.NotActions)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     datamodel.RoleDefinitionResourceType,
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			Description: to.String(src.Properties.Description),
+			Actions:     actions,
+			NotActions:  notActions,
+		},
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleDefinitionResource resource.
+func (dst *RoleDefinitionResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleDefinition)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = new(dm.Type)
+	dst.Location = new(dm.Location)
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	dst.Properties = &RoleDefinitionProperties{
+		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Actions:           to.SliceOfPtrs(dm.Properties.Actions...),
+		NotActions:        to.SliceOfPtrs(dm.Properties.NotActions...),
+	}
+	if dm.Properties.Description != "" {
+		dst.Properties.Description = new(dm.Properties.Description)
+	}
+
+	return nil
+}
+
+func toActions(path string, input []*string) ([]string, error) {
+	actions := []string{}
+	for _, action := range input {
+		if action == nil || *action == "" {
+			return nil, v1.NewClientErrInvalidRequest(path + " cannot contain empty actions")
+		}
+
+		actions = append(actions, *action)
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleDefinition_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_resource.json")
+	versioned := &RoleDefinitionResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       "/planes/radius/local/providers/System.Authorization/roleDefinitions/reader",
+				Name:     "reader",
+				Type:     datamodel.RoleDefinitionResourceType,
+				Location: v1.LocationGlobal,
+				Tags:     map[string]string{},
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			Description: "Read access to all resources",
+			Actions:     []string{"*/read"},
+			NotActions:  []string{"System.Authorization/*/read"},
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleDefinition_VersionedToDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties *RoleDefinitionProperties
+		err        string
+	}{
+		{
+			name: "missing properties",
+			err:  "$.properties.actions must contain at least one action",
+		},
+		{
+			name:       "no actions",
+			properties: &RoleDefinitionProperties{Actions: []*string{}},
+			err:        "$.properties.actions must contain at least one action",
+		},
+		{
+			name:       "empty action",
+			properties: &RoleDefinitionProperties{Actions: []*string{new("")}},
+			err:        "$.properties.actions cannot contain empty actions",
+		},
+		{
+			name:       "empty not action",
+			properties: &RoleDefinitionProperties{Actions: []*string{new("*")}, NotActions: []*string{nil}},
+			err:        "$.properties.notActions cannot contain empty actions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &RoleDefinitionResource{Properties: tt.properties}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
+
+func Test_RoleDefinition_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_datamodel.json")
+	dm := &datamodel.RoleDefinition{}
+	err := json.Unmarshal(rawPayload, dm)
+	require.NoError(t, err)
+
+	versioned := &RoleDefinitionResource{}
+	err = versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	expected := &RoleDefinitionResource{
+		ID:       new("/planes/radius/local/providers/System.Authorization/roleDefinitions/reader"),
+		Name:     new("reader"),
+		Type:     new(datamodel.RoleDefinitionResourceType),
+		Location: new(v1.LocationGlobal),
+		Tags:     map[string]*string{},
+		Properties: &RoleDefinitionProperties{
+			ProvisioningState: new(ProvisioningStateSucceeded),
+			Description:       new("Read access to all resources"),
+			Actions:           []*string{new("*/read")},
+			NotActions:        []*string{new("System.Authorization/*/read")},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_RoleDefinition_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &RoleDefinitionResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
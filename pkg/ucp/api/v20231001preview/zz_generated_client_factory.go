@@ -70,6 +70,13 @@ func (c *ClientFactory) NewLocationsClient() *LocationsClient {
 	}
 }
 
+// NewLocksClient creates a new instance of LocksClient.
+func (c *ClientFactory) NewLocksClient() *LocksClient {
+	return &LocksClient{
+		internal: c.internal,
+	}
+}
+
 // NewPlanesClient creates a new instance of PlanesClient.
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	return &PlanesClient{
@@ -111,3 +118,17 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 		internal: c.internal,
 	}
 }
+
+// NewRoleAssignmentsClient creates a new instance of RoleAssignmentsClient.
+func (c *ClientFactory) NewRoleAssignmentsClient() *RoleAssignmentsClient {
+	return &RoleAssignmentsClient{
+		internal: c.internal,
+	}
+}
+
+// NewRoleDefinitionsClient creates a new instance of RoleDefinitionsClient.
+func (c *ClientFactory) NewRoleDefinitionsClient() *RoleDefinitionsClient {
+	return &RoleDefinitionsClient{
+		internal: c.internal,
+	}
+}
@@ -84,6 +84,42 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// LockLevel - The level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - The scope can be read and modified, but not deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - The scope can be read, but not modified or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
+// PrincipalType - The type of the principal of a role assignment.
+type PrincipalType string
+
+const (
+	// PrincipalTypeGroup - The principal is a group.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeUser - The principal is a user.
+	PrincipalTypeUser PrincipalType = "User"
+)
+
+// PossiblePrincipalTypeValues returns the possible values for the PrincipalType const type.
+func PossiblePrincipalTypeValues() []PrincipalType {
+	return []PrincipalType{
+		PrincipalTypeGroup,
+		PrincipalTypeUser,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
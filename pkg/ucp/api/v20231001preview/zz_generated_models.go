@@ -492,6 +492,55 @@ type LocationResourceType struct {
 type LocationResourceTypeAPIVersion struct {
 }
 
+// LockProperties - The management lock resource properties
+type LockProperties struct {
+	// REQUIRED; The level of the lock.
+	Level *LockLevel
+
+	// REQUIRED; The ID of the plane, resource group or resource the lock applies to. The lock applies to the scope and to all
+	// the resources below it.
+	Scope *string
+
+	// Notes about the lock, such as the reason it was created.
+	Notes *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - The management lock resource
+type LockResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PagedResourceProviderSummary - Paged collection of ResourceProviderSummary items
 type PagedResourceProviderSummary struct {
 	// REQUIRED; The ResourceProviderSummary items on this page
@@ -717,6 +766,107 @@ type ResourceTypeSummaryResultAPIVersion struct {
 	Schema map[string]any
 }
 
+// RoleAssignmentProperties - The role assignment resource properties
+type RoleAssignmentProperties struct {
+	// REQUIRED; The name of the user or group the role is assigned to.
+	PrincipalName *string
+
+	// REQUIRED; The type of the principal the role is assigned to.
+	PrincipalType *PrincipalType
+
+	// REQUIRED; The resource ID of the role definition to assign.
+	RoleDefinitionID *string
+
+	// REQUIRED; The ID of the plane or resource group the role is assigned at. The assignment applies to the scope and to all
+	// the resources below it.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleAssignmentResource - The role assignment resource
+type RoleAssignmentResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *RoleAssignmentProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleAssignmentResourceListResult - The response of a RoleAssignmentResource list operation.
+type RoleAssignmentResourceListResult struct {
+	// REQUIRED; The RoleAssignmentResource items on this page
+	Value []*RoleAssignmentResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// RoleDefinitionProperties - The role definition resource properties
+type RoleDefinitionProperties struct {
+	// REQUIRED; The operations allowed by the role, for example 'Applications.Core/containers/read'. Segments can be replaced
+	// by '*' to match any value.
+	Actions []*string
+
+	// The description of the role.
+	Description *string
+
+	// The operations excluded from the allowed operations.
+	NotActions []*string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleDefinitionResource - The role definition resource
+type RoleDefinitionResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *RoleDefinitionProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleDefinitionResourceListResult - The response of a RoleDefinitionResource list operation.
+type RoleDefinitionResourceListResult struct {
+	// REQUIRED; The RoleDefinitionResource items on this page
+	Value []*RoleDefinitionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
@@ -159,6 +159,27 @@ type LocationsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate
+// method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+type LocksClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientListPlanesOptions contains the optional parameters for the PlanesClient.NewListPlanesPager method.
 type PlanesClientListPlanesOptions struct {
 	// placeholder for future optional parameters
@@ -282,3 +303,45 @@ type ResourceTypesClientListOptions struct {
 type ResourcesClientListOptions struct {
 	// placeholder for future optional parameters
 }
+
+// RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate
+// method.
+type RoleAssignmentsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+type RoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+type RoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+type RoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientCreateOrUpdateOptions contains the optional parameters for the RoleDefinitionsClient.CreateOrUpdate
+// method.
+type RoleDefinitionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientDeleteOptions contains the optional parameters for the RoleDefinitionsClient.Delete method.
+type RoleDefinitionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientGetOptions contains the optional parameters for the RoleDefinitionsClient.Get method.
+type RoleDefinitionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientListOptions contains the optional parameters for the RoleDefinitionsClient.NewListPager method.
+type RoleDefinitionsClientListOptions struct {
+	// placeholder for future optional parameters
+}
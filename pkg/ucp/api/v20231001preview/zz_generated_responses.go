@@ -165,6 +165,29 @@ type LocationsClientListResponse struct {
 	LocationResourceListResult
 }
 
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// The management lock resource
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// The management lock resource
+	LockResource
+}
+
+// LocksClientListResponse contains the response from method LocksClient.NewListPager.
+type LocksClientListResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientListPlanesResponse contains the response from method PlanesClient.NewListPlanesPager.
 type PlanesClientListPlanesResponse struct {
 	// The response of a GenericPlaneResource list operation.
@@ -292,3 +315,49 @@ type ResourcesClientListResponse struct {
 	// The response of a GenericResource list operation.
 	GenericResourceListResult
 }
+
+// RoleAssignmentsClientCreateOrUpdateResponse contains the response from method RoleAssignmentsClient.CreateOrUpdate.
+type RoleAssignmentsClientCreateOrUpdateResponse struct {
+	// The role assignment resource
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientDeleteResponse contains the response from method RoleAssignmentsClient.Delete.
+type RoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleAssignmentsClientGetResponse contains the response from method RoleAssignmentsClient.Get.
+type RoleAssignmentsClientGetResponse struct {
+	// The role assignment resource
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientListResponse contains the response from method RoleAssignmentsClient.NewListPager.
+type RoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
+
+// RoleDefinitionsClientCreateOrUpdateResponse contains the response from method RoleDefinitionsClient.CreateOrUpdate.
+type RoleDefinitionsClientCreateOrUpdateResponse struct {
+	// The role definition resource
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientDeleteResponse contains the response from method RoleDefinitionsClient.Delete.
+type RoleDefinitionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleDefinitionsClientGetResponse contains the response from method RoleDefinitionsClient.Get.
+type RoleDefinitionsClientGetResponse struct {
+	// The role definition resource
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientListResponse contains the response from method RoleDefinitionsClient.NewListPager.
+type RoleDefinitionsClientListResponse struct {
+	// The response of a RoleDefinitionResource list operation.
+	RoleDefinitionResourceListResult
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// VerbRead is the verb for operations that read resources.
+	VerbRead = "read"
+
+	// VerbWrite is the verb for operations that create or update resources.
+	VerbWrite = "write"
+
+	// VerbDelete is the verb for operations that delete resources.
+	VerbDelete = "delete"
+
+	// VerbAction is the verb for custom actions on resources.
+	VerbAction = "action"
+)
+
+// Action returns the action string for an operation on the given resource ID, for example
+// 'Applications.Core/environments/read'. Custom actions include the name of the action, for example
+// 'Applications.Core/environments/getMetadata/action'.
+//
+// The path is the request path without the path base and is used to find the name of custom actions.
+func Action(id resources.ID, method string, path string) string {
+	resourceType := id.Type()
+	if resourceType == "" && len(id.ScopeSegments()) == 2 && id.IsScopeCollection() {
+		// The resource group collection does not have a type of its own.
+		resourceType = resources.ResourceGroupType
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return resourceType + "/" + VerbRead
+	case http.MethodPut, http.MethodPatch:
+		return resourceType + "/" + VerbWrite
+	case http.MethodDelete:
+		return resourceType + "/" + VerbDelete
+	}
+
+	// POST operations are custom actions. The ID does not include the name of the action, so it is taken
+	// from the last segment of the path.
+	path = strings.TrimSuffix(path, "/")
+	if len(path) > len(id.String()) {
+		if name := path[strings.LastIndex(path, "/")+1:]; name != "" {
+			return resourceType + "/" + name + "/" + VerbAction
+		}
+	}
+
+	return resourceType + "/" + VerbAction
+}
+
+// ActionMatches returns true if the action matches the pattern. Patterns are case-insensitive and may
+// contain '*' wildcards that match any sequence of characters, for example 'Applications.Core/*' or '*/read'.
+func ActionMatches(pattern string, action string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	expression := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+	matched, err := regexp.MatchString(expression, action)
+	return err == nil && matched
+}
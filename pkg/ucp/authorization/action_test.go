@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Action(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodGet, "/planes/radius/local", "System.Radius/planes/read"},
+		{http.MethodGet, "/planes/radius/local/resourceGroups", "System.Resources/resourceGroups/read"},
+		{http.MethodPut, "/planes/radius/local/resourceGroups/default", "System.Resources/resourceGroups/write"},
+		{http.MethodPatch, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/containers/web", "Applications.Core/containers/write"},
+		{http.MethodDelete, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/containers/web", "Applications.Core/containers/delete"},
+		{http.MethodGet, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/containers", "Applications.Core/containers/read"},
+		{http.MethodPost, "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/env/getMetadata", "Applications.Core/environments/getMetadata/action"},
+		{http.MethodGet, "/planes/radius/local/providers/System.Authorization/roleAssignments/reader", "System.Authorization/roleAssignments/read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			id, err := resources.ParseByMethod(tt.path, tt.method)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, Action(id, tt.method, tt.path))
+		})
+	}
+}
+
+func Test_ActionMatches(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		action   string
+		expected bool
+	}{
+		{"*", "Applications.Core/containers/read", true},
+		{"*/read", "Applications.Core/containers/read", true},
+		{"*/read", "Applications.Core/containers/write", false},
+		{"Applications.Core/*", "applications.core/containers/delete", true},
+		{"Applications.Core/*", "Applications.Dapr/stateStores/read", false},
+		{"Applications.Core/containers/read", "Applications.Core/containers/read", true},
+		{"Applications.Core/containers/read", "Applications.Core/containers/readExtra", false},
+		{"Applications.Core/containers.read", "Applications.Core/containersXread", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.action, func(t *testing.T) {
+			require.Equal(t, tt.expected, ActionMatches(tt.pattern, tt.action))
+		})
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ComponentRole grants the actions of a role to a Radius component that calls UCP, such as a resource provider or
+// the deployment engine. Unlike role assignments, component roles are part of the UCP configuration and apply to
+// every plane, so that the components work before any role assignment is created.
+type ComponentRole struct {
+	// User is the name of the user the component is identified as.
+	User string `yaml:"user"`
+
+	// Actions is the list of actions the component is allowed to perform. Actions may contain '*' wildcards.
+	Actions []string `yaml:"actions"`
+
+	// NotActions is the list of actions excluded from Actions.
+	NotActions []string `yaml:"notActions,omitempty"`
+}
+
+// Authorizer decides whether a principal is allowed to perform an action on a resource, based on the role
+// assignments and role definitions stored in UCP.
+type Authorizer struct {
+	databaseClient database.Client
+	adminUsers     []string
+	adminGroups    []string
+	componentRoles []ComponentRole
+}
+
+// NewAuthorizer creates a new Authorizer. Principals in the admin users or admin groups are allowed to perform
+// any operation, and the components identified by the users of componentRoles are allowed to perform the actions
+// of their role on any resource.
+func NewAuthorizer(databaseClient database.Client, adminUsers []string, adminGroups []string, componentRoles []ComponentRole) *Authorizer {
+	return &Authorizer{
+		databaseClient: databaseClient,
+		adminUsers:     adminUsers,
+		adminGroups:    adminGroups,
+		componentRoles: componentRoles,
+	}
+}
+
+// Authorize returns true if the principal is allowed to perform the action on the resource with the given ID.
+//
+// Role assignments are read from the Radius plane that contains the resource. Operations outside of a Radius
+// plane, such as listing planes, are only allowed for admins.
+func (a *Authorizer) Authorize(ctx context.Context, principal Principal, id resources.ID, action string) (bool, error) {
+	if a.isAdmin(principal) || a.componentAllows(principal, action) {
+		return true, nil
+	}
+
+	scopes := id.ScopeSegments()
+	if len(scopes) == 0 || !strings.EqualFold(scopes[0].Type, "radius") || scopes[0].Name == "" {
+		return false, nil
+	}
+
+	assignments, err := a.listRoleAssignments(ctx, "/planes/radius/"+scopes[0].Name)
+	if err != nil {
+		return false, err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	roleDefinitions := map[string]*datamodel.RoleDefinition{}
+	for _, assignment := range assignments {
+		if !assignmentMatchesPrincipal(assignment, principal) || !scopeContains(assignment.Properties.Scope, id.String()) {
+			continue
+		}
+
+		key := strings.ToLower(assignment.Properties.RoleDefinitionID)
+		roleDefinition, ok := roleDefinitions[key]
+		if !ok {
+			roleDefinition, err = a.getRoleDefinition(ctx, assignment.Properties.RoleDefinitionID)
+			if err != nil {
+				return false, err
+			}
+			roleDefinitions[key] = roleDefinition
+		}
+
+		if roleDefinition == nil {
+			logger.Info(fmt.Sprintf("role assignment %q refers to role definition %q which does not exist", assignment.ID, assignment.Properties.RoleDefinitionID))
+			continue
+		}
+
+		if roleAllows(roleDefinition, action) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (a *Authorizer) isAdmin(principal Principal) bool {
+	if slices.Contains(a.adminUsers, principal.User) {
+		return true
+	}
+
+	for _, group := range principal.Groups {
+		if slices.Contains(a.adminGroups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// componentAllows returns true if the role of the component identified by the principal allows the action.
+func (a *Authorizer) componentAllows(principal Principal, action string) bool {
+	for _, role := range a.componentRoles {
+		if role.User != principal.User {
+			continue
+		}
+
+		if roleAllows(&datamodel.RoleDefinition{Properties: datamodel.RoleDefinitionProperties{Actions: role.Actions, NotActions: role.NotActions}}, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Authorizer) listRoleAssignments(ctx context.Context, rootScope string) ([]datamodel.RoleAssignment, error) {
+	query := database.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   datamodel.RoleAssignmentResourceType,
+	}
+
+	assignments := []datamodel.RoleAssignment{}
+	paginationToken := ""
+	for {
+		result, err := a.databaseClient.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query role assignments: %w", err)
+		}
+
+		for _, item := range result.Items {
+			assignment := datamodel.RoleAssignment{}
+			if err := item.As(&assignment); err != nil {
+				return nil, fmt.Errorf("failed to decode role assignment %q: %w", item.ID, err)
+			}
+			assignments = append(assignments, assignment)
+		}
+
+		if result.PaginationToken == "" {
+			return assignments, nil
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+// getRoleDefinition returns the role definition with the given ID, or nil if it does not exist.
+func (a *Authorizer) getRoleDefinition(ctx context.Context, id string) (*datamodel.RoleDefinition, error) {
+	obj, err := a.databaseClient.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get role definition %q: %w", id, err)
+	}
+
+	roleDefinition := &datamodel.RoleDefinition{}
+	if err := obj.As(roleDefinition); err != nil {
+		return nil, fmt.Errorf("failed to decode role definition %q: %w", id, err)
+	}
+
+	return roleDefinition, nil
+}
+
+func assignmentMatchesPrincipal(assignment datamodel.RoleAssignment, principal Principal) bool {
+	switch assignment.Properties.PrincipalType {
+	case datamodel.PrincipalTypeUser:
+		return assignment.Properties.PrincipalName == principal.User
+	case datamodel.PrincipalTypeGroup:
+		return slices.Contains(principal.Groups, assignment.Properties.PrincipalName)
+	}
+
+	return false
+}
+
+// scopeContains returns true if the resource ID is the scope itself or is nested inside of the scope.
+func scopeContains(scope string, id string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	id = strings.ToLower(id)
+	return id == scope || strings.HasPrefix(id, scope+"/")
+}
+
+// roleAllows returns true if the action matches one of the actions of the role and none of its not-actions.
+func roleAllows(roleDefinition *datamodel.RoleDefinition, action string) bool {
+	for _, notAction := range roleDefinition.Properties.NotActions {
+		if ActionMatches(notAction, action) {
+			return false
+		}
+	}
+
+	for _, allowed := range roleDefinition.Properties.Actions {
+		if ActionMatches(allowed, action) {
+			return true
+		}
+	}
+
+	return false
+}
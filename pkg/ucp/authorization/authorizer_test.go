@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRoleDefinitionsPath = "/planes/radius/local/providers/System.Authorization/roleDefinitions/"
+	testRoleAssignmentsPath = "/planes/radius/local/providers/System.Authorization/roleAssignments/"
+)
+
+func saveRoleDefinition(t *testing.T, client database.Client, name string, actions []string, notActions []string) {
+	id := testRoleDefinitionsPath + name
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.RoleDefinition{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: id, Name: name, Type: datamodel.RoleDefinitionResourceType},
+			},
+			Properties: datamodel.RoleDefinitionProperties{Actions: actions, NotActions: notActions},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func saveRoleAssignment(t *testing.T, client database.Client, name string, principalType string, principalName string, role string, scope string) {
+	id := testRoleAssignmentsPath + name
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: id, Name: name, Type: datamodel.RoleAssignmentResourceType},
+			},
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalName:    principalName,
+				PrincipalType:    principalType,
+				RoleDefinitionID: testRoleDefinitionsPath + role,
+				Scope:            scope,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_Authorizer_Authorize(t *testing.T) {
+	client := inmemory.NewClient()
+	saveRoleDefinition(t, client, "reader", []string{"*/read"}, nil)
+	saveRoleDefinition(t, client, "contributor", []string{"*"}, []string{"System.Authorization/*"})
+	saveRoleAssignment(t, client, "alice-reader", datamodel.PrincipalTypeUser, "alice", "reader", "/planes/radius/local")
+	saveRoleAssignment(t, client, "team-a-contributor", datamodel.PrincipalTypeGroup, "team-a", "contributor", "/planes/radius/local/resourceGroups/team-a")
+	saveRoleAssignment(t, client, "bob-missing", datamodel.PrincipalTypeUser, "bob", "missing", "/planes/radius/local")
+
+	authorizer := NewAuthorizer(client, []string{"root"}, []string{"system:masters"}, []ComponentRole{
+		{User: "system:serviceaccount:radius-system:dashboard", Actions: []string{"*/read"}},
+		{User: "radius:bicep-de", Actions: []string{"*"}, NotActions: []string{"System.Authorization/*"}},
+	})
+
+	tests := []struct {
+		name      string
+		principal Principal
+		method    string
+		path      string
+		expected  bool
+	}{
+		{
+			name:      "admin user",
+			principal: Principal{User: "root"},
+			method:    http.MethodDelete,
+			path:      "/planes/radius/local",
+			expected:  true,
+		},
+		{
+			name:      "admin group",
+			principal: Principal{User: "someone", Groups: []string{"system:masters"}},
+			method:    http.MethodGet,
+			path:      "/planes",
+			expected:  true,
+		},
+		{
+			name:      "reader can read in plane",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/containers/web",
+			expected:  true,
+		},
+		{
+			name:      "reader cannot write",
+			principal: Principal{User: "alice"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/containers/web",
+			expected:  false,
+		},
+		{
+			name:      "group contributor can write in resource group",
+			principal: Principal{User: "dave", Groups: []string{"team-a"}},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourceGroups/TEAM-A/providers/Applications.Core/containers/web",
+			expected:  true,
+		},
+		{
+			name:      "group contributor cannot write in another resource group",
+			principal: Principal{User: "dave", Groups: []string{"team-a"}},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourceGroups/team-ab/providers/Applications.Core/containers/web",
+			expected:  false,
+		},
+		{
+			name:      "not actions are excluded",
+			principal: Principal{User: "dave", Groups: []string{"team-a"}},
+			method:    http.MethodDelete,
+			path:      "/planes/radius/local/resourceGroups/team-a/providers/System.Authorization/roleAssignments/x",
+			expected:  false,
+		},
+		{
+			name:      "missing role definition",
+			principal: Principal{User: "bob"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local",
+			expected:  false,
+		},
+		{
+			name:      "outside of a radius plane",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes",
+			expected:  false,
+		},
+		{
+			name:      "other plane",
+			principal: Principal{User: "alice"},
+			method:    http.MethodGet,
+			path:      "/planes/radius/other",
+			expected:  false,
+		},
+		{
+			name:      "component reader can read outside of a radius plane",
+			principal: Principal{User: "system:serviceaccount:radius-system:dashboard"},
+			method:    http.MethodGet,
+			path:      "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.S3/Bucket/b",
+			expected:  true,
+		},
+		{
+			name:      "component reader cannot write",
+			principal: Principal{User: "system:serviceaccount:radius-system:dashboard"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/resourceGroups/team-b/providers/Applications.Core/containers/web",
+			expected:  false,
+		},
+		{
+			name:      "component role is granted to the user only",
+			principal: Principal{User: "system:serviceaccount:radius-system:other", Groups: []string{"system:serviceaccounts:radius-system"}},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local",
+			expected:  false,
+		},
+		{
+			name:      "component can write in any plane",
+			principal: Principal{User: "radius:bicep-de"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/other/resourceGroups/team-b/providers/Applications.Core/containers/web",
+			expected:  true,
+		},
+		{
+			name:      "component not actions are excluded",
+			principal: Principal{User: "radius:bicep-de"},
+			method:    http.MethodPut,
+			path:      "/planes/radius/local/providers/System.Authorization/roleAssignments/x",
+			expected:  false,
+		},
+		{
+			name:      "anonymous",
+			principal: Principal{User: AnonymousUser, Groups: []string{UnauthenticatedGroup}},
+			method:    http.MethodGet,
+			path:      "/planes/radius/local",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.ParseByMethod(tt.path, tt.method)
+			require.NoError(t, err)
+
+			allowed, err := authorizer.Authorize(context.Background(), tt.principal, id, Action(id, tt.method, tt.path))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, allowed)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Middleware is the middleware that rejects requests whose caller is not allowed to perform the requested
+// operation with a 403 Forbidden response.
+//
+// The resource ID is parsed from the request path rather than taken from the ARM request context because the
+// ARM request context prefers the Referer header, which is controlled by the caller.
+//
+// The caller is identified by authenticator. Requests that do not come through a trusted front proxy are
+// treated as anonymous.
+func Middleware(authenticator *Authenticator, authorizer *Authorizer, pathBase string) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			base := pathBase
+			if base == "" {
+				base = v1.ParsePathBase(r.URL.Path)
+			}
+
+			path := strings.TrimPrefix(r.URL.Path, base)
+			if isDiscoveryPath(path) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			logger := ucplog.FromContextOrDiscard(ctx)
+			principal := authenticator.PrincipalFromRequest(r)
+
+			// Requests that do not target a resource, such as the administrative APIs, are only allowed for admins.
+			id, err := resources.ParseByMethod(path, r.Method)
+			if err != nil {
+				id = resources.ID{}
+			}
+
+			action := Action(id, r.Method, path)
+			allowed, err := authorizer.Authorize(ctx, principal, id, action)
+			if err != nil {
+				logger.Error(err, "failed to authorize request")
+				resp := rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: err.Error(),
+					},
+				})
+				_ = resp.Apply(ctx, w, r)
+				return
+			}
+
+			if !allowed {
+				logger.Info(fmt.Sprintf("denied %q to %q on %q", action, principal.User, path))
+				resp := rest.NewForbiddenResponse(fmt.Sprintf("The client %q does not have authorization to perform action %q over scope %q.", principal.User, action, path))
+				_ = resp.Apply(ctx, w, r)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// isDiscoveryPath returns true for the Kubernetes discovery and OpenAPI documents, which the Kubernetes API
+// server requests on its own behalf and which do not expose any resource data.
+func isDiscoveryPath(path string) bool {
+	return path == "" || path == "/" || strings.HasPrefix(path, "/openapi/")
+}
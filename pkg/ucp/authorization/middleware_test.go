@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Middleware(t *testing.T) {
+	const pathBase = "/apis/api.ucp.dev/v1alpha3"
+
+	client := inmemory.NewClient()
+	saveRoleDefinition(t, client, "reader", []string{"*/read"}, nil)
+	saveRoleAssignment(t, client, "alice-reader", datamodel.PrincipalTypeUser, "alice", "reader", "/planes/radius/local")
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	ca := newTestCA(t)
+	frontProxy := ca.issue(t, "front-proxy-client")
+	authenticator := NewAuthenticator(ca.pool(), []string{"front-proxy-client"}, nil)
+	handler := Middleware(authenticator, NewAuthorizer(client, nil, []string{"system:masters"}, nil), pathBase)(next)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		user        string
+		group       string
+		certificate *x509.Certificate
+		expected    int
+	}{
+		{name: "allowed", method: http.MethodGet, path: pathBase + "/planes/radius/local/resourceGroups/default", user: "alice", certificate: frontProxy, expected: http.StatusOK},
+		{name: "denied", method: http.MethodPut, path: pathBase + "/planes/radius/local/resourceGroups/default", user: "alice", certificate: frontProxy, expected: http.StatusForbidden},
+		{name: "anonymous", method: http.MethodGet, path: pathBase + "/planes/radius/local", expected: http.StatusForbidden},
+		{name: "admin only without resource", method: http.MethodGet, path: pathBase + "/admin/deadletters", user: "alice", certificate: frontProxy, expected: http.StatusForbidden},
+		{name: "admin group", method: http.MethodGet, path: pathBase + "/admin/deadletters", user: "bob", group: "system:masters", certificate: frontProxy, expected: http.StatusOK},
+		{name: "spoofed headers without certificate", method: http.MethodGet, path: pathBase + "/admin/deadletters", user: "bob", group: "system:masters", expected: http.StatusForbidden},
+		{name: "spoofed headers with untrusted certificate", method: http.MethodGet, path: pathBase + "/admin/deadletters", user: "bob", group: "system:masters", certificate: newTestCA(t).issue(t, "front-proxy-client"), expected: http.StatusForbidden},
+		{name: "spoofed headers with certificate name not allowed", method: http.MethodGet, path: pathBase + "/planes/radius/local/resourceGroups/default", user: "alice", certificate: ca.issue(t, "alice"), expected: http.StatusForbidden},
+		{name: "discovery document", method: http.MethodGet, path: pathBase, expected: http.StatusOK},
+		{name: "openapi document", method: http.MethodGet, path: "/openapi/v2", expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.certificate != nil {
+				r = withClientCertificate(r, tt.certificate)
+			}
+			if tt.user != "" {
+				r.Header.Set(RemoteUserHeader, tt.user)
+			}
+			if tt.group != "" {
+				r.Header.Set(RemoteGroupHeader, tt.group)
+			}
+			// The referer header must not be used to decide which resource is targeted.
+			r.Header.Set(v1.RefererHeader, "http://localhost"+pathBase+"/planes/radius/local/resourceGroups/default")
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			require.Equal(t, tt.expected, w.Code)
+
+			if tt.expected == http.StatusForbidden {
+				body := v1.ErrorResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, v1.CodeAuthorizationFailed, body.Error.Code)
+				if tt.certificate != frontProxy {
+					require.Contains(t, body.Error.Message, AnonymousUser)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	// RemoteUserHeader is the header used by the Kubernetes API aggregation layer to carry the name of the
+	// authenticated user.
+	RemoteUserHeader = "X-Remote-User"
+
+	// RemoteGroupHeader is the header used by the Kubernetes API aggregation layer to carry the groups of the
+	// authenticated user. It may be repeated.
+	RemoteGroupHeader = "X-Remote-Group"
+
+	// AnonymousUser is the user name assigned to requests that do not carry an identity.
+	AnonymousUser = "system:anonymous"
+
+	// UnauthenticatedGroup is the group assigned to requests that do not carry an identity.
+	UnauthenticatedGroup = "system:unauthenticated"
+)
+
+// Principal is the identity of the caller of a UCP request.
+type Principal struct {
+	// User is the name of the user.
+	User string
+
+	// Groups is the list of groups the user belongs to.
+	Groups []string
+}
+
+// IsAnonymous returns true if the principal does not carry an identity.
+func (p Principal) IsAnonymous() bool {
+	return p.User == AnonymousUser
+}
+
+// anonymous returns the principal assigned to requests that do not carry a trusted identity.
+func anonymous() Principal {
+	return Principal{User: AnonymousUser, Groups: []string{UnauthenticatedGroup}}
+}
+
+// Authenticator identifies the caller of a request from its client certificate.
+//
+// Requests made through an authenticating front proxy, such as the Kubernetes API aggregation layer, carry the
+// identity of the caller in headers. The headers are only trusted when the request was made with a client certificate
+// that chains to the request header client CA and, if allowed names are configured, whose common name is one of them.
+// This matches the --requestheader-client-ca-file and --requestheader-allowed-names options of the Kubernetes API
+// server.
+//
+// In-cluster components that call UCP directly, such as the deployment engine, are identified by their own client
+// certificate when it chains to the client CA: the common name is the user and the organizations are the groups. This
+// matches the --client-ca-file option of the Kubernetes API server.
+type Authenticator struct {
+	requestHeaderCAs *x509.CertPool
+	allowedNames     []string
+	clientCAs        *x509.CertPool
+}
+
+// NewAuthenticator creates an Authenticator that trusts front proxy certificates issued by requestHeaderCAs and
+// component certificates issued by clientCAs. When allowedNames is empty, any certificate issued by requestHeaderCAs
+// is trusted as a front proxy. A nil pool disables the corresponding kind of identity, and a nil authenticator treats
+// every request as anonymous.
+func NewAuthenticator(requestHeaderCAs *x509.CertPool, allowedNames []string, clientCAs *x509.CertPool) *Authenticator {
+	return &Authenticator{requestHeaderCAs: requestHeaderCAs, allowedNames: allowedNames, clientCAs: clientCAs}
+}
+
+// LoadClientCAs reads the PEM encoded certificates in the file at path into a certificate pool.
+func LoadClientCAs(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in client CA file %q", path)
+	}
+
+	return pool, nil
+}
+
+// PrincipalFromRequest extracts the identity of the caller from the X-Remote-User and X-Remote-Group headers of
+// requests made by the front proxy, or from the client certificate of requests made by in-cluster components.
+// Requests without a verified certificate are treated as anonymous, whatever headers they carry.
+func (a *Authenticator) PrincipalFromRequest(r *http.Request) Principal {
+	if a == nil || r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return anonymous()
+	}
+
+	if a.verifyFrontProxy(r) {
+		user := strings.TrimSpace(r.Header.Get(RemoteUserHeader))
+		if user == "" {
+			return anonymous()
+		}
+
+		return Principal{User: user, Groups: headerValues(r.Header, RemoteGroupHeader)}
+	}
+
+	if a.clientCAs != nil && verifyClientCertificate(r, a.clientCAs) {
+		certificate := r.TLS.PeerCertificates[0]
+		if certificate.Subject.CommonName == "" {
+			return anonymous()
+		}
+
+		return Principal{User: certificate.Subject.CommonName, Groups: slices.Clone(certificate.Subject.Organization)}
+	}
+
+	return anonymous()
+}
+
+// verifyFrontProxy returns true if the client certificate of the request chains to the request header client CA
+// and has an allowed common name.
+func (a *Authenticator) verifyFrontProxy(r *http.Request) bool {
+	if a.requestHeaderCAs == nil || !verifyClientCertificate(r, a.requestHeaderCAs) {
+		return false
+	}
+
+	return len(a.allowedNames) == 0 || slices.Contains(a.allowedNames, r.TLS.PeerCertificates[0].Subject.CommonName)
+}
+
+// verifyClientCertificate returns true if the client certificate of the request chains to one of roots.
+//
+// The server requests client certificates without verifying them during the handshake, because they are issued by
+// different CAs for the front proxy and for in-cluster components. The chain is verified here instead, and
+// tls.ConnectionState.VerifiedChains is never used.
+func verifyClientCertificate(r *http.Request, roots *x509.CertPool) bool {
+	certificate := r.TLS.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(intermediate)
+	}
+
+	_, err := certificate.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
+
+// headerValues returns the non-empty values of a header that may be repeated or comma-separated.
+func headerValues(header http.Header, key string) []string {
+	values := []string{}
+	for _, value := range header.Values(key) {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+
+	return values
+}
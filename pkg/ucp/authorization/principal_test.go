@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCA is a certificate authority that issues client certificates for tests.
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{certificate: certificate, key: key}
+}
+
+// pool returns a certificate pool that contains the CA.
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.certificate)
+	return pool
+}
+
+// issue returns a client certificate with the given common name and organizations signed by the CA.
+func (ca *testCA) issue(t *testing.T, commonName string, organizations ...string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName, Organization: organizations},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+// withClientCertificate sets the TLS connection state of the request as if it was made with certificate.
+func withClientCertificate(r *http.Request, certificate *x509.Certificate) *http.Request {
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+	return r
+}
+
+func Test_Authenticator_PrincipalFromRequest(t *testing.T) {
+	ca := newTestCA(t)
+	componentCA := newTestCA(t)
+	authenticator := NewAuthenticator(ca.pool(), []string{"front-proxy-client"}, componentCA.pool())
+
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		r.Header.Set(RemoteUserHeader, "bob")
+		r.Header.Add(RemoteGroupHeader, "team-a")
+		r.Header.Add(RemoteGroupHeader, "team-b, team-c")
+		return r
+	}
+
+	t.Run("front proxy certificate", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), ca.issue(t, "front-proxy-client"))
+
+		require.Equal(t, Principal{User: "bob", Groups: []string{"team-a", "team-b", "team-c"}}, authenticator.PrincipalFromRequest(r))
+	})
+
+	t.Run("any name allowed", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), ca.issue(t, "other-proxy"))
+
+		principal := NewAuthenticator(ca.pool(), nil, nil).PrincipalFromRequest(r)
+		require.Equal(t, "bob", principal.User)
+	})
+
+	t.Run("front proxy certificate without user", func(t *testing.T) {
+		r := withClientCertificate(httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil), ca.issue(t, "front-proxy-client"))
+
+		require.True(t, authenticator.PrincipalFromRequest(r).IsAnonymous())
+	})
+
+	t.Run("headers without certificate", func(t *testing.T) {
+		principal := authenticator.PrincipalFromRequest(newRequest())
+		require.True(t, principal.IsAnonymous())
+		require.Equal(t, []string{UnauthenticatedGroup}, principal.Groups)
+	})
+
+	t.Run("certificate name not allowed", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), ca.issue(t, "mallory"))
+
+		require.True(t, authenticator.PrincipalFromRequest(r).IsAnonymous())
+	})
+
+	t.Run("certificate from another CA", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), newTestCA(t).issue(t, "front-proxy-client"))
+
+		require.True(t, authenticator.PrincipalFromRequest(r).IsAnonymous())
+	})
+
+	t.Run("certificate in verified chains only", func(t *testing.T) {
+		// A certificate verified by the server against another CA must not be trusted.
+		r := newRequest()
+		certificate := newTestCA(t).issue(t, "front-proxy-client")
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}, VerifiedChains: [][]*x509.Certificate{{certificate}}}
+
+		require.True(t, authenticator.PrincipalFromRequest(r).IsAnonymous())
+	})
+
+	t.Run("component certificate", func(t *testing.T) {
+		r := withClientCertificate(httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil), componentCA.issue(t, "radius:bicep-de", "radius:components"))
+
+		require.Equal(t, Principal{User: "radius:bicep-de", Groups: []string{"radius:components"}}, authenticator.PrincipalFromRequest(r))
+	})
+
+	t.Run("component certificate ignores headers", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), componentCA.issue(t, "radius:bicep-de"))
+
+		require.Equal(t, Principal{User: "radius:bicep-de"}, authenticator.PrincipalFromRequest(r))
+	})
+
+	t.Run("component certificate without common name", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), componentCA.issue(t, ""))
+
+		require.True(t, authenticator.PrincipalFromRequest(r).IsAnonymous())
+	})
+
+	t.Run("component certificate is not a front proxy", func(t *testing.T) {
+		// A certificate issued by the client CA must not be able to assert another identity with headers.
+		r := withClientCertificate(newRequest(), componentCA.issue(t, "front-proxy-client"))
+
+		require.Equal(t, "front-proxy-client", authenticator.PrincipalFromRequest(r).User)
+	})
+
+	t.Run("no client CA", func(t *testing.T) {
+		r := withClientCertificate(newRequest(), ca.issue(t, "front-proxy-client"))
+
+		require.True(t, NewAuthenticator(nil, nil, nil).PrincipalFromRequest(r).IsAnonymous())
+		require.True(t, (*Authenticator)(nil).PrincipalFromRequest(r).IsAnonymous())
+	})
+}
+
+func Test_LoadClientCAs(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		ca := newTestCA(t)
+		path := filepath.Join(t.TempDir(), "ca.crt")
+		require.NoError(t, os.WriteFile(path, pemEncode(ca.certificate), 0600))
+
+		pool, err := LoadClientCAs(path)
+		require.NoError(t, err)
+
+		_, err = ca.issue(t, "front-proxy-client").Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		require.NoError(t, err)
+	})
+
+	t.Run("no certificates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.crt")
+		require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+
+		_, err := LoadClientCAs(path)
+		require.ErrorContains(t, err, "no certificates found")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadClientCAs(filepath.Join(t.TempDir(), "missing.crt"))
+		require.ErrorContains(t, err, "failed to read client CA file")
+	})
+}
+
+func pemEncode(certificate *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})
+}
@@ -20,12 +20,14 @@ import (
 	"bytes"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/audit/auditprovider"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.yaml.in/yaml/v3"
@@ -35,6 +37,12 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Audit is the configuration for the audit log of mutating operations.
+	Audit auditprovider.Options `yaml:"auditProvider"`
+
+	// Authorization is the configuration for role-based authorization of UCP requests.
+	Authorization AuthorizationConfig `yaml:"authorization"`
+
 	// Database is the configuration for the database used for resource data.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
@@ -92,6 +100,41 @@ type IdentityConfig struct {
 	AuthMethod string `yaml:"authMethod"`
 }
 
+// AuthorizationConfig provides configuration for role-based authorization of UCP requests.
+//
+// When authorization is enabled, every request is checked against the role assignments stored in UCP. The identity
+// of the caller is taken from the X-Remote-User and X-Remote-Group headers set by the Kubernetes API aggregation
+// layer. The headers are only trusted on requests made with a client certificate issued by the request header
+// client CA. In-cluster components that call UCP directly are identified by a client certificate issued by the
+// client CA. All other callers are anonymous.
+type AuthorizationConfig struct {
+	// Enabled enables role-based authorization. When disabled, every request is allowed.
+	Enabled bool `yaml:"enabled"`
+
+	// AdminUsers is the list of users that are allowed to perform any operation regardless of role assignments.
+	AdminUsers []string `yaml:"adminUsers,omitempty"`
+
+	// AdminGroups is the list of groups whose members are allowed to perform any operation regardless of role assignments.
+	AdminGroups []string `yaml:"adminGroups,omitempty"`
+
+	// ComponentRoles is the list of roles granted to the Radius components that call UCP, such as the resource
+	// providers and the deployment engine, on every resource.
+	ComponentRoles []authorization.ComponentRole `yaml:"componentRoles,omitempty"`
+
+	// RequestHeaderClientCAFile is the path of the PEM encoded CA bundle used to verify the client certificate of the
+	// front proxy. It is required when authorization is enabled.
+	RequestHeaderClientCAFile string `yaml:"requestHeaderClientCAFile,omitempty"`
+
+	// RequestHeaderAllowedNames is the list of common names allowed for the client certificate of the front proxy.
+	// When empty, any client certificate issued by the request header client CA is allowed.
+	RequestHeaderAllowedNames []string `yaml:"requestHeaderAllowedNames,omitempty"`
+
+	// ClientCAFile is the path of the PEM encoded CA bundle used to verify the client certificates of in-cluster
+	// components, such as the deployment engine. The common name of the certificate is the user and its organizations
+	// are the groups. Optional.
+	ClientCAFile string `yaml:"clientCAFile,omitempty"`
+}
+
 // RoutingConfig provides configuration for UCP routing.
 type RoutingConfig struct {
 	// DefaultDownstreamEndpoint is the default destination when a resource provider does not provide a downstream endpoint.
@@ -21,6 +21,9 @@ import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 const (
 	// APIVersionResourceType is the resource type for an API version.
 	APIVersionResourceType = "System.Resources/resourceProviders/resourceTypes/apiVersions"
+
+	// APIVersionUnqualifiedResourceType is the unqualified resource type for an API version.
+	APIVersionUnqualifiedResourceType = "apiVersions"
 )
 
 // APIVersion represents an API version of a resource type.
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// LockResourceType is the type of a management lock.
+	LockResourceType = "System.Authorization/locks"
+
+	// LockLevelCanNotDelete is the level of a lock that prevents resources from being deleted.
+	LockLevelCanNotDelete = "CanNotDelete"
+
+	// LockLevelReadOnly is the level of a lock that prevents resources from being modified or deleted.
+	LockLevelReadOnly = "ReadOnly"
+)
+
+// Lock protects the resources at a scope from being modified or deleted.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the lock.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the lock.
+func (l *Lock) ResourceTypeName() string {
+	return LockResourceType
+}
+
+// LockProperties stores the properties of a lock.
+type LockProperties struct {
+	// Level is the level of the lock, either LockLevelCanNotDelete or LockLevelReadOnly.
+	Level string `json:"level"`
+
+	// Scope is the ID of the plane, resource group or resource the lock applies to. The lock applies to the scope
+	// and to all the resources below it.
+	Scope string `json:"scope"`
+
+	// Notes describes why the lock was created.
+	Notes string `json:"notes,omitempty"`
+}
@@ -57,6 +57,20 @@ func ResourceTypeIDFromResourceID(id resources.ID) (resources.ID, error) {
 			resources.SegmentSeparator + unqualifiedResourceType)
 }
 
+// APIVersionIDFromResourceID converts an inbound resource id to the resource ID
+// of the API version of its resource type.
+func APIVersionIDFromResourceID(id resources.ID, apiVersion string) (resources.ID, error) {
+	// Ex:
+	// /planes/radius/local/providers/Applications.Test/testResources/foo + 2025-01-01
+	// => /planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01
+	base, err := ResourceTypeIDFromResourceID(id)
+	if err != nil {
+		return resources.ID{}, err
+	}
+
+	return base.Append(resources.TypeSegment{Type: APIVersionUnqualifiedResourceType, Name: apiVersion}), nil
+}
+
 // ResourceProviderLocationIDFromResourceID converts an inbound resource id to the resource ID
 // of the resource provider's location.
 func ResourceProviderLocationIDFromResourceID(id resources.ID, location string) (resources.ID, error) {
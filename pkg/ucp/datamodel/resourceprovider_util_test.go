@@ -43,6 +43,16 @@ func Test_ResourceTypeIDFromResourceID(t *testing.T) {
 	require.Equal(t, expected, result)
 }
 
+func Test_APIVersionIDFromResourceID(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/foo")
+
+	result, err := APIVersionIDFromResourceID(id, "2025-01-01")
+	require.NoError(t, err)
+
+	expected := resources.MustParse("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01")
+	require.Equal(t, expected, result)
+}
+
 func Test_ResourceProviderLocationIDFromResourceID(t *testing.T) {
 	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/foo")
 
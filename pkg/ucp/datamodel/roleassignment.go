@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleAssignmentResourceType is the type of a role assignment.
+	RoleAssignmentResourceType = "System.Authorization/roleAssignments"
+
+	// PrincipalTypeUser is the principal type of a role assigned to a user.
+	PrincipalTypeUser = "User"
+
+	// PrincipalTypeGroup is the principal type of a role assigned to a group.
+	PrincipalTypeGroup = "Group"
+)
+
+// RoleAssignment grants the operations of a role definition to a user or group at a scope.
+type RoleAssignment struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role assignment.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the role assignment.
+func (r *RoleAssignment) ResourceTypeName() string {
+	return RoleAssignmentResourceType
+}
+
+// RoleAssignmentProperties stores the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// PrincipalName is the name of the user or group the role is assigned to.
+	PrincipalName string `json:"principalName"`
+
+	// PrincipalType is the type of the principal, either PrincipalTypeUser or PrincipalTypeGroup.
+	PrincipalType string `json:"principalType"`
+
+	// RoleDefinitionID is the resource ID of the assigned role definition.
+	RoleDefinitionID string `json:"roleDefinitionId"`
+
+	// Scope is the ID of the plane or resource group the role is assigned at. The assignment applies to the scope
+	// and to all the resources below it.
+	Scope string `json:"scope"`
+}
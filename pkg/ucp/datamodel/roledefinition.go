@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleDefinitionResourceType is the type of a role definition.
+	RoleDefinitionResourceType = "System.Authorization/roleDefinitions"
+)
+
+// RoleDefinition represents a set of operations that can be granted to users and groups with a role assignment.
+type RoleDefinition struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role definition.
+	Properties RoleDefinitionProperties `json:"properties"`
+}
+
+// ResourceTypeName returns the resource type of the role definition.
+func (r *RoleDefinition) ResourceTypeName() string {
+	return RoleDefinitionResourceType
+}
+
+// RoleDefinitionProperties stores the properties of a role definition.
+type RoleDefinitionProperties struct {
+	// Description is the description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Actions is the list of operations allowed by the role, for example 'Applications.Core/containers/read'.
+	// Segments can be replaced by '*' to match any value.
+	Actions []string `json:"actions"`
+
+	// NotActions is the list of operations excluded from Actions.
+	NotActions []string `json:"notActions,omitempty"`
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// AuditRecordsPath is the path of the audit admin API, relative to the UCP path base.
+	AuditRecordsPath = "/admin/auditrecords"
+
+	// ResourceIDParameter is the query parameter selecting the records of a resource or scope.
+	ResourceIDParameter = "resourceId"
+
+	// SinceParameter is the query parameter selecting the records written at or after a time, in RFC3339 format.
+	SinceParameter = "since"
+
+	// UntilParameter is the query parameter selecting the records written before a time, in RFC3339 format.
+	UntilParameter = "until"
+
+	// TopParameter is the query parameter limiting the number of records returned.
+	TopParameter = "top"
+)
+
+// AuditRecordList is the response body of the list audit records operation.
+type AuditRecordList struct {
+	// Value is the list of audit records, newest first.
+	Value []*audit.Record `json:"value"`
+}
+
+// RegisterAuditRoutes registers the audit admin API under path:
+//
+//	GET {path}    lists audit records.
+//
+// The records are selected with the ResourceIDParameter, SinceParameter, UntilParameter and TopParameter query
+// parameters. The operation fails with 400 when auditing is disabled or the sink cannot be queried.
+func RegisterAuditRoutes(router chi.Router, path string, sink audit.Sink) {
+	h := &auditHandler{sink: sink}
+	router.Get(path, h.list)
+}
+
+type auditHandler struct {
+	sink audit.Sink
+}
+
+func (h *auditHandler) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	var resp rest.Response
+	query, err := parseAuditQuery(r)
+	if err != nil {
+		resp = rest.NewBadRequestResponse(err.Error())
+	} else if h.sink == nil {
+		resp = rest.NewBadRequestResponse("auditing is not enabled")
+	} else if reader, ok := h.sink.(audit.Reader); !ok {
+		resp = rest.NewBadRequestResponse(audit.ErrQueryUnsupported.Error())
+	} else if records, err := reader.Query(ctx, query); err != nil {
+		resp = rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: err.Error(),
+			},
+		})
+	} else {
+		resp = rest.NewOKResponse(&AuditRecordList{Value: records})
+	}
+
+	if err := resp.Apply(ctx, w, r); err != nil {
+		logger.Error(err, "failed to write the audit admin response")
+	}
+}
+
+func parseAuditQuery(r *http.Request) (audit.Query, error) {
+	values := r.URL.Query()
+	query := audit.Query{ResourceID: values.Get(ResourceIDParameter)}
+
+	var err error
+	if since := values.Get(SinceParameter); since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return audit.Query{}, fmt.Errorf("invalid %s %q: the value must be in RFC3339 format", SinceParameter, since)
+		}
+	}
+
+	if until := values.Get(UntilParameter); until != "" {
+		if query.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return audit.Query{}, fmt.Errorf("invalid %s %q: the value must be in RFC3339 format", UntilParameter, until)
+		}
+	}
+
+	if top := values.Get(TopParameter); top != "" {
+		if query.Top, err = strconv.Atoi(top); err != nil || query.Top < 0 {
+			return audit.Query{}, fmt.Errorf("invalid %s %q: the value must be a non-negative integer", TopParameter, top)
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const testAuditPath = "/apis/api.ucp.dev/v1alpha3" + AuditRecordsPath
+
+func TestAuditRoutes(t *testing.T) {
+	ctx := testcontext.New(t)
+	sink := audit.NewDatabaseSink(inmemory.NewClient(), 0)
+
+	// The database sink only reads the recent days when no start time is selected.
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	require.NoError(t, sink.Write(ctx, &audit.Record{ID: "1", Timestamp: now.Add(-time.Hour), ResourceID: "/planes/radius/local/resourceGroups/a/providers/Applications.Core/containers/c"}))
+	require.NoError(t, sink.Write(ctx, &audit.Record{ID: "2", Timestamp: now, ResourceID: "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/c"}))
+
+	router := chi.NewRouter()
+	RegisterAuditRoutes(router, testAuditPath, sink)
+
+	list := func(t *testing.T, query string) []*audit.Record {
+		w := serve(router, http.MethodGet, testAuditPath+query)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		list := &AuditRecordList{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), list))
+		return list.Value
+	}
+
+	t.Run("all", func(t *testing.T) {
+		records := list(t, "")
+		require.Len(t, records, 2)
+		require.Equal(t, "2", records[0].ID)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		records := list(t, "?resourceId=/planes/radius/local/resourceGroups/a")
+		require.Len(t, records, 1)
+		require.Equal(t, "1", records[0].ID)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		records := list(t, "?since="+now.Add(-2*time.Hour).Format(time.RFC3339)+"&until="+now.Format(time.RFC3339))
+		require.Len(t, records, 1)
+		require.Equal(t, "1", records[0].ID)
+	})
+
+	t.Run("top", func(t *testing.T) {
+		records := list(t, "?top=1")
+		require.Len(t, records, 1)
+		require.Equal(t, "2", records[0].ID)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		w := serve(router, http.MethodGet, testAuditPath+"?since=yesterday")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid top", func(t *testing.T) {
+		w := serve(router, http.MethodGet, testAuditPath+"?top=-1")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuditRoutes_Unsupported(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		router := chi.NewRouter()
+		RegisterAuditRoutes(router, testAuditPath, nil)
+
+		w := serve(router, http.MethodGet, testAuditPath)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "auditing is not enabled")
+	})
+
+	t.Run("write-only sink", func(t *testing.T) {
+		router := chi.NewRouter()
+		RegisterAuditRoutes(router, testAuditPath, audit.NewWebhookSink("http://localhost", http.DefaultClient))
+
+		w := serve(router, http.MethodGet, testAuditPath)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), audit.ErrQueryUnsupported.Error())
+	})
+}
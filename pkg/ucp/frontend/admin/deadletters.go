@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DeadLettersPath is the path of the dead-letter admin API, relative to the UCP path base.
+	DeadLettersPath = "/admin/deadletters"
+
+	// QueueNameParameter is the query parameter selecting the queue. UCP's own queue is used when it is omitted.
+	QueueNameParameter = "queue"
+
+	deadLetterIDParameter = "deadLetterID"
+)
+
+// DeadLetterList is the response body of the list dead-letters operation.
+type DeadLetterList struct {
+	// Value is the list of dead-lettered messages, oldest first.
+	Value []*queue.DeadLetter `json:"value"`
+}
+
+// ResourceProviderQueueNames are the names of the queues of the resource providers deployed with UCP: "radius" for the
+// Applications resource provider and "dynamic-rp" for the dynamic resource provider.
+var ResourceProviderQueueNames = []string{"radius", "dynamic-rp"}
+
+// ErrUnknownQueue is returned by a DeadLetterClientGetter for a queue name it does not know.
+var ErrUnknownQueue = errors.New("unknown queue")
+
+// ErrQueueNotShared is returned by a DeadLetterClientGetter for the queue of another resource provider when the queue
+// provider keeps queues in the memory of the process that uses them, so UCP cannot reach them.
+var ErrQueueNotShared = errors.New("queue is not shared with UCP")
+
+// DeadLetterClientGetter returns the dead-letter client of the named queue. An empty name selects UCP's own queue.
+type DeadLetterClientGetter func(ctx context.Context, queueName string) (queue.DeadLetterClient, error)
+
+// NewDeadLetterClientGetter returns a DeadLetterClientGetter backed by provider. The queues of other resource providers
+// named by queueNames are opened with the options of provider and the queue name replaced. Any other queue name is
+// rejected with ErrUnknownQueue.
+//
+// The in-memory provider keeps each queue in the process of the resource provider that uses it, so opening a queue of
+// the same name in UCP would show an unrelated, empty queue. The queues of other resource providers are rejected with
+// ErrQueueNotShared when provider is in-memory.
+func NewDeadLetterClientGetter(provider *queueprovider.QueueProvider, queueNames []string) DeadLetterClientGetter {
+	opts := provider.Options()
+	shared := opts.Provider != queueprovider.TypeInmemory
+	providers := map[string]*queueprovider.QueueProvider{}
+	for _, name := range queueNames {
+		if name == opts.Name {
+			continue
+		}
+
+		named := opts
+		named.Name = name
+		providers[name] = queueprovider.New(named)
+	}
+
+	return func(ctx context.Context, queueName string) (queue.DeadLetterClient, error) {
+		if queueName == "" || queueName == opts.Name {
+			return provider.GetDeadLetterClient(ctx)
+		}
+
+		p, ok := providers[queueName]
+		if !ok {
+			return nil, fmt.Errorf("%w %q, the queue must be %q or one of %s", ErrUnknownQueue, queueName, opts.Name, strings.Join(queueNames, ", "))
+		}
+		if !shared {
+			return nil, fmt.Errorf("%w: the %s queue provider keeps queue %q in the memory of its resource provider", ErrQueueNotShared, opts.Provider, queueName)
+		}
+
+		return p.GetDeadLetterClient(ctx)
+	}
+}
+
+// RegisterDeadLetterRoutes registers the dead-letter admin API under path:
+//
+//	GET    {path}                 lists dead-lettered messages.
+//	GET    {path}/{id}            gets a dead-lettered message.
+//	POST   {path}/{id}/replay     moves a dead-lettered message back to its queue.
+//	DELETE {path}/{id}            purges a dead-lettered message.
+//
+// Every operation accepts the QueueNameParameter query parameter.
+func RegisterDeadLetterRoutes(router chi.Router, path string, getClient DeadLetterClientGetter) {
+	h := &deadLetterHandler{getClient: getClient}
+	itemPath := path + "/{" + deadLetterIDParameter + "}"
+
+	router.Get(path, h.handle(h.list))
+	router.Get(itemPath, h.handle(h.get))
+	router.Post(itemPath+"/replay", h.handle(h.replay))
+	router.Delete(itemPath, h.handle(h.purge))
+}
+
+type deadLetterHandler struct {
+	getClient DeadLetterClientGetter
+}
+
+// handle adapts a dead-letter operation to an http.HandlerFunc.
+func (h *deadLetterHandler) handle(op func(ctx context.Context, client queue.DeadLetterClient, id string) (rest.Response, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		queueName := r.URL.Query().Get(QueueNameParameter)
+		client, err := h.getClient(ctx, queueName)
+		var resp rest.Response
+		if err == nil {
+			resp, err = op(ctx, client, chi.URLParam(r, deadLetterIDParameter))
+		}
+		if err != nil {
+			resp = errorResponse(err)
+		}
+
+		if err := resp.Apply(ctx, w, r); err != nil {
+			logger.Error(err, "failed to write the dead-letter admin response")
+		}
+	}
+}
+
+func (h *deadLetterHandler) list(ctx context.Context, client queue.DeadLetterClient, _ string) (rest.Response, error) {
+	letters, err := client.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(&DeadLetterList{Value: letters}), nil
+}
+
+func (h *deadLetterHandler) get(ctx context.Context, client queue.DeadLetterClient, id string) (rest.Response, error) {
+	letter, err := client.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(letter), nil
+}
+
+func (h *deadLetterHandler) replay(ctx context.Context, client queue.DeadLetterClient, id string) (rest.Response, error) {
+	if err := client.ReplayDeadLetter(ctx, id); err != nil {
+		return nil, err
+	}
+	return rest.NewNoContentResponse(), nil
+}
+
+func (h *deadLetterHandler) purge(ctx context.Context, client queue.DeadLetterClient, id string) (rest.Response, error) {
+	if err := client.PurgeDeadLetter(ctx, id); err != nil {
+		return nil, err
+	}
+	return rest.NewNoContentResponse(), nil
+}
+
+// errorResponse converts an error returned by a dead-letter client to a response.
+func errorResponse(err error) rest.Response {
+	switch {
+	case errors.Is(err, queue.ErrDeadLetterNotFound):
+		return rest.NewNotFoundMessageResponse(err.Error())
+	case errors.Is(err, queue.ErrDeadLetterUnsupported), errors.Is(err, queueprovider.ErrUnsupportedQueueProvider), errors.Is(err, ErrUnknownQueue), errors.Is(err, ErrQueueNotShared):
+		return rest.NewBadRequestResponse(err.Error())
+	default:
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: err.Error(),
+			},
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const testPath = "/apis/api.ucp.dev/v1alpha3" + DeadLettersPath
+
+func newTestRouter(t *testing.T, queueName string, otherQueueNames ...string) (chi.Router, queue.Client) {
+	provider := queueprovider.New(queueprovider.QueueProviderOptions{
+		Provider: queueprovider.TypeInmemory,
+		Name:     queueName,
+		InMemory: &queueprovider.InMemoryQueueOptions{},
+	})
+	client, err := provider.GetClient(testcontext.New(t))
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	RegisterDeadLetterRoutes(router, testPath, NewDeadLetterClientGetter(provider, otherQueueNames))
+	return router, client
+}
+
+// deadLetterTestMessage enqueues a message and moves it to the dead-letter queue.
+func deadLetterTestMessage(t *testing.T, client queue.Client) string {
+	ctx := testcontext.New(t)
+	require.NoError(t, client.Enqueue(ctx, queue.NewMessage(map[string]string{"operation": "PUT"})))
+	msg, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	err = client.(queue.DeadLetterClient).DeadLetterMessage(ctx, msg, queue.DeadLetterInfo{Reason: queue.DeadLetterReasonPanic, Error: "boom"})
+	require.NoError(t, err)
+	return msg.ID
+}
+
+func serve(router chi.Router, method string, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w
+}
+
+func TestDeadLetterRoutes(t *testing.T) {
+	router, client := newTestRouter(t, "ucp-"+uuid.NewString())
+	id := deadLetterTestMessage(t, client)
+
+	t.Run("list", func(t *testing.T) {
+		w := serve(router, http.MethodGet, testPath)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		list := &DeadLetterList{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), list))
+		require.Len(t, list.Value, 1)
+		require.Equal(t, id, list.Value[0].ID)
+		require.Equal(t, queue.DeadLetterReasonPanic, list.Value[0].Reason)
+		require.Equal(t, "boom", list.Value[0].Error)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		w := serve(router, http.MethodGet, testPath+"/"+id)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		letter := &queue.DeadLetter{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), letter))
+		require.Equal(t, id, letter.ID)
+		require.JSONEq(t, `{"operation":"PUT"}`, string(letter.Data))
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		w := serve(router, http.MethodGet, testPath+"/missing")
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		resp := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, v1.CodeNotFound, resp.Error.Code)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		w := serve(router, http.MethodPost, testPath+"/"+id+"/replay")
+		require.Equal(t, http.StatusNoContent, w.Code)
+
+		msg, err := client.Dequeue(testcontext.New(t), queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, id, msg.ID)
+
+		w = serve(router, http.MethodPost, testPath+"/"+id+"/replay")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		purgeID := deadLetterTestMessage(t, client)
+
+		w := serve(router, http.MethodDelete, testPath+"/"+purgeID)
+		require.Equal(t, http.StatusNoContent, w.Code)
+
+		w = serve(router, http.MethodDelete, testPath+"/"+purgeID)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeadLetterRoutes_OtherQueue_InMemory(t *testing.T) {
+	otherName := "radius-" + uuid.NewString()
+	router, _ := newTestRouter(t, "ucp-"+uuid.NewString(), otherName)
+
+	w := serve(router, http.MethodGet, testPath)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"value":[]}`, w.Body.String())
+
+	// The in-memory queue of another resource provider lives in that
+	// provider's process, so UCP must not show its own empty copy.
+	w = serve(router, http.MethodGet, testPath+"?"+QueueNameParameter+"="+otherName)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "queue is not shared with UCP")
+}
+
+func TestDeadLetterRoutes_UnknownQueue(t *testing.T) {
+	router, _ := newTestRouter(t, "ucp-"+uuid.NewString(), "radius")
+
+	w := serve(router, http.MethodGet, testPath+"?"+QueueNameParameter+"=unknown")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), `unknown queue \"unknown\"`)
+}
+
+func TestDeadLetterRoutes_Unsupported(t *testing.T) {
+	provider := queueprovider.New(queueprovider.QueueProviderOptions{Name: "ucp"})
+	provider.SetClient(queue.NewMockClient(nil))
+
+	router := chi.NewRouter()
+	RegisterDeadLetterRoutes(router, testPath, NewDeadLetterClientGetter(provider, nil))
+
+	w := serve(router, http.MethodGet, testPath)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// auditSource is the source recorded in the audit records written by UCP.
+const auditSource = "ucp"
+
+// sensitiveFieldPaths returns a function that reads the sensitive fields of a resource from the schema of its
+// registered resource type. Resources whose type or API version is not registered have no sensitive fields
+// beyond the defaults of the audit middleware.
+func sensitiveFieldPaths(databaseClient database.Client) audit.SensitiveFieldPathsFunc {
+	return func(ctx context.Context, id resources.ID, apiVersion string) ([]string, error) {
+		// Only resource types of the radius plane are registered with a schema.
+		if !id.IsResource() || !strings.HasPrefix(strings.ToLower(id.PlaneNamespace()), "radius/") || apiVersion == "" {
+			return nil, nil
+		}
+
+		apiVersionID, err := datamodel.APIVersionIDFromResourceID(id, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := databaseClient.Get(ctx, apiVersionID.String())
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		apiVersionResource := &datamodel.APIVersion{}
+		if err := obj.As(apiVersionResource); err != nil {
+			return nil, err
+		}
+
+		return schema.ExtractSensitiveFieldPaths(apiVersionResource.Properties.Schema, ""), nil
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sensitiveFieldPaths(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	apiVersionID := "/planes/radius/local/providers/System.Resources/resourceProviders/Test.Resources/resourceTypes/secrets/apiVersions/2025-01-01"
+	err := client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: apiVersionID},
+		Data: &datamodel.APIVersion{
+			Properties: datamodel.APIVersionProperties{
+				Schema: map[string]any{
+					"properties": map[string]any{
+						"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+						"username": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	fn := sensitiveFieldPaths(client)
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test/providers/Test.Resources/secrets/s1")
+
+	t.Run("registered type", func(t *testing.T) {
+		paths, err := fn(ctx, id, "2025-01-01")
+		require.NoError(t, err)
+		require.Equal(t, []string{"password"}, paths)
+	})
+
+	t.Run("unregistered api version", func(t *testing.T) {
+		paths, err := fn(ctx, id, "2020-01-01")
+		require.NoError(t, err)
+		require.Empty(t, paths)
+	})
+
+	t.Run("other plane", func(t *testing.T) {
+		paths, err := fn(ctx, resources.MustParse("/planes/aws/aws/accounts/0000/regions/us-west-2/providers/AWS.S3/Bucket/b"), "2025-01-01")
+		require.NoError(t, err)
+		require.Empty(t, paths)
+	})
+}
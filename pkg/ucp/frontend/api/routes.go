@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/frontend/admin"
 	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
@@ -164,6 +165,15 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		}
 	}
 
+	// The dead-letter admin API inspects, replays or purges poisoned async operations. Like the other administrative
+	// APIs, it is restricted to admins when authorization is enabled.
+	if options.QueueProvider != nil {
+		admin.RegisterDeadLetterRoutes(router, options.Config.Server.PathBase+admin.DeadLettersPath, admin.NewDeadLetterClientGetter(options.QueueProvider, admin.ResourceProviderQueueNames))
+	}
+
+	// The audit admin API queries the audit log of mutating operations.
+	admin.RegisterAuditRoutes(router, options.Config.Server.PathBase+admin.AuditRecordsPath, options.AuditSink)
+
 	// Register a catch-all route to handle requests that get dispatched to a specific plane.
 	unknownPlaneRouter := server.NewSubrouter(router, options.Config.Server.PathBase+planeTypeCollectionPath)
 	unknownPlaneRouter.HandleFunc(server.CatchAllPath, func(w http.ResponseWriter, r *http.Request) {
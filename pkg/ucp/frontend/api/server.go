@@ -19,7 +19,10 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -29,9 +32,11 @@ import (
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/components/audit"
 	"github.com/radius-project/radius/pkg/components/hosting"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
@@ -98,7 +103,37 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	authenticator, tlsConfig, err := s.newAuthenticator()
+	if err != nil {
+		return nil, err
+	}
+
 	app := http.Handler(r)
+	if s.options.Config.Authorization.Enabled {
+		db, err := s.options.DatabaseProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizer := authorization.NewAuthorizer(db, s.options.Config.Authorization.AdminUsers, s.options.Config.Authorization.AdminGroups, s.options.Config.Authorization.ComponentRoles)
+		app = authorization.Middleware(authenticator, authorizer, s.options.Config.Server.PathBase)(app)
+	}
+	if s.options.AuditSink != nil {
+		db, err := s.options.DatabaseProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Audit runs outside of authorization so that denied requests are recorded too.
+		app = audit.Middleware(audit.MiddlewareOptions{
+			Sink:                s.options.AuditSink,
+			Source:              auditSource,
+			DatabaseClient:      db,
+			SensitiveFieldPaths: sensitiveFieldPaths(db),
+			Authenticator:       authenticator,
+			PathBase:            s.options.Config.Server.PathBase,
+		})(app)
+	}
 	app = servicecontext.ARMRequestCtx(s.options.Config.Server.PathBase, s.options.Config.Environment.RoleLocation)(app)
 	app = middleware.WithLogger(app)
 
@@ -122,10 +157,52 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		BaseContext: func(ln net.Listener) context.Context {
 			return ctx
 		},
+		TLSConfig: tlsConfig,
 	}
 	return server, nil
 }
 
+// newAuthenticator creates the authenticator that identifies callers from the headers set by the front proxy or from
+// the client certificates of in-cluster components, and the TLS configuration that requests client certificates.
+//
+// It returns a nil authenticator, which treats every caller as anonymous, when no request header client CA is
+// configured. Authorization requires the request header client CA because every caller would be denied otherwise.
+func (s *Service) newAuthenticator() (*authorization.Authenticator, *tls.Config, error) {
+	config := s.options.Config.Authorization
+	if config.RequestHeaderClientCAFile == "" {
+		if config.Enabled {
+			return nil, nil, errors.New("authorization requires requestHeaderClientCAFile to be set")
+		}
+		return nil, nil, nil
+	}
+
+	if s.options.Config.Server.TLSCertificateDirectory == "" {
+		return nil, nil, errors.New("requestHeaderClientCAFile requires tlsCertificateDirectory to be set")
+	}
+
+	requestHeaderCAs, err := authorization.LoadClientCAs(config.RequestHeaderClientCAFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clientCAs *x509.CertPool
+	if config.ClientCAFile != "" {
+		clientCAs, err = authorization.LoadClientCAs(config.ClientCAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Client certificates are issued by different CAs for the front proxy and for in-cluster components, so they are
+	// verified by the authenticator rather than during the handshake. Callers without a certificate are anonymous.
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequestClientCert,
+	}
+
+	return authorization.NewAuthenticator(requestHeaderCAs, config.RequestHeaderAllowedNames, clientCAs), tlsConfig, nil
+}
+
 // configureDefaultPlanes reads the configuration file specified by the env var to configure default planes into UCP
 func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	for _, plane := range s.options.Config.Initialization.Planes {
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCA(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return path
+}
+
+func Test_Service_newAuthenticator(t *testing.T) {
+	newService := func(authorization ucp.AuthorizationConfig, tlsDirectory string) *Service {
+		return NewService(&ucp.Options{Config: &ucp.Config{
+			Authorization: authorization,
+			Server:        hostoptions.ServerOptions{TLSCertificateDirectory: tlsDirectory},
+		}})
+	}
+
+	t.Run("request header client CA", func(t *testing.T) {
+		s := newService(ucp.AuthorizationConfig{Enabled: true, RequestHeaderClientCAFile: writeTestCA(t), RequestHeaderAllowedNames: []string{"front-proxy-client"}}, "/var/tls/cert")
+
+		authenticator, tlsConfig, err := s.newAuthenticator()
+		require.NoError(t, err)
+		require.NotNil(t, authenticator)
+		require.Equal(t, tls.RequestClientCert, tlsConfig.ClientAuth)
+		require.Nil(t, tlsConfig.ClientCAs)
+	})
+
+	t.Run("client CA", func(t *testing.T) {
+		s := newService(ucp.AuthorizationConfig{Enabled: true, RequestHeaderClientCAFile: writeTestCA(t), ClientCAFile: writeTestCA(t)}, "/var/tls/cert")
+
+		authenticator, _, err := s.newAuthenticator()
+		require.NoError(t, err)
+		require.NotNil(t, authenticator)
+	})
+
+	t.Run("invalid client CA", func(t *testing.T) {
+		s := newService(ucp.AuthorizationConfig{Enabled: true, RequestHeaderClientCAFile: writeTestCA(t), ClientCAFile: "/missing/ca.crt"}, "/var/tls/cert")
+
+		_, _, err := s.newAuthenticator()
+		require.ErrorContains(t, err, "failed to read client CA file")
+	})
+
+	t.Run("authorization disabled without request header client CA", func(t *testing.T) {
+		authenticator, tlsConfig, err := newService(ucp.AuthorizationConfig{}, "").newAuthenticator()
+		require.NoError(t, err)
+		require.Nil(t, authenticator)
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("authorization enabled without request header client CA", func(t *testing.T) {
+		_, _, err := newService(ucp.AuthorizationConfig{Enabled: true}, "/var/tls/cert").newAuthenticator()
+		require.ErrorContains(t, err, "authorization requires requestHeaderClientCAFile to be set")
+	})
+
+	t.Run("request header client CA without TLS", func(t *testing.T) {
+		_, _, err := newService(ucp.AuthorizationConfig{Enabled: true, RequestHeaderClientCAFile: writeTestCA(t)}, "").newAuthenticator()
+		require.ErrorContains(t, err, "requestHeaderClientCAFile requires tlsCertificateDirectory to be set")
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+)
+
+var _ armrpc_controller.Controller = (*CreateOrUpdateLock)(nil)
+
+// CreateOrUpdateLock is the controller implementation to create or update a lock.
+type CreateOrUpdateLock struct {
+	armrpc_controller.Operation[*datamodel.Lock, datamodel.Lock]
+}
+
+// NewCreateOrUpdateLock creates a new controller for creating or updating a lock.
+func NewCreateOrUpdateLock(opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[datamodel.Lock]) (armrpc_controller.Controller, error) {
+	return &CreateOrUpdateLock{armrpc_controller.NewOperation(opts, resourceOpts)}, nil
+}
+
+// Run saves the lock along with its entry in the index of locks by scope, in a single batch so that the index
+// never disagrees with the locks.
+func (c *CreateOrUpdateLock) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range c.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, c.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
+
+	operations, err := locks.IndexOperations(ctx, c.DatabaseClient(), newResource, old)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &database.Object{
+		Metadata: database.Metadata{ID: serviceCtx.ResourceID.String()},
+		Data:     newResource,
+	}
+	operations = append(operations, database.NewSaveOperation(obj, database.WithETag(etag)))
+	if err := c.DatabaseClient().ExecuteBatch(ctx, operations); err != nil {
+		return nil, err
+	}
+
+	return c.ConstructSyncResponse(ctx, req.Method, obj.ETag, newResource)
+}
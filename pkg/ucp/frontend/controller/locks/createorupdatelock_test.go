@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLockID      = "/planes/radius/local/providers/System.Authorization/locks/production"
+	testAPIVersion  = "2023-10-01-preview"
+	testIndexSuffix = "/providers/System.Authorization/lockIndex/production"
+)
+
+var testLockResourceOptions = armrpc_controller.ResourceOptions[datamodel.Lock]{
+	RequestConverter:  converter.LockDataModelFromVersioned,
+	ResponseConverter: converter.LockDataModelToVersioned,
+}
+
+// putLock creates or updates the test lock with the given scope and returns the status code of the response.
+func putLock(t *testing.T, client database.Client, scope string) int {
+	body := `{"properties": {"level": "CanNotDelete", "scope": "` + scope + `"}}`
+	req := httptest.NewRequest(http.MethodPut, testLockID+"?api-version="+testAPIVersion, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewCreateOrUpdateLock(armrpc_controller.Options{DatabaseClient: client}, testLockResourceOptions)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, nil, req)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.Apply(ctx, w, req))
+	return w.Result().StatusCode
+}
+
+func requireIndexEntry(t *testing.T, client database.Client, rootScope string, exists bool) {
+	_, err := client.Get(context.Background(), rootScope+testIndexSuffix)
+	if exists {
+		require.NoError(t, err)
+	} else {
+		require.ErrorIs(t, err, &database.ErrNotFound{})
+	}
+}
+
+func Test_CreateOrUpdateLock(t *testing.T) {
+	client := inmemory.NewClient()
+
+	require.Equal(t, http.StatusOK, putLock(t, client, "/planes/radius/local/resourceGroups/a"))
+	_, err := client.Get(context.Background(), testLockID)
+	require.NoError(t, err)
+	requireIndexEntry(t, client, "/planes/radius/local/resourceGroups/a", true)
+
+	// Moving the lock to another resource group moves its index entry.
+	require.Equal(t, http.StatusOK, putLock(t, client, "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/web"))
+	requireIndexEntry(t, client, "/planes/radius/local/resourceGroups/a", false)
+	requireIndexEntry(t, client, "/planes/radius/local/resourceGroups/b", true)
+
+	obj, err := client.Get(context.Background(), "/planes/radius/local/resourceGroups/b"+testIndexSuffix)
+	require.NoError(t, err)
+	lock := &datamodel.Lock{}
+	require.NoError(t, obj.As(lock))
+	require.Equal(t, testLockID, lock.ID)
+	require.Equal(t, "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/web", lock.Properties.Scope)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+)
+
+var _ armrpc_controller.Controller = (*DeleteLock)(nil)
+
+// DeleteLock is the controller implementation to delete a lock.
+type DeleteLock struct {
+	armrpc_controller.Operation[*datamodel.Lock, datamodel.Lock]
+}
+
+// NewDeleteLock creates a new controller for deleting a lock.
+func NewDeleteLock(opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[datamodel.Lock]) (armrpc_controller.Controller, error) {
+	return &DeleteLock{armrpc_controller.NewOperation(opts, resourceOpts)}, nil
+}
+
+// Run deletes the lock along with its entry in the index of locks by scope, in a single batch. A No Content response
+// is returned if the lock does not exist.
+func (c *DeleteLock) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return armrpc_rest.NewNoContentResponse(), nil
+	}
+
+	if r, err := c.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	operations, err := locks.IndexOperations(ctx, c.DatabaseClient(), nil, old)
+	if err != nil {
+		return nil, err
+	}
+
+	operations = append(operations, database.NewDeleteOperation(serviceCtx.ResourceID.String(), database.WithETag(etag)))
+	err = c.DatabaseClient().ExecuteBatch(ctx, operations)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(nil), nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+// deleteLock deletes the test lock and returns the status code of the response.
+func deleteLock(t *testing.T, client database.Client) int {
+	req := httptest.NewRequest(http.MethodDelete, testLockID+"?api-version="+testAPIVersion, nil)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewDeleteLock(armrpc_controller.Options{DatabaseClient: client}, testLockResourceOptions)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, nil, req)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.Apply(ctx, w, req))
+	return w.Result().StatusCode
+}
+
+func Test_DeleteLock(t *testing.T) {
+	client := inmemory.NewClient()
+	require.Equal(t, http.StatusOK, putLock(t, client, "/planes/radius/local/resourceGroups/a"))
+
+	require.Equal(t, http.StatusOK, deleteLock(t, client))
+	_, err := client.Get(context.Background(), testLockID)
+	require.ErrorIs(t, err, &database.ErrNotFound{})
+	requireIndexEntry(t, client, "/planes/radius/local/resourceGroups/a", false)
+
+	require.Equal(t, http.StatusNoContent, deleteLock(t, client))
+}
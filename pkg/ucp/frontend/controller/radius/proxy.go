@@ -123,6 +123,22 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, fmt.Errorf("failed to validate downstream: %w", err)
 	}
 
+	// Locks are enforced here for the resources of downstream resource providers, which do not have access to them.
+	if p.Options().LockChecker != nil {
+		lockID, err := armrpc_controller.LockTargetID(ctx, p.Options().PathBase, req.Method)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		response, err := p.Options().LockChecker.CheckLocks(ctx, lockID, req.Method)
+		if err != nil {
+			return nil, err
+		}
+		if response != nil {
+			return response, nil
+		}
+	}
+
 	if downstreamURL == nil {
 		downstreamURL = p.defaultDownstream
 	}
@@ -341,6 +341,60 @@ func Test_Run(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expected, response)
 	})
+
+	t.Run("failure (locked)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		locked := rest.NewConflictResponse("locked")
+		lockChecker := &fakeLockChecker{Response: locked}
+		options := p.Options()
+		options.LockChecker = lockChecker
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String()+"?api-version="+apiVersion, nil)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion:  apiVersion,
+			ResourceID:  id,
+			OriginalURL: *req.URL,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		response, err := p.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, locked, response)
+		require.Equal(t, id, lockChecker.ID)
+		require.Equal(t, http.MethodDelete, lockChecker.Method)
+	})
+}
+
+type fakeLockChecker struct {
+	Response rest.Response
+	ID       resources.ID
+	Method   string
+}
+
+func (c *fakeLockChecker) CheckLocks(ctx context.Context, id resources.ID, method string) (rest.Response, error) {
+	c.ID = id
+	c.Method = method
+	return c.Response, nil
 }
 
 func Test_ProxyController_PrepareProxyRequest(t *testing.T) {
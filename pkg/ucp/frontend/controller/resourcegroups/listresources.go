@@ -50,7 +50,8 @@ func NewListResources(opts armrpc_controller.Options) (armrpc_controller.Control
 	}, nil
 }
 
-// Run implements controller.Controller.
+// Run implements controller.Controller. When the watch query parameter is set, Run streams the changes to the
+// resources stored in the resource group as server-sent events instead of listing them.
 func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
 	id, err := resources.Parse(relativePath)
@@ -74,6 +75,13 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		ResourceType: v20231001preview.ResourceType,
 	}
 
+	if armrpc_controller.IsWatchRequest(req) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		return armrpc_controller.WatchResources(ctx, r.DatabaseClient(), query, armrpc_controller.GetResumeToken(req), func(obj *database.Object) (any, error) {
+			return convertResource(obj, serviceCtx.APIVersion)
+		})
+	}
+
 	result, err := r.DatabaseClient().Query(ctx, query)
 	if err != nil {
 		return nil, err
@@ -92,13 +100,7 @@ func (r *ListResources) createResponse(ctx context.Context, result *database.Obj
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	for _, item := range result.Items {
-		data := datamodel.GenericResource{}
-		err := item.As(&data)
-		if err != nil {
-			return nil, err
-		}
-
-		versioned, err := converter.GenericResourceDataModelToVersioned(&data, serviceCtx.APIVersion)
+		versioned, err := convertResource(&item, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
 		}
@@ -108,3 +110,12 @@ func (r *ListResources) createResponse(ctx context.Context, result *database.Obj
 
 	return &items, nil
 }
+
+func convertResource(obj *database.Object, apiVersion string) (any, error) {
+	data := datamodel.GenericResource{}
+	if err := obj.As(&data); err != nil {
+		return nil, err
+	}
+
+	return converter.GenericResourceDataModelToVersioned(&data, apiVersion)
+}
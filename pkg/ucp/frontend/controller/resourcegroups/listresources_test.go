@@ -16,6 +16,7 @@ limitations under the License.
 package resourcegroups
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -28,6 +29,8 @@ import (
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/changefeed"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -130,6 +133,38 @@ func Test_ListResources(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expected, response)
 	})
+
+	t.Run("watch", func(t *testing.T) {
+		databaseClient := changefeed.New(inmemory.NewClient(), 0)
+		c, err := NewListResources(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()})
+		require.NoError(t, err)
+		ctrl := c.(*ListResources)
+
+		err = databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: resourceGroupID}, Data: resourceGroupDatamodel})
+		require.NoError(t, err)
+
+		entryID := resourceGroupID + "/providers/" + v20231001preview.ResourceType + "/" + "test-app"
+		err = databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: entryID}, Data: entryDatamodel})
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&watch=true", nil)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(rpctest.NewARMRequestContext(request))
+		defer cancel()
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_controller.WatchResponse{}, response)
+
+		watch := response.(*armrpc_controller.WatchResponse)
+		event := <-watch.Events
+		require.Equal(t, database.EventTypeSaved, event.Type)
+		require.Equal(t, entryID, event.ID)
+
+		versioned, err := watch.Convert(event.Object)
+		require.NoError(t, err)
+		require.Equal(t, &entryResource, versioned)
+	})
 }
 
 func setupListResources(t *testing.T) (*database.MockClient, *ListResources) {
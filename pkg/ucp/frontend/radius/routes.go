@@ -30,10 +30,12 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -74,6 +76,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		DatabaseClient: databaseClient,
 		PathBase:       m.options.Config.Server.PathBase,
 		StatusManager:  m.options.StatusManager,
+		LockChecker:    locks.NewChecker(databaseClient),
 
 		KubeClient:   nil, // Unused by Radius module
 		ResourceType: "",  // Set dynamically
@@ -96,6 +99,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					// Routes for async support: operationResults + operationStatuses
 					r.Route("/locations/{location}", func(r chi.Router) {
 						r.Get("/operationStatuses/{operationId}", capture(operationStatusGetHandler(ctx, ctrlOptions)))
+						r.Post("/operationStatuses/{operationId}/cancel", capture(operationStatusCancelHandler(ctx, ctrlOptions)))
+						r.Get("/operationStatuses/{operationId}/logs", capture(operationStatusLogsHandler(ctx, ctrlOptions)))
 						r.Get("/operationResults/{operationId}", capture(operationResultGetHandler(ctx, ctrlOptions)))
 					})
 
@@ -136,6 +141,35 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					})
 				})
 
+				r.Route("/System.Authorization", func(r chi.Router) {
+					r.Route("/roleDefinitions", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleDefinitionListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleDefinitionName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleDefinitionGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleDefinitionPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleDefinitionDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+
+					r.Route("/roleAssignments", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleAssignmentListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleAssignmentName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleAssignmentPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleAssignmentDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+
+					r.Route("/locks", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(lockListHandler(ctx, ctrlOptions)))
+						r.Route("/{lockName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(lockGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(lockPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(lockDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+				})
+
 				// Proxy to plane-scoped ResourceProvider APIs
 				//
 				// NOTE: DO NOT validate schema for proxy routes.
@@ -366,6 +400,96 @@ func locationDeleteHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
+var roleDefinitionResourceOptions = controller.ResourceOptions[datamodel.RoleDefinition]{
+	RequestConverter:  converter.RoleDefinitionDataModelFromVersioned,
+	ResponseConverter: converter.RoleDefinitionDataModelToVersioned,
+}
+
+func roleDefinitionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleDefinitionResourceOptions)
+	})
+}
+
+var roleAssignmentResourceOptions = controller.ResourceOptions[datamodel.RoleAssignment]{
+	RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+	ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+}
+
+func roleAssignmentListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleAssignmentResourceOptions)
+	})
+}
+
+var lockResourceOptions = controller.ResourceOptions[datamodel.Lock]{
+	RequestConverter:  converter.LockDataModelFromVersioned,
+	ResponseConverter: converter.LockDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.Lock]{
+		locks.ValidateLockScope,
+	},
+}
+
+func lockListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, lockResourceOptions)
+	})
+}
+
+func lockGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, lockResourceOptions)
+	})
+}
+
+func lockPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return locks_ctrl.NewCreateOrUpdateLock(opts, lockResourceOptions)
+	})
+}
+
+func lockDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return locks_ctrl.NewDeleteLock(opts, lockResourceOptions)
+	})
+}
+
 func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
 		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
@@ -382,6 +506,14 @@ func operationStatusGetHandler(ctx context.Context, ctrlOptions controller.Optio
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationStatus)
 }
 
+func operationStatusCancelHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationPost, ctrlOptions, defaultoperation.NewCancelOperation)
+}
+
+func operationStatusLogsHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationLogs)
+}
+
 func operationResultGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	// NOTE: The resource type below is CORRECT. operation status and operation result use the same resource type in the database.
 	return server.CreateHandler(ctx, "System.Resources/operationstatuses", v1.OperationGet, ctrlOptions, defaultoperation.NewGetOperationResult)
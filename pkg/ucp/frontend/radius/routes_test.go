@@ -142,6 +142,68 @@ func Test_Routes(t *testing.T) {
 			Path:          "/planes/radius/someName/providers/System.Resources/resourceproviders/Applications.Test/resourcetypes/testResources/apiversions/2025-01-01",
 		},
 
+		// Role-based authorization
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-readers",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-readers",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-readers",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/locks",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/locks/production",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/someName/providers/System.Authorization/locks/production",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/someName/providers/System.Authorization/locks/production",
+		},
+
 		// Resource groups
 		{
 			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationList},
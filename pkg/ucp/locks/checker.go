@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ controller.LockChecker = (*Checker)(nil)
+
+// Checker enforces the management locks stored in UCP.
+//
+// A lock applies to its scope and to all the resources below it:
+//
+//   - A CanNotDelete lock prevents the resources from being deleted.
+//   - A ReadOnly lock prevents the resources from being created, updated or deleted.
+//
+// Deleting a scope also deletes the resources below it, so a delete is rejected when any lock applies to the scope
+// or to one of the resources below it.
+type Checker struct {
+	databaseClient database.Client
+}
+
+// NewChecker creates a new Checker.
+func NewChecker(databaseClient database.Client) *Checker {
+	return &Checker{databaseClient: databaseClient}
+}
+
+// CheckLocks returns a 409 Conflict response if a lock prevents the HTTP method from being performed on the resource
+// with the given ID, or nil if the request is allowed.
+//
+// Locks are read from the index of locks by scope: one query for the plane of the resource and one for its resource
+// group, if any. Deleting a plane reads all the locks of the plane. Requests outside of a Radius plane and requests
+// that operate on locks themselves are always allowed, so that a lock can be removed.
+//
+// Only PUT, PATCH and DELETE requests are checked. POST requests, such as the listSecrets action, are always allowed,
+// including custom actions that delete or modify resources as a side effect. Locks do not protect resources from
+// such actions.
+func (c *Checker) CheckLocks(ctx context.Context, id resources.ID, method string) (rest.Response, error) {
+	if method != http.MethodPut && method != http.MethodPatch && method != http.MethodDelete {
+		return nil, nil
+	}
+
+	scopes := id.ScopeSegments()
+	if len(scopes) == 0 || !strings.EqualFold(scopes[0].Type, "radius") || scopes[0].Name == "" {
+		return nil, nil
+	}
+
+	if strings.EqualFold(id.Type(), datamodel.LockResourceType) {
+		return nil, nil
+	}
+
+	locks, err := c.listLocks(ctx, id, method)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lock := range locks {
+		if !lockPrevents(lock, id.String(), method) {
+			continue
+		}
+
+		operation := "modified"
+		if method == http.MethodDelete {
+			operation = "deleted"
+		}
+
+		message := fmt.Sprintf("%q cannot be %s because it is protected by the %s lock %q on scope %q. Remove the lock and try again.",
+			id.String(), operation, lock.Properties.Level, lock.ID, lock.Properties.Scope)
+		return &rest.ConflictResponse{
+			Body: v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeScopeLocked,
+					Message: message,
+					Target:  id.String(),
+				},
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// listLocks returns the locks that may prevent the HTTP method from being performed on the resource with the given ID:
+// the locks whose scope is in the plane or in the resource group of the resource. A lock on a resource can only
+// apply to the resources of the same resource group, or of the plane if the resource is not in a resource group.
+func (c *Checker) listLocks(ctx context.Context, id resources.ID, method string) ([]datamodel.Lock, error) {
+	plane := "/planes/radius/" + id.ScopeSegments()[0].Name
+	rootScope := id.RootScope()
+
+	// Deleting the plane deletes the resources of all its resource groups.
+	if method == http.MethodDelete && id.IsScope() && len(id.ScopeSegments()) == 1 {
+		return listIndexedLocks(ctx, c.databaseClient, plane, true)
+	}
+
+	locks, err := listIndexedLocks(ctx, c.databaseClient, plane, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(rootScope, plane) {
+		return locks, nil
+	}
+
+	groupLocks, err := listIndexedLocks(ctx, c.databaseClient, rootScope, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(locks, groupLocks...), nil
+}
+
+// lockPrevents returns true if the lock prevents the HTTP method from being performed on the resource with the given ID.
+func lockPrevents(lock datamodel.Lock, id string, method string) bool {
+	if method == http.MethodDelete {
+		return scopeContains(lock.Properties.Scope, id) || scopeContains(id, lock.Properties.Scope)
+	}
+
+	return strings.EqualFold(lock.Properties.Level, datamodel.LockLevelReadOnly) && scopeContains(lock.Properties.Scope, id)
+}
+
+// scopeContains returns true if the resource ID is the scope itself or is nested inside of the scope.
+func scopeContains(scope string, id string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	id = strings.ToLower(strings.TrimSuffix(id, "/"))
+	return id == scope || strings.HasPrefix(id, scope+"/")
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testLocksPath = "/planes/radius/local/providers/System.Authorization/locks/"
+
+func saveLock(t *testing.T, client database.Client, name string, level string, scope string) {
+	id := testLocksPath + name
+	lock := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Name: name, Type: datamodel.LockResourceType},
+		},
+		Properties: datamodel.LockProperties{Level: level, Scope: scope},
+	}
+
+	operations, err := IndexOperations(context.Background(), client, lock, nil)
+	require.NoError(t, err)
+	operations = append(operations, database.NewSaveOperation(&database.Object{Metadata: database.Metadata{ID: id}, Data: lock}))
+	require.NoError(t, client.ExecuteBatch(context.Background(), operations))
+}
+
+func Test_Checker_CheckLocks(t *testing.T) {
+	client := inmemory.NewClient()
+	saveLock(t, client, "production", datamodel.LockLevelCanNotDelete, "/planes/radius/local/resourceGroups/production")
+	saveLock(t, client, "frozen", datamodel.LockLevelReadOnly, "/planes/radius/local/resourceGroups/frozen")
+	saveLock(t, client, "env", datamodel.LockLevelCanNotDelete, "/planes/radius/local/resourceGroups/staging/providers/Applications.Core/environments/staging")
+
+	checker := NewChecker(client)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		locked   bool
+		lockedBy string
+	}{
+		{
+			name:     "delete resource group with lock",
+			method:   http.MethodDelete,
+			path:     "/planes/radius/local/resourceGroups/PRODUCTION",
+			locked:   true,
+			lockedBy: "production",
+		},
+		{
+			name:     "delete resource in locked resource group",
+			method:   http.MethodDelete,
+			path:     "/planes/radius/local/resourceGroups/production/providers/Applications.Core/containers/web",
+			locked:   true,
+			lockedBy: "production",
+		},
+		{
+			name:   "update resource in resource group with delete lock",
+			method: http.MethodPut,
+			path:   "/planes/radius/local/resourceGroups/production/providers/Applications.Core/containers/web",
+		},
+		{
+			name:     "update resource in read-only resource group",
+			method:   http.MethodPatch,
+			path:     "/planes/radius/local/resourceGroups/frozen/providers/Applications.Core/containers/web",
+			locked:   true,
+			lockedBy: "frozen",
+		},
+		{
+			name:     "delete resource group containing locked resource",
+			method:   http.MethodDelete,
+			path:     "/planes/radius/local/resourceGroups/staging",
+			locked:   true,
+			lockedBy: "env",
+		},
+		{
+			name:   "delete plane containing locks",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local",
+			locked: true,
+		},
+		{
+			name:   "delete unlocked resource next to locked resource",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local/resourceGroups/staging/providers/Applications.Core/environments/staging2",
+		},
+		{
+			name:   "delete resource group with similar name",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local/resourceGroups/production2",
+		},
+		{
+			name:   "delete lock",
+			method: http.MethodDelete,
+			path:   testLocksPath + "production",
+		},
+		{
+			name:   "read locked resource group",
+			method: http.MethodGet,
+			path:   "/planes/radius/local/resourceGroups/frozen",
+		},
+		{
+			name:   "other plane",
+			method: http.MethodDelete,
+			path:   "/planes/radius/other/resourceGroups/production",
+		},
+		{
+			name:   "outside of a radius plane",
+			method: http.MethodDelete,
+			path:   "/planes/aws/aws/accounts/0000/regions/us-west-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.ParseByMethod(tt.path, tt.method)
+			require.NoError(t, err)
+
+			response, err := checker.CheckLocks(context.Background(), id, tt.method)
+			require.NoError(t, err)
+
+			if !tt.locked {
+				require.Nil(t, response)
+				return
+			}
+
+			require.IsType(t, &rest.ConflictResponse{}, response)
+			conflict := response.(*rest.ConflictResponse)
+			require.Equal(t, v1.CodeScopeLocked, conflict.Body.Error.Code)
+			require.Equal(t, id.String(), conflict.Body.Error.Target)
+			require.Contains(t, conflict.Body.Error.Message, testLocksPath+tt.lockedBy)
+		})
+	}
+}
+
+func Test_Checker_CheckLocks_Message(t *testing.T) {
+	client := inmemory.NewClient()
+	saveLock(t, client, "production", datamodel.LockLevelCanNotDelete, "/planes/radius/local/resourceGroups/production")
+
+	id := resources.MustParse("/planes/radius/local/resourceGroups/production")
+	response, err := NewChecker(client).CheckLocks(context.Background(), id, http.MethodDelete)
+	require.NoError(t, err)
+
+	expected := &rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code: v1.CodeScopeLocked,
+				Message: `"/planes/radius/local/resourceGroups/production" cannot be deleted because it is protected by the CanNotDelete lock ` +
+					`"/planes/radius/local/providers/System.Authorization/locks/production" on scope "/planes/radius/local/resourceGroups/production". ` +
+					`Remove the lock and try again.`,
+				Target: "/planes/radius/local/resourceGroups/production",
+			},
+		},
+	}
+	require.Equal(t, expected, response)
+}
+
+func Test_Checker_CheckLocks_PlaneLock(t *testing.T) {
+	client := inmemory.NewClient()
+	saveLock(t, client, "plane", datamodel.LockLevelReadOnly, "/planes/radius/local")
+
+	id := resources.MustParse("/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/web")
+	response, err := NewChecker(client).CheckLocks(context.Background(), id, http.MethodPut)
+	require.NoError(t, err)
+	require.IsType(t, &rest.ConflictResponse{}, response)
+}
+
+func Test_Checker_CheckLocks_QueriesScopeLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		queries []database.Query
+	}{
+		{
+			name:   "resource in resource group",
+			method: http.MethodPut,
+			path:   "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/web",
+			queries: []database.Query{
+				{RootScope: "/planes/radius/local", ResourceType: IndexResourceType},
+				{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: IndexResourceType},
+			},
+		},
+		{
+			name:   "resource group",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local/resourceGroups/rg",
+			queries: []database.Query{
+				{RootScope: "/planes/radius/local", ResourceType: IndexResourceType},
+				{RootScope: "/planes/radius/local/resourceGroups/rg", ResourceType: IndexResourceType},
+			},
+		},
+		{
+			name:   "resource in plane",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test",
+			queries: []database.Query{
+				{RootScope: "/planes/radius/local", ResourceType: IndexResourceType},
+			},
+		},
+		{
+			name:   "plane",
+			method: http.MethodDelete,
+			path:   "/planes/radius/local",
+			queries: []database.Query{
+				{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: IndexResourceType},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			client := database.NewMockClient(mctrl)
+			for _, query := range tt.queries {
+				client.EXPECT().Query(gomock.Any(), query, gomock.Any()).Return(&database.ObjectQueryResult{}, nil)
+			}
+
+			id, err := resources.ParseByMethod(tt.path, tt.method)
+			require.NoError(t, err)
+
+			response, err := NewChecker(client).CheckLocks(context.Background(), id, tt.method)
+			require.NoError(t, err)
+			require.Nil(t, response)
+		})
+	}
+}
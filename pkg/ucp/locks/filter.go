@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ controller.UpdateFilter[datamodel.Lock] = ValidateLockScope
+
+// ValidateLockScope is an update filter that rejects a lock whose scope is outside of the Radius plane the lock is
+// created in. Locks are only enforced for the resources of their own plane, so such a lock would protect nothing.
+func ValidateLockScope(ctx context.Context, newResource *datamodel.Lock, oldResource *datamodel.Lock, options *controller.Options) (rest.Response, error) {
+	id, err := resources.ParseResource(newResource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.scope %q is not a valid resource ID", newResource.Properties.Scope)), nil
+	}
+
+	plane := "/planes/" + id.PlaneNamespace()
+	if !scopeContains(plane, scope.String()) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.scope must be inside of the plane %q of the lock, got %q", plane, newResource.Properties.Scope)), nil
+	}
+
+	return nil, nil
+}
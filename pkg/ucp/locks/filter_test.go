@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateLockScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+		err   string
+	}{
+		{
+			name:  "plane",
+			scope: "/planes/radius/local",
+		},
+		{
+			name:  "resource in plane",
+			scope: "/planes/radius/LOCAL/resourceGroups/production/providers/Applications.Core/environments/prod",
+		},
+		{
+			name:  "other plane",
+			scope: "/planes/radius/other/resourceGroups/production",
+			err:   `$.properties.scope must be inside of the plane "/planes/radius/local" of the lock, got "/planes/radius/other/resourceGroups/production"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lock := &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{ID: testLocksPath + "production"},
+				},
+				Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete, Scope: tt.scope},
+			}
+
+			response, err := ValidateLockScope(context.Background(), lock, nil, nil)
+			require.NoError(t, err)
+
+			if tt.err == "" {
+				require.Nil(t, response)
+			} else {
+				require.Equal(t, rest.NewBadRequestResponse(tt.err), response)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// IndexResourceType is the type of the entries of the index of locks by scope.
+//
+// Locks are stored in their plane whatever their scope, so each lock also has an index entry stored in the root scope
+// of its scope: the resource group that contains the scope, or the plane if the scope is not in a resource group.
+// The entry is a copy of the lock. The locks that apply to a resource are found by querying the index entries of the
+// plane and of the resource group of the resource, rather than all the locks of the plane.
+const IndexResourceType = "System.Authorization/lockIndex"
+
+// IndexEntryID returns the ID of the index entry of the lock.
+func IndexEntryID(lock *datamodel.Lock) (string, error) {
+	id, err := resources.ParseResource(lock.ID)
+	if err != nil {
+		return "", err
+	}
+
+	scope, err := resources.Parse(lock.Properties.Scope)
+	if err != nil {
+		return "", err
+	}
+
+	return scope.RootScope() + "/providers/" + IndexResourceType + "/" + id.Name(), nil
+}
+
+// IndexOperations returns the batch operations that update the index of locks by scope when a lock is saved or
+// deleted. newLock is nil when the lock is deleted, and oldLock is nil when the lock is created. The entry of the old
+// lock is deleted if it exists and the new lock does not use the same entry.
+func IndexOperations(ctx context.Context, client database.Client, newLock *datamodel.Lock, oldLock *datamodel.Lock) ([]database.BatchOperation, error) {
+	entryID := ""
+	if newLock != nil {
+		var err error
+		entryID, err = IndexEntryID(newLock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	operations := []database.BatchOperation{}
+	if oldLock != nil {
+		oldEntryID, err := IndexEntryID(oldLock)
+		if err != nil {
+			return nil, err
+		}
+
+		if oldEntryID != entryID {
+			_, err := client.Get(ctx, oldEntryID)
+			if err == nil {
+				operations = append(operations, database.NewDeleteOperation(oldEntryID))
+			} else if !errors.Is(err, &database.ErrNotFound{}) {
+				return nil, fmt.Errorf("failed to get lock index entry %q: %w", oldEntryID, err)
+			}
+		}
+	}
+
+	if newLock != nil {
+		operations = append(operations, database.NewSaveOperation(&database.Object{
+			Metadata: database.Metadata{ID: entryID},
+			Data:     newLock,
+		}))
+	}
+
+	return operations, nil
+}
+
+// listIndexedLocks returns the locks whose index entries are stored in the given root scope, including the nested
+// scopes if recursive is true.
+func listIndexedLocks(ctx context.Context, client database.Client, rootScope string, recursive bool) ([]datamodel.Lock, error) {
+	query := database.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: recursive,
+		ResourceType:   IndexResourceType,
+	}
+
+	locks := []datamodel.Lock{}
+	paginationToken := ""
+	for {
+		result, err := client.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query locks: %w", err)
+		}
+
+		for _, item := range result.Items {
+			lock := datamodel.Lock{}
+			if err := item.As(&lock); err != nil {
+				return nil, fmt.Errorf("failed to decode lock %q: %w", item.ID, err)
+			}
+			locks = append(locks, lock)
+		}
+
+		if result.PaginationToken == "" {
+			return locks, nil
+		}
+		paginationToken = result.PaginationToken
+	}
+}
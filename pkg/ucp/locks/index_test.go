@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLock(scope string) *datamodel.Lock {
+	return &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: testLocksPath + "lock", Name: "lock", Type: datamodel.LockResourceType},
+		},
+		Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete, Scope: scope},
+	}
+}
+
+func Test_IndexEntryID(t *testing.T) {
+	tests := []struct {
+		scope    string
+		expected string
+	}{
+		{"/planes/radius/local", "/planes/radius/local/providers/System.Authorization/lockIndex/lock"},
+		{"/planes/radius/local/resourceGroups/rg", "/planes/radius/local/resourceGroups/rg/providers/System.Authorization/lockIndex/lock"},
+		{"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/web", "/planes/radius/local/resourceGroups/rg/providers/System.Authorization/lockIndex/lock"},
+		{"/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test", "/planes/radius/local/providers/System.Authorization/lockIndex/lock"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			id, err := IndexEntryID(newTestLock(tt.scope))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, id)
+		})
+	}
+}
+
+func Test_IndexOperations(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+
+	lockA := newTestLock("/planes/radius/local/resourceGroups/a")
+	operations, err := IndexOperations(ctx, client, lockA, nil)
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	require.Equal(t, database.BatchOperationSave, operations[0].Kind)
+	require.Equal(t, "/planes/radius/local/resourceGroups/a/providers/System.Authorization/lockIndex/lock", operations[0].TargetID())
+	require.NoError(t, client.ExecuteBatch(ctx, operations))
+
+	t.Run("same root scope", func(t *testing.T) {
+		operations, err := IndexOperations(ctx, client, newTestLock("/planes/radius/local/resourceGroups/a/providers/Applications.Core/containers/web"), lockA)
+		require.NoError(t, err)
+		require.Len(t, operations, 1)
+		require.Equal(t, database.BatchOperationSave, operations[0].Kind)
+	})
+
+	t.Run("moved lock", func(t *testing.T) {
+		operations, err := IndexOperations(ctx, client, newTestLock("/planes/radius/local/resourceGroups/b"), lockA)
+		require.NoError(t, err)
+		require.Len(t, operations, 2)
+		require.Equal(t, database.BatchOperationDelete, operations[0].Kind)
+		require.Equal(t, "/planes/radius/local/resourceGroups/a/providers/System.Authorization/lockIndex/lock", operations[0].TargetID())
+		require.Equal(t, database.BatchOperationSave, operations[1].Kind)
+		require.Equal(t, "/planes/radius/local/resourceGroups/b/providers/System.Authorization/lockIndex/lock", operations[1].TargetID())
+	})
+
+	t.Run("deleted lock", func(t *testing.T) {
+		operations, err := IndexOperations(ctx, client, nil, lockA)
+		require.NoError(t, err)
+		require.Len(t, operations, 1)
+		require.Equal(t, database.BatchOperationDelete, operations[0].Kind)
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		operations, err := IndexOperations(ctx, client, nil, newTestLock("/planes/radius/local/resourceGroups/c"))
+		require.NoError(t, err)
+		require.Empty(t, operations)
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/components/audit"
+	"github.com/radius-project/radius/pkg/components/audit/auditprovider"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
@@ -38,6 +40,9 @@ import (
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// AuditSink is the sink audit records are written to. It is nil when auditing is disabled.
+	AuditSink audit.Sink
+
 	// Config is the configuration for the server.
 	Config *Config
 
@@ -89,6 +94,11 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 
 	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
 
+	options.AuditSink, err = auditprovider.NewSink(ctx, config.Audit, databaseClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit sink: %w", err)
+	}
+
 	options.SpecLoader, err = validator.LoadSpec(ctx, "ucp", swagger.SpecFilesUCP, []string{config.Server.PathBase}, "")
 	if err != nil {
 		return nil, err
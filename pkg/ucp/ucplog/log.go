@@ -173,6 +173,17 @@ func Unwrap(logger logr.Logger) *zap.Logger {
 	return nil
 }
 
+// Warn logs a message at the warning level if the logger is backed by zap. logr has no warning level, so the message is
+// logged at the info level otherwise.
+func Warn(logger logr.Logger, msg string, keysAndValues ...any) {
+	if zapLogger := Unwrap(logger); zapLogger != nil {
+		zapLogger.WithOptions(zap.AddCallerSkip(1)).Sugar().Warnw(msg, keysAndValues...)
+		return
+	}
+
+	logger.Info(msg, keysAndValues...)
+}
+
 // FromContextOrDiscard returns a logger with trace and span IDs populated from the context if they exist.
 // In order to get logger without span, use logr.FromContextOrDiscard(ctx context.Context).
 func FromContextOrDiscard(ctx context.Context) logr.Logger {
@@ -83,6 +83,15 @@ func Test_LogConstants(t *testing.T) {
 	}
 }
 
+func Test_Warn(t *testing.T) {
+	sink := &testCore{DesiredLevel: zapcore.InfoLevel}
+	Warn(zapr.NewLogger(zap.New(sink)), "Warning")
+
+	require.Len(t, sink.Writes, 1)
+	require.Equal(t, zapcore.WarnLevel, sink.Writes[0].Level)
+	require.Equal(t, "Warning", sink.Writes[0].Message)
+}
+
 var _ zapcore.Core = (*testCore)(nil)
 
 type testCore struct {
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package graphstoretest contains SHARED conformance tests for the
+// persistence.Store implementations in /pkg/graph/persistence.
+package graphstoretest
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/graph/persistence"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	FrontendID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/frontend"
+	BackendID  = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/backend"
+	DatabaseID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Datastores/redisCaches/db"
+)
+
+// NewTestGraph returns a three-tier graph (frontend -> backend -> database)
+// with connections recorded in both directions, an output resource and diff
+// hashes, so that round-trip tests exercise every field of the model.
+func NewTestGraph() *corerpv20250801preview.ApplicationGraphResponse {
+	outbound := to.Ptr(corerpv20250801preview.DirectionOutbound)
+	inbound := to.Ptr(corerpv20250801preview.DirectionInbound)
+	return &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(FrontendID),
+				Name:              to.Ptr("frontend"),
+				Type:              to.Ptr("Applications.Core/containers"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				DiffHash:          to.Ptr("sha256:frontend"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(BackendID), Direction: outbound},
+				},
+				OutputResources: []*corerpv20250801preview.ApplicationGraphOutputResource{
+					{ID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend"), Name: to.Ptr("frontend"), Type: to.Ptr("apps/Deployment")},
+				},
+			},
+			{
+				ID:                to.Ptr(BackendID),
+				Name:              to.Ptr("backend"),
+				Type:              to.Ptr("Applications.Core/containers"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				DiffHash:          to.Ptr("sha256:backend"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(DatabaseID), Direction: outbound},
+					{ID: to.Ptr(FrontendID), Direction: inbound},
+				},
+				OutputResources: []*corerpv20250801preview.ApplicationGraphOutputResource{},
+			},
+			{
+				ID:                to.Ptr(DatabaseID),
+				Name:              to.Ptr("db"),
+				Type:              to.Ptr("Applications.Datastores/redisCaches"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(BackendID), Direction: inbound},
+				},
+				OutputResources: []*corerpv20250801preview.ApplicationGraphOutputResource{},
+			},
+		},
+	}
+}
+
+// RunTest tests the Store's Save, Load, List and Delete methods. Every
+// persistence.Store implementation must pass these tests so that callers can
+// switch backends without code changes. clear is called before each subtest
+// and must remove every graph from store.
+func RunTest(t *testing.T, store persistence.Store, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	t.Run("save_rejects_nil_graph", func(t *testing.T) {
+		clear(t)
+
+		err := store.Save(ctx, persistence.Key{Namespace: "ns", Name: "n"}, nil, persistence.SaveOptions{})
+		require.Error(t, err)
+	})
+
+	t.Run("save_rejects_invalid_key", func(t *testing.T) {
+		clear(t)
+
+		keys := []persistence.Key{
+			{Name: "n"},
+			{Namespace: "ns"},
+			{Namespace: "..", Name: "n"},
+			{Namespace: "ns", Name: "a/b"},
+			{Namespace: `a\b`, Name: "n"},
+			{Namespace: "ns", Name: "a\x00b"},
+		}
+		for _, key := range keys {
+			err := store.Save(ctx, key, &corerpv20250801preview.ApplicationGraphResponse{}, persistence.SaveOptions{})
+			require.Error(t, err, "key %+v", key)
+		}
+	})
+
+	t.Run("save_load_delete_round_trip", func(t *testing.T) {
+		clear(t)
+
+		key := persistence.Key{Namespace: "main", Name: "app"}
+		graph := NewTestGraph()
+		require.NoError(t, store.Save(ctx, key, graph, persistence.SaveOptions{Message: "test save"}))
+
+		got, err := store.Load(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, graph, got)
+
+		require.NoError(t, store.Delete(ctx, key))
+
+		_, err = store.Load(ctx, key)
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("save_replaces_existing_graph", func(t *testing.T) {
+		clear(t)
+
+		key := persistence.Key{Namespace: "main", Name: "app"}
+		require.NoError(t, store.Save(ctx, key, NewTestGraph(), persistence.SaveOptions{}))
+
+		updated := NewTestGraph()
+		updated.Resources = updated.Resources[:1]
+		updated.Resources[0].Connections = []*corerpv20250801preview.ApplicationGraphConnection{}
+		require.NoError(t, store.Save(ctx, key, updated, persistence.SaveOptions{}))
+
+		got, err := store.Load(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+	})
+
+	t.Run("save_is_idempotent", func(t *testing.T) {
+		clear(t)
+
+		key := persistence.Key{Namespace: "main", Name: "app"}
+		require.NoError(t, store.Save(ctx, key, NewTestGraph(), persistence.SaveOptions{}))
+		require.NoError(t, store.Save(ctx, key, NewTestGraph(), persistence.SaveOptions{}))
+
+		got, err := store.Load(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, NewTestGraph(), got)
+	})
+
+	t.Run("load_not_found", func(t *testing.T) {
+		clear(t)
+
+		_, err := store.Load(ctx, persistence.Key{Namespace: "ns", Name: "missing"})
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("delete_not_found", func(t *testing.T) {
+		clear(t)
+
+		err := store.Delete(ctx, persistence.Key{Namespace: "ns", Name: "missing"})
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		clear(t)
+
+		keys := []persistence.Key{
+			{Namespace: "main", Name: "app"},
+			{Namespace: "main", Name: "other"},
+			{Namespace: "feature", Name: "other"},
+		}
+		for _, k := range keys {
+			require.NoError(t, store.Save(ctx, k, &corerpv20250801preview.ApplicationGraphResponse{}, persistence.SaveOptions{}))
+		}
+
+		got, err := store.List(ctx, "main")
+		require.NoError(t, err)
+		sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
+		require.Equal(t, []persistence.Key{
+			{Namespace: "main", Name: "app"},
+			{Namespace: "main", Name: "other"},
+		}, got)
+
+		all, err := store.List(ctx, "")
+		require.NoError(t, err)
+		require.ElementsMatch(t, keys, all)
+	})
+
+	t.Run("list_missing_namespace_returns_empty", func(t *testing.T) {
+		clear(t)
+
+		got, err := store.List(ctx, "does-not-exist")
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("list_rejects_invalid_namespace", func(t *testing.T) {
+		clear(t)
+
+		for _, namespace := range []string{"..", ".", "a/b", `a\b`, "a\x00b"} {
+			got, err := store.List(ctx, namespace)
+			require.Error(t, err, "namespace %q", namespace)
+			require.Nil(t, got)
+		}
+	})
+}
+
+// WorkerID is the ID of the resource in the graph returned by
+// NewTestWorkerGraph.
+const WorkerID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/worker"
+
+// EnvironmentID is the environment the worker graph is deployed to in
+// RunQueryTest.
+const EnvironmentID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/default"
+
+// NewTestWorkerGraph returns a graph in which a worker connects to the
+// database of NewTestGraph. Only the outbound side of the connection is
+// recorded.
+func NewTestWorkerGraph() *corerpv20250801preview.ApplicationGraphResponse {
+	return &corerpv20250801preview.ApplicationGraphResponse{
+		Resources: []*corerpv20250801preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(WorkerID),
+				Name:              to.Ptr("worker"),
+				Type:              to.Ptr("Applications.Core/containers"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				Connections: []*corerpv20250801preview.ApplicationGraphConnection{
+					{ID: to.Ptr(DatabaseID), Direction: to.Ptr(corerpv20250801preview.DirectionOutbound)},
+				},
+				OutputResources: []*corerpv20250801preview.ApplicationGraphOutputResource{},
+			},
+		},
+	}
+}
+
+// QueryStore is a persistence.Store that also implements persistence.Querier.
+type QueryStore interface {
+	persistence.Store
+	persistence.Querier
+}
+
+// RunQueryTest tests the Querier's Neighbors, Reachable and BlastRadius
+// methods against graphs saved through the Store. clear is called before the
+// graphs are saved and must remove every graph from store.
+func RunQueryTest(t *testing.T, store QueryStore, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	clear(t)
+	appKey := persistence.Key{Namespace: "main", Name: "app"}
+	workerKey := persistence.Key{Namespace: "main", Name: "worker"}
+	require.NoError(t, store.Save(ctx, appKey, NewTestGraph(), persistence.SaveOptions{}))
+	require.NoError(t, store.Save(ctx, workerKey, NewTestWorkerGraph(), persistence.SaveOptions{
+		Labels: map[string]string{persistence.EnvironmentLabel: EnvironmentID},
+	}))
+
+	// A graph in another namespace that depends on the frontend must not
+	// leak into queries for "main".
+	other := NewTestWorkerGraph()
+	other.Resources[0].Connections[0].ID = to.Ptr(FrontendID)
+	require.NoError(t, store.Save(ctx, persistence.Key{Namespace: "feature", Name: "worker"}, other, persistence.SaveOptions{}))
+
+	ids := func(refs []persistence.ResourceRef) []string {
+		result := []string{}
+		for _, ref := range refs {
+			result = append(result, ref.ID)
+		}
+		return result
+	}
+
+	t.Run("neighbors_upstream", func(t *testing.T) {
+		got, err := store.Neighbors(ctx, "main", DatabaseID, persistence.Upstream)
+		require.NoError(t, err)
+		require.Equal(t, []string{BackendID, WorkerID}, ids(got))
+		for _, ref := range got {
+			require.Equal(t, 1, ref.Depth)
+		}
+	})
+
+	t.Run("neighbors_downstream", func(t *testing.T) {
+		got, err := store.Neighbors(ctx, "main", BackendID, persistence.Downstream)
+		require.NoError(t, err)
+		require.Equal(t, []string{DatabaseID}, ids(got))
+		require.Equal(t, "db", got[0].Name)
+		require.Equal(t, "Applications.Datastores/redisCaches", got[0].Type)
+		require.Equal(t, []persistence.Key{appKey}, got[0].Graphs)
+	})
+
+	t.Run("reachable_upstream", func(t *testing.T) {
+		got, err := store.Reachable(ctx, "main", DatabaseID, persistence.Upstream)
+		require.NoError(t, err)
+		require.Equal(t, []string{BackendID, WorkerID, FrontendID}, ids(got))
+		require.Equal(t, 2, got[2].Depth)
+	})
+
+	t.Run("reachable_downstream", func(t *testing.T) {
+		got, err := store.Reachable(ctx, "main", FrontendID, persistence.Downstream)
+		require.NoError(t, err)
+		require.Equal(t, []string{BackendID, DatabaseID}, ids(got))
+	})
+
+	t.Run("reachable_is_case_insensitive", func(t *testing.T) {
+		got, err := store.Reachable(ctx, "main", strings.ToUpper(DatabaseID), persistence.Upstream)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+	})
+
+	t.Run("reachable_all_namespaces", func(t *testing.T) {
+		got, err := store.Reachable(ctx, "", FrontendID, persistence.Upstream)
+		require.NoError(t, err)
+		require.Equal(t, []string{WorkerID}, ids(got))
+		require.ElementsMatch(t, []persistence.Key{workerKey, {Namespace: "feature", Name: "worker"}}, got[0].Graphs)
+	})
+
+	t.Run("blast_radius", func(t *testing.T) {
+		got, err := store.BlastRadius(ctx, "main", DatabaseID)
+		require.NoError(t, err)
+		require.Equal(t, DatabaseID, got.Root.ID)
+		require.Equal(t, []string{BackendID, WorkerID, FrontendID}, ids(got.Affected))
+		require.Equal(t, []persistence.Key{appKey, workerKey}, got.Graphs)
+		require.Equal(t, []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend"}, got.OutputResources)
+	})
+
+	t.Run("blast_radius_leaf", func(t *testing.T) {
+		got, err := store.BlastRadius(ctx, "main", WorkerID)
+		require.NoError(t, err)
+		require.Empty(t, got.Affected)
+		require.Equal(t, []persistence.Key{workerKey}, got.Graphs)
+	})
+
+	t.Run("blast_radius_environment", func(t *testing.T) {
+		got, err := store.BlastRadius(ctx, "main", EnvironmentID)
+		require.NoError(t, err)
+		require.Equal(t, "default", got.Root.Name)
+		require.Equal(t, "Applications.Core/environments", got.Root.Type)
+		require.Equal(t, []string{WorkerID}, ids(got.Affected))
+		require.Equal(t, []persistence.Key{workerKey}, got.Graphs)
+	})
+
+	t.Run("environment_not_deployed", func(t *testing.T) {
+		_, err := store.BlastRadius(ctx, "feature", EnvironmentID)
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		_, err := store.Reachable(ctx, "main", "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/missing", persistence.Upstream)
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+
+		_, err = store.BlastRadius(ctx, "does-not-exist", DatabaseID)
+		require.ErrorIs(t, err, persistence.ErrNotFound)
+	})
+
+	t.Run("invalid_direction", func(t *testing.T) {
+		_, err := store.Neighbors(ctx, "main", DatabaseID, persistence.TraversalDirection("Sideways"))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid_namespace", func(t *testing.T) {
+		_, err := store.Reachable(ctx, "a/b", DatabaseID, persistence.Upstream)
+		require.Error(t, err)
+	})
+}
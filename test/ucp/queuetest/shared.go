@@ -35,6 +35,8 @@ const (
 	pollingInterval = time.Duration(100) * time.Millisecond
 
 	defaultTestDequeueInterval = time.Duration(5) * time.Millisecond
+
+	notifyTimeout = time.Duration(10) * time.Second
 )
 
 type testQueueMessage struct {
@@ -240,3 +242,202 @@ func RunTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
 		require.Equal(t, msgCount, recvCnt)
 	})
 }
+
+// RunDeadLetterTest tests the client's DeadLetterClient methods by dead-lettering, replaying and purging messages.
+func RunDeadLetterTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	dlc, ok := cli.(queue.DeadLetterClient)
+	require.True(t, ok, "client must implement queue.DeadLetterClient")
+
+	info := queue.DeadLetterInfo{
+		Reason: queue.DeadLetterReasonMaxDequeueCountExceeded,
+		Error:  "operation failed",
+		Stack:  "goroutine 1 [running]:",
+	}
+
+	t.Run("nil message", func(t *testing.T) {
+		err := dlc.DeadLetterMessage(ctx, nil, info)
+		require.ErrorIs(t, err, queue.ErrEmptyMessage)
+	})
+
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dlc.DeadLetterMessage(ctx, msg, info)
+		require.NoError(t, err)
+
+		// Wait until the lease would have expired to make sure the message is not requeued.
+		time.Sleep(TestMessageLockTime + pollingInterval)
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		letters, err := dlc.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, letters, 1)
+		require.Equal(t, msg.ID, letters[0].ID)
+		require.Equal(t, info, letters[0].DeadLetterInfo)
+		require.Equal(t, 1, letters[0].DequeueCount)
+		require.False(t, letters[0].DeadLetteredAt.IsZero())
+
+		expected := &testQueueMessage{}
+		err = json.Unmarshal(msg.Data, expected)
+		require.NoError(t, err)
+		actual := &testQueueMessage{}
+		err = json.Unmarshal(letters[0].Data, actual)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		letter, err := dlc.GetDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, letters[0], letter)
+	})
+
+	t.Run("dead-letter message leased by another client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		// Keep a copy of the expired lease since clients may update the dequeued message in place.
+		stale := *msg1
+
+		// Dequeue until message is requeued.
+		for {
+			_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+
+		err = dlc.DeadLetterMessage(ctx, &stale, info)
+		require.Error(t, err)
+
+		letters, err := dlc.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, letters)
+	})
+
+	t.Run("replay dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = dlc.DeadLetterMessage(ctx, msg, info)
+		require.NoError(t, err)
+
+		err = dlc.ReplayDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		replayed, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.ID, replayed.ID)
+		require.Equal(t, 1, replayed.DequeueCount)
+		require.NoError(t, cli.FinishMessage(ctx, replayed))
+
+		_, err = dlc.GetDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+		err = dlc.ReplayDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+	})
+
+	t.Run("purge dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = dlc.DeadLetterMessage(ctx, msg, info)
+		require.NoError(t, err)
+
+		err = dlc.PurgeDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		letters, err := dlc.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, letters)
+		err = dlc.PurgeDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+	})
+}
+
+// RunNotifyTest tests the client's Notifier implementation by checking that enqueued messages are notified and that
+// StartDequeuer picks them up without waiting for the next poll.
+func RunNotifyTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
+	notifier, ok := cli.(queue.Notifier)
+	require.True(t, ok, "client must implement queue.Notifier")
+
+	t.Run("enqueue notifies", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		notifyCh, err := notifier.Notify(ctx)
+		require.NoError(t, err)
+
+		err = queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			select {
+			case <-notifyCh:
+				return true
+			default:
+				return false
+			}
+		}, notifyTimeout, pollingInterval)
+
+		// The channel is closed once ctx is done.
+		cancel()
+		require.Eventually(t, func() bool {
+			for {
+				select {
+				case _, ok := <-notifyCh:
+					if !ok {
+						return true
+					}
+				default:
+					return false
+				}
+			}
+		}, notifyTimeout, pollingInterval)
+	})
+
+	t.Run("StartDequeuer wakes up on notification", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		t.Cleanup(cancel)
+
+		// Use intervals much longer than the test so that the message can only be dequeued through a notification.
+		msgCh, err := queue.StartDequeuer(ctx, cli, queue.WithDequeueInterval(time.Hour), queue.WithNotifyFallbackInterval(time.Hour))
+		require.NoError(t, err)
+
+		// Let the initial dequeue find the queue empty.
+		time.Sleep(pollingInterval)
+
+		err = queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		select {
+		case msg := <-msgCh:
+			require.Equal(t, 1, msg.DequeueCount)
+		case <-time.After(notifyTimeout):
+			require.Fail(t, "message was not dequeued after notification")
+		}
+	})
+}
@@ -20,6 +20,7 @@ package storetest
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -48,6 +49,9 @@ const (
 	ARMResourceScope    = "/subscriptions/abc/resourceGroups/group3"
 )
 
+// changesTimeout is the time to wait for a change to be reported.
+const changesTimeout = 10 * time.Second
+
 var ResourceGroup1ID = parseOrPanic(ResourceGroup1Scope)
 var ResourceGroup2ID = parseOrPanic(ResourceGroup2Scope)
 var Resource1ID = parseOrPanic(ResourceGroup1Scope + "/providers/" + ResourcePath1)
@@ -377,6 +381,97 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, &database.ErrConcurrency{})
 	})
 
+	t.Run("batch_save_and_delete", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj1.Data = Data2
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.ExecuteBatch(ctx, []database.BatchOperation{
+			database.NewSaveOperation(&obj1, database.WithETag(obj1.ETag)),
+			database.NewSaveOperation(&obj2),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, obj2.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		obj2Get, err := client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+
+		err = client.ExecuteBatch(ctx, []database.BatchOperation{
+			database.NewDeleteOperation(Resource1ID.String()),
+			database.NewDeleteOperation(Resource2ID.String(), database.WithETag(obj2.ETag)),
+		})
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource1ID.String()})
+		_, err = client.Get(ctx, Resource2ID.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource2ID.String()})
+	})
+
+	t.Run("batch_is_not_applied_with_not_matching_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		// The first operation succeeds on its own but must not be applied because the second one fails.
+		obj1Update := createObject(Resource1ID, Data3)
+		obj3 := createObject(Resource3ID, Data3)
+		err = client.ExecuteBatch(ctx, []database.BatchOperation{
+			database.NewSaveOperation(&obj1Update, database.WithETag(obj1.ETag)),
+			database.NewSaveOperation(&obj3),
+			database.NewDeleteOperation(Resource2ID.String(), database.WithETag(etag.New(MarshalOrPanic(Data3)))),
+		})
+		require.ErrorIs(t, err, &database.ErrConcurrency{})
+		require.Empty(t, obj1Update.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		obj2Get, err := client.Get(ctx, Resource2ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+
+		_, err = client.Get(ctx, Resource3ID.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource3ID.String()})
+	})
+
+	t.Run("batch_is_not_applied_with_missing_resource", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.ExecuteBatch(ctx, []database.BatchOperation{
+			database.NewSaveOperation(&obj1),
+			database.NewDeleteOperation(Resource2ID.String()),
+		})
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource2ID.String()})
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: Resource1ID.String()})
+	})
+
+	t.Run("batch_rejects_invalid_operation", func(t *testing.T) {
+		clear(t)
+
+		err := client.ExecuteBatch(ctx, []database.BatchOperation{{Kind: database.BatchOperationSave}})
+		require.ErrorIs(t, err, &database.ErrInvalid{})
+	})
+
 	t.Run("list_can_be_empty", func(t *testing.T) {
 		clear(t)
 
@@ -550,3 +645,83 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 		})
 	})
 }
+
+// RunChangesTest tests that the database Client reports the changes made by Save, Delete and ExecuteBatch through
+// database.ChangeSource.
+func RunChangesTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
+	source, ok := client.(database.ChangeSource)
+	require.True(t, ok, "client must implement database.ChangeSource")
+
+	receive := func(t *testing.T, changes <-chan database.Event) database.Event {
+		t.Helper()
+
+		select {
+		case e, ok := <-changes:
+			require.True(t, ok, "the changes were closed")
+			return e
+		case <-time.After(changesTimeout):
+			require.Fail(t, "timed out waiting for a change")
+			return database.Event{}
+		}
+	}
+
+	t.Run("changes_report_save_and_delete", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		t.Cleanup(cancel)
+
+		changes, err := source.Changes(ctx)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err = client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		e := receive(t, changes)
+		require.Equal(t, database.EventTypeSaved, e.Type)
+		require.Equal(t, Resource1ID.String(), e.ID)
+		compareObjects(t, &obj1, e.Object)
+
+		err = client.Delete(ctx, Resource1ID.String())
+		require.NoError(t, err)
+
+		e = receive(t, changes)
+		require.Equal(t, database.EventTypeDeleted, e.Type)
+		require.Equal(t, Resource1ID.String(), e.ID)
+	})
+
+	t.Run("changes_report_batch", func(t *testing.T) {
+		clear(t)
+
+		ctx, cancel := testcontext.NewWithCancel(t)
+		t.Cleanup(cancel)
+
+		changes, err := source.Changes(ctx)
+		require.NoError(t, err)
+
+		obj1 := createObject(Resource1ID, Data1)
+		obj2 := createObject(Resource2ID, Data2)
+		err = client.ExecuteBatch(ctx, []database.BatchOperation{
+			database.NewSaveOperation(&obj1),
+			database.NewSaveOperation(&obj2),
+		})
+		require.NoError(t, err)
+
+		ids := []string{receive(t, changes).ID, receive(t, changes).ID}
+		require.ElementsMatch(t, []string{Resource1ID.String(), Resource2ID.String()}, ids)
+	})
+
+	t.Run("changes_closed_when_context_done", func(t *testing.T) {
+		ctx, cancel := testcontext.NewWithCancel(t)
+
+		changes, err := source.Changes(ctx)
+		require.NoError(t, err)
+
+		cancel()
+		require.Eventually(t, func() bool {
+			_, ok := <-changes
+			return !ok
+		}, changesTimeout, 10*time.Millisecond)
+	})
+}